@@ -40,8 +40,83 @@ func run() error {
 	// Garante que a conexão será fechada ao final
 	defer dbConn.Close()
 
+	// Barramento de eventos em tempo real, compartilhado entre as requisições e as rotinas
+	barramento, err := router.InicializarBarramentoEventos(cfg)
+	if err != nil {
+		return fmt.Errorf("[main.run]: %w", err)
+	}
+
+	// Monta os casos de uso, compartilhados entre o router, as rotinas e a leitura de e-mails
+	casos, err := router.InicializarCasosDeUso(cfg, dbConn, barramento)
+	if err != nil {
+		return fmt.Errorf("[main.run]: %w", err)
+	}
+
 	// Monta o router
-	r := router.InicializarRoteadorHTTP(cfg, dbConn)
+	r, err := router.InicializarRoteadorHTTP(cfg, dbConn, casos)
+	if err != nil {
+		return fmt.Errorf("[main.run]: %w", err)
+	}
+
+	// Inicia as rotinas automáticas em segundo plano
+	executor, err := router.InicializarJobs(cfg, casos)
+	if err != nil {
+		return fmt.Errorf("[main.run]: %w", err)
+	}
+	ctxJobs, cancelarJobs := context.WithCancel(context.Background())
+	defer cancelarJobs()
+	jobsEncerrados := make(chan struct{})
+	go func() {
+		defer close(jobsEncerrados)
+		executor.Iniciar(ctxJobs)
+	}()
+
+	// Inicia o envio dos e-mails das notificações em segundo plano, quando configurado
+	notificacoes, err := router.InicializarNotificacoes(cfg, dbConn)
+	if err != nil {
+		return fmt.Errorf("[main.run]: %w", err)
+	}
+	notificacoesEncerradas := make(chan struct{})
+	go func() {
+		defer close(notificacoesEncerradas)
+		notificacoes.Iniciar(ctxJobs)
+	}()
+
+	// Inicia a leitura da caixa de entrada de e-mails em segundo plano, quando configurada
+	entradaEmail, err := router.InicializarEntradaEmail(cfg, dbConn, casos)
+	if err != nil {
+		return fmt.Errorf("[main.run]: %w", err)
+	}
+	entradaEmailEncerrada := make(chan struct{})
+	go func() {
+		defer close(entradaEmailEncerrada)
+		if entradaEmail != nil {
+			entradaEmail.Iniciar(ctxJobs)
+		}
+	}()
+
+	// Inicia o envio das entregas dos webhooks em segundo plano
+	webhooks, err := router.InicializarWebhooks(cfg, dbConn)
+	if err != nil {
+		return fmt.Errorf("[main.run]: %w", err)
+	}
+	webhooksEncerrados := make(chan struct{})
+	go func() {
+		defer close(webhooksEncerrados)
+		webhooks.Iniciar(ctxJobs)
+	}()
+
+	// Inicia o despacho dos logs e eventos gravados na outbox em segundo plano, que inclui as
+	// notificações na central e as entregas na fila dos webhooks
+	despachoOutbox, err := router.InicializarOutbox(cfg, dbConn, barramento, webhooks, notificacoes)
+	if err != nil {
+		return fmt.Errorf("[main.run]: %w", err)
+	}
+	outboxEncerrada := make(chan struct{})
+	go func() {
+		defer close(outboxEncerrada)
+		despachoOutbox.Iniciar(ctxJobs)
+	}()
 
 	// Cria o servidor HTTP
 	srv := &http.Server{
@@ -71,5 +146,14 @@ func run() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// Encerra as rotinas, as notificações, a leitura de e-mails, os webhooks e a outbox antes de fechar a conexão com o banco de dados
+	cancelarJobs()
+	for _, encerrado := range []chan struct{}{jobsEncerrados, notificacoesEncerradas, entradaEmailEncerrada, webhooksEncerrados, outboxEncerrada} {
+		select {
+		case <-encerrado:
+		case <-ctx.Done():
+		}
+	}
+
 	return srv.Shutdown(ctx)
 }
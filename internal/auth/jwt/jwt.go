@@ -27,6 +27,9 @@ type JWTUsecase interface {
 
 	// ValidarToken valida um token de acesso
 	ValidarToken(token string) (*Claims, error)
+
+	// ValidadeRefresh retorna o tempo de vida dos tokens de refresh
+	ValidadeRefresh() time.Duration
 }
 
 // GerenteJWT gerencia a criação e validação de tokens JWT
@@ -54,9 +57,29 @@ type Claims struct {
 	Nome      string `json:"nome"`
 	Email     string `json:"email"`
 	Permissao string `json:"permissao"`
+	Papel     string `json:"papel,omitempty"` // papel que define as permissões nomeadas; vazio nos tokens antigos
+	Sessao    string `json:"sid,omitempty"`   // sessão aberta no login; vazia nos tokens antigos
 	goJwt.RegisteredClaims
 }
 
+// JTI retorna o identificador do token, usado na revogação
+func (c *Claims) JTI() string {
+	return c.RegisteredClaims.ID
+}
+
+// EmitidoEm retorna o instante de emissão do token; nil nos tokens emitidos sem o iat
+func (c *Claims) EmitidoEm() *time.Time {
+	if c.IssuedAt == nil {
+		return nil
+	}
+	return &c.IssuedAt.Time
+}
+
+// ValidadeRefresh retorna o tempo de vida dos tokens de refresh
+func (g *GerenteJWT) ValidadeRefresh() time.Duration {
+	return g.TLLRefresh
+}
+
 // GerarToken gera um token de acesso
 func (g *GerenteJWT) GerarToken(c Claims) (string, error) {
 	tokenGerado, err := g.gerarJWT(c, g.ChaveAcesso, g.TLLAcesso)
@@ -93,9 +116,21 @@ func (g *GerenteJWT) ValidarRefreshToken(token string) (*Claims, error) {
 	return claimsValidadas, nil
 }
 
-// gerarJWT é uma função helper interna para gerar token
+// gerarJWT é uma função helper interna para gerar token. O token usa o jti informado nas
+// claims, como o do token de refresh registrado na sessão, ou recebe um jti próprio, que
+// identifica o token na revogação
 func (g *GerenteJWT) gerarJWT(c Claims, secret []byte, ttl time.Duration) (string, error) {
-	c.RegisteredClaims.ExpiresAt = goJwt.NewNumericDate(time.Now().Add(ttl))
+	if c.RegisteredClaims.ID == "" {
+		jti, err := utils.NewUUIDv7String()
+		if err != nil {
+			return "", fmt.Errorf("[jwt.gerarJWT]: %w", err)
+		}
+		c.RegisteredClaims.ID = jti
+	}
+
+	agora := time.Now()
+	c.RegisteredClaims.IssuedAt = goJwt.NewNumericDate(agora)
+	c.RegisteredClaims.ExpiresAt = goJwt.NewNumericDate(agora.Add(ttl))
 	tokenJWT := goJwt.NewWithClaims(goJwt.SigningMethodHS256, c)
 
 	jwtString, err := tokenJWT.SignedString(secret)
@@ -1,15 +1,16 @@
 package middleware
 
 import (
+	"log"
 	"net/http"
-	"slices"
-	"strings"
 
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
 )
 
-// RequerPermissoes libera se o usuário tiver QUALQUER uma das permissões
-func RequerPermissoes(perms ...string) func(http.Handler) http.Handler {
+// RequerPermissao libera se o papel do usuário conceder a permissão nomeada
+func RequerPermissao(permissao model.PermissaoNomeada, papeis usecase.VerificarPermissaoPapel) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -20,11 +21,18 @@ func RequerPermissoes(perms ...string) func(http.Handler) http.Handler {
 				return
 			}
 
-			// Normaliza a permissão do usuário
-			permissaoUsuario := strings.ToLower(strings.TrimSpace(claims.Permissao))
-			ok := slices.ContainsFunc(perms, func(p string) bool {
-				return permissaoUsuario == strings.ToLower(strings.TrimSpace(p))
-			})
+			// Tokens emitidos antes dos papéis não trazem o papel; vale o papel do sistema da permissão
+			papel := claims.Papel
+			if papel == "" {
+				papel = claims.Permissao
+			}
+
+			ok, err := papeis.PapelPossuiPermissao(r.Context(), papel, permissao)
+			if err != nil {
+				log.Printf("[middleware.RequerPermissao]: %v", err)
+				response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao verificar as permissões do usuário", err.Error())
+				return
+			}
 
 			if !ok {
 				response.ErrorJSON(w, http.StatusForbidden, "forbidden", "Você não tem permissão para acessar este recurso")
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+// RejeitarTokenRevogado recusa os tokens revogados no logout, no encerramento da sessão, na
+// desativação do usuário ou na alteração da sua permissão. Deve ser aplicado depois de
+// AutenticarUsuario.
+func RejeitarTokenRevogado(next http.Handler, revogacoes usecase.VerificarRevogacaoToken) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Obtém as claims do usuário
+		claims := UsuarioFromCtx(r)
+		if claims == nil {
+			response.ErrorJSON(w, http.StatusUnauthorized, mensagemNaoAutorizado, ErrUsuarioNaoAutenticado.Error())
+			return
+		}
+
+		revogado, err := revogacoes.TokenRevogado(r.Context(), claims.ID, claims.Sessao, claims.JTI(), claims.EmitidoEm())
+		if err != nil {
+			log.Printf("[middleware.RejeitarTokenRevogado]: %v", err)
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao verificar a revogação do token", err.Error())
+			return
+		}
+
+		if revogado {
+			response.ErrorJSON(w, http.StatusUnauthorized, mensagemNaoAutorizado, model.ErrTokenRevogado.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	goJwt "github.com/golang-jwt/jwt/v5"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/jwt"
+)
+
+// revogacoesFake recusa as sessões revogadas, podendo simular a falha na consulta.
+type revogacoesFake struct {
+	sessoes map[string]struct{}
+	erro    error
+}
+
+func (r *revogacoesFake) TokenRevogado(_ context.Context, _, sessaoID, _ string, _ *time.Time) (bool, error) {
+	if r.erro != nil {
+		return false, r.erro
+	}
+	_, revogada := r.sessoes[sessaoID]
+	return revogada, nil
+}
+
+func TestRejeitarTokenRevogado(t *testing.T) {
+	revogacoes := &revogacoesFake{sessoes: map[string]struct{}{"ses-revogada": {}}}
+
+	casos := []struct {
+		nome     string
+		claims   *jwt.Claims
+		erro     error
+		esperado int
+		atendida bool
+	}{
+		{"token válido", &jwt.Claims{ID: "usr-1", Sessao: "ses-1"}, nil, http.StatusOK, true},
+		{"sessão revogada", &jwt.Claims{ID: "usr-1", Sessao: "ses-revogada"}, nil, http.StatusUnauthorized, false},
+		{"sem autenticação", nil, nil, http.StatusUnauthorized, false},
+		{"falha na consulta", &jwt.Claims{ID: "usr-1", Sessao: "ses-1"}, errors.New("banco indisponível"), http.StatusInternalServerError, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			revogacoes.erro = c.erro
+			atendida := false
+			handler := RejeitarTokenRevogado(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atendida = true
+			}), revogacoes)
+
+			req := httptest.NewRequest(http.MethodGet, "/chamados", nil)
+			if c.claims != nil {
+				c.claims.RegisteredClaims = goJwt.RegisteredClaims{ID: "jti-1", IssuedAt: goJwt.NewNumericDate(time.Now())}
+				req = req.WithContext(context.WithValue(req.Context(), ChaveUsuario, c.claims))
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != c.esperado || atendida != c.atendida {
+				t.Errorf("status = %d (atendida %t), esperado %d (atendida %t)", rec.Code, atendida, c.esperado, c.atendida)
+			}
+		})
+	}
+}
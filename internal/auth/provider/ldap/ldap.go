@@ -81,15 +81,33 @@ func (c *Client) Bind(login, senha string) error {
 
 // PesquisarPorLogin busca usuário pelo atributo LoginAttr
 func (c *Client) PesquisarPorLogin(login string) (nome, email, outLogin string, err error) {
-	metodo := "[ldap.PesquisarPorLogin]: %w"
+	filter := fmt.Sprintf("(%s=%s)", c.LoginAttr, goLdap.EscapeFilter(login))
+	nome, email, outLogin, err = c.pesquisar(filter)
+	if err != nil {
+		return "", "", "", fmt.Errorf("[ldap.PesquisarPorLogin]: %w", err)
+	}
+	return nome, email, outLogin, nil
+}
+
+// PesquisarPorEmail busca usuário pelo atributo mail
+func (c *Client) PesquisarPorEmail(email string) (nome, outEmail, login string, err error) {
+	filter := fmt.Sprintf("(mail=%s)", goLdap.EscapeFilter(email))
+	nome, outEmail, login, err = c.pesquisar(filter)
+	if err != nil {
+		return "", "", "", fmt.Errorf("[ldap.PesquisarPorEmail]: %w", err)
+	}
+	return nome, outEmail, login, nil
+}
+
+// pesquisar retorna nome, e-mail e login da primeira entrada que atende ao filtro
+func (c *Client) pesquisar(filter string) (nome, email, login string, err error) {
+	metodo := "[ldap.pesquisar]: %w"
 	ldapConn, err := c.conectar(c.UsuarioComDominio(), c.Pass)
 	if err != nil {
 		return "", "", "", fmt.Errorf(metodo, err)
 	}
 	defer ldapConn.Close()
 
-	filter := fmt.Sprintf("(%s=%s)", c.LoginAttr, goLdap.EscapeFilter(login))
-
 	req := goLdap.NewSearchRequest(
 		c.Base,
 		goLdap.ScopeWholeSubtree,
@@ -27,6 +27,44 @@ type Config struct {
 	LDAPUser      string // Usuário para bind no LDAP
 	LDAPPass      string // Senha para bind no LDAP
 	LDAPLoginAttr string // Atributo usado para login (ex: uid, cn, mail)
+
+	JobIntervalo        string // Intervalo entre as execuções das rotinas automáticas
+	FechamentoDiasUteis string // Dias úteis após a resolução para fechar o chamado automaticamente
+	ArquivamentoDias    string // Dias após o fechamento para arquivar o chamado automaticamente
+	NotificacoesDias    string // Dias em que as notificações são mantidas na central de notificações
+	UsuarioSistemaID    string // ID do usuário de sistema que registra as ações automáticas
+	PrazoReabertura     string // Prazo após a solução em que o chamado pode ser reaberto
+	PrazoEdicao         string // Prazo após a criação em que o autor pode editar o acompanhamento
+
+	AnexosDiretorio       string // Diretório onde o conteúdo dos anexos é armazenado
+	AnexosTamanhoMaximo   string // Tamanho máximo de cada anexo, em bytes
+	AnexosTiposPermitidos string // Tipos MIME aceitos nos anexos, separados por vírgula
+
+	EventosBuffer string // Quantidade de eventos recentes mantidos para reenvio na reconexão
+
+	SMTPHost             string // Host do servidor SMTP; vazio desativa o envio de e-mails
+	SMTPPorta            string // Porta do servidor SMTP
+	SMTPUsuario          string // Usuário do servidor SMTP; vazio para servidores sem autenticação
+	SMTPSenha            string // Senha do servidor SMTP
+	SMTPRemetente        string // Remetente dos e-mails enviados
+	SMTPTentativas       string // Tentativas de envio de cada e-mail, incluindo a primeira
+	SMTPEspera           string // Espera antes de repetir um envio com falha, dobrada a cada tentativa
+	EmailFila            string // Quantidade máxima de e-mails aguardando envio
+	FrontendURL          string // Endereço do frontend, usado nos links dos e-mails
+	SLAAvisoAntecedencia string // Antecedência do aviso de prazo de solução perto de vencer
+
+	EmailEntradaMaildir   string // Caixa de entrada Maildir dos e-mails recebidos; vazio desativa o recebimento
+	EmailEntradaIntervalo string // Intervalo entre as leituras da caixa de entrada
+	EmailResponderPara    string // Endereço da caixa de entrada, usado como Reply-To dos e-mails enviados
+	EmailCategoriaID      string // Categoria dos chamados abertos por e-mail
+	EmailSubcategoriaID   string // Subcategoria dos chamados abertos por e-mail
+
+	WebhookIntervalo  string // Intervalo entre os envios das entregas pendentes dos webhooks
+	WebhookTentativas string // Tentativas de envio de cada entrega, incluindo a primeira
+	WebhookEspera     string // Espera antes de repetir uma entrega com falha, dobrada a cada tentativa
+	WebhookTimeout    string // Tempo limite de cada envio ao webhook
+
+	OutboxIntervalo string // Intervalo entre os despachos dos logs e eventos gravados na outbox
 }
 
 // Load carrega as configurações do ambiente ou usa valores padrão
@@ -56,6 +94,44 @@ func Load() Config {
 		LDAPUser:      getenv("LDAP_USER", ""),
 		LDAPPass:      getenv("LDAP_PASS", ""),
 		LDAPLoginAttr: getenv("LDAP_LOGIN_ATTR", "uid"),
+
+		JobIntervalo:        getenv("JOB_INTERVALO", "15m"),
+		FechamentoDiasUteis: getenv("FECHAMENTO_DIAS_UTEIS", "5"),
+		ArquivamentoDias:    getenv("ARQUIVAMENTO_DIAS", "30"),
+		NotificacoesDias:    getenv("NOTIFICACOES_DIAS", "90"),
+		UsuarioSistemaID:    getenv("USUARIO_SISTEMA_ID", "01998000-00ff-7000-8000-000000000001"),
+		PrazoReabertura:     getenv("PRAZO_REABERTURA", "168h"),
+		PrazoEdicao:         getenv("PRAZO_EDICAO_ACOMPANHAMENTO", "15m"),
+
+		AnexosDiretorio:       getenv("ANEXOS_DIRETORIO", "./anexos"),
+		AnexosTamanhoMaximo:   getenv("ANEXOS_TAMANHO_MAXIMO", "10485760"),
+		AnexosTiposPermitidos: getenv("ANEXOS_TIPOS_PERMITIDOS", ""),
+
+		EventosBuffer: getenv("EVENTOS_BUFFER", "1000"),
+
+		SMTPHost:             getenv("SMTP_HOST", ""),
+		SMTPPorta:            getenv("SMTP_PORTA", "1025"),
+		SMTPUsuario:          getenv("SMTP_USUARIO", ""),
+		SMTPSenha:            getenv("SMTP_SENHA", ""),
+		SMTPRemetente:        getenv("SMTP_REMETENTE", "Gestor de Chamados <nao-responda@localhost>"),
+		SMTPTentativas:       getenv("SMTP_TENTATIVAS", "3"),
+		SMTPEspera:           getenv("SMTP_ESPERA", "30s"),
+		EmailFila:            getenv("EMAIL_FILA", "500"),
+		FrontendURL:          getenv("FRONTEND_URL", ""),
+		SLAAvisoAntecedencia: getenv("SLA_AVISO_ANTECEDENCIA", "1h"),
+
+		EmailEntradaMaildir:   getenv("EMAIL_ENTRADA_MAILDIR", ""),
+		EmailEntradaIntervalo: getenv("EMAIL_ENTRADA_INTERVALO", "1m"),
+		EmailResponderPara:    getenv("EMAIL_RESPONDER_PARA", ""),
+		EmailCategoriaID:      getenv("EMAIL_CATEGORIA_ID", ""),
+		EmailSubcategoriaID:   getenv("EMAIL_SUBCATEGORIA_ID", ""),
+
+		WebhookIntervalo:  getenv("WEBHOOK_INTERVALO", "15s"),
+		WebhookTentativas: getenv("WEBHOOK_TENTATIVAS", "8"),
+		WebhookEspera:     getenv("WEBHOOK_ESPERA", "1m"),
+		WebhookTimeout:    getenv("WEBHOOK_TIMEOUT", "10s"),
+
+		OutboxIntervalo: getenv("OUTBOX_INTERVALO", "1s"),
 	}
 
 	if cfg.JWTSecret == "" || cfg.RTSecret == "" {
@@ -10,28 +10,53 @@ import (
 var (
 	ErrAcompanhamentoIDInvalido = errors.New("ID do acompanhamento não pode ser vazio")
 	ErrConteudoInvalido         = errors.New("conteúdo do acompanhamento não pode ser vazio")
-	ErrRemetenteInvalido        = errors.New("O remetente deve ser uma das seguintes permissões: TEC, USR")
+	ErrRemetenteInvalido        = errors.New("O remetente deve ser uma das seguintes permissões: ADM, TEC, USR, DEV")
+	ErrVisibilidadeInvalida     = errors.New("a visibilidade do acompanhamento deve ser PUBLICO ou INTERNO")
+	ErrNotaInternaNaoPermitida  = errors.New("apenas a equipe técnica pode criar ou acessar notas internas")
 )
 
 // rmetentesValidos contém todas as permissões aceitas como remetentes de acompanhamentos.
 var remetentesValidos = map[Permissao]struct{}{
+	PermADM: {},
 	PermTEC: {},
 	PermUSR: {},
+	PermDEV: {},
 }
 
+// VisibilidadeAcompanhamento define quem pode ler um acompanhamento.
+type VisibilidadeAcompanhamento string
+
+const (
+	// VisibilidadePublico é visível a todos que acessam o chamado.
+	VisibilidadePublico VisibilidadeAcompanhamento = "PUBLICO"
+
+	// VisibilidadeInterno é uma nota interna, visível apenas à equipe técnica.
+	VisibilidadeInterno VisibilidadeAcompanhamento = "INTERNO"
+)
+
 // Acompanhamento representa um comentário ou atualização feita em um chamado.
 type Acompanhamento struct {
-	ID           string    `json:"id"`
-	Conteudo     string    `json:"conteudo"`
-	ChamadoID    string    `json:"chamadoId"`
-	UsuarioID    string    `json:"usuarioId"`
-	Remetente    Permissao `json:"remetente"`
-	CriadoEm     time.Time `json:"criadoEm"`
-	AtualizadoEm time.Time `json:"atualizadoEm"`
+	ID           string                     `json:"id"`
+	Conteudo     string                     `json:"conteudo"`
+	ChamadoID    string                     `json:"chamadoId"`
+	UsuarioID    string                     `json:"usuarioId"`
+	Remetente    Permissao                  `json:"remetente"`
+	Visibilidade VisibilidadeAcompanhamento `json:"visibilidade"`
+	CriadoEm     time.Time                  `json:"criadoEm"`
+	AtualizadoEm time.Time                  `json:"atualizadoEm"`
+
+	EditadoEm     *time.Time `json:"editadoEm"`     // última edição do conteúdo; nulo se nunca editado
+	RemovidoEm    *time.Time `json:"removidoEm"`    // nulo enquanto o acompanhamento não é removido
+	RemovidoPorID *string    `json:"removidoPorId"` // quem removeu o acompanhamento
 }
 
 // NewAcompanhamento cria uma nova instância de Acompanhamento com os dados fornecidos.
-func NewAcompanhamento(id, chamadoID, usuarioID, conteudo string, remetente Permissao) (*Acompanhamento, error) {
+// Sem visibilidade informada, o acompanhamento é público.
+func NewAcompanhamento(id, chamadoID, usuarioID, conteudo string, remetente Permissao, visibilidade VisibilidadeAcompanhamento) (*Acompanhamento, error) {
+	if visibilidade == "" {
+		visibilidade = VisibilidadePublico
+	}
+
 	now := time.Now()
 	acompanhamento := &Acompanhamento{
 		ID:           id,
@@ -39,6 +64,7 @@ func NewAcompanhamento(id, chamadoID, usuarioID, conteudo string, remetente Perm
 		ChamadoID:    chamadoID,
 		UsuarioID:    usuarioID,
 		Remetente:    remetente,
+		Visibilidade: visibilidade,
 		CriadoEm:     now,
 		AtualizadoEm: now,
 	}
@@ -67,6 +93,9 @@ func ValidarAcompanhamento(a *Acompanhamento) error {
 	if err := ValidarRemetente(a.Remetente); err != nil {
 		erros = append(erros, err)
 	}
+	if err := ValidarVisibilidade(a.Visibilidade); err != nil {
+		erros = append(erros, err)
+	}
 	if len(erros) > 0 {
 		return fmt.Errorf("[model.ValidarAcompanhamento] erros de validação: %v", erros)
 	}
@@ -81,18 +110,42 @@ func ValidarRemetente(remetente Permissao) error {
 	return nil
 }
 
+// ValidarVisibilidade valida se a visibilidade é válida.
+func ValidarVisibilidade(visibilidade VisibilidadeAcompanhamento) error {
+	if visibilidade != VisibilidadePublico && visibilidade != VisibilidadeInterno {
+		return fmt.Errorf("[model.ValidarVisibilidade] %w", ErrVisibilidadeInvalida)
+	}
+	return nil
+}
+
+// PodeAcessarNotasInternas indica se a permissão pertence à equipe técnica, que cria e lê notas internas.
+func PodeAcessarNotasInternas(permissao Permissao) bool {
+	return permissao == PermADM || permissao == PermTEC || permissao == PermDEV
+}
+
+// VisivelPara indica se o acompanhamento pode ser lido por quem tem a permissão informada.
+func (a *Acompanhamento) VisivelPara(permissao Permissao) bool {
+	return a.Visibilidade != VisibilidadeInterno || PodeAcessarNotasInternas(permissao)
+}
+
 // AcompanhamentoFiltro representa os filtros para listar acompanhamentos.
 type AcompanhamentoFiltro struct {
 	Pagina    int
 	Limite    int
 	ChamadoID *string
 	UsuarioID *string
+
+	// IncluirInternos inclui as notas internas na listagem; preenchido conforme a permissão de quem consulta
+	IncluirInternos bool
+
+	// Escopo restringe a listagem aos chamados visíveis a quem consulta; nil não restringe
+	Escopo *EscopoChamados
 }
 
 // String retorna uma representação em string do acompanhamento para fins de logging.
 func (a *Acompanhamento) String() string {
 	return fmt.Sprintf(
-		"[ID=%s | ChamadoID=%s | UsuarioID=%s | Conteudo=%s | Remetente=%s]",
-		a.ID, a.ChamadoID, a.UsuarioID, a.Conteudo, a.Remetente,
+		"[ID=%s | ChamadoID=%s | UsuarioID=%s | Conteudo=%s | Remetente=%s | Visibilidade=%s]",
+		a.ID, a.ChamadoID, a.UsuarioID, a.Conteudo, a.Remetente, a.Visibilidade,
 	)
 }
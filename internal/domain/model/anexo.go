@@ -0,0 +1,121 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Erros de validação específicos para os anexos
+var (
+	ErrNomeAnexoInvalido     = errors.New("o nome do arquivo anexado é obrigatório e deve ter no máximo 255 caracteres")
+	ErrAnexoVazio            = errors.New("o arquivo anexado está vazio")
+	ErrTamanhoAnexoExcedido  = errors.New("o arquivo anexado excede o tamanho máximo permitido")
+	ErrTipoAnexoNaoPermitido = errors.New("o tipo do arquivo anexado não é permitido")
+	ErrAnexoDeOutroChamado   = errors.New("o acompanhamento informado não pertence ao chamado do anexo")
+	ErrAnexoDeOutroUsuario   = errors.New("apenas quem enviou o anexo ou um administrador pode removê-lo")
+	ErrChamadoNaoVisivel     = errors.New("o usuário não tem acesso a este chamado")
+)
+
+// Limites padrão dos anexos, usados quando a configuração não informa valores válidos
+const (
+	TamanhoMaximoAnexoPadrao int64 = 10 << 20 // 10 MiB
+	tamanhoMaximoNomeAnexo         = 255
+)
+
+// TiposAnexoPadrao são os tipos MIME aceitos quando a configuração não informa a lista
+var TiposAnexoPadrao = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"text/plain",
+	"application/zip",
+}
+
+// Anexo representa os metadados de um arquivo enviado a um chamado ou acompanhamento
+type Anexo struct {
+	ID               string    `json:"id"`
+	ChamadoID        string    `json:"chamadoId"`
+	AcompanhamentoID *string   `json:"acompanhamentoId"` // nulo quando anexado diretamente ao chamado
+	NomeArquivo      string    `json:"nomeArquivo"`
+	TipoMIME         string    `json:"tipoMime"`
+	Tamanho          int64     `json:"tamanho"` // em bytes
+	Hash             string    `json:"hash"`    // SHA-256 do conteúdo, em hexadecimal
+	Chave            string    `json:"-"`       // localização do conteúdo no armazenamento
+	EnviadoPorID     string    `json:"enviadoPorId"`
+	CriadoEm         time.Time `json:"criadoEm"`
+}
+
+// EnvioAnexo representa os dados informados no envio de um arquivo
+type EnvioAnexo struct {
+	ChamadoID        string
+	AcompanhamentoID *string
+	NomeArquivo      string
+}
+
+// RegrasAnexo reúne o tamanho máximo e os tipos MIME aceitos nos anexos
+type RegrasAnexo struct {
+	TamanhoMaximo   int64
+	TiposPermitidos map[string]struct{}
+}
+
+// NewRegrasAnexo cria as regras de anexo, recorrendo aos valores padrão quando o
+// tamanho não é positivo ou a lista de tipos está vazia.
+func NewRegrasAnexo(tamanhoMaximo int64, tipos []string) RegrasAnexo {
+	if tamanhoMaximo <= 0 {
+		tamanhoMaximo = TamanhoMaximoAnexoPadrao
+	}
+
+	permitidos := make(map[string]struct{}, len(tipos))
+	for _, tipo := range tipos {
+		if tipo = strings.ToLower(strings.TrimSpace(tipo)); tipo != "" {
+			permitidos[tipo] = struct{}{}
+		}
+	}
+	if len(permitidos) == 0 {
+		for _, tipo := range TiposAnexoPadrao {
+			permitidos[tipo] = struct{}{}
+		}
+	}
+
+	return RegrasAnexo{TamanhoMaximo: tamanhoMaximo, TiposPermitidos: permitidos}
+}
+
+// ValidarTipo verifica se o tipo MIME, desconsiderando parâmetros como charset, é aceito
+func (r RegrasAnexo) ValidarTipo(tipo string) error {
+	base, _, err := mime.ParseMediaType(tipo)
+	if err != nil {
+		return fmt.Errorf("[model.ValidarTipo] %q: %w", tipo, ErrTipoAnexoNaoPermitido)
+	}
+	if _, ok := r.TiposPermitidos[base]; !ok {
+		return fmt.Errorf("[model.ValidarTipo] %q: %w", base, ErrTipoAnexoNaoPermitido)
+	}
+	return nil
+}
+
+// NormalizarNomeAnexo descarta diretórios do nome enviado pelo cliente e valida o resultado
+func NormalizarNomeAnexo(nome string) (string, error) {
+	nome = strings.TrimSpace(filepath.Base(strings.ReplaceAll(nome, "\\", "/")))
+	if nome == "" || nome == "." || nome == "/" || utf8.RuneCountInString(nome) > tamanhoMaximoNomeAnexo {
+		return "", fmt.Errorf("[model.NormalizarNomeAnexo]: %w", ErrNomeAnexoInvalido)
+	}
+	return nome, nil
+}
+
+// String retorna uma representação de Anexo para fins de logging.
+func (a *Anexo) String() string {
+	acompanhamentoID := ""
+	if a.AcompanhamentoID != nil {
+		acompanhamentoID = *a.AcompanhamentoID
+	}
+	return fmt.Sprintf(
+		"[ID=%s | ChamadoID=%s | AcompanhamentoID=%s | Nome=%s | Tipo=%s | Tamanho=%d | Hash=%s | EnviadoPorID=%s]",
+		a.ID, a.ChamadoID, acompanhamentoID, a.NomeArquivo, a.TipoMIME, a.Tamanho, a.Hash, a.EnviadoPorID,
+	)
+}
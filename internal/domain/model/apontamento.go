@@ -0,0 +1,203 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Erros de validação específicos para o modelo Apontamento
+var (
+	ErrApontamentoIDInvalido          = errors.New("ID do apontamento não pode ser vazio")
+	ErrAtendimentoApontamentoVazio    = errors.New("o atendimento do apontamento é obrigatório")
+	ErrDescricaoApontamentoVazia      = errors.New("a descrição do apontamento é obrigatória")
+	ErrPeriodoApontamentoInvalido     = errors.New("informe o início e o fim do trabalho ou a duração em minutos")
+	ErrFimApontamentoInvalido         = errors.New("o fim do apontamento deve ser posterior ao início")
+	ErrApontamentoEmAndamento         = errors.New("o técnico já possui um apontamento em andamento")
+	ErrSemApontamentoEmAndamento      = errors.New("o técnico não possui apontamento em andamento")
+	ErrApontamentoDeOutroUsuario      = errors.New("apenas o autor do apontamento pode alterá-lo")
+	ErrAgrupamentoApontamentoInvalido = errors.New("agrupamento inválido: o agrupamento deve ser uma das seguintes opções: CHAMADO, CATEGORIA, TECNICO")
+)
+
+// Apontamento representa um registro de horas trabalhadas por um técnico em um atendimento.
+// Um apontamento sem fim é um cronômetro em andamento.
+type Apontamento struct {
+	ID            string     `json:"id"`
+	AtendimentoID string     `json:"atendimentoId"`
+	ChamadoID     string     `json:"chamadoId"` // obtido do atendimento
+	UsuarioID     string     `json:"usuarioId"`
+	IniciadoEm    time.Time  `json:"iniciadoEm"`
+	FinalizadoEm  *time.Time `json:"finalizadoEm,omitempty"`
+	Minutos       int        `json:"minutos"`
+	Descricao     string     `json:"descricao"`
+	Faturavel     bool       `json:"faturavel"`
+	CriadoEm      time.Time  `json:"criadoEm"`
+	AtualizadoEm  time.Time  `json:"atualizadoEm"`
+}
+
+// NewApontamento cria um apontamento já concluído. O período pode ser informado pelo
+// início e fim ou apenas pela duração em minutos; neste caso, o trabalho é considerado
+// encerrado no fim informado ou, na sua ausência, no instante atual.
+func NewApontamento(id, atendimentoID, usuarioID string, inicio time.Time, fim *time.Time, minutos int, descricao string, faturavel bool) (*Apontamento, error) {
+	now := time.Now()
+	apontamento := &Apontamento{
+		ID:            id,
+		AtendimentoID: atendimentoID,
+		UsuarioID:     usuarioID,
+		Descricao:     strings.TrimSpace(descricao),
+		Faturavel:     faturavel,
+		CriadoEm:      now,
+		AtualizadoEm:  now,
+	}
+
+	if err := apontamento.DefinirPeriodo(inicio, fim, minutos, now); err != nil {
+		return nil, fmt.Errorf("[model.NewApontamento]: %w", err)
+	}
+
+	if err := ValidarApontamento(apontamento); err != nil {
+		return nil, fmt.Errorf("[model.NewApontamento]: %w", err)
+	}
+	return apontamento, nil
+}
+
+// IniciarApontamento cria um apontamento em andamento, iniciado no instante atual.
+func IniciarApontamento(id, atendimentoID, usuarioID, descricao string, faturavel bool) (*Apontamento, error) {
+	now := time.Now()
+	apontamento := &Apontamento{
+		ID:            id,
+		AtendimentoID: atendimentoID,
+		UsuarioID:     usuarioID,
+		IniciadoEm:    now,
+		Descricao:     strings.TrimSpace(descricao),
+		Faturavel:     faturavel,
+		CriadoEm:      now,
+		AtualizadoEm:  now,
+	}
+	if err := ValidarApontamento(apontamento); err != nil {
+		return nil, fmt.Errorf("[model.IniciarApontamento]: %w", err)
+	}
+	return apontamento, nil
+}
+
+// DefinirPeriodo ajusta o início, o fim e a duração do apontamento a partir do
+// início e fim informados ou apenas da duração em minutos.
+func (a *Apontamento) DefinirPeriodo(inicio time.Time, fim *time.Time, minutos int, agora time.Time) error {
+	switch {
+	case !inicio.IsZero() && fim != nil:
+		if !fim.After(inicio) {
+			return ErrFimApontamentoInvalido
+		}
+		a.IniciadoEm = inicio
+		a.FinalizadoEm = fim
+		a.Minutos = minutosEntre(inicio, *fim)
+
+	case inicio.IsZero() && minutos > 0:
+		termino := agora
+		if fim != nil {
+			termino = *fim
+		}
+		a.IniciadoEm = termino.Add(-time.Duration(minutos) * time.Minute)
+		a.FinalizadoEm = &termino
+		a.Minutos = minutos
+
+	default:
+		return ErrPeriodoApontamentoInvalido
+	}
+	return nil
+}
+
+// Parar encerra o apontamento em andamento no instante informado.
+func (a *Apontamento) Parar(agora time.Time) error {
+	if !a.EmAndamento() {
+		return ErrSemApontamentoEmAndamento
+	}
+	a.FinalizadoEm = &agora
+	a.Minutos = minutosEntre(a.IniciadoEm, agora)
+	return nil
+}
+
+// EmAndamento indica se o cronômetro do apontamento ainda está correndo.
+func (a *Apontamento) EmAndamento() bool {
+	return a.FinalizadoEm == nil
+}
+
+// minutosEntre retorna a duração, arredondada em minutos, entre dois instantes.
+func minutosEntre(inicio, fim time.Time) int {
+	return int(fim.Sub(inicio).Round(time.Minute) / time.Minute)
+}
+
+// ValidarApontamento valida os campos do apontamento.
+func ValidarApontamento(a *Apontamento) error {
+	var erros []error
+
+	if a.AtendimentoID == "" {
+		erros = append(erros, ErrAtendimentoApontamentoVazio)
+	}
+	if a.UsuarioID == "" {
+		erros = append(erros, ErrIDInvalido)
+	}
+	if a.Descricao == "" {
+		erros = append(erros, ErrDescricaoApontamentoVazia)
+	}
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarApontamento] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// ApontamentoFiltro representa os filtros para listar apontamentos.
+type ApontamentoFiltro struct {
+	Pagina        int
+	Limite        int
+	AtendimentoID *string
+	ChamadoID     *string
+	UsuarioID     *string
+	Faturavel     *bool
+	Inicio        *time.Time // apontamentos iniciados a partir de
+	Fim           *time.Time // apontamentos iniciados antes de
+}
+
+// AgrupamentoApontamento define o critério de totalização das horas apontadas
+type AgrupamentoApontamento string
+
+const (
+	AgruparPorChamado   AgrupamentoApontamento = "CHAMADO"
+	AgruparPorCategoria AgrupamentoApontamento = "CATEGORIA"
+	AgruparPorTecnico   AgrupamentoApontamento = "TECNICO"
+)
+
+// ValidarAgrupamentoApontamento verifica se o agrupamento informado é válido
+func ValidarAgrupamentoApontamento(a AgrupamentoApontamento) error {
+	switch a {
+	case AgruparPorChamado, AgruparPorCategoria, AgruparPorTecnico:
+		return nil
+	}
+	return ErrAgrupamentoApontamentoInvalido
+}
+
+// TotaisApontamentoFiltro representa os filtros para totalizar as horas apontadas.
+// Apenas apontamentos concluídos entram nos totais.
+type TotaisApontamentoFiltro struct {
+	AgruparPor AgrupamentoApontamento
+	Faturavel  *bool
+	Inicio     *time.Time
+	Fim        *time.Time
+}
+
+// TotalApontamento representa as horas apontadas em um chamado, categoria ou técnico
+type TotalApontamento struct {
+	ID                string `json:"id"`
+	Nome              string `json:"nome"` // título do chamado, nome da categoria ou do técnico
+	Apontamentos      int    `json:"apontamentos"`
+	Minutos           int64  `json:"minutos"`
+	MinutosFaturaveis int64  `json:"minutosFaturaveis"`
+}
+
+// String retorna uma representação em string do apontamento para fins de logging.
+func (a *Apontamento) String() string {
+	return fmt.Sprintf(
+		"[ID=%s | AtendimentoID=%s | UsuarioID=%s | Minutos=%d | Faturavel=%t]",
+		a.ID, a.AtendimentoID, a.UsuarioID, a.Minutos, a.Faturavel,
+	)
+}
@@ -3,23 +3,50 @@ package model
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
 // Erros de validação específicos para o modelo Atendimento.
 var (
-	ErrAtribuidoIDInvalido = errors.New("ID do técnico atribuído não pode ser vazio")
-	ErrChamadoIDInvalido   = errors.New("ID do chamado não pode ser vazio")
+	ErrAtribuidoIDInvalido   = errors.New("ID do técnico atribuído não pode ser vazio")
+	ErrChamadoIDInvalido     = errors.New("ID do chamado não pode ser vazio")
 	ErrAtendimentoIDInvalido = errors.New("ID do atendimento não pode ser vazio")
+
+	ErrMotivoAtendimentoObrigatorio = errors.New("o motivo é obrigatório para transferir ou devolver o chamado")
+	ErrAtendimentoAtivoExistente    = errors.New("o chamado já possui um técnico atribuído: utilize a transferência")
+	ErrChamadoSemAtendimentoAtivo   = errors.New("o chamado não possui um técnico atribuído")
+	ErrTransferenciaMesmoTecnico    = errors.New("o chamado já está atribuído ao técnico informado")
+	ErrTransferenciaNaoPermitida    = errors.New("o chamado não pode ser transferido no status atual")
 )
 
-// Atendimento representa a atribuição de um técnico a um chamado.
+// Atendimento representa um período em que um técnico esteve atribuído a um chamado.
+// Os atendimentos de um chamado formam uma linha do tempo: cada transferência ou
+// devolução para a fila finaliza o atendimento vigente em vez de sobrescrevê-lo.
 type Atendimento struct {
 	ID           string    `json:"id"`
 	AtribuidoID  string    `json:"atribuidoId"`
 	ChamadoID    string    `json:"chamadoId"`
 	CriadoEm     time.Time `json:"criadoEm"`
 	AtualizadoEm time.Time `json:"atualizadoEm"`
+
+	// Origem e encerramento do período de atribuição
+	AtribuidoPorID    *string    `json:"atribuidoPorId,omitempty"` // nulo na atribuição automática
+	Motivo            *string    `json:"motivo,omitempty"`
+	FinalizadoEm      *time.Time `json:"finalizadoEm,omitempty"`
+	FinalizadoPorID   *string    `json:"finalizadoPorId,omitempty"`
+	MotivoFinalizacao *string    `json:"motivoFinalizacao,omitempty"`
+}
+
+// TransferenciaAtendimento representa a transferência de um chamado para outro técnico
+type TransferenciaAtendimento struct {
+	AtribuidoID string `json:"atribuidoId"`
+	Motivo      string `json:"motivo"`
+}
+
+// DevolucaoAtendimento representa a devolução de um chamado para a fila de atendimento
+type DevolucaoAtendimento struct {
+	Motivo string `json:"motivo"`
 }
 
 // NewAtendimento cria uma nova instância de Atendimento com os dados fornecidos.
@@ -54,12 +81,45 @@ func ValidarAtendimento(a *Atendimento) error {
 	return nil
 }
 
+// ValidarTransferenciaAtendimento valida o técnico de destino e o motivo da transferência.
+func ValidarTransferenciaAtendimento(t *TransferenciaAtendimento) error {
+	var erros []error
+
+	if t.AtribuidoID == "" {
+		erros = append(erros, ErrAtribuidoIDInvalido)
+	}
+	if strings.TrimSpace(t.Motivo) == "" {
+		erros = append(erros, ErrMotivoAtendimentoObrigatorio)
+	}
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarTransferenciaAtendimento] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// Ativo indica se o técnico ainda está atribuído ao chamado.
+func (a *Atendimento) Ativo() bool {
+	return a.FinalizadoEm == nil
+}
+
+// Duracao retorna o tempo corrido do atendimento. Para o atendimento ativo,
+// considera o período até o instante informado.
+func (a *Atendimento) Duracao(agora time.Time) time.Duration {
+	if a.FinalizadoEm != nil {
+		return a.FinalizadoEm.Sub(a.CriadoEm)
+	}
+	return agora.Sub(a.CriadoEm)
+}
+
 // AtendimentoFiltro representa os filtros para listar atendimentos.
 type AtendimentoFiltro struct {
 	Pagina      int
 	Limite      int
 	ChamadoID   *string
 	AtribuidoID *string
+
+	// Escopo restringe a listagem aos chamados visíveis a quem consulta; nil não restringe
+	Escopo *EscopoChamados
 }
 
 // String retorna uma representação em string do atendimento para fins de logging.
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Erros de validação específicos para a atribuição automática de chamados
+var (
+	ErrEstrategiaAtribuicaoInvalida = errors.New("estratégia de atribuição inválida: a estratégia deve ser uma das seguintes opções: MANUAL, RODIZIO, MENOR_CARGA, PERMISSAO_CATEGORIA")
+)
+
+// EstrategiaAtribuicao define como o técnico é escolhido para os chamados abertos em uma categoria
+type EstrategiaAtribuicao string
+
+const (
+	// AtribuicaoManual mantém o chamado em aberto até que um técnico seja atribuído manualmente
+	AtribuicaoManual EstrategiaAtribuicao = "MANUAL"
+	// AtribuicaoRodizio escolhe o técnico que está há mais tempo sem receber chamados da categoria
+	AtribuicaoRodizio EstrategiaAtribuicao = "RODIZIO"
+	// AtribuicaoMenorCarga escolhe o técnico com menos chamados em andamento
+	AtribuicaoMenorCarga EstrategiaAtribuicao = "MENOR_CARGA"
+	// AtribuicaoPermissaoCategoria faz o rodízio apenas entre os técnicos com permissão na categoria
+	AtribuicaoPermissaoCategoria EstrategiaAtribuicao = "PERMISSAO_CATEGORIA"
+)
+
+// estrategiasAtribuicaoValidas contém todas as estratégias de atribuição aceitas.
+var estrategiasAtribuicaoValidas = map[EstrategiaAtribuicao]struct{}{
+	AtribuicaoManual:             {},
+	AtribuicaoRodizio:            {},
+	AtribuicaoMenorCarga:         {},
+	AtribuicaoPermissaoCategoria: {},
+}
+
+// ValidarEstrategiaAtribuicao verifica se a estratégia de atribuição informada é válida
+func ValidarEstrategiaAtribuicao(e EstrategiaAtribuicao) error {
+	if _, ok := estrategiasAtribuicaoValidas[e]; !ok {
+		return ErrEstrategiaAtribuicaoInvalida
+	}
+	return nil
+}
+
+// CandidatoAtribuicao representa um técnico ativo apto a receber chamados
+type CandidatoAtribuicao struct {
+	UsuarioID           string     `json:"usuarioId"`
+	Nome                string     `json:"nome"`
+	ChamadosEmAndamento int        `json:"chamadosEmAndamento"`
+	UltimaAtribuicao    *time.Time `json:"ultimaAtribuicao"` // última atribuição na categoria
+	PermissaoCategoria  bool       `json:"permissaoCategoria"`
+}
+
+// SugestaoAtribuicao representa o técnico escolhido para uma categoria e o motivo da escolha
+type SugestaoAtribuicao struct {
+	CategoriaID string                `json:"categoriaId"`
+	Estrategia  EstrategiaAtribuicao  `json:"estrategia"`
+	Tecnico     *CandidatoAtribuicao  `json:"tecnico"`
+	Motivo      string                `json:"motivo"`
+	Candidatos  []CandidatoAtribuicao `json:"candidatos"`
+}
+
+// EscolherTecnico aplica a estratégia aos candidatos e retorna o técnico escolhido
+// e o motivo da escolha. Sem candidatos elegíveis, o técnico retornado é nulo.
+func EscolherTecnico(estrategia EstrategiaAtribuicao, candidatos []CandidatoAtribuicao) (*CandidatoAtribuicao, string) {
+	if estrategia == AtribuicaoManual {
+		return nil, "a categoria utiliza atribuição manual"
+	}
+
+	elegiveis := make([]CandidatoAtribuicao, 0, len(candidatos))
+	for _, c := range candidatos {
+		if estrategia == AtribuicaoPermissaoCategoria && !c.PermissaoCategoria {
+			continue
+		}
+		elegiveis = append(elegiveis, c)
+	}
+
+	if len(elegiveis) == 0 {
+		if estrategia == AtribuicaoPermissaoCategoria {
+			return nil, "nenhum técnico ativo possui permissão na categoria"
+		}
+		return nil, "nenhum técnico ativo disponível"
+	}
+
+	switch estrategia {
+	case AtribuicaoMenorCarga:
+		sort.SliceStable(elegiveis, func(i, j int) bool {
+			if elegiveis[i].ChamadosEmAndamento != elegiveis[j].ChamadosEmAndamento {
+				return elegiveis[i].ChamadosEmAndamento < elegiveis[j].ChamadosEmAndamento
+			}
+			return vezNoRodizio(elegiveis[i], elegiveis[j])
+		})
+		escolhido := elegiveis[0]
+		return &escolhido, fmt.Sprintf(
+			"menor carga: %d chamado(s) em andamento entre %d técnico(s) disponível(is)",
+			escolhido.ChamadosEmAndamento, len(elegiveis),
+		)
+
+	default:
+		sort.SliceStable(elegiveis, func(i, j int) bool {
+			return vezNoRodizio(elegiveis[i], elegiveis[j])
+		})
+		escolhido := elegiveis[0]
+
+		ultima := "nunca recebeu chamados da categoria"
+		if escolhido.UltimaAtribuicao != nil {
+			ultima = "última atribuição na categoria em " + escolhido.UltimaAtribuicao.In(FusoSaoPaulo).Format("02/01/2006 15:04")
+		}
+		if estrategia == AtribuicaoPermissaoCategoria {
+			return &escolhido, fmt.Sprintf(
+				"rodízio entre %d técnico(s) com permissão na categoria: %s",
+				len(elegiveis), ultima,
+			)
+		}
+		return &escolhido, fmt.Sprintf("rodízio entre %d técnico(s): %s", len(elegiveis), ultima)
+	}
+}
+
+// vezNoRodizio indica se o candidato a está à frente de b no rodízio: quem nunca
+// recebeu chamados da categoria vem primeiro, seguido da atribuição mais antiga.
+func vezNoRodizio(a, b CandidatoAtribuicao) bool {
+	switch {
+	case a.UltimaAtribuicao == nil && b.UltimaAtribuicao == nil:
+		return a.UsuarioID < b.UsuarioID
+	case a.UltimaAtribuicao == nil:
+		return true
+	case b.UltimaAtribuicao == nil:
+		return false
+	case !a.UltimaAtribuicao.Equal(*b.UltimaAtribuicao):
+		return a.UltimaAtribuicao.Before(*b.UltimaAtribuicao)
+	default:
+		return a.UsuarioID < b.UsuarioID
+	}
+}
@@ -0,0 +1,183 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Erros relacionados à autorização das operações sobre os chamados
+var (
+	ErrOperacaoNaoPermitida = errors.New("operação não permitida para a permissão do usuário na categoria do chamado")
+	ErrOperacaoInvalida     = errors.New("operação inválida: a operação deve ser uma das seguintes: ATUALIZAR, ALTERAR_STATUS, ARQUIVAR, REABRIR, ALTERAR_PRIORIDADE, ATRIBUIR, TRANSFERIR, ACOMPANHAR, NOTA_INTERNA")
+)
+
+// OperacaoChamado define as operações autorizadas sobre os chamados de uma categoria.
+type OperacaoChamado string
+
+const (
+	OperacaoAtualizar         OperacaoChamado = "ATUALIZAR"          // alterar os dados do chamado
+	OperacaoAlterarStatus     OperacaoChamado = "ALTERAR_STATUS"     // mover o chamado no fluxo de status
+	OperacaoArquivar          OperacaoChamado = "ARQUIVAR"           // arquivar e desarquivar o chamado
+	OperacaoReabrir           OperacaoChamado = "REABRIR"            // reabrir o chamado solucionado
+	OperacaoAlterarPrioridade OperacaoChamado = "ALTERAR_PRIORIDADE" // alterar o impacto e a urgência do chamado
+	OperacaoAtribuir          OperacaoChamado = "ATRIBUIR"           // atribuir o chamado a um técnico
+	OperacaoTransferir        OperacaoChamado = "TRANSFERIR"         // transferir o chamado ou devolvê-lo à fila
+	OperacaoAcompanhar        OperacaoChamado = "ACOMPANHAR"         // registrar acompanhamentos públicos
+	OperacaoNotaInterna       OperacaoChamado = "NOTA_INTERNA"       // registrar e ler notas internas
+)
+
+// ordemOperacoesChamado define a ordem em que as operações permitidas são apresentadas.
+var ordemOperacoesChamado = []OperacaoChamado{
+	OperacaoAtualizar,
+	OperacaoAlterarStatus,
+	OperacaoArquivar,
+	OperacaoReabrir,
+	OperacaoAlterarPrioridade,
+	OperacaoAtribuir,
+	OperacaoTransferir,
+	OperacaoAcompanhar,
+	OperacaoNotaInterna,
+}
+
+// permissaoNomeadaPorOperacao define a permissão nomeada que concede cada operação aos
+// usuários de papel personalizado.
+var permissaoNomeadaPorOperacao = map[OperacaoChamado]PermissaoNomeada{
+	OperacaoAtualizar:         PermissaoChamadoEditar,
+	OperacaoAlterarStatus:     PermissaoChamadoAlterarStatus,
+	OperacaoArquivar:          PermissaoChamadoArquivar,
+	OperacaoReabrir:           PermissaoChamadoReabrir,
+	OperacaoAlterarPrioridade: PermissaoChamadoAlterarPrioridade,
+	OperacaoAtribuir:          PermissaoAtendimentoCriar,
+	OperacaoTransferir:        PermissaoAtendimentoTransferir,
+	OperacaoAcompanhar:        PermissaoAcompanhamentoCriar,
+	OperacaoNotaInterna:       PermissaoAcompanhamentoNotaInterna,
+}
+
+// permissoesPorOperacao define as permissões que podem realizar cada operação quando o
+// usuário não tem papel personalizado.
+var permissoesPorOperacao = map[OperacaoChamado][]Permissao{
+	OperacaoAtualizar:         {PermADM, PermTEC, PermUSR, PermDEV},
+	OperacaoAlterarStatus:     {PermADM, PermTEC, PermUSR, PermDEV},
+	OperacaoArquivar:          {PermADM, PermTEC, PermDEV},
+	OperacaoReabrir:           {PermADM, PermTEC, PermUSR, PermDEV},
+	OperacaoAlterarPrioridade: {PermADM, PermTEC, PermDEV},
+	OperacaoAtribuir:          {PermADM, PermTEC, PermDEV},
+	OperacaoTransferir:        {PermADM, PermTEC, PermDEV},
+	OperacaoAcompanhar:        {PermADM, PermTEC, PermUSR, PermDEV},
+	OperacaoNotaInterna:       {PermADM, PermTEC, PermDEV},
+}
+
+// operacoesDoCriador define as operações que a permissão USR só realiza nos chamados que
+// criou. Nos chamados em que foi apenas mencionado, o usuário lê e acompanha.
+var operacoesDoCriador = map[OperacaoChamado]struct{}{
+	OperacaoAtualizar:     {},
+	OperacaoAlterarStatus: {},
+	OperacaoReabrir:       {},
+}
+
+// nivelPermissao ordena as permissões para a escolha da permissão efetiva.
+var nivelPermissao = map[Permissao]int{
+	PermUSR: 1,
+	PermTEC: 2,
+	PermADM: 3,
+	PermDEV: 3,
+}
+
+// PermissaoEfetivaCategoria representa a permissão efetiva de um usuário em uma categoria
+// e as operações que ela permite sobre os chamados da categoria.
+type PermissaoEfetivaCategoria struct {
+	CategoriaID string            `json:"categoriaId"`
+	Categoria   string            `json:"categoria"`
+	Permissao   Permissao         `json:"permissao"`
+	Concedida   bool              `json:"concedida"` // indica se a permissão vem de uma permissão da categoria
+	Operacoes   []OperacaoChamado `json:"operacoes"`
+}
+
+// PermissoesEfetivas representa as permissões efetivas do usuário em cada categoria ativa.
+type PermissoesEfetivas struct {
+	UsuarioID  string                      `json:"usuarioId"`
+	Permissao  Permissao                   `json:"permissao"` // permissão global do usuário
+	Categorias []PermissaoEfetivaCategoria `json:"categorias"`
+}
+
+// ValidarOperacao valida se a operação é uma das operações permitidas.
+func ValidarOperacao(operacao OperacaoChamado) error {
+	if _, ok := permissoesPorOperacao[operacao]; !ok {
+		return fmt.Errorf("[model.ValidarOperacao]: %w", ErrOperacaoInvalida)
+	}
+	return nil
+}
+
+// PermissaoIrrestrita indica se a permissão global vale para todas as categorias,
+// dispensando a consulta às permissões por categoria.
+func PermissaoIrrestrita(permissao Permissao) bool {
+	return permissao == PermADM || permissao == PermDEV
+}
+
+// PermissaoEfetiva retorna a maior entre a permissão global do usuário e as permissões
+// concedidas a ele na categoria informada.
+func PermissaoEfetiva(global Permissao, categoriaID string, concessoes []CategoriaPermissao) Permissao {
+	efetiva := global
+	for _, c := range concessoes {
+		if c.CategoriaID == categoriaID && nivelPermissao[c.Permissao] > nivelPermissao[efetiva] {
+			efetiva = c.Permissao
+		}
+	}
+	return efetiva
+}
+
+// OperacoesChamado retorna as operações sobre os chamados na ordem de apresentação.
+func OperacoesChamado() []OperacaoChamado {
+	return slices.Clone(ordemOperacoesChamado)
+}
+
+// PermissaoNomeadaDaOperacao retorna a permissão nomeada que concede a operação.
+func PermissaoNomeadaDaOperacao(operacao OperacaoChamado) PermissaoNomeada {
+	return permissaoNomeadaPorOperacao[operacao]
+}
+
+// PapelPersonalizado indica se o código é de um papel criado pelos administradores. Os
+// tokens emitidos antes dos papéis trazem o código vazio.
+func PapelPersonalizado(codigo string) bool {
+	return codigo != "" && !PapelDoSistema(codigo)
+}
+
+// PermiteOperacao indica se a permissão do sistema pode realizar a operação.
+func PermiteOperacao(permissao Permissao, operacao OperacaoChamado) bool {
+	for _, p := range permissoesPorOperacao[operacao] {
+		if p == permissao {
+			return true
+		}
+	}
+	return false
+}
+
+// PermiteOperacaoNoChamado indica se o usuário, com a permissão efetiva na categoria do
+// chamado, pode realizar no chamado criado por criadorID a operação já autorizada na
+// categoria. Com a permissão USR, as operações do criador ficam restritas aos seus chamados.
+func PermiteOperacaoNoChamado(permissao Permissao, operacao OperacaoChamado, usuarioID, criadorID string) bool {
+	if _, doCriador := operacoesDoCriador[operacao]; doCriador && permissao == PermUSR {
+		return usuarioID == criadorID
+	}
+	return true
+}
+
+// OperacoesPermitidas retorna as operações que a permissão do sistema pode realizar.
+func OperacoesPermitidas(permissao Permissao) []OperacaoChamado {
+	operacoes := []OperacaoChamado{}
+	for _, operacao := range ordemOperacoesChamado {
+		if PermiteOperacao(permissao, operacao) {
+			operacoes = append(operacoes, operacao)
+		}
+	}
+	return operacoes
+}
+
+// String retorna uma representação de PermissaoEfetivaCategoria para fins de logging.
+func (p *PermissaoEfetivaCategoria) String() string {
+	return fmt.Sprintf(
+		"CategoriaID(%s) | Permissao(%s) | Concedida(%t) | Operacoes(%v)",
+		p.CategoriaID, p.Permissao, p.Concedida, p.Operacoes,
+	)
+}
@@ -0,0 +1,150 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPermissaoEfetiva(t *testing.T) {
+	concessoes := []CategoriaPermissao{
+		{CategoriaID: "cat-tec", Permissao: PermTEC},
+		{CategoriaID: "cat-usr", Permissao: PermUSR},
+		{CategoriaID: "cat-adm", Permissao: PermADM},
+		{CategoriaID: "cat-adm", Permissao: PermTEC},
+	}
+
+	casos := []struct {
+		nome        string
+		global      Permissao
+		categoriaID string
+		esperado    Permissao
+	}{
+		{"concessão eleva o usuário", PermUSR, "cat-tec", PermTEC},
+		{"sem concessão vale a global", PermUSR, "cat-outra", PermUSR},
+		{"concessão menor não rebaixa", PermTEC, "cat-usr", PermTEC},
+		{"vale a maior concessão da categoria", PermUSR, "cat-adm", PermADM},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if obtido := PermissaoEfetiva(c.global, c.categoriaID, concessoes); obtido != c.esperado {
+				t.Errorf("PermissaoEfetiva = %s, esperado %s", obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestPermiteOperacao(t *testing.T) {
+	casos := []struct {
+		nome      string
+		permissao Permissao
+		operacao  OperacaoChamado
+		esperado  bool
+	}{
+		{"usuário atualiza o chamado", PermUSR, OperacaoAtualizar, true},
+		{"usuário acompanha o chamado", PermUSR, OperacaoAcompanhar, true},
+		{"usuário não arquiva o chamado", PermUSR, OperacaoArquivar, false},
+		{"usuário não transfere o chamado", PermUSR, OperacaoTransferir, false},
+		{"usuário não registra nota interna", PermUSR, OperacaoNotaInterna, false},
+		{"técnico registra nota interna", PermTEC, OperacaoNotaInterna, true},
+		{"desenvolvedor altera a prioridade", PermDEV, OperacaoAlterarPrioridade, true},
+		{"operação inexistente", PermADM, OperacaoChamado("EXCLUIR"), false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if obtido := PermiteOperacao(c.permissao, c.operacao); obtido != c.esperado {
+				t.Errorf("PermiteOperacao = %t, esperado %t", obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestPermiteOperacaoNoChamado(t *testing.T) {
+	const usuario, outro = "usr-1", "usr-2"
+
+	casos := []struct {
+		nome      string
+		permissao Permissao
+		operacao  OperacaoChamado
+		criadorID string
+		esperado  bool
+	}{
+		{"usuário atualiza o próprio chamado", PermUSR, OperacaoAtualizar, usuario, true},
+		{"usuário não atualiza o chamado de outro", PermUSR, OperacaoAtualizar, outro, false},
+		{"usuário não altera o status do chamado de outro", PermUSR, OperacaoAlterarStatus, outro, false},
+		{"usuário não reabre o chamado de outro", PermUSR, OperacaoReabrir, outro, false},
+		{"usuário acompanha o chamado de outro", PermUSR, OperacaoAcompanhar, outro, true},
+		{"usuário arquiva o chamado de outro quando o papel permite", PermUSR, OperacaoArquivar, outro, true},
+		{"técnico atualiza o chamado de outro", PermTEC, OperacaoAtualizar, outro, true},
+		{"técnico registra nota interna", PermTEC, OperacaoNotaInterna, outro, true},
+		{"administrador reabre o chamado de outro", PermADM, OperacaoReabrir, outro, true},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if obtido := PermiteOperacaoNoChamado(c.permissao, c.operacao, usuario, c.criadorID); obtido != c.esperado {
+				t.Errorf("PermiteOperacaoNoChamado = %t, esperado %t", obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestOperacoesPermitidas(t *testing.T) {
+	casos := []struct {
+		permissao Permissao
+		esperado  []OperacaoChamado
+	}{
+		{PermUSR, []OperacaoChamado{OperacaoAtualizar, OperacaoAlterarStatus, OperacaoReabrir, OperacaoAcompanhar}},
+		{PermTEC, ordemOperacoesChamado},
+		{PermADM, ordemOperacoesChamado},
+	}
+
+	for _, c := range casos {
+		t.Run(string(c.permissao), func(t *testing.T) {
+			if obtido := OperacoesPermitidas(c.permissao); !slices.Equal(obtido, c.esperado) {
+				t.Errorf("OperacoesPermitidas = %v, esperado %v", obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestPermissaoNomeadaDaOperacao(t *testing.T) {
+	for _, operacao := range OperacoesChamado() {
+		t.Run(string(operacao), func(t *testing.T) {
+			if err := ValidarPermissaoNomeada(PermissaoNomeadaDaOperacao(operacao)); err != nil {
+				t.Errorf("PermissaoNomeadaDaOperacao(%s) = %v, esperado uma permissão disponível", operacao, err)
+			}
+		})
+	}
+}
+
+func TestPapelPersonalizado(t *testing.T) {
+	casos := []struct {
+		codigo   string
+		esperado bool
+	}{
+		{"", false},
+		{"USR", false},
+		{"TEC", false},
+		{"SUPORTE_N1", true},
+	}
+
+	for _, c := range casos {
+		t.Run(c.codigo, func(t *testing.T) {
+			if obtido := PapelPersonalizado(c.codigo); obtido != c.esperado {
+				t.Errorf("PapelPersonalizado(%q) = %t, esperado %t", c.codigo, obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestValidarOperacao(t *testing.T) {
+	if err := ValidarOperacao(OperacaoTransferir); err != nil {
+		t.Errorf("ValidarOperacao(%s) = %v, esperado nil", OperacaoTransferir, err)
+	}
+	if err := ValidarOperacao("EXCLUIR"); !errors.Is(err, ErrOperacaoInvalida) {
+		t.Errorf("ValidarOperacao(EXCLUIR) = %v, esperado %v", err, ErrOperacaoInvalida)
+	}
+}
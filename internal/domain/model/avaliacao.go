@@ -0,0 +1,137 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Erros de validação específicos para a pesquisa de satisfação
+var (
+	ErrNotaAvaliacaoInvalida         = errors.New("a nota da avaliação deve estar entre 1 e 5")
+	ErrComentarioRejeicaoObrigatorio = errors.New("o comentário é obrigatório para rejeitar a solução do chamado")
+	ErrChamadoNaoResolvido           = errors.New("a avaliação só pode ser respondida enquanto o chamado estiver resolvido")
+	ErrAvaliacaoDeOutroUsuario       = errors.New("apenas o criador do chamado pode responder a avaliação")
+	ErrAgrupamentoCSATInvalido       = errors.New("agrupamento inválido: o agrupamento deve ser uma das seguintes opções: TECNICO, CATEGORIA, MES")
+)
+
+// Limites da nota da pesquisa de satisfação; notas a partir de NotaSatisfeito contam como satisfação
+const (
+	NotaMinima     = 1
+	NotaMaxima     = 5
+	NotaSatisfeito = 4
+)
+
+// Avaliacao representa a resposta do criador à pesquisa de satisfação de uma solução
+type Avaliacao struct {
+	ID            string    `json:"id"`
+	ChamadoID     string    `json:"chamadoId"`
+	AvaliadorID   string    `json:"avaliadorId"`
+	TecnicoID     *string   `json:"tecnicoId"` // técnico do atendimento vigente na resolução
+	Nota          int       `json:"nota"`
+	Comentario    *string   `json:"comentario"`
+	SolucaoAceita bool      `json:"solucaoAceita"`
+	CriadoEm      time.Time `json:"criadoEm"`
+}
+
+// RespostaAvaliacao representa a nota, o comentário e o aceite enviados pelo criador do chamado
+type RespostaAvaliacao struct {
+	Nota       int     `json:"nota"`
+	Comentario *string `json:"comentario"`
+	Aceitar    bool    `json:"aceitar"`
+}
+
+// NewAvaliacao cria uma avaliação a partir da resposta do criador do chamado.
+func NewAvaliacao(id, chamadoID, avaliadorID string, tecnicoID *string, r *RespostaAvaliacao) (*Avaliacao, error) {
+	if err := ValidarRespostaAvaliacao(r); err != nil {
+		return nil, fmt.Errorf("[model.NewAvaliacao]: %w", err)
+	}
+
+	var comentario *string
+	if r.Comentario != nil && strings.TrimSpace(*r.Comentario) != "" {
+		texto := strings.TrimSpace(*r.Comentario)
+		comentario = &texto
+	}
+
+	return &Avaliacao{
+		ID:            id,
+		ChamadoID:     chamadoID,
+		AvaliadorID:   avaliadorID,
+		TecnicoID:     tecnicoID,
+		Nota:          r.Nota,
+		Comentario:    comentario,
+		SolucaoAceita: r.Aceitar,
+		CriadoEm:      time.Now(),
+	}, nil
+}
+
+// ValidarRespostaAvaliacao valida a nota e exige o comentário na rejeição da solução
+func ValidarRespostaAvaliacao(r *RespostaAvaliacao) error {
+	var erros []error
+
+	if r.Nota < NotaMinima || r.Nota > NotaMaxima {
+		erros = append(erros, ErrNotaAvaliacaoInvalida)
+	}
+	if !r.Aceitar && (r.Comentario == nil || strings.TrimSpace(*r.Comentario) == "") {
+		erros = append(erros, ErrComentarioRejeicaoObrigatorio)
+	}
+
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarRespostaAvaliacao] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// AgrupamentoCSAT define o critério de agregação da satisfação dos usuários
+type AgrupamentoCSAT string
+
+const (
+	CSATPorTecnico   AgrupamentoCSAT = "TECNICO"
+	CSATPorCategoria AgrupamentoCSAT = "CATEGORIA"
+	CSATPorMes       AgrupamentoCSAT = "MES"
+)
+
+// ValidarAgrupamentoCSAT verifica se o agrupamento informado é válido
+func ValidarAgrupamentoCSAT(a AgrupamentoCSAT) error {
+	switch a {
+	case CSATPorTecnico, CSATPorCategoria, CSATPorMes:
+		return nil
+	}
+	return ErrAgrupamentoCSATInvalido
+}
+
+// CSATFiltro representa os filtros para agregar as avaliações
+type CSATFiltro struct {
+	AgruparPor AgrupamentoCSAT
+	Inicio     *time.Time // avaliações respondidas a partir de
+	Fim        *time.Time // avaliações respondidas antes de
+}
+
+// TotalCSAT representa a satisfação agregada de um técnico, categoria ou mês
+type TotalCSAT struct {
+	ID                 string  `json:"id"`
+	Nome               string  `json:"nome"` // nome do técnico, da categoria ou o mês (AAAA-MM)
+	Avaliacoes         int     `json:"avaliacoes"`
+	Satisfeitos        int     `json:"satisfeitos"`
+	SolucoesRejeitadas int     `json:"solucoesRejeitadas"`
+	NotaMedia          float64 `json:"notaMedia"`
+	CSAT               float64 `json:"csat"` // percentual de avaliações com nota a partir de NotaSatisfeito
+}
+
+// CalcularCSAT preenche o percentual de satisfação a partir das contagens.
+func (t *TotalCSAT) CalcularCSAT() {
+	if t.Avaliacoes == 0 {
+		t.CSAT = 0
+		return
+	}
+	t.CSAT = float64(t.Satisfeitos) * 100 / float64(t.Avaliacoes)
+}
+
+// String retorna uma representação em string da avaliação para fins de logging.
+func (a *Avaliacao) String() string {
+	return fmt.Sprintf(
+		"[ID=%s | ChamadoID=%s | AvaliadorID=%s | Nota=%d | SolucaoAceita=%t]",
+		a.ID, a.ChamadoID, a.AvaliadorID, a.Nota, a.SolucaoAceita,
+	)
+}
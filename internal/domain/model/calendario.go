@@ -0,0 +1,308 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Erros de validação específicos para o modelo Calendario
+var (
+	ErrCalendarioIDInvalido      = errors.New("ID do calendário não pode ser vazio")
+	ErrCalendarioSemExpediente   = errors.New("o calendário deve possuir ao menos um dia de expediente")
+	ErrDiaSemanaInvalido         = errors.New("dia da semana inválido: deve estar entre 0 (domingo) e 6 (sábado)")
+	ErrDiaSemanaDuplicado        = errors.New("o expediente possui dias da semana duplicados")
+	ErrHorarioExpedienteInvalido = errors.New("horário de expediente inválido: use o formato HH:MM com início anterior ao fim")
+	ErrDataFeriadoInvalida       = errors.New("data de feriado inválida: use o formato AAAA-MM-DD")
+)
+
+// FusoSaoPaulo é o fuso horário usado em todos os cálculos de calendário.
+// São Paulo não adota horário de verão desde 2019, por isso o deslocamento fixo
+// de -03:00 dispensa a base de dados de fusos horários do sistema operacional.
+var FusoSaoPaulo = time.FixedZone("America/Sao_Paulo", -3*60*60)
+
+// limiteDiasCalendario limita a varredura de dias nos cálculos de tempo útil,
+// evitando laços infinitos em calendários sem nenhum dia útil alcançável.
+const limiteDiasCalendario = 366 * 10
+
+// Expediente representa o horário de trabalho de um dia da semana
+type Expediente struct {
+	DiaSemana time.Weekday `json:"diaSemana"`
+	Inicio    string       `json:"inicio"`
+	Fim       string       `json:"fim"`
+}
+
+// Feriado representa um dia sem expediente no calendário
+type Feriado struct {
+	Data      string `json:"data"`
+	Descricao string `json:"descricao"`
+}
+
+// Calendario representa os dias e horários de expediente usados no cálculo de
+// tempo útil. Um calendário sem categoria é o padrão; um calendário associado a
+// uma categoria substitui o padrão para os chamados daquela categoria.
+type Calendario struct {
+	ID           string       `json:"id"`
+	Nome         string       `json:"nome"`
+	CategoriaID  *string      `json:"categoriaId,omitempty"`
+	Status       bool         `json:"status"`
+	Expedientes  []Expediente `json:"expedientes"`
+	Feriados     []Feriado    `json:"feriados,omitempty"`
+	CriadoEm     time.Time    `json:"criadoEm"`
+	AtualizadoEm time.Time    `json:"atualizadoEm"`
+}
+
+// NewCalendario cria uma nova instância de Calendario com os dados fornecidos
+func NewCalendario(id, nome string, categoriaID *string, status bool, expedientes []Expediente) (*Calendario, error) {
+	now := time.Now()
+	calendario := &Calendario{
+		ID:           id,
+		Nome:         nome,
+		CategoriaID:  categoriaID,
+		Status:       status,
+		Expedientes:  expedientes,
+		CriadoEm:     now,
+		AtualizadoEm: now,
+	}
+
+	if err := ValidarCalendario(calendario); err != nil {
+		return nil, fmt.Errorf("[model.NewCalendario]: %w", err)
+	}
+	return calendario, nil
+}
+
+// ValidarCalendario valida os campos do calendário e seus expedientes
+func ValidarCalendario(c *Calendario) error {
+	var erros []error
+
+	if c.Nome == "" {
+		erros = append(erros, ErrNomeInvalido)
+	}
+	if len(c.Expedientes) == 0 {
+		erros = append(erros, ErrCalendarioSemExpediente)
+	}
+
+	dias := make(map[time.Weekday]struct{}, len(c.Expedientes))
+	for _, e := range c.Expedientes {
+		if err := ValidarExpediente(e); err != nil {
+			erros = append(erros, err)
+			continue
+		}
+		if _, ok := dias[e.DiaSemana]; ok {
+			erros = append(erros, ErrDiaSemanaDuplicado)
+			continue
+		}
+		dias[e.DiaSemana] = struct{}{}
+	}
+
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarCalendario] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// ValidarExpediente valida o dia da semana e o intervalo de horário do expediente
+func ValidarExpediente(e Expediente) error {
+	if e.DiaSemana < time.Sunday || e.DiaSemana > time.Saturday {
+		return ErrDiaSemanaInvalido
+	}
+
+	inicio, errInicio := minutosDoDia(e.Inicio)
+	fim, errFim := minutosDoDia(e.Fim)
+	if errInicio != nil || errFim != nil || inicio >= fim {
+		return ErrHorarioExpedienteInvalido
+	}
+	return nil
+}
+
+// NewFeriado cria um feriado normalizando a data para o formato AAAA-MM-DD.
+// Também são aceitas datas no formato DD/MM/AAAA.
+func NewFeriado(data, descricao string) (*Feriado, error) {
+	for _, layout := range []string{time.DateOnly, "02/01/2006"} {
+		if d, err := time.Parse(layout, data); err == nil {
+			return &Feriado{Data: d.Format(time.DateOnly), Descricao: descricao}, nil
+		}
+	}
+	return nil, fmt.Errorf("[model.NewFeriado]: %w: %q", ErrDataFeriadoInvalida, data)
+}
+
+// TempoUtil calcula o tempo decorrido entre inicio e fim contando apenas os
+// horários de expediente, desconsiderando fins de semana e feriados.
+// Um calendário nulo considera o tempo corrido.
+func (c *Calendario) TempoUtil(inicio, fim time.Time) time.Duration {
+	if c == nil {
+		if fim.Before(inicio) {
+			return 0
+		}
+		return fim.Sub(inicio)
+	}
+	if !fim.After(inicio) {
+		return 0
+	}
+
+	expedientes, feriados := c.indices()
+	inicio, fim = inicio.In(FusoSaoPaulo), fim.In(FusoSaoPaulo)
+
+	var total time.Duration
+	for dia := inicioDoDia(inicio); dia.Before(fim); dia = dia.AddDate(0, 0, 1) {
+		abertura, fechamento, ok := janelaExpediente(dia, expedientes, feriados)
+		if !ok {
+			continue
+		}
+		if abertura.Before(inicio) {
+			abertura = inicio
+		}
+		if fechamento.After(fim) {
+			fechamento = fim
+		}
+		if fechamento.After(abertura) {
+			total += fechamento.Sub(abertura)
+		}
+	}
+	return total
+}
+
+// AdicionarTempoUtil retorna o instante em que a duração informada, contada
+// apenas em horário de expediente, se completa a partir de inicio.
+// Um calendário nulo considera o tempo corrido.
+func (c *Calendario) AdicionarTempoUtil(inicio time.Time, duracao time.Duration) time.Time {
+	if c == nil || duracao <= 0 {
+		return inicio.Add(duracao)
+	}
+
+	expedientes, feriados := c.indices()
+	if len(expedientes) == 0 {
+		return inicio.Add(duracao)
+	}
+
+	atual := inicio.In(FusoSaoPaulo)
+	restante := duracao
+	dia := inicioDoDia(atual)
+	for i := 0; i < limiteDiasCalendario; i, dia = i+1, dia.AddDate(0, 0, 1) {
+		abertura, fechamento, ok := janelaExpediente(dia, expedientes, feriados)
+		if !ok || !fechamento.After(atual) {
+			continue
+		}
+		if abertura.Before(atual) {
+			abertura = atual
+		}
+		disponivel := fechamento.Sub(abertura)
+		if disponivel >= restante {
+			return abertura.Add(restante)
+		}
+		restante -= disponivel
+	}
+
+	// nenhum dia útil alcançável dentro do limite: recorre ao tempo corrido
+	return inicio.Add(duracao)
+}
+
+// AdicionarDiasUteis retorna o mesmo horário de inicio no dia útil que fica
+// dias dias úteis depois, desconsiderando fins de semana e feriados.
+// Um calendário nulo considera dias corridos.
+func (c *Calendario) AdicionarDiasUteis(inicio time.Time, dias int) time.Time {
+	if c == nil || dias <= 0 {
+		return inicio.AddDate(0, 0, dias)
+	}
+
+	expedientes, feriados := c.indices()
+	if len(expedientes) == 0 {
+		return inicio.AddDate(0, 0, dias)
+	}
+
+	atual := inicio.In(FusoSaoPaulo)
+	restantes := dias
+	for i := 1; i <= limiteDiasCalendario; i++ {
+		dia := inicioDoDia(atual).AddDate(0, 0, i)
+		if _, _, ok := janelaExpediente(dia, expedientes, feriados); !ok {
+			continue
+		}
+		restantes--
+		if restantes == 0 {
+			return atual.AddDate(0, 0, i)
+		}
+	}
+
+	// nenhum dia útil alcançável dentro do limite: recorre aos dias corridos
+	return inicio.AddDate(0, 0, dias)
+}
+
+// CalendarioFiltro representa os critérios de filtro para listar calendários
+type CalendarioFiltro struct {
+	Pagina      int
+	Limite      int
+	Busca       *string
+	CategoriaID *string
+	Status      *bool
+}
+
+// String retorna uma representação de Calendario para fins de logging.
+func (c *Calendario) String() string {
+	categoriaID := ""
+	if c.CategoriaID != nil {
+		categoriaID = *c.CategoriaID
+	}
+	return fmt.Sprintf(
+		"[ID=%s | Nome=%s | CategoriaID=%s | Expedientes=%d | Feriados=%d | Status=%t]",
+		c.ID, c.Nome, categoriaID, len(c.Expedientes), len(c.Feriados), c.Status,
+	)
+}
+
+// Metodos auxiliares
+
+// janela representa o intervalo de expediente de um dia em minutos desde a meia-noite
+type janela struct {
+	inicio int
+	fim    int
+}
+
+// indices monta os expedientes por dia da semana e o conjunto de feriados.
+func (c *Calendario) indices() (map[time.Weekday]janela, map[string]struct{}) {
+	expedientes := make(map[time.Weekday]janela, len(c.Expedientes))
+	for _, e := range c.Expedientes {
+		if ValidarExpediente(e) != nil {
+			continue
+		}
+		inicio, _ := minutosDoDia(e.Inicio)
+		fim, _ := minutosDoDia(e.Fim)
+		expedientes[e.DiaSemana] = janela{inicio: inicio, fim: fim}
+	}
+
+	feriados := make(map[string]struct{}, len(c.Feriados))
+	for _, f := range c.Feriados {
+		feriados[f.Data] = struct{}{}
+	}
+	return expedientes, feriados
+}
+
+// janelaExpediente retorna a abertura e o fechamento do expediente do dia,
+// ou false quando o dia não possui expediente.
+func janelaExpediente(dia time.Time, expedientes map[time.Weekday]janela, feriados map[string]struct{}) (time.Time, time.Time, bool) {
+	if _, ok := feriados[dia.Format(time.DateOnly)]; ok {
+		return time.Time{}, time.Time{}, false
+	}
+	j, ok := expedientes[dia.Weekday()]
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	return dia.Add(time.Duration(j.inicio) * time.Minute), dia.Add(time.Duration(j.fim) * time.Minute), true
+}
+
+// inicioDoDia retorna a meia-noite do dia de t no fuso de São Paulo.
+func inicioDoDia(t time.Time) time.Time {
+	t = t.In(FusoSaoPaulo)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, FusoSaoPaulo)
+}
+
+// minutosDoDia converte um horário no formato HH:MM em minutos desde a meia-noite.
+// O valor 24:00 é aceito para representar o fim do dia.
+func minutosDoDia(horario string) (int, error) {
+	if horario == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", horario)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// calendarioComercial retorna um calendário de segunda a sexta, das 08:00 às 17:00, com os
+// feriados informados.
+func calendarioComercial(feriados ...string) *Calendario {
+	c := &Calendario{ID: "cal-1", Nome: "Comercial", Status: true}
+	for dia := time.Monday; dia <= time.Friday; dia++ {
+		c.Expedientes = append(c.Expedientes, Expediente{DiaSemana: dia, Inicio: "08:00", Fim: "17:00"})
+	}
+	for _, data := range feriados {
+		c.Feriados = append(c.Feriados, Feriado{Data: data})
+	}
+	return c
+}
+
+// instante converte data e hora no formato "AAAA-MM-DD HH:MM" para o fuso de São Paulo.
+func instante(t *testing.T, valor string) time.Time {
+	t.Helper()
+	i, err := time.ParseInLocation("2006-01-02 15:04", valor, FusoSaoPaulo)
+	if err != nil {
+		t.Fatalf("instante inválido %q: %v", valor, err)
+	}
+	return i
+}
+
+// Em junho de 2025, o dia 02 é uma segunda-feira e o dia 06, uma sexta-feira.
+
+func TestCalendarioTempoUtil(t *testing.T) {
+	casos := []struct {
+		nome       string
+		calendario *Calendario
+		inicio     string
+		fim        string
+		esperado   time.Duration
+	}{
+		{"dentro do expediente", calendarioComercial(), "2025-06-02 09:00", "2025-06-02 11:00", 2 * time.Hour},
+		{"antes da abertura e após o fechamento", calendarioComercial(), "2025-06-02 07:00", "2025-06-02 18:00", 9 * time.Hour},
+		{"atravessa o fim de semana", calendarioComercial(), "2025-06-06 16:00", "2025-06-09 09:00", 2 * time.Hour},
+		{"desconta o feriado", calendarioComercial("2025-06-03"), "2025-06-02 16:00", "2025-06-04 09:00", 2 * time.Hour},
+		{"apenas no fim de semana", calendarioComercial(), "2025-06-07 09:00", "2025-06-08 18:00", 0},
+		{"fim anterior ao início", calendarioComercial(), "2025-06-02 11:00", "2025-06-02 09:00", 0},
+		{"calendário nulo conta o tempo corrido", nil, "2025-06-06 16:00", "2025-06-09 09:00", 65 * time.Hour},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			obtido := c.calendario.TempoUtil(instante(t, c.inicio), instante(t, c.fim))
+			if obtido != c.esperado {
+				t.Errorf("TempoUtil = %s, esperado %s", obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestCalendarioAdicionarTempoUtil(t *testing.T) {
+	casos := []struct {
+		nome       string
+		calendario *Calendario
+		inicio     string
+		duracao    time.Duration
+		esperado   string
+	}{
+		{"dentro do mesmo expediente", calendarioComercial(), "2025-06-02 09:00", 2 * time.Hour, "2025-06-02 11:00"},
+		{"continua no dia seguinte", calendarioComercial(), "2025-06-02 16:00", 2 * time.Hour, "2025-06-03 09:00"},
+		{"pula o feriado", calendarioComercial("2025-06-03"), "2025-06-02 16:00", 2 * time.Hour, "2025-06-04 09:00"},
+		{"pula o fim de semana", calendarioComercial(), "2025-06-06 16:00", 2 * time.Hour, "2025-06-09 09:00"},
+		{"início fora do expediente", calendarioComercial(), "2025-06-07 10:00", time.Hour, "2025-06-09 09:00"},
+		{"duração nula", calendarioComercial(), "2025-06-07 10:00", 0, "2025-06-07 10:00"},
+		{"calendário nulo conta o tempo corrido", nil, "2025-06-06 16:00", 2 * time.Hour, "2025-06-06 18:00"},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			obtido := c.calendario.AdicionarTempoUtil(instante(t, c.inicio), c.duracao)
+			if esperado := instante(t, c.esperado); !obtido.Equal(esperado) {
+				t.Errorf("AdicionarTempoUtil = %s, esperado %s", obtido, esperado)
+			}
+		})
+	}
+}
+
+func TestCalendarioAdicionarDiasUteis(t *testing.T) {
+	casos := []struct {
+		nome       string
+		calendario *Calendario
+		inicio     string
+		dias       int
+		esperado   string
+	}{
+		{"dia útil seguinte", calendarioComercial(), "2025-06-02 10:00", 1, "2025-06-03 10:00"},
+		{"pula o fim de semana", calendarioComercial(), "2025-06-06 10:00", 1, "2025-06-09 10:00"},
+		{"pula o feriado", calendarioComercial("2025-06-03"), "2025-06-02 10:00", 1, "2025-06-04 10:00"},
+		{"início no fim de semana", calendarioComercial(), "2025-06-07 10:00", 1, "2025-06-09 10:00"},
+		{"vários dias úteis", calendarioComercial(), "2025-06-05 10:00", 3, "2025-06-10 10:00"},
+		{"calendário nulo conta dias corridos", nil, "2025-06-06 10:00", 2, "2025-06-08 10:00"},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			obtido := c.calendario.AdicionarDiasUteis(instante(t, c.inicio), c.dias)
+			if esperado := instante(t, c.esperado); !obtido.Equal(esperado) {
+				t.Errorf("AdicionarDiasUteis = %s, esperado %s", obtido, esperado)
+			}
+		})
+	}
+}
+
+func TestValidarExpediente(t *testing.T) {
+	casos := []struct {
+		nome       string
+		expediente Expediente
+		valido     bool
+	}{
+		{"horário comercial", Expediente{DiaSemana: time.Monday, Inicio: "08:00", Fim: "17:00"}, true},
+		{"até o fim do dia", Expediente{DiaSemana: time.Saturday, Inicio: "00:00", Fim: "24:00"}, true},
+		{"início após o fim", Expediente{DiaSemana: time.Monday, Inicio: "17:00", Fim: "08:00"}, false},
+		{"horário mal formatado", Expediente{DiaSemana: time.Monday, Inicio: "8h", Fim: "17:00"}, false},
+		{"dia da semana inexistente", Expediente{DiaSemana: 7, Inicio: "08:00", Fim: "17:00"}, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if err := ValidarExpediente(c.expediente); (err == nil) != c.valido {
+				t.Errorf("ValidarExpediente = %v, esperado válido = %t", err, c.valido)
+			}
+		})
+	}
+}
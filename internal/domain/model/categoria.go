@@ -10,26 +10,35 @@ var ErrCategoriaIDInvalido = errors.New("ID da categoria não pode ser vazio")
 
 // Categoria representa uma categoria de chamados no sistema.
 type Categoria struct {
-	ID           string    `json:"id"`
-	Nome         string    `json:"nome"`
-	Status       bool      `json:"status"`
-	CriadoEm     time.Time `json:"criadoEm"`
-	AtualizadoEm time.Time `json:"atualizadoEm"`
+	ID                   string               `json:"id"`
+	Nome                 string               `json:"nome"`
+	Status               bool                 `json:"status"`
+	EstrategiaAtribuicao EstrategiaAtribuicao `json:"estrategiaAtribuicao"`
+	CriadoEm             time.Time            `json:"criadoEm"`
+	AtualizadoEm         time.Time            `json:"atualizadoEm"`
 }
 
 // NewCategoria cria uma nova instância de Categoria com os dados fornecidos.
-func NewCategoria(id, nome string, status bool) (*Categoria, error) {
+func NewCategoria(id, nome string, status bool, estrategia EstrategiaAtribuicao) (*Categoria, error) {
 	if nome == "" {
 		return nil, fmt.Errorf("[model.NewCategoria]: %w", ErrNomeInvalido)
 	}
 
+	if estrategia == "" {
+		estrategia = AtribuicaoManual
+	}
+	if err := ValidarEstrategiaAtribuicao(estrategia); err != nil {
+		return nil, fmt.Errorf("[model.NewCategoria]: %w", err)
+	}
+
 	now := time.Now()
 	categoria := &Categoria{
-		ID:           id,
-		Nome:         nome,
-		Status:       status,
-		CriadoEm:     now,
-		AtualizadoEm: now,
+		ID:                   id,
+		Nome:                 nome,
+		Status:               status,
+		EstrategiaAtribuicao: estrategia,
+		CriadoEm:             now,
+		AtualizadoEm:         now,
 	}
 	return categoria, nil
 }
@@ -45,7 +54,7 @@ type CategoriaFiltro struct {
 // String retorna uma representação em string da Categoria para fins de logging.
 func (c *Categoria) String() string {
 	return fmt.Sprintf(
-		"[ID=%s | Nome=%s | Status=%t]", 
-		c.ID, c.Nome, c.Status,
+		"[ID=%s | Nome=%s | Status=%t | EstrategiaAtribuicao=%s]",
+		c.ID, c.Nome, c.Status, c.EstrategiaAtribuicao,
 	)
 }
@@ -3,39 +3,80 @@ package model
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
 // Erros de validação específicos para o modelo Chamado
 var (
-	ErrStatusChamadoInvalido       = errors.New("status inválido: o status deve ser uma das seguintes opções: ABERTO, ATRIBUIDO, RESOLVIDO, REJEITADO, FECHADO, ARQUIVADO")
+	ErrStatusChamadoInvalido       = errors.New("status inválido: o status deve ser uma das seguintes opções: ABERTO, ATRIBUIDO, AGUARDANDO_USUARIO, RESOLVIDO, REJEITADO, FECHADO, ARQUIVADO")
 	ErrCriadorChamadoInvalido      = errors.New("criador do chamado não pode ser vazio")
 	ErrSubcategoriaChamadoInvalido = errors.New("subcategoria do chamado não pode ser vazia")
 	ErrCategoriaChamadoInvalido    = errors.New("categoria do chamado não pode ser vazia")
 	ErrDescricaoChamadoInvalido    = errors.New("descrição do chamado não pode ser vazia")
 	ErrTituloChamadoInvalido       = errors.New("título do chamado não pode ser vazio")
+	ErrTransicaoStatusInvalida     = errors.New("transição de status inválida para o status atual do chamado")
+	ErrTransicaoStatusNaoPermitida = errors.New("permissão insuficiente para realizar a transição de status do chamado")
+	ErrSolucaoObrigatoria          = errors.New("a solução é obrigatória para resolver o chamado")
 )
 
 // StatusChamado define os possíveis status de um chamado
 type StatusChamado string
 
 const (
-	StatusAberto    StatusChamado = "ABERTO"
-	StatusAtribuido StatusChamado = "ATRIBUIDO"
-	StatusResolvido StatusChamado = "RESOLVIDO"
-	StatusRejeitado StatusChamado = "REJEITADO"
-	StatusFechado   StatusChamado = "FECHADO"
-	StatusArquivado StatusChamado = "ARQUIVADO"
+	StatusAberto     StatusChamado = "ABERTO"
+	StatusAtribuido  StatusChamado = "ATRIBUIDO"
+	StatusAguardando StatusChamado = "AGUARDANDO_USUARIO"
+	StatusResolvido  StatusChamado = "RESOLVIDO"
+	StatusRejeitado  StatusChamado = "REJEITADO"
+	StatusFechado    StatusChamado = "FECHADO"
+	StatusArquivado  StatusChamado = "ARQUIVADO"
 )
 
 // statusChamadoValidos contém todos os status aceitos.
 var statusChamadoValidos = map[StatusChamado]struct{}{
-	StatusAberto:    {},
-	StatusAtribuido: {},
-	StatusResolvido: {},
-	StatusRejeitado: {},
-	StatusFechado:   {},
-	StatusArquivado: {},
+	StatusAberto:     {},
+	StatusAtribuido:  {},
+	StatusAguardando: {},
+	StatusResolvido:  {},
+	StatusRejeitado:  {},
+	StatusFechado:    {},
+	StatusArquivado:  {},
+}
+
+// ordemStatusChamado define a ordem de apresentação dos status.
+var ordemStatusChamado = []StatusChamado{
+	StatusAberto,
+	StatusAtribuido,
+	StatusAguardando,
+	StatusResolvido,
+	StatusRejeitado,
+	StatusFechado,
+	StatusArquivado,
+}
+
+// transicoesStatusChamado define, para cada status de origem, os status de destino
+// possíveis e as permissões autorizadas a realizar cada transição. A reabertura de chamados
+// resolvidos ou fechados não faz parte do fluxo: ela exige motivo e prazo e é feita apenas
+// por ReabrirChamado. Chamados rejeitados e fechados não saem do status por aqui.
+var transicoesStatusChamado = map[StatusChamado]map[StatusChamado][]Permissao{
+	StatusAberto: {
+		StatusAtribuido: {PermADM, PermTEC, PermDEV},
+		StatusRejeitado: {PermADM, PermTEC, PermDEV},
+	},
+	StatusAtribuido: {
+		StatusAberto:     {PermADM, PermTEC, PermDEV}, // devolução para a fila
+		StatusAguardando: {PermADM, PermTEC, PermDEV},
+		StatusResolvido:  {PermADM, PermTEC, PermDEV},
+		StatusRejeitado:  {PermADM, PermTEC, PermDEV},
+	},
+	StatusAguardando: {
+		StatusAtribuido: {PermADM, PermTEC, PermUSR, PermDEV}, // retorno do usuário
+		StatusResolvido: {PermADM, PermTEC, PermDEV},
+	},
+	StatusResolvido: {
+		StatusFechado: {PermADM, PermTEC, PermUSR, PermDEV},
+	},
 }
 
 // Chamado representa um chamado no sistema
@@ -53,6 +94,42 @@ type Chamado struct {
 	CategoriaID    string        `json:"categoriaId"`
 	SubcategoriaID string        `json:"subcategoriaId"`
 	CriadorID      string        `json:"criadorId"`
+	Impacto        Impacto       `json:"impacto"`
+	Urgencia       Urgencia      `json:"urgencia"`
+	Prioridade     Prioridade    `json:"prioridade"`
+
+	// Prazos e indicadores de SLA
+	PoliticaSLAID              *string    `json:"politicaSlaId,omitempty"`
+	PrazoPrimeiraResposta      *time.Time `json:"prazoPrimeiraResposta,omitempty"`
+	PrazoSolucao               *time.Time `json:"prazoSolucao,omitempty"`
+	PrimeiraRespostaEm         *time.Time `json:"primeiraRespostaEm,omitempty"`
+	SLAPausadoEm               *time.Time `json:"slaPausadoEm,omitempty"`
+	SLAPrimeiraRespostaViolado bool       `json:"slaPrimeiraRespostaViolado"`
+	SLASolucaoViolado          bool       `json:"slaSolucaoViolado"`
+
+	// Controle do tempo útil em que o chamado permaneceu atribuído
+	AtribuidoEm            *time.Time `json:"atribuidoEm,omitempty"`
+	TempoAtribuidoSegundos int64      `json:"tempoAtribuidoSegundos"`
+
+	// Reaberturas após a solução, usadas como indicador de qualidade
+	Reaberturas int        `json:"reaberturas"`
+	ReabertoEm  *time.Time `json:"reabertoEm,omitempty"`
+
+	// Tempos calculados no calendário de expediente; não são persistidos
+	Tempos *TemposChamado `json:"tempos,omitempty"`
+
+	// Linha do tempo de atendimentos; preenchida apenas na busca por ID
+	Atendimentos []Atendimento `json:"atendimentos,omitempty"`
+
+	// Respostas à pesquisa de satisfação; preenchidas apenas na busca por ID
+	Avaliacoes []Avaliacao `json:"avaliacoes,omitempty"`
+}
+
+// TemposChamado reúne as durações do chamado, em minutos de expediente
+type TemposChamado struct {
+	IdadeUtilMinutos          int64  `json:"idadeUtilMinutos"`
+	TempoResolucaoUtilMinutos *int64 `json:"tempoResolucaoUtilMinutos,omitempty"`
+	TempoAtribuidoUtilMinutos int64  `json:"tempoAtribuidoUtilMinutos"`
 }
 
 // NewChamado cria uma nova instância de Chamado com os dados fornecidos
@@ -113,6 +190,35 @@ func ValidarStatusChamado(status StatusChamado) error {
 	return fmt.Errorf("[model.ValidarStatusChamado]: %w", ErrStatusChamadoInvalido)
 }
 
+// ValidarTransicaoStatus verifica se o chamado pode passar do status de origem
+// para o status de destino e se a permissão informada pode realizar a transição.
+func ValidarTransicaoStatus(origem, destino StatusChamado, permissao Permissao) error {
+	permissoes, ok := transicoesStatusChamado[origem][destino]
+	if !ok {
+		return fmt.Errorf("[model.ValidarTransicaoStatus] %s -> %s: %w", origem, destino, ErrTransicaoStatusInvalida)
+	}
+
+	for _, p := range permissoes {
+		if p == permissao {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("[model.ValidarTransicaoStatus] %s -> %s (%s): %w", origem, destino, permissao, ErrTransicaoStatusNaoPermitida)
+}
+
+// TransicoesPermitidas retorna os status para os quais um chamado no status atual
+// pode ser movido pela permissão informada.
+func (s StatusChamado) TransicoesPermitidas(permissao Permissao) []StatusChamado {
+	transicoes := []StatusChamado{}
+	for _, destino := range ordemStatusChamado {
+		if ValidarTransicaoStatus(s, destino, permissao) == nil {
+			transicoes = append(transicoes, destino)
+		}
+	}
+	return transicoes
+}
+
 // AdiconarSolucao adiciona uma solução ao chamado e atualiza seu status
 func (c *Chamado) AdiconarSolucao(solucao string) {
 	now := time.Now()
@@ -122,21 +228,173 @@ func (c *Chamado) AdiconarSolucao(solucao string) {
 	c.AtualizadoEm = now
 }
 
+// AplicarPrioridade define o impacto e a urgência do chamado e deriva a sua
+// prioridade a partir da matriz informada.
+func (c *Chamado) AplicarPrioridade(impacto Impacto, urgencia Urgencia, matriz MatrizPrioridade) error {
+	prioridade, err := matriz.Derivar(impacto, urgencia)
+	if err != nil {
+		return fmt.Errorf("[model.AplicarPrioridade]: %w", err)
+	}
+
+	c.Impacto = impacto
+	c.Urgencia = urgencia
+	c.Prioridade = prioridade
+	return nil
+}
+
+// AplicarPoliticaSLA associa a política de SLA ao chamado e calcula os prazos
+// de primeira resposta e de solução a partir do instante informado, contando
+// apenas o horário de expediente do calendário.
+func (c *Chamado) AplicarPoliticaSLA(p *PoliticaSLA, calendario *Calendario, inicio time.Time) {
+	prazoPrimeiraResposta := calendario.AdicionarTempoUtil(inicio, time.Duration(p.PrimeiraRespostaMinutos)*time.Minute)
+	prazoSolucao := calendario.AdicionarTempoUtil(inicio, time.Duration(p.SolucaoMinutos)*time.Minute)
+
+	c.PoliticaSLAID = &p.ID
+	c.PrazoPrimeiraResposta = &prazoPrimeiraResposta
+	c.PrazoSolucao = &prazoSolucao
+}
+
+// RegistrarPrimeiraResposta marca o instante da primeira resposta ao chamado.
+// Retorna false caso a primeira resposta já tenha sido registrada.
+func (c *Chamado) RegistrarPrimeiraResposta(agora time.Time) bool {
+	if c.PrimeiraRespostaEm != nil {
+		return false
+	}
+	c.PrimeiraRespostaEm = &agora
+	return true
+}
+
+// PausarSLA interrompe a contagem do prazo de solução enquanto o chamado aguarda o usuário.
+func (c *Chamado) PausarSLA(agora time.Time) {
+	if c.SLAPausadoEm != nil || c.PrazoSolucao == nil {
+		return
+	}
+	c.SLAPausadoEm = &agora
+}
+
+// RetomarSLA retoma a contagem do prazo de solução, estendendo-o pelo tempo
+// útil em que o chamado permaneceu em pausa.
+func (c *Chamado) RetomarSLA(calendario *Calendario, agora time.Time) {
+	if c.SLAPausadoEm == nil {
+		return
+	}
+	if c.PrazoSolucao != nil {
+		pausa := calendario.TempoUtil(*c.SLAPausadoEm, agora)
+		prazo := calendario.AdicionarTempoUtil(*c.PrazoSolucao, pausa)
+		c.PrazoSolucao = &prazo
+	}
+	c.SLAPausadoEm = nil
+}
+
+// ContabilizarTempoAtribuido acumula o tempo útil em que o chamado esteve
+// atribuído ao deixar o status ATRIBUIDO e inicia uma nova contagem ao entrar nele.
+func (c *Chamado) ContabilizarTempoAtribuido(destino StatusChamado, calendario *Calendario, agora time.Time) {
+	if c.Status == StatusAtribuido && c.AtribuidoEm != nil {
+		c.TempoAtribuidoSegundos += int64(calendario.TempoUtil(*c.AtribuidoEm, agora) / time.Second)
+		c.AtribuidoEm = nil
+	}
+	if destino == StatusAtribuido {
+		c.AtribuidoEm = &agora
+	}
+}
+
+// CalcularTempos calcula a idade, o tempo de resolução e o tempo atribuído do
+// chamado em horário de expediente. Chamados fechados têm a idade contada até o
+// fechamento.
+func (c *Chamado) CalcularTempos(calendario *Calendario, agora time.Time) TemposChamado {
+	fim := agora
+	if c.FechadoEm != nil {
+		fim = *c.FechadoEm
+	}
+
+	tempoAtribuido := time.Duration(c.TempoAtribuidoSegundos) * time.Second
+	if c.Status == StatusAtribuido && c.AtribuidoEm != nil {
+		tempoAtribuido += calendario.TempoUtil(*c.AtribuidoEm, agora)
+	}
+
+	tempos := TemposChamado{
+		IdadeUtilMinutos:          int64(calendario.TempoUtil(c.CriadoEm, fim) / time.Minute),
+		TempoAtribuidoUtilMinutos: int64(tempoAtribuido / time.Minute),
+	}
+
+	if c.SolucionadoEm != nil {
+		resolucao := int64(calendario.TempoUtil(c.CriadoEm, *c.SolucionadoEm) / time.Minute)
+		tempos.TempoResolucaoUtilMinutos = &resolucao
+	}
+
+	return tempos
+}
+
 // ChamadoFiltro representa os filtros possíveis para buscar chamados
 type ChamadoFiltro struct {
-	Pagina         int
-	Limite         int
-	Busca          *string
-	Status         *string
-	CategoriaID    *string
-	SubcategoriaID *string
-	CriadorID      *string
+	Pagina                     int
+	Limite                     int
+	Busca                      *string
+	Status                     *string
+	CategoriaID                *string
+	SubcategoriaID             *string
+	CriadorID                  *string
+	SLAPrimeiraRespostaViolado *bool
+	SLASolucaoViolado          *bool
+	Impacto                    *string
+	Urgencia                   *string
+	Prioridade                 *string
+	ReaberturasMin             *int
+	OrdenarPor                 CampoOrdenacaoChamado
+	Ordem                      Ordem
+
+	// Escopo restringe a listagem aos chamados visíveis ao usuário; nil não restringe
+	Escopo *EscopoChamados
+}
+
+// CampoOrdenacaoChamado define os campos aceitos para ordenar a listagem de chamados
+type CampoOrdenacaoChamado string
+
+const (
+	OrdenarPorCriadoEm     CampoOrdenacaoChamado = "criadoEm"
+	OrdenarPorAtualizadoEm CampoOrdenacaoChamado = "atualizadoEm"
+	OrdenarPorPrioridade   CampoOrdenacaoChamado = "prioridade"
+	OrdenarPorPrazoSolucao CampoOrdenacaoChamado = "prazoSolucao"
+)
+
+// camposOrdenacaoChamado contém todos os campos de ordenação aceitos.
+var camposOrdenacaoChamado = map[CampoOrdenacaoChamado]struct{}{
+	OrdenarPorCriadoEm:     {},
+	OrdenarPorAtualizadoEm: {},
+	OrdenarPorPrioridade:   {},
+	OrdenarPorPrazoSolucao: {},
+}
+
+// Ordem define a direção da ordenação de uma listagem
+type Ordem string
+
+const (
+	OrdemAsc  Ordem = "ASC"
+	OrdemDesc Ordem = "DESC"
+)
+
+// NormalizarOrdenacao substitui campos e direções de ordenação desconhecidos pelo
+// padrão da listagem: chamados mais recentes primeiro. A prioridade sem direção
+// explícita é ordenada da mais alta (P1) para a mais baixa (P4).
+func (f *ChamadoFiltro) NormalizarOrdenacao() {
+	if _, ok := camposOrdenacaoChamado[f.OrdenarPor]; !ok {
+		f.OrdenarPor = OrdenarPorCriadoEm
+	}
+
+	f.Ordem = Ordem(strings.ToUpper(string(f.Ordem)))
+	if f.Ordem != OrdemAsc && f.Ordem != OrdemDesc {
+		if f.OrdenarPor == OrdenarPorPrioridade || f.OrdenarPor == OrdenarPorPrazoSolucao {
+			f.Ordem = OrdemAsc
+		} else {
+			f.Ordem = OrdemDesc
+		}
+	}
 }
 
 // String retorna uma representação de Chamado para fins de logging.
 func (c *Chamado) String() string {
 	return fmt.Sprintf(
-		"[ID=%s | Título=%s | Status=%s | CategoriaID=%s | SubcategoriaID=%s | CriadorID=%s | Arquivado=%t]",
-		c.ID, c.Titulo, c.Status, c.CategoriaID, c.SubcategoriaID, c.CriadorID, c.Arquivado,
+		"[ID=%s | Título=%s | Status=%s | Prioridade=%s | CategoriaID=%s | SubcategoriaID=%s | CriadorID=%s | Arquivado=%t]",
+		c.ID, c.Titulo, c.Status, c.Prioridade, c.CategoriaID, c.SubcategoriaID, c.CriadorID, c.Arquivado,
 	)
 }
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestValidarTransicaoStatus(t *testing.T) {
+	casos := []struct {
+		nome      string
+		origem    StatusChamado
+		destino   StatusChamado
+		permissao Permissao
+		esperado  error
+	}{
+		{"técnico atribui o chamado aberto", StatusAberto, StatusAtribuido, PermTEC, nil},
+		{"usuário não atribui o chamado aberto", StatusAberto, StatusAtribuido, PermUSR, ErrTransicaoStatusNaoPermitida},
+		{"chamado aberto não é resolvido sem atribuição", StatusAberto, StatusResolvido, PermADM, ErrTransicaoStatusInvalida},
+		{"técnico devolve o chamado para a fila", StatusAtribuido, StatusAberto, PermTEC, nil},
+		{"usuário responde ao chamado aguardando", StatusAguardando, StatusAtribuido, PermUSR, nil},
+		{"usuário não resolve o chamado aguardando", StatusAguardando, StatusResolvido, PermUSR, ErrTransicaoStatusNaoPermitida},
+		{"usuário fecha o chamado resolvido", StatusResolvido, StatusFechado, PermUSR, nil},
+		{"chamado resolvido não é reaberto pelo fluxo", StatusResolvido, StatusAtribuido, PermADM, ErrTransicaoStatusInvalida},
+		{"chamado fechado não é reaberto pelo fluxo", StatusFechado, StatusAtribuido, PermADM, ErrTransicaoStatusInvalida},
+		{"chamado rejeitado não sai do status", StatusRejeitado, StatusAberto, PermADM, ErrTransicaoStatusInvalida},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			err := ValidarTransicaoStatus(c.origem, c.destino, c.permissao)
+			if c.esperado == nil && err != nil {
+				t.Fatalf("ValidarTransicaoStatus = %v, esperado nil", err)
+			}
+			if c.esperado != nil && !errors.Is(err, c.esperado) {
+				t.Fatalf("ValidarTransicaoStatus = %v, esperado %v", err, c.esperado)
+			}
+		})
+	}
+}
+
+func TestStatusChamadoTransicoesPermitidas(t *testing.T) {
+	casos := []struct {
+		nome      string
+		status    StatusChamado
+		permissao Permissao
+		esperado  []StatusChamado
+	}{
+		{"técnico no chamado atribuído", StatusAtribuido, PermTEC, []StatusChamado{StatusAberto, StatusAguardando, StatusResolvido, StatusRejeitado}},
+		{"usuário no chamado atribuído", StatusAtribuido, PermUSR, []StatusChamado{}},
+		{"usuário no chamado resolvido", StatusResolvido, PermUSR, []StatusChamado{StatusFechado}},
+		{"administrador no chamado fechado", StatusFechado, PermADM, []StatusChamado{}},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if obtido := c.status.TransicoesPermitidas(c.permissao); !slices.Equal(obtido, c.esperado) {
+				t.Errorf("TransicoesPermitidas = %v, esperado %v", obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestChamadoAplicarPoliticaSLA(t *testing.T) {
+	politica := &PoliticaSLA{ID: "sla-1", PrimeiraRespostaMinutos: 60, SolucaoMinutos: 9 * 60}
+	chamado := &Chamado{}
+
+	chamado.AplicarPoliticaSLA(politica, calendarioComercial(), instante(t, "2025-06-06 16:00"))
+
+	if chamado.PoliticaSLAID == nil || *chamado.PoliticaSLAID != politica.ID {
+		t.Fatalf("PoliticaSLAID = %v, esperado %s", chamado.PoliticaSLAID, politica.ID)
+	}
+	if esperado := instante(t, "2025-06-06 17:00"); !chamado.PrazoPrimeiraResposta.Equal(esperado) {
+		t.Errorf("PrazoPrimeiraResposta = %s, esperado %s", chamado.PrazoPrimeiraResposta, esperado)
+	}
+	if esperado := instante(t, "2025-06-09 16:00"); !chamado.PrazoSolucao.Equal(esperado) {
+		t.Errorf("PrazoSolucao = %s, esperado %s", chamado.PrazoSolucao, esperado)
+	}
+}
+
+func TestChamadoRegistrarPrimeiraResposta(t *testing.T) {
+	primeira := time.Date(2025, 6, 6, 16, 0, 0, 0, time.UTC)
+	chamado := &Chamado{}
+
+	if !chamado.RegistrarPrimeiraResposta(primeira) {
+		t.Fatal("RegistrarPrimeiraResposta = false, esperado true na primeira resposta")
+	}
+	if chamado.RegistrarPrimeiraResposta(primeira.Add(time.Hour)) {
+		t.Error("RegistrarPrimeiraResposta = true, esperado false na segunda resposta")
+	}
+	if !chamado.PrimeiraRespostaEm.Equal(primeira) {
+		t.Errorf("PrimeiraRespostaEm = %s, esperado %s", chamado.PrimeiraRespostaEm, primeira)
+	}
+}
+
+func TestChamadoPausarRetomarSLA(t *testing.T) {
+	calendario := calendarioComercial()
+	prazo := instante(t, "2025-06-09 12:00")
+	chamado := &Chamado{PrazoSolucao: &prazo}
+
+	// pausado na sexta às 16:00 e retomado na segunda às 10:00: 3 horas úteis em pausa
+	chamado.PausarSLA(instante(t, "2025-06-06 16:00"))
+	chamado.PausarSLA(instante(t, "2025-06-06 16:30")) // a segunda pausa não reinicia a contagem
+	chamado.RetomarSLA(calendario, instante(t, "2025-06-09 10:00"))
+
+	if chamado.SLAPausadoEm != nil {
+		t.Errorf("SLAPausadoEm = %s, esperado nil", chamado.SLAPausadoEm)
+	}
+	if esperado := instante(t, "2025-06-09 15:00"); !chamado.PrazoSolucao.Equal(esperado) {
+		t.Errorf("PrazoSolucao = %s, esperado %s", chamado.PrazoSolucao, esperado)
+	}
+}
+
+func TestChamadoCalcularTempos(t *testing.T) {
+	calendario := calendarioComercial()
+	solucionadoEm := instante(t, "2025-06-03 10:00")
+	atribuidoEm := instante(t, "2025-06-04 09:00")
+	chamado := &Chamado{
+		Status:                 StatusAtribuido,
+		CriadoEm:               instante(t, "2025-06-02 16:00"),
+		SolucionadoEm:          &solucionadoEm,
+		AtribuidoEm:            &atribuidoEm,
+		TempoAtribuidoSegundos: int64((30 * time.Minute) / time.Second),
+	}
+
+	tempos := chamado.CalcularTempos(calendario, instante(t, "2025-06-04 11:00"))
+
+	if tempos.IdadeUtilMinutos != 13*60 {
+		t.Errorf("IdadeUtilMinutos = %d, esperado %d", tempos.IdadeUtilMinutos, 13*60)
+	}
+	if tempos.TempoResolucaoUtilMinutos == nil || *tempos.TempoResolucaoUtilMinutos != 3*60 {
+		t.Errorf("TempoResolucaoUtilMinutos = %v, esperado %d", tempos.TempoResolucaoUtilMinutos, 3*60)
+	}
+	if tempos.TempoAtribuidoUtilMinutos != 150 {
+		t.Errorf("TempoAtribuidoUtilMinutos = %d, esperado %d", tempos.TempoAtribuidoUtilMinutos, 150)
+	}
+}
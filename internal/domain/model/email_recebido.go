@@ -0,0 +1,97 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Erros específicos do recebimento de chamados por e-mail
+var (
+	ErrRemetenteDesconhecido     = errors.New("o remetente do e-mail não corresponde a nenhum usuário")
+	ErrRemetenteInativo          = errors.New("o remetente do e-mail é um usuário desativado")
+	ErrRemetenteSemAcessoChamado = errors.New("o remetente do e-mail não tem acesso ao chamado referenciado")
+	ErrEmailSemConteudo          = errors.New("o e-mail não tem texto nem anexos")
+	ErrEmailAutomatico           = errors.New("o e-mail foi gerado automaticamente e não é processado")
+)
+
+// tamanhoMaximoTitulo é o tamanho da coluna chamados.titulo
+const tamanhoMaximoTitulo = 255
+
+// tituloEmailPadrao é o título dos chamados abertos por e-mail sem assunto
+const tituloEmailPadrao = "Chamado aberto por e-mail"
+
+// referenciaChamado identifica o chamado no assunto das mensagens, como em "[#<id do chamado>]"
+var referenciaChamado = regexp.MustCompile(`\[#([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})\]`)
+
+// prefixosResposta são os prefixos de resposta e encaminhamento removidos do assunto
+var prefixosResposta = regexp.MustCompile(`^(?i)((re|res|fw|fwd|enc|tr)\s*:\s*)+`)
+
+// AnexoEmail representa um arquivo anexado ao e-mail recebido
+type AnexoEmail struct {
+	NomeArquivo string
+	Conteudo    []byte
+}
+
+// EmailRecebido representa uma mensagem lida da caixa de entrada do atendimento
+type EmailRecebido struct {
+	Chave          string // identifica a mensagem na caixa de entrada
+	MessageID      string
+	RemetenteNome  string
+	RemetenteEmail string
+	Assunto        string
+	Corpo          string // texto da mensagem, sem as citações e a assinatura
+	Anexos         []AnexoEmail
+	Automatico     bool // respostas automáticas, avisos de entrega e listas de e-mail
+	RecebidoEm     time.Time
+}
+
+// RegistroEmailRecebido associa a mensagem processada ao chamado ou ao acompanhamento criado,
+// evitando que a mesma mensagem seja processada duas vezes
+type RegistroEmailRecebido struct {
+	MessageID        string    `json:"messageId"`
+	ChamadoID        string    `json:"chamadoId"`
+	AcompanhamentoID *string   `json:"acompanhamentoId,omitempty"` // nulo quando a mensagem abriu o chamado
+	RemetenteID      string    `json:"remetenteId"`
+	RecebidoEm       time.Time `json:"recebidoEm"`
+	ProcessadoEm     time.Time `json:"processadoEm"`
+}
+
+// ReferenciaChamado retorna a referência do chamado incluída no assunto dos e-mails enviados,
+// usada para associar as respostas ao chamado.
+func ReferenciaChamado(id string) string {
+	return "[#" + id + "]"
+}
+
+// ExtrairReferenciaChamado retorna o ID do chamado referenciado no assunto, ou vazio
+// quando a mensagem inicia uma nova conversa.
+func (e *EmailRecebido) ExtrairReferenciaChamado() string {
+	if m := referenciaChamado.FindStringSubmatch(e.Assunto); m != nil {
+		return strings.ToLower(m[1])
+	}
+	return ""
+}
+
+// TituloChamado retorna o assunto sem a referência e os prefixos de resposta, limitado
+// ao tamanho do título do chamado.
+func (e *EmailRecebido) TituloChamado() string {
+	titulo := referenciaChamado.ReplaceAllString(e.Assunto, "")
+	titulo = strings.Join(strings.Fields(titulo), " ")
+	titulo = strings.TrimSpace(prefixosResposta.ReplaceAllString(titulo, ""))
+	if titulo == "" {
+		return tituloEmailPadrao
+	}
+
+	if utf8.RuneCountInString(titulo) > tamanhoMaximoTitulo {
+		titulo = string([]rune(titulo)[:tamanhoMaximoTitulo])
+	}
+	return titulo
+}
+
+// String retorna uma representação de EmailRecebido para fins de logging.
+func (e *EmailRecebido) String() string {
+	return fmt.Sprintf("MessageID(%s) | Remetente(%s) | Assunto(%s) | Anexos(%d)", e.MessageID, e.RemetenteEmail, e.Assunto, len(e.Anexos))
+}
@@ -0,0 +1,111 @@
+package model
+
+import "fmt"
+
+// EscopoChamados descreve os chamados visíveis a um usuário. Administradores e
+// desenvolvedores veem todos os chamados; técnicos veem os chamados das categorias em que
+// possuem permissão e os que já foram atribuídos a eles; os demais usuários veem os chamados
+// que criaram e os das categorias em que receberam permissão de técnico ou superior.
+// Qualquer usuário vê também os chamados que observa e aqueles em que foi mencionado.
+type EscopoChamados struct {
+	UsuarioID string
+	Permissao Permissao
+}
+
+// NewEscopoChamados cria o escopo de chamados visíveis ao usuário informado.
+func NewEscopoChamados(usuarioID string, permissao Permissao) *EscopoChamados {
+	return &EscopoChamados{UsuarioID: usuarioID, Permissao: permissao}
+}
+
+// Irrestrito indica se o escopo inclui todos os chamados.
+func (e *EscopoChamados) Irrestrito() bool {
+	return e == nil || PermissaoIrrestrita(e.Permissao)
+}
+
+// PorCategoria indica se o escopo inclui os chamados das categorias em que o usuário
+// possui qualquer permissão e os que já foram atribuídos a ele. Nos demais escopos, apenas
+// as permissões de técnico ou superior na categoria dão acesso aos chamados.
+func (e *EscopoChamados) PorCategoria() bool {
+	return e != nil && e.Permissao == PermTEC
+}
+
+// IncluiCategoria indica se a permissão concedida ao usuário na categoria dá acesso aos
+// chamados da categoria no escopo.
+func (e *EscopoChamados) IncluiCategoria(concedida Permissao) bool {
+	return e.PorCategoria() || concedida != PermUSR
+}
+
+// EscopoEventos aplica as regras de EscopoChamados aos eventos em tempo real, a partir das
+// permissões do usuário nas categorias e dos chamados atribuídos a ele ou em que foi
+// mencionado e dos chamados que observa, carregados na assinatura, sem consultar o banco a
+// cada evento.
+type EscopoEventos struct {
+	Escopo     *EscopoChamados
+	categorias map[string]struct{}
+	chamados   map[string]struct{}
+}
+
+// NewEscopoEventos cria o escopo de eventos a partir das permissões concedidas ao usuário
+// nas categorias e dos chamados atribuídos a ele, que observa ou em que foi mencionado.
+func NewEscopoEventos(escopo *EscopoChamados, concessoes []CategoriaPermissao, chamados []string) *EscopoEventos {
+	e := &EscopoEventos{
+		Escopo:     escopo,
+		categorias: make(map[string]struct{}, len(concessoes)),
+		chamados:   make(map[string]struct{}, len(chamados)),
+	}
+	for _, c := range concessoes {
+		if escopo.IncluiCategoria(c.Permissao) {
+			e.categorias[c.CategoriaID] = struct{}{}
+		}
+	}
+	for _, id := range chamados {
+		e.chamados[id] = struct{}{}
+	}
+	return e
+}
+
+// Registrar inclui no escopo o chamado do evento quando ele menciona o usuário, o adiciona
+// como observador ou, no escopo por categoria, quando atribui o chamado ao usuário.
+func (e *EscopoEventos) Registrar(evento *Evento) {
+	if e.Escopo.Irrestrito() {
+		return
+	}
+	switch dados := evento.Dados.(type) {
+	case MencaoEvento:
+		if dados.UsuarioID == e.Escopo.UsuarioID {
+			e.chamados[evento.ChamadoID] = struct{}{}
+		}
+	case *Observador:
+		if dados != nil && dados.UsuarioID == e.Escopo.UsuarioID {
+			e.chamados[evento.ChamadoID] = struct{}{}
+		}
+	case *Atendimento:
+		if e.Escopo.PorCategoria() && dados != nil && dados.AtribuidoID == e.Escopo.UsuarioID {
+			e.chamados[evento.ChamadoID] = struct{}{}
+		}
+	}
+}
+
+// Inclui indica se o evento pode ser entregue ao usuário: o chamado do evento deve estar no
+// escopo e os eventos internos são vistos apenas pela equipe técnica.
+func (e *EscopoEventos) Inclui(evento *Evento) bool {
+	if evento.Interno && !PodeAcessarNotasInternas(e.Escopo.Permissao) {
+		return false
+	}
+	if e.Escopo.Irrestrito() {
+		return true
+	}
+	if evento.CriadorChamadoID != "" && evento.CriadorChamadoID == e.Escopo.UsuarioID {
+		return true
+	}
+	if _, ok := e.chamados[evento.ChamadoID]; ok {
+		return true
+	}
+	_, ok := e.categorias[evento.CategoriaID]
+	return ok
+}
+
+// String retorna uma representação de EscopoChamados para fins de logging.
+func (e *EscopoChamados) String() string {
+	return fmt.Sprintf("Usuario(%s) | Permissao(%s)", e.UsuarioID, e.Permissao)
+}
@@ -0,0 +1,102 @@
+package model
+
+import "testing"
+
+// eventoChamado cria um evento do chamado informado, criado por criadorID na categoria.
+func eventoChamado(chamadoID, criadorID, categoriaID string, interno bool, dados any) *Evento {
+	chamado := &Chamado{ID: chamadoID, CriadorID: criadorID, CategoriaID: categoriaID}
+	return NewEvento(EventoStatusAlterado, chamado, interno, dados)
+}
+
+func TestEscopoChamados(t *testing.T) {
+	casos := []struct {
+		permissao    Permissao
+		irrestrito   bool
+		porCategoria bool
+	}{
+		{PermADM, true, false},
+		{PermDEV, true, false},
+		{PermTEC, false, true},
+		{PermUSR, false, false},
+	}
+
+	for _, c := range casos {
+		t.Run(string(c.permissao), func(t *testing.T) {
+			escopo := NewEscopoChamados("usr-1", c.permissao)
+			if obtido := escopo.Irrestrito(); obtido != c.irrestrito {
+				t.Errorf("Irrestrito = %t, esperado %t", obtido, c.irrestrito)
+			}
+			if obtido := escopo.PorCategoria(); obtido != c.porCategoria {
+				t.Errorf("PorCategoria = %t, esperado %t", obtido, c.porCategoria)
+			}
+		})
+	}
+}
+
+func TestEscopoEventosInclui(t *testing.T) {
+	const usuario = "usr-1"
+	concessoes := []CategoriaPermissao{
+		{CategoriaID: "cat-tec", Permissao: PermTEC},
+		{CategoriaID: "cat-usr", Permissao: PermUSR},
+	}
+	chamados := []string{"ch-mencionado"}
+
+	casos := []struct {
+		nome      string
+		permissao Permissao
+		evento    *Evento
+		esperado  bool
+	}{
+		{"administrador recebe tudo", PermADM, eventoChamado("ch-1", "outro", "cat-outra", true, nil), true},
+		{"usuário recebe o próprio chamado", PermUSR, eventoChamado("ch-1", usuario, "cat-outra", false, nil), true},
+		{"usuário recebe o chamado em que foi mencionado", PermUSR, eventoChamado("ch-mencionado", "outro", "cat-outra", false, nil), true},
+		{"usuário recebe a categoria com concessão de técnico", PermUSR, eventoChamado("ch-1", "outro", "cat-tec", false, nil), true},
+		{"usuário não recebe a categoria com concessão de usuário", PermUSR, eventoChamado("ch-1", "outro", "cat-usr", false, nil), false},
+		{"usuário não recebe chamado fora do escopo", PermUSR, eventoChamado("ch-1", "outro", "cat-outra", false, nil), false},
+		{"usuário não recebe evento interno do próprio chamado", PermUSR, eventoChamado("ch-1", usuario, "cat-outra", true, nil), false},
+		{"técnico recebe a categoria com qualquer concessão", PermTEC, eventoChamado("ch-1", "outro", "cat-usr", false, nil), true},
+		{"técnico recebe evento interno no escopo", PermTEC, eventoChamado("ch-1", "outro", "cat-tec", true, nil), true},
+		{"técnico não recebe chamado fora do escopo", PermTEC, eventoChamado("ch-1", "outro", "cat-outra", false, nil), false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			escopo := NewEscopoEventos(NewEscopoChamados(usuario, c.permissao), concessoes, chamados)
+			if obtido := escopo.Inclui(c.evento); obtido != c.esperado {
+				t.Errorf("Inclui = %t, esperado %t", obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestEscopoEventosRegistrar(t *testing.T) {
+	const usuario = "usr-1"
+
+	casos := []struct {
+		nome      string
+		permissao Permissao
+		dados     any
+		esperado  bool
+	}{
+		{"menção ao usuário", PermUSR, MencaoEvento{UsuarioID: usuario}, true},
+		{"menção a outro usuário", PermUSR, MencaoEvento{UsuarioID: "outro"}, false},
+		{"usuário adicionado como observador", PermUSR, &Observador{UsuarioID: usuario}, true},
+		{"outro usuário adicionado como observador", PermUSR, &Observador{UsuarioID: "outro"}, false},
+		{"chamado atribuído ao técnico", PermTEC, &Atendimento{AtribuidoID: usuario}, true},
+		{"atribuição não inclui o chamado fora do escopo por categoria", PermUSR, &Atendimento{AtribuidoID: usuario}, false},
+		{"alteração de status não inclui o chamado", PermUSR, AlteracaoStatusEvento{}, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			escopo := NewEscopoEventos(NewEscopoChamados(usuario, c.permissao), nil, nil)
+
+			escopo.Registrar(eventoChamado("ch-1", "outro", "cat-outra", false, c.dados))
+
+			// os eventos seguintes do chamado passam a ser entregues ao usuário
+			if obtido := escopo.Inclui(eventoChamado("ch-1", "outro", "cat-outra", false, nil)); obtido != c.esperado {
+				t.Errorf("Inclui após Registrar = %t, esperado %t", obtido, c.esperado)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// TipoEvento define os tipos de eventos de domínio transmitidos em tempo real
+type TipoEvento string
+
+const (
+	EventoChamadoCriado        TipoEvento = "CHAMADO_CRIADO"
+	EventoAcompanhamentoCriado TipoEvento = "ACOMPANHAMENTO_CRIADO"
+	EventoStatusAlterado       TipoEvento = "STATUS_ALTERADO"
+	EventoChamadoAtribuido     TipoEvento = "CHAMADO_ATRIBUIDO"
+	EventoSLAEmRisco           TipoEvento = "SLA_EM_RISCO"
+	EventoSLAViolado           TipoEvento = "SLA_VIOLADO"
+	EventoUsuarioMencionado    TipoEvento = "USUARIO_MENCIONADO"
+	EventoObservadorAdicionado TipoEvento = "OBSERVADOR_ADICIONADO"
+
+	// EventoRessincronizar avisa o cliente que eventos anteriores à reconexão foram
+	// descartados do buffer e que o estado deve ser recarregado pela API.
+	EventoRessincronizar TipoEvento = "RESSINCRONIZAR"
+)
+
+// Evento representa um evento de domínio ocorrido em um chamado
+type Evento struct {
+	ID        uint64     `json:"id"` // sequencial e crescente, atribuído na publicação
+	Tipo      TipoEvento `json:"tipo"`
+	ChamadoID string     `json:"chamadoId"`
+	Dados     any        `json:"dados,omitempty"`
+	CriadoEm  time.Time  `json:"criadoEm"`
+
+	CriadorChamadoID string `json:"-"` // usado para restringir o evento ao criador do chamado
+	CategoriaID      string `json:"-"` // usado para restringir o evento ao escopo de chamados
+	Interno          bool   `json:"-"` // eventos internos são vistos apenas pela equipe técnica
+}
+
+// AlteracaoStatusEvento são os dados do evento de alteração de status
+type AlteracaoStatusEvento struct {
+	StatusAnterior StatusChamado `json:"statusAnterior"`
+	Status         StatusChamado `json:"status"`
+}
+
+// ViolacaoSLAEvento são os dados do evento de violação de SLA
+type ViolacaoSLAEvento struct {
+	Tipo TipoSLA `json:"tipo"`
+}
+
+// RiscoSLAEvento são os dados do evento de prazo de SLA próximo do vencimento
+type RiscoSLAEvento struct {
+	Tipo  TipoSLA   `json:"tipo"`
+	Prazo time.Time `json:"prazo"`
+}
+
+// MencaoEvento são os dados do evento de menção de um usuário em um acompanhamento
+type MencaoEvento struct {
+	UsuarioID      string          `json:"usuarioId"`
+	Acompanhamento *Acompanhamento `json:"acompanhamento"`
+}
+
+// NewEvento cria um novo evento referente ao chamado informado.
+func NewEvento(tipo TipoEvento, chamado *Chamado, interno bool, dados any) *Evento {
+	return &Evento{
+		Tipo:             tipo,
+		ChamadoID:        chamado.ID,
+		Dados:            dados,
+		CriadoEm:         time.Now(),
+		CriadorChamadoID: chamado.CriadorID,
+		CategoriaID:      chamado.CategoriaID,
+		Interno:          interno,
+	}
+}
+
+// AssinaturaEventos representa a inscrição de um cliente no fluxo de eventos
+type AssinaturaEventos struct {
+	// Pendentes são os eventos publicados após o último evento recebido pelo cliente,
+	// ainda disponíveis no buffer, em ordem de publicação.
+	Pendentes []Evento
+
+	// Completa indica se todos os eventos posteriores ao último recebido estavam no buffer.
+	Completa bool
+
+	// Eventos entrega os novos eventos; o canal é fechado quando a assinatura é
+	// cancelada ou quando o cliente não consome os eventos a tempo.
+	Eventos <-chan Evento
+
+	// Cancelar encerra a assinatura.
+	Cancelar func()
+}
+
+// String retorna uma representação de Evento para fins de logging.
+func (e *Evento) String() string {
+	return fmt.Sprintf("ID(%d) | Tipo(%s) | Chamado(%s) | Interno(%t)", e.ID, e.Tipo, e.ChamadoID, e.Interno)
+}
@@ -24,6 +24,7 @@ const (
 	AcaoArquivar    Acao = "ARQUIVAR"
 	AcaoDesarquivar Acao = "DESARQUIVAR"
 	AcaoDeletar     Acao = "DELETAR"
+	AcaoViolarSLA   Acao = "VIOLAR_SLA"
 )
 
 var acoesValidas = map[Acao]struct{}{
@@ -33,6 +34,8 @@ var acoesValidas = map[Acao]struct{}{
 	AcaoDesativar:   {},
 	AcaoArquivar:    {},
 	AcaoDesarquivar: {},
+	AcaoDeletar:     {},
+	AcaoViolarSLA:   {},
 }
 
 // Log representa uma entrada de log no sistema.
@@ -41,6 +44,7 @@ type Log struct {
 	UsuarioID string    `json:"usuario_id"`
 	Acao      Acao      `json:"acao"`
 	Entidade  string    `json:"entidade"`
+	ChamadoID *string   `json:"chamado_id,omitempty"` // chamado a que o registro se refere, quando houver
 	Detalhes  string    `json:"detalhes,omitempty"`
 	CriadoEm  time.Time `json:"criado_em"`
 }
@@ -99,4 +103,7 @@ type LogFiltro struct {
 	Entidade   *string
 	DataInicio *time.Time
 	DataFim    *time.Time
+
+	// Escopo restringe os logs de chamados aos chamados visíveis a quem consulta; nil não restringe
+	Escopo *EscopoChamados
 }
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// padraoMencao reconhece @login no início do texto ou após um caractere que não faz parte de
+// um login, de modo que endereços de e-mail não sejam tratados como menções.
+var padraoMencao = regexp.MustCompile(`(?:^|[^\w@.])@([\w.-]+)`)
+
+// Mencao representa um usuário mencionado com @login no conteúdo de um acompanhamento. A
+// menção concede ao usuário acesso de leitura ao chamado, mesmo que ele não seja o criador.
+type Mencao struct {
+	AcompanhamentoID string    `json:"acompanhamentoId"`
+	ChamadoID        string    `json:"chamadoId"`
+	UsuarioID        string    `json:"usuarioId"`
+	CriadoEm         time.Time `json:"criadoEm"`
+}
+
+// NewMencao cria a menção do usuário no acompanhamento informado.
+func NewMencao(acompanhamento *Acompanhamento, usuarioID string) *Mencao {
+	return &Mencao{
+		AcompanhamentoID: acompanhamento.ID,
+		ChamadoID:        acompanhamento.ChamadoID,
+		UsuarioID:        usuarioID,
+		CriadoEm:         time.Now(),
+	}
+}
+
+// ExtrairMencoes retorna os logins mencionados no conteúdo, sem repetições e na ordem em que
+// aparecem. A pontuação ao fim da menção, como em "@login.", não faz parte do login.
+func ExtrairMencoes(conteudo string) []string {
+	vistos := map[string]struct{}{}
+	logins := []string{}
+	for _, m := range padraoMencao.FindAllStringSubmatch(conteudo, -1) {
+		login := strings.TrimRight(m[1], ".-")
+		if login == "" {
+			continue
+		}
+		if _, ok := vistos[login]; ok {
+			continue
+		}
+		vistos[login] = struct{}{}
+		logins = append(logins, login)
+	}
+	return logins
+}
+
+// String retorna uma representação de Mencao para fins de logging.
+func (m *Mencao) String() string {
+	return fmt.Sprintf("Acompanhamento(%s) | Chamado(%s) | Usuario(%s)", m.AcompanhamentoID, m.ChamadoID, m.UsuarioID)
+}
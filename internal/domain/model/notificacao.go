@@ -0,0 +1,158 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Erros de validação específicos para as notificações
+var (
+	ErrTipoNotificacaoInvalido = errors.New("tipo de notificação inválido: o tipo deve ser um dos seguintes: CHAMADO_CRIADO, CHAMADO_ATRIBUIDO, NOVO_ACOMPANHAMENTO, CHAMADO_RESOLVIDO, SLA_EM_RISCO, MENCAO")
+	ErrFilaNotificacaoCheia    = errors.New("a fila de envio de notificações está cheia")
+	ErrNotificacaoIDInvalido   = errors.New("ID da notificação inválido")
+)
+
+// TipoNotificacao define os tipos de notificação enviados aos usuários
+type TipoNotificacao string
+
+const (
+	NotificacaoChamadoCriado      TipoNotificacao = "CHAMADO_CRIADO"
+	NotificacaoChamadoAtribuido   TipoNotificacao = "CHAMADO_ATRIBUIDO"
+	NotificacaoNovoAcompanhamento TipoNotificacao = "NOVO_ACOMPANHAMENTO"
+	NotificacaoChamadoResolvido   TipoNotificacao = "CHAMADO_RESOLVIDO"
+	NotificacaoSLAEmRisco         TipoNotificacao = "SLA_EM_RISCO"
+	NotificacaoMencao             TipoNotificacao = "MENCAO"
+)
+
+// TiposNotificacao lista os tipos de notificação na ordem apresentada ao usuário
+var TiposNotificacao = []TipoNotificacao{
+	NotificacaoChamadoCriado,
+	NotificacaoChamadoAtribuido,
+	NotificacaoNovoAcompanhamento,
+	NotificacaoChamadoResolvido,
+	NotificacaoSLAEmRisco,
+	NotificacaoMencao,
+}
+
+// PreferenciaNotificacao indica se o usuário recebe um tipo de notificação por e-mail
+type PreferenciaNotificacao struct {
+	Tipo  TipoNotificacao `json:"tipo"`
+	Email bool            `json:"email"`
+}
+
+// Notificacao reúne o destinatário e os dados usados na mensagem enviada ao usuário
+type Notificacao struct {
+	Tipo           TipoNotificacao
+	Destinatario   *Usuario
+	Chamado        *Chamado
+	Acompanhamento *Acompanhamento // preenchido em NOVO_ACOMPANHAMENTO e MENCAO
+	Prazo          *time.Time      // preenchido em SLA_EM_RISCO
+}
+
+// NotificacaoUsuario representa um item da central de notificações do usuário
+type NotificacaoUsuario struct {
+	ID               string          `json:"id"`
+	UsuarioID        string          `json:"usuarioId"`
+	Tipo             TipoNotificacao `json:"tipo"`
+	ChamadoID        string          `json:"chamadoId"`
+	AcompanhamentoID *string         `json:"acompanhamentoId,omitempty"`
+	Mensagem         string          `json:"mensagem"`
+	LidaEm           *time.Time      `json:"lidaEm,omitempty"`
+	CriadoEm         time.Time       `json:"criadoEm"`
+}
+
+// NotificacaoUsuarioFiltro representa os critérios de filtro para listar as notificações do usuário
+type NotificacaoUsuarioFiltro struct {
+	Pagina    int
+	Limite    int
+	UsuarioID string
+	Lida      *bool
+}
+
+// NewNotificacaoUsuario cria o item da central de notificações do destinatário da
+// notificação. Retorna nil para os tipos que não são exibidos na central.
+func NewNotificacaoUsuario(id string, n *Notificacao) *NotificacaoUsuario {
+	if n.Destinatario == nil || n.Chamado == nil {
+		return nil
+	}
+
+	var mensagem string
+	switch n.Tipo {
+	case NotificacaoChamadoAtribuido:
+		mensagem = fmt.Sprintf("O chamado \"%s\" foi atribuído a você", n.Chamado.Titulo)
+	case NotificacaoNovoAcompanhamento:
+		mensagem = fmt.Sprintf("Nova resposta no chamado \"%s\"", n.Chamado.Titulo)
+	case NotificacaoSLAEmRisco:
+		mensagem = fmt.Sprintf("O prazo de solução do chamado \"%s\" está perto de vencer", n.Chamado.Titulo)
+	case NotificacaoMencao:
+		mensagem = fmt.Sprintf("Você foi mencionado no chamado \"%s\"", n.Chamado.Titulo)
+	default:
+		return nil
+	}
+
+	notificacao := &NotificacaoUsuario{
+		ID:        id,
+		UsuarioID: n.Destinatario.ID,
+		Tipo:      n.Tipo,
+		ChamadoID: n.Chamado.ID,
+		Mensagem:  mensagem,
+		CriadoEm:  time.Now(),
+	}
+	if n.Acompanhamento != nil {
+		notificacao.AcompanhamentoID = &n.Acompanhamento.ID
+	}
+	return notificacao
+}
+
+// ValidarTipoNotificacao valida se o tipo é um dos tipos de notificação permitidos.
+func ValidarTipoNotificacao(tipo TipoNotificacao) error {
+	for _, t := range TiposNotificacao {
+		if t == tipo {
+			return nil
+		}
+	}
+	return fmt.Errorf("[model.ValidarTipoNotificacao]: %w", ErrTipoNotificacaoInvalido)
+}
+
+// MesclarPreferenciasNotificacao completa as preferências salvas com os tipos ainda não
+// configurados pelo usuário, que recebem notificações por padrão.
+func MesclarPreferenciasNotificacao(salvas []PreferenciaNotificacao) []PreferenciaNotificacao {
+	porTipo := make(map[TipoNotificacao]bool, len(salvas))
+	for _, p := range salvas {
+		porTipo[p.Tipo] = p.Email
+	}
+
+	preferencias := make([]PreferenciaNotificacao, 0, len(TiposNotificacao))
+	for _, tipo := range TiposNotificacao {
+		email, ok := porTipo[tipo]
+		if !ok {
+			email = true
+		}
+		preferencias = append(preferencias, PreferenciaNotificacao{Tipo: tipo, Email: email})
+	}
+	return preferencias
+}
+
+// PodeReceberEmail indica se o destinatário tem um e-mail para receber a notificação
+// e ainda está ativo.
+func (n *Notificacao) PodeReceberEmail() bool {
+	return n.Destinatario != nil && n.Destinatario.Status && n.Destinatario.Email != ""
+}
+
+// String retorna uma representação de Notificacao para fins de logging.
+func (n *Notificacao) String() string {
+	destinatario, chamado := "", ""
+	if n.Destinatario != nil {
+		destinatario = n.Destinatario.ID
+	}
+	if n.Chamado != nil {
+		chamado = n.Chamado.ID
+	}
+	return fmt.Sprintf("Tipo(%s) | Destinatario(%s) | Chamado(%s)", n.Tipo, destinatario, chamado)
+}
+
+// String retorna uma representação de NotificacaoUsuario para fins de logging.
+func (n *NotificacaoUsuario) String() string {
+	return fmt.Sprintf("ID(%s) | Usuario(%s) | Tipo(%s) | Chamado(%s) | Lida(%t)", n.ID, n.UsuarioID, n.Tipo, n.ChamadoID, n.LidaEm != nil)
+}
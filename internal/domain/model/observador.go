@@ -0,0 +1,47 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Erros de validação específicos para os observadores dos chamados
+var (
+	ErrObservadorUsuarioIDInvalido = errors.New("o ID do usuário observador não pode ser vazio")
+)
+
+// Observador representa um usuário que acompanha o chamado sem tê-lo criado. Observar
+// concede ao usuário acesso de leitura ao chamado e aos seus eventos.
+type Observador struct {
+	ChamadoID       string    `json:"chamadoId"`
+	UsuarioID       string    `json:"usuarioId"`
+	AdicionadoPorID string    `json:"adicionadoPorId"` // o próprio observador ou quem o incluiu
+	CriadoEm        time.Time `json:"criadoEm"`
+}
+
+// NewObservador cria o observador do chamado, incluído por adicionadoPorID.
+func NewObservador(chamadoID, usuarioID, adicionadoPorID string) (*Observador, error) {
+	var erros []error
+	if chamadoID == "" {
+		erros = append(erros, ErrChamadoIDInvalido)
+	}
+	if usuarioID == "" {
+		erros = append(erros, ErrObservadorUsuarioIDInvalido)
+	}
+	if len(erros) > 0 {
+		return nil, fmt.Errorf("[model.NewObservador]: %w", errors.Join(erros...))
+	}
+
+	return &Observador{
+		ChamadoID:       chamadoID,
+		UsuarioID:       usuarioID,
+		AdicionadoPorID: adicionadoPorID,
+		CriadoEm:        time.Now(),
+	}, nil
+}
+
+// String retorna uma representação de Observador para fins de logging.
+func (o *Observador) String() string {
+	return fmt.Sprintf("Chamado(%s) | Usuario(%s) | AdicionadoPor(%s)", o.ChamadoID, o.UsuarioID, o.AdicionadoPorID)
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Erros relacionados às mensagens da outbox
+var (
+	ErrDestinoOutboxInvalido = errors.New("destino da mensagem da outbox inválido: o destino deve ser um dos seguintes: LOG, EVENTO")
+)
+
+// DestinoOutbox define para onde a mensagem da outbox é publicada
+type DestinoOutbox string
+
+const (
+	OutboxLog    DestinoOutbox = "LOG"    // registro de log de auditoria
+	OutboxEvento DestinoOutbox = "EVENTO" // evento de domínio, entregue aos eventos em tempo real, às notificações e aos webhooks
+)
+
+// MensagemOutbox representa um registro de log ou um evento de domínio gravado na mesma
+// transação que a alteração que o originou, e publicado depois pelo despacho da outbox
+type MensagemOutbox struct {
+	ID           string
+	Destino      DestinoOutbox
+	Log          *Log    // preenchido quando o destino é LOG
+	Evento       *Evento // preenchido quando o destino é EVENTO
+	Tentativas   int
+	DisponivelEm time.Time // momento a partir do qual a mensagem pode ser publicada
+	UltimoErro   *string
+	CriadoEm     time.Time
+}
+
+// NewMensagemOutboxLog cria a mensagem que publica o registro de log.
+func NewMensagemOutboxLog(id string, l *Log) *MensagemOutbox {
+	now := time.Now()
+	return &MensagemOutbox{
+		ID:           id,
+		Destino:      OutboxLog,
+		Log:          l,
+		DisponivelEm: now,
+		CriadoEm:     now,
+	}
+}
+
+// NewMensagemOutboxEvento cria a mensagem que publica o evento de domínio.
+func NewMensagemOutboxEvento(id string, e *Evento) *MensagemOutbox {
+	now := time.Now()
+	return &MensagemOutbox{
+		ID:           id,
+		Destino:      OutboxEvento,
+		Evento:       e,
+		DisponivelEm: now,
+		CriadoEm:     now,
+	}
+}
+
+// ValidarDestinoOutbox valida se o destino é um dos destinos permitidos.
+func ValidarDestinoOutbox(destino DestinoOutbox) error {
+	switch destino {
+	case OutboxLog, OutboxEvento:
+		return nil
+	}
+	return fmt.Errorf("[model.ValidarDestinoOutbox]: %w", ErrDestinoOutboxInvalido)
+}
+
+// RegistrarFalha registra a falha na publicação, adiando a mensagem pela espera informada.
+func (m *MensagemOutbox) RegistrarFalha(erro string, espera time.Duration) {
+	m.Tentativas++
+	m.UltimoErro = &erro
+	m.DisponivelEm = time.Now().Add(espera)
+}
+
+// DecodificarDadosEvento converte os dados de um evento gravados em JSON para o tipo usado
+// pelos assinantes do evento.
+func DecodificarDadosEvento(tipo TipoEvento, dados json.RawMessage) (any, error) {
+	var destino any
+	switch tipo {
+	case EventoAcompanhamentoCriado:
+		destino = &Acompanhamento{}
+	case EventoChamadoAtribuido:
+		destino = &Atendimento{}
+	case EventoStatusAlterado:
+		destino = &AlteracaoStatusEvento{}
+	case EventoSLAEmRisco:
+		destino = &RiscoSLAEvento{}
+	case EventoSLAViolado:
+		destino = &ViolacaoSLAEvento{}
+	case EventoUsuarioMencionado:
+		destino = &MencaoEvento{}
+	case EventoObservadorAdicionado:
+		destino = &Observador{}
+	default:
+		return nil, nil
+	}
+
+	if len(dados) == 0 || string(dados) == "null" {
+		return nil, nil
+	}
+	if err := json.Unmarshal(dados, destino); err != nil {
+		return nil, fmt.Errorf("[model.DecodificarDadosEvento]: %w", err)
+	}
+
+	// os assinantes recebem os dados de status, de SLA e de menção por valor
+	switch d := destino.(type) {
+	case *AlteracaoStatusEvento:
+		return *d, nil
+	case *RiscoSLAEvento:
+		return *d, nil
+	case *ViolacaoSLAEvento:
+		return *d, nil
+	case *MencaoEvento:
+		return *d, nil
+	}
+	return destino, nil
+}
+
+// String retorna uma representação de MensagemOutbox para fins de logging.
+func (m *MensagemOutbox) String() string {
+	detalhe := ""
+	switch {
+	case m.Evento != nil:
+		detalhe = m.Evento.String()
+	case m.Log != nil:
+		detalhe = fmt.Sprintf("Acao(%s) | Entidade(%s)", m.Log.Acao, m.Log.Entidade)
+	}
+	return fmt.Sprintf("ID(%s) | Destino(%s) | Tentativas(%d) | %s", m.ID, m.Destino, m.Tentativas, detalhe)
+}
@@ -0,0 +1,323 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Erros de validação específicos para os papéis e as permissões nomeadas
+var (
+	ErrCodigoPapelInvalido      = errors.New("código do papel inválido: use de 2 a 40 letras maiúsculas, números ou sublinhado, começando por uma letra")
+	ErrNomePapelInvalido        = errors.New("o nome do papel deve ter entre 1 e 100 caracteres")
+	ErrDescricaoPapelInvalida   = errors.New("a descrição do papel deve ter no máximo 255 caracteres")
+	ErrPermissaoNomeadaInvalida = errors.New("permissão desconhecida: consulte a lista de permissões disponíveis")
+	ErrPapelDoSistema           = errors.New("os papéis do sistema não podem ser removidos nem ter a permissão base alterada")
+	ErrPermissaoEssencialPapel  = errors.New("o papel ADM deve manter a permissão papel.editar, para que os papéis continuem administráveis")
+)
+
+// Limites dos campos do papel
+const (
+	TamanhoMaximoNomePapel      = 100
+	TamanhoMaximoDescricaoPapel = 255
+)
+
+// regexCodigoPapel define o formato aceito para o código dos papéis.
+var regexCodigoPapel = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,39}$`)
+
+// PermissaoNomeada define uma ação do sistema que pode ser concedida aos papéis, no formato
+// recurso.acao. As rotas da API exigem uma permissão nomeada, e cada papel reúne as
+// permissões concedidas aos seus usuários. Nos papéis personalizados, as permissões nomeadas
+// também concedem as operações sobre os chamados (ver PermissaoNomeadaDaOperacao); nos papéis
+// do sistema, as operações seguem a permissão efetiva na categoria (ver PermiteOperacao).
+type PermissaoNomeada string
+
+const (
+	PermissaoUsuarioCriar           PermissaoNomeada = "usuario.criar"
+	PermissaoUsuarioLer             PermissaoNomeada = "usuario.ler"
+	PermissaoUsuarioEditar          PermissaoNomeada = "usuario.editar"
+	PermissaoUsuarioEditarPermissao PermissaoNomeada = "usuario.editar_permissao"
+	PermissaoUsuarioAtivar          PermissaoNomeada = "usuario.ativar"
+
+	PermissaoChamadoCriar             PermissaoNomeada = "chamado.criar"
+	PermissaoChamadoLer               PermissaoNomeada = "chamado.ler"
+	PermissaoChamadoEditar            PermissaoNomeada = "chamado.editar"
+	PermissaoChamadoAlterarStatus     PermissaoNomeada = "chamado.alterar_status"
+	PermissaoChamadoAlterarPrioridade PermissaoNomeada = "chamado.alterar_prioridade"
+	PermissaoChamadoReabrir           PermissaoNomeada = "chamado.reabrir"
+	PermissaoChamadoLerReaberturas    PermissaoNomeada = "chamado.ler_reaberturas"
+	PermissaoChamadoArquivar          PermissaoNomeada = "chamado.arquivar"
+	PermissaoChamadoObservar          PermissaoNomeada = "chamado.observar"
+
+	PermissaoCategoriaCriar  PermissaoNomeada = "categoria.criar"
+	PermissaoCategoriaLer    PermissaoNomeada = "categoria.ler"
+	PermissaoCategoriaEditar PermissaoNomeada = "categoria.editar"
+	PermissaoCategoriaAtivar PermissaoNomeada = "categoria.ativar"
+
+	PermissaoSubcategoriaCriar  PermissaoNomeada = "subcategoria.criar"
+	PermissaoSubcategoriaLer    PermissaoNomeada = "subcategoria.ler"
+	PermissaoSubcategoriaEditar PermissaoNomeada = "subcategoria.editar"
+	PermissaoSubcategoriaAtivar PermissaoNomeada = "subcategoria.ativar"
+
+	PermissaoLogLer PermissaoNomeada = "log.ler"
+
+	PermissaoAcompanhamentoCriar       PermissaoNomeada = "acompanhamento.criar"
+	PermissaoAcompanhamentoLer         PermissaoNomeada = "acompanhamento.ler"
+	PermissaoAcompanhamentoEditar      PermissaoNomeada = "acompanhamento.editar"
+	PermissaoAcompanhamentoDeletar     PermissaoNomeada = "acompanhamento.deletar"
+	PermissaoAcompanhamentoLerRevisao  PermissaoNomeada = "acompanhamento.ler_revisoes"
+	PermissaoAcompanhamentoNotaInterna PermissaoNomeada = "acompanhamento.nota_interna"
+
+	PermissaoAnexoEnviar  PermissaoNomeada = "anexo.enviar"
+	PermissaoAnexoLer     PermissaoNomeada = "anexo.ler"
+	PermissaoAnexoDeletar PermissaoNomeada = "anexo.deletar"
+
+	PermissaoEventoAssinar PermissaoNomeada = "evento.assinar"
+
+	PermissaoNotificacaoLer    PermissaoNomeada = "notificacao.ler"
+	PermissaoNotificacaoEditar PermissaoNomeada = "notificacao.editar"
+
+	PermissaoAtendimentoCriar      PermissaoNomeada = "atendimento.criar"
+	PermissaoAtendimentoLer        PermissaoNomeada = "atendimento.ler"
+	PermissaoAtendimentoTransferir PermissaoNomeada = "atendimento.transferir"
+
+	PermissaoApontamentoCriar   PermissaoNomeada = "apontamento.criar"
+	PermissaoApontamentoLer     PermissaoNomeada = "apontamento.ler"
+	PermissaoApontamentoEditar  PermissaoNomeada = "apontamento.editar"
+	PermissaoApontamentoDeletar PermissaoNomeada = "apontamento.deletar"
+
+	PermissaoAvaliacaoResponder PermissaoNomeada = "avaliacao.responder"
+	PermissaoAvaliacaoLer       PermissaoNomeada = "avaliacao.ler"
+	PermissaoAvaliacaoLerCSAT   PermissaoNomeada = "avaliacao.ler_csat"
+
+	PermissaoCategoriaPermissaoLer    PermissaoNomeada = "categoria_permissao.ler"
+	PermissaoCategoriaPermissaoEditar PermissaoNomeada = "categoria_permissao.editar"
+
+	PermissaoPoliticaSLALer    PermissaoNomeada = "politica_sla.ler"
+	PermissaoPoliticaSLAEditar PermissaoNomeada = "politica_sla.editar"
+
+	PermissaoCalendarioLer       PermissaoNomeada = "calendario.ler"
+	PermissaoCalendarioEditar    PermissaoNomeada = "calendario.editar"
+	PermissaoCalendarioTempoUtil PermissaoNomeada = "calendario.tempo_util"
+
+	PermissaoPrioridadeLer    PermissaoNomeada = "prioridade.ler"
+	PermissaoPrioridadeEditar PermissaoNomeada = "prioridade.editar"
+
+	PermissaoAtribuicaoPrevia PermissaoNomeada = "atribuicao.previa"
+
+	PermissaoAutorizacaoLer PermissaoNomeada = "autorizacao.ler"
+
+	PermissaoWebhookLer    PermissaoNomeada = "webhook.ler"
+	PermissaoWebhookEditar PermissaoNomeada = "webhook.editar"
+
+	PermissaoPapelLer    PermissaoNomeada = "papel.ler"
+	PermissaoPapelEditar PermissaoNomeada = "papel.editar"
+
+	PermissaoSessaoPropria   PermissaoNomeada = "sessao.propria"
+	PermissaoSessaoGerenciar PermissaoNomeada = "sessao.gerenciar"
+)
+
+// DefinicaoPermissao descreve uma permissão nomeada na lista de permissões disponíveis.
+type DefinicaoPermissao struct {
+	Nome      PermissaoNomeada `json:"nome"`
+	Descricao string           `json:"descricao"`
+}
+
+// PermissoesDisponiveis lista as permissões nomeadas que podem ser concedidas aos papéis.
+var PermissoesDisponiveis = []DefinicaoPermissao{
+	{PermissaoUsuarioCriar, "cadastrar usuários e pesquisá-los no diretório"},
+	{PermissaoUsuarioLer, "consultar os usuários"},
+	{PermissaoUsuarioEditar, "alterar os dados dos usuários"},
+	{PermissaoUsuarioEditarPermissao, "alterar a permissão e o papel dos usuários"},
+	{PermissaoUsuarioAtivar, "ativar, desativar e autorizar usuários"},
+	{PermissaoChamadoCriar, "abrir chamados"},
+	{PermissaoChamadoLer, "consultar os chamados visíveis ao usuário"},
+	{PermissaoChamadoEditar, "alterar os dados dos chamados"},
+	{PermissaoChamadoAlterarStatus, "mover os chamados no fluxo de status"},
+	{PermissaoChamadoAlterarPrioridade, "alterar o impacto e a urgência dos chamados"},
+	{PermissaoChamadoReabrir, "reabrir chamados solucionados"},
+	{PermissaoChamadoLerReaberturas, "consultar o relatório de reaberturas"},
+	{PermissaoChamadoArquivar, "arquivar e desarquivar chamados"},
+	{PermissaoChamadoObservar, "observar os chamados visíveis e gerenciar os seus observadores"},
+	{PermissaoCategoriaCriar, "cadastrar categorias"},
+	{PermissaoCategoriaLer, "consultar as categorias"},
+	{PermissaoCategoriaEditar, "alterar as categorias"},
+	{PermissaoCategoriaAtivar, "ativar e desativar categorias"},
+	{PermissaoSubcategoriaCriar, "cadastrar subcategorias"},
+	{PermissaoSubcategoriaLer, "consultar as subcategorias"},
+	{PermissaoSubcategoriaEditar, "alterar as subcategorias"},
+	{PermissaoSubcategoriaAtivar, "ativar e desativar subcategorias"},
+	{PermissaoLogLer, "consultar os logs"},
+	{PermissaoAcompanhamentoCriar, "registrar acompanhamentos"},
+	{PermissaoAcompanhamentoLer, "consultar os acompanhamentos"},
+	{PermissaoAcompanhamentoEditar, "alterar acompanhamentos"},
+	{PermissaoAcompanhamentoDeletar, "remover acompanhamentos"},
+	{PermissaoAcompanhamentoLerRevisao, "consultar o histórico de revisões dos acompanhamentos"},
+	{PermissaoAcompanhamentoNotaInterna, "registrar e ler as notas internas dos chamados"},
+	{PermissaoAnexoEnviar, "enviar anexos aos chamados"},
+	{PermissaoAnexoLer, "consultar e baixar os anexos"},
+	{PermissaoAnexoDeletar, "remover anexos"},
+	{PermissaoEventoAssinar, "assinar os eventos em tempo real"},
+	{PermissaoNotificacaoLer, "consultar as notificações e as preferências de notificação"},
+	{PermissaoNotificacaoEditar, "marcar notificações como lidas e alterar as preferências"},
+	{PermissaoAtendimentoCriar, "atribuir chamados a técnicos"},
+	{PermissaoAtendimentoLer, "consultar os atendimentos"},
+	{PermissaoAtendimentoTransferir, "transferir e devolver chamados"},
+	{PermissaoApontamentoCriar, "registrar apontamentos de horas e controlar o cronômetro"},
+	{PermissaoApontamentoLer, "consultar os apontamentos e os totais de horas"},
+	{PermissaoApontamentoEditar, "alterar apontamentos"},
+	{PermissaoApontamentoDeletar, "remover apontamentos"},
+	{PermissaoAvaliacaoResponder, "responder as avaliações de satisfação"},
+	{PermissaoAvaliacaoLer, "consultar a avaliação de um chamado"},
+	{PermissaoAvaliacaoLerCSAT, "consultar o indicador de satisfação (CSAT)"},
+	{PermissaoCategoriaPermissaoLer, "consultar as permissões por categoria"},
+	{PermissaoCategoriaPermissaoEditar, "conceder e revogar permissões por categoria"},
+	{PermissaoPoliticaSLALer, "consultar as políticas de SLA"},
+	{PermissaoPoliticaSLAEditar, "cadastrar, alterar, ativar e desativar políticas de SLA"},
+	{PermissaoCalendarioLer, "consultar os calendários de expediente"},
+	{PermissaoCalendarioEditar, "cadastrar e alterar calendários e feriados"},
+	{PermissaoCalendarioTempoUtil, "calcular o tempo útil entre duas datas"},
+	{PermissaoPrioridadeLer, "consultar a matriz de prioridade"},
+	{PermissaoPrioridadeEditar, "alterar a matriz de prioridade"},
+	{PermissaoAtribuicaoPrevia, "simular a atribuição automática"},
+	{PermissaoAutorizacaoLer, "consultar as próprias permissões por categoria"},
+	{PermissaoWebhookLer, "consultar os webhooks e as suas entregas"},
+	{PermissaoWebhookEditar, "cadastrar, alterar, ativar e desativar webhooks e reenviar entregas"},
+	{PermissaoPapelLer, "consultar os papéis e as suas permissões"},
+	{PermissaoPapelEditar, "cadastrar, alterar e remover papéis e as suas permissões"},
+	{PermissaoSessaoPropria, "consultar e encerrar as próprias sessões"},
+	{PermissaoSessaoGerenciar, "consultar e encerrar as sessões dos demais usuários"},
+}
+
+// Papel reúne as permissões nomeadas concedidas aos seus usuários. Os papéis do sistema
+// correspondem às permissões ADM, TEC, USR e DEV; os demais são criados pelos
+// administradores. A permissão base define as regras de negócio aplicadas aos usuários do
+// papel, como a visibilidade dos chamados e o fluxo de status.
+type Papel struct {
+	Codigo        string             `json:"codigo"`
+	Nome          string             `json:"nome"`
+	Descricao     *string            `json:"descricao,omitempty"`
+	PermissaoBase Permissao          `json:"permissaoBase"`
+	Sistema       bool               `json:"sistema"`
+	Permissoes    []PermissaoNomeada `json:"permissoes"`
+	CriadoEm      time.Time          `json:"criadoEm"`
+	AtualizadoEm  time.Time          `json:"atualizadoEm"`
+}
+
+// NewPapel cria uma nova instância de Papel criado pelos administradores.
+func NewPapel(codigo, nome string, descricao *string, permissaoBase Permissao, permissoes []PermissaoNomeada) (*Papel, error) {
+	now := time.Now()
+	papel := &Papel{
+		Codigo:        strings.ToUpper(strings.TrimSpace(codigo)),
+		Nome:          strings.TrimSpace(nome),
+		Descricao:     descricao,
+		PermissaoBase: permissaoBase,
+		Sistema:       false,
+		Permissoes:    permissoes,
+		CriadoEm:      now,
+		AtualizadoEm:  now,
+	}
+
+	if papel.Permissoes == nil {
+		papel.Permissoes = []PermissaoNomeada{}
+	}
+
+	if err := ValidarPapel(papel); err != nil {
+		return nil, fmt.Errorf("[model.NewPapel]: %w", err)
+	}
+	return papel, nil
+}
+
+// ValidarPapel valida os campos do papel e as permissões concedidas.
+func ValidarPapel(p *Papel) error {
+	var erros []error
+
+	if err := ValidarCodigoPapel(p.Codigo); err != nil {
+		erros = append(erros, err)
+	}
+	if tamanho := utf8.RuneCountInString(strings.TrimSpace(p.Nome)); tamanho == 0 || tamanho > TamanhoMaximoNomePapel {
+		erros = append(erros, ErrNomePapelInvalido)
+	}
+	if p.Descricao != nil && utf8.RuneCountInString(*p.Descricao) > TamanhoMaximoDescricaoPapel {
+		erros = append(erros, ErrDescricaoPapelInvalida)
+	}
+	if err := ValidarPermissao(p.PermissaoBase); err != nil {
+		erros = append(erros, err)
+	}
+	if err := ValidarPermissoesNomeadas(p.Permissoes); err != nil {
+		erros = append(erros, err)
+	}
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarPapel] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// ValidarCodigoPapel valida o formato do código do papel.
+func ValidarCodigoPapel(codigo string) error {
+	if !regexCodigoPapel.MatchString(codigo) {
+		return fmt.Errorf("[model.ValidarCodigoPapel]: %w", ErrCodigoPapelInvalido)
+	}
+	return nil
+}
+
+// ValidarPermissaoNomeada valida se a permissão é uma das permissões disponíveis.
+func ValidarPermissaoNomeada(permissao PermissaoNomeada) error {
+	for _, p := range PermissoesDisponiveis {
+		if p.Nome == permissao {
+			return nil
+		}
+	}
+	return fmt.Errorf("[model.ValidarPermissaoNomeada]: %w: %s", ErrPermissaoNomeadaInvalida, permissao)
+}
+
+// ValidarPermissoesNomeadas valida todas as permissões da lista.
+func ValidarPermissoesNomeadas(permissoes []PermissaoNomeada) error {
+	for _, permissao := range permissoes {
+		if err := ValidarPermissaoNomeada(permissao); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidarPermissoesEssenciais impede que o papel ADM perca a administração dos papéis, o
+// que deixaria o sistema sem meio de corrigir as permissões pela API.
+func ValidarPermissoesEssenciais(codigo string, permissoes []PermissaoNomeada) error {
+	if codigo != string(PermADM) {
+		return nil
+	}
+	for _, permissao := range permissoes {
+		if permissao == PermissaoPapelEditar {
+			return nil
+		}
+	}
+	return fmt.Errorf("[model.ValidarPermissoesEssenciais]: %w", ErrPermissaoEssencialPapel)
+}
+
+// PapelDoSistema indica se o código corresponde a um dos papéis do sistema.
+func PapelDoSistema(codigo string) bool {
+	_, ok := permissoesValidas[Permissao(codigo)]
+	return ok
+}
+
+// Possui indica se o papel concede a permissão.
+func (p *Papel) Possui(permissao PermissaoNomeada) bool {
+	for _, concedida := range p.Permissoes {
+		if concedida == permissao {
+			return true
+		}
+	}
+	return false
+}
+
+// String retorna uma representação de Papel para fins de logging.
+func (p *Papel) String() string {
+	return fmt.Sprintf(
+		"[Codigo=%s | Nome=%s | PermissaoBase=%s | Sistema=%t | Permissoes=%v]",
+		p.Codigo, p.Nome, p.PermissaoBase, p.Sistema, p.Permissoes,
+	)
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Erros de validação específicos para o modelo PoliticaSLA
+var (
+	ErrPoliticaSLAIDInvalido             = errors.New("ID da política de SLA não pode ser vazio")
+	ErrPrazoPrimeiraRespostaInvalido     = errors.New("o prazo de primeira resposta deve ser maior que zero")
+	ErrPrazoSolucaoInvalido              = errors.New("o prazo de solução deve ser maior que zero")
+	ErrPrazoSolucaoMenorPrimeiraResposta = errors.New("o prazo de solução não pode ser menor que o prazo de primeira resposta")
+)
+
+// TipoSLA identifica qual dos prazos de SLA está sendo tratado
+type TipoSLA string
+
+const (
+	SLAPrimeiraResposta TipoSLA = "PRIMEIRA_RESPOSTA"
+	SLASolucao          TipoSLA = "SOLUCAO"
+)
+
+// PoliticaSLA representa os prazos de atendimento acordados para uma categoria,
+// opcionalmente restrita a uma subcategoria
+type PoliticaSLA struct {
+	ID                      string    `json:"id"`
+	Nome                    string    `json:"nome"`
+	CategoriaID             string    `json:"categoriaId"`
+	SubcategoriaID          *string   `json:"subcategoriaId,omitempty"`
+	PrimeiraRespostaMinutos int       `json:"primeiraRespostaMinutos"`
+	SolucaoMinutos          int       `json:"solucaoMinutos"`
+	PausarAguardandoUsuario bool      `json:"pausarAguardandoUsuario"`
+	Status                  bool      `json:"status"`
+	CriadoEm                time.Time `json:"criadoEm"`
+	AtualizadoEm            time.Time `json:"atualizadoEm"`
+}
+
+// NewPoliticaSLA cria uma nova instância de PoliticaSLA com os dados fornecidos
+func NewPoliticaSLA(id, nome, categoriaID string, subcategoriaID *string, primeiraRespostaMinutos, solucaoMinutos int, pausarAguardandoUsuario, status bool) (*PoliticaSLA, error) {
+	now := time.Now()
+	politica := &PoliticaSLA{
+		ID:                      id,
+		Nome:                    nome,
+		CategoriaID:             categoriaID,
+		SubcategoriaID:          subcategoriaID,
+		PrimeiraRespostaMinutos: primeiraRespostaMinutos,
+		SolucaoMinutos:          solucaoMinutos,
+		PausarAguardandoUsuario: pausarAguardandoUsuario,
+		Status:                  status,
+		CriadoEm:                now,
+		AtualizadoEm:            now,
+	}
+
+	if err := ValidarPoliticaSLA(politica); err != nil {
+		return nil, fmt.Errorf("[model.NewPoliticaSLA]: %w", err)
+	}
+	return politica, nil
+}
+
+// ValidarPoliticaSLA valida os campos da política de SLA
+func ValidarPoliticaSLA(p *PoliticaSLA) error {
+	var erros []error
+
+	if p.Nome == "" {
+		erros = append(erros, ErrNomeInvalido)
+	}
+	if p.CategoriaID == "" {
+		erros = append(erros, ErrCategoriaIDInvalido)
+	}
+	if p.PrimeiraRespostaMinutos <= 0 {
+		erros = append(erros, ErrPrazoPrimeiraRespostaInvalido)
+	}
+	if p.SolucaoMinutos <= 0 {
+		erros = append(erros, ErrPrazoSolucaoInvalido)
+	}
+	if p.SolucaoMinutos > 0 && p.SolucaoMinutos < p.PrimeiraRespostaMinutos {
+		erros = append(erros, ErrPrazoSolucaoMenorPrimeiraResposta)
+	}
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarPoliticaSLA] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// PoliticaSLAFiltro representa os critérios de filtro para listar políticas de SLA
+type PoliticaSLAFiltro struct {
+	Pagina         int
+	Limite         int
+	CategoriaID    *string
+	SubcategoriaID *string
+	Status         *bool
+}
+
+// String retorna uma representação de PoliticaSLA para fins de logging.
+func (p *PoliticaSLA) String() string {
+	subcategoriaID := ""
+	if p.SubcategoriaID != nil {
+		subcategoriaID = *p.SubcategoriaID
+	}
+	return fmt.Sprintf(
+		"[ID=%s | Nome=%s | CategoriaID=%s | SubcategoriaID=%s | PrimeiraResposta=%dmin | Solucao=%dmin | PausarAguardandoUsuario=%t | Status=%t]",
+		p.ID, p.Nome, p.CategoriaID, subcategoriaID, p.PrimeiraRespostaMinutos, p.SolucaoMinutos, p.PausarAguardandoUsuario, p.Status,
+	)
+}
@@ -0,0 +1,182 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Erros de validação específicos para a priorização de chamados
+var (
+	ErrImpactoInvalido             = errors.New("impacto inválido: o impacto deve ser uma das seguintes opções: BAIXO, MEDIO, ALTO")
+	ErrUrgenciaInvalida            = errors.New("urgência inválida: a urgência deve ser uma das seguintes opções: BAIXA, MEDIA, ALTA")
+	ErrPrioridadeInvalida          = errors.New("prioridade inválida: a prioridade deve ser uma das seguintes opções: P1, P2, P3, P4")
+	ErrMotivoPrioridadeObrigatorio = errors.New("o motivo é obrigatório para alterar a prioridade do chamado")
+	ErrMatrizPrioridadeVazia       = errors.New("a matriz de prioridade deve possuir ao menos uma combinação")
+	ErrMatrizPrioridadeIncompleta  = errors.New("a matriz de prioridade não possui a combinação de impacto e urgência informada")
+)
+
+// Impacto define o alcance do problema relatado no chamado
+type Impacto string
+
+const (
+	ImpactoBaixo Impacto = "BAIXO"
+	ImpactoMedio Impacto = "MEDIO"
+	ImpactoAlto  Impacto = "ALTO"
+)
+
+// impactosValidos contém todos os impactos aceitos.
+var impactosValidos = map[Impacto]struct{}{
+	ImpactoBaixo: {},
+	ImpactoMedio: {},
+	ImpactoAlto:  {},
+}
+
+// Urgencia define a rapidez com que o problema relatado precisa ser resolvido
+type Urgencia string
+
+const (
+	UrgenciaBaixa Urgencia = "BAIXA"
+	UrgenciaMedia Urgencia = "MEDIA"
+	UrgenciaAlta  Urgencia = "ALTA"
+)
+
+// urgenciasValidas contém todas as urgências aceitas.
+var urgenciasValidas = map[Urgencia]struct{}{
+	UrgenciaBaixa: {},
+	UrgenciaMedia: {},
+	UrgenciaAlta:  {},
+}
+
+// Prioridade define a ordem de atendimento do chamado, sendo P1 a mais alta
+type Prioridade string
+
+const (
+	PrioridadeP1 Prioridade = "P1"
+	PrioridadeP2 Prioridade = "P2"
+	PrioridadeP3 Prioridade = "P3"
+	PrioridadeP4 Prioridade = "P4"
+)
+
+// prioridadesValidas contém todas as prioridades aceitas.
+var prioridadesValidas = map[Prioridade]struct{}{
+	PrioridadeP1: {},
+	PrioridadeP2: {},
+	PrioridadeP3: {},
+	PrioridadeP4: {},
+}
+
+// Impacto e urgência atribuídos aos chamados recém-abertos
+const (
+	ImpactoPadrao  = ImpactoMedio
+	UrgenciaPadrao = UrgenciaMedia
+)
+
+// ValidarImpacto verifica se o impacto informado é válido
+func ValidarImpacto(i Impacto) error {
+	if _, ok := impactosValidos[i]; !ok {
+		return ErrImpactoInvalido
+	}
+	return nil
+}
+
+// ValidarUrgencia verifica se a urgência informada é válida
+func ValidarUrgencia(u Urgencia) error {
+	if _, ok := urgenciasValidas[u]; !ok {
+		return ErrUrgenciaInvalida
+	}
+	return nil
+}
+
+// ValidarPrioridade verifica se a prioridade informada é válida
+func ValidarPrioridade(p Prioridade) error {
+	if _, ok := prioridadesValidas[p]; !ok {
+		return ErrPrioridadeInvalida
+	}
+	return nil
+}
+
+// CelulaMatrizPrioridade associa uma combinação de impacto e urgência a uma prioridade
+type CelulaMatrizPrioridade struct {
+	Impacto      Impacto    `json:"impacto"`
+	Urgencia     Urgencia   `json:"urgencia"`
+	Prioridade   Prioridade `json:"prioridade"`
+	AtualizadoEm time.Time  `json:"atualizadoEm"`
+}
+
+// MatrizPrioridade é o conjunto de combinações usado para derivar a prioridade dos chamados
+type MatrizPrioridade []CelulaMatrizPrioridade
+
+// ValidarMatrizPrioridade valida as combinações da matriz de prioridade
+func ValidarMatrizPrioridade(m MatrizPrioridade) error {
+	var erros []error
+
+	if len(m) == 0 {
+		erros = append(erros, ErrMatrizPrioridadeVazia)
+	}
+	for _, c := range m {
+		if err := ValidarImpacto(c.Impacto); err != nil {
+			erros = append(erros, err)
+		}
+		if err := ValidarUrgencia(c.Urgencia); err != nil {
+			erros = append(erros, err)
+		}
+		if err := ValidarPrioridade(c.Prioridade); err != nil {
+			erros = append(erros, err)
+		}
+	}
+
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarMatrizPrioridade] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// Derivar retorna a prioridade correspondente à combinação de impacto e urgência
+func (m MatrizPrioridade) Derivar(impacto Impacto, urgencia Urgencia) (Prioridade, error) {
+	for _, c := range m {
+		if c.Impacto == impacto && c.Urgencia == urgencia {
+			return c.Prioridade, nil
+		}
+	}
+	return "", fmt.Errorf("[model.MatrizPrioridade.Derivar]: %w: impacto(%s) urgência(%s)", ErrMatrizPrioridadeIncompleta, impacto, urgencia)
+}
+
+// AlteracaoPrioridade registra a alteração de prioridade de um chamado e o seu motivo
+type AlteracaoPrioridade struct {
+	ChamadoID          string     `json:"chamadoId"`
+	PrioridadeAnterior Prioridade `json:"prioridadeAnterior"`
+	Impacto            Impacto    `json:"impacto"`
+	Urgencia           Urgencia   `json:"urgencia"`
+	Prioridade         Prioridade `json:"prioridade"`
+	Motivo             string     `json:"motivo"`
+}
+
+// ValidarAlteracaoPrioridade valida o impacto, a urgência e o motivo da alteração
+func ValidarAlteracaoPrioridade(a *AlteracaoPrioridade) error {
+	var erros []error
+
+	if err := ValidarImpacto(a.Impacto); err != nil {
+		erros = append(erros, err)
+	}
+	if err := ValidarUrgencia(a.Urgencia); err != nil {
+		erros = append(erros, err)
+	}
+	if strings.TrimSpace(a.Motivo) == "" {
+		erros = append(erros, ErrMotivoPrioridadeObrigatorio)
+	}
+
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarAlteracaoPrioridade] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// String retorna uma representação de AlteracaoPrioridade para fins de logging.
+func (a *AlteracaoPrioridade) String() string {
+	return fmt.Sprintf(
+		"[ChamadoID=%s | Prioridade=%s -> %s | Impacto=%s | Urgencia=%s | Motivo=%s]",
+		a.ChamadoID, a.PrioridadeAnterior, a.Prioridade, a.Impacto, a.Urgencia, a.Motivo,
+	)
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatrizPrioridadeDerivar(t *testing.T) {
+	matriz := MatrizPrioridade{
+		{Impacto: ImpactoAlto, Urgencia: UrgenciaAlta, Prioridade: PrioridadeP1},
+		{Impacto: ImpactoMedio, Urgencia: UrgenciaMedia, Prioridade: PrioridadeP3},
+		{Impacto: ImpactoBaixo, Urgencia: UrgenciaBaixa, Prioridade: PrioridadeP4},
+	}
+
+	casos := []struct {
+		nome     string
+		impacto  Impacto
+		urgencia Urgencia
+		esperado Prioridade
+		erro     error
+	}{
+		{"impacto e urgência altos", ImpactoAlto, UrgenciaAlta, PrioridadeP1, nil},
+		{"valores padrão", ImpactoPadrao, UrgenciaPadrao, PrioridadeP3, nil},
+		{"combinação ausente da matriz", ImpactoAlto, UrgenciaBaixa, "", ErrMatrizPrioridadeIncompleta},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			obtido, err := matriz.Derivar(c.impacto, c.urgencia)
+			if !errors.Is(err, c.erro) {
+				t.Fatalf("Derivar erro = %v, esperado %v", err, c.erro)
+			}
+			if obtido != c.esperado {
+				t.Errorf("Derivar = %s, esperado %s", obtido, c.esperado)
+			}
+		})
+	}
+}
+
+func TestValidarMatrizPrioridade(t *testing.T) {
+	casos := []struct {
+		nome   string
+		matriz MatrizPrioridade
+		erro   error
+	}{
+		{"matriz válida", MatrizPrioridade{{Impacto: ImpactoAlto, Urgencia: UrgenciaAlta, Prioridade: PrioridadeP1}}, nil},
+		{"matriz vazia", MatrizPrioridade{}, ErrMatrizPrioridadeVazia},
+		{"impacto inválido", MatrizPrioridade{{Impacto: "CRITICO", Urgencia: UrgenciaAlta, Prioridade: PrioridadeP1}}, ErrImpactoInvalido},
+		{"urgência inválida", MatrizPrioridade{{Impacto: ImpactoAlto, Urgencia: "IMEDIATA", Prioridade: PrioridadeP1}}, ErrUrgenciaInvalida},
+		{"prioridade inválida", MatrizPrioridade{{Impacto: ImpactoAlto, Urgencia: UrgenciaAlta, Prioridade: "P0"}}, ErrPrioridadeInvalida},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			err := ValidarMatrizPrioridade(c.matriz)
+			if c.erro == nil && err != nil {
+				t.Fatalf("ValidarMatrizPrioridade = %v, esperado nil", err)
+			}
+			if c.erro != nil && !errors.Is(err, c.erro) {
+				t.Fatalf("ValidarMatrizPrioridade = %v, esperado %v", err, c.erro)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Erros de validação específicos para a reabertura de chamados
+var (
+	ErrMotivoReaberturaObrigatorio   = errors.New("o motivo é obrigatório para reabrir o chamado")
+	ErrChamadoNaoReabrivel           = errors.New("apenas chamados resolvidos ou fechados podem ser reabertos")
+	ErrPrazoReaberturaExpirado       = errors.New("o prazo para reabrir o chamado expirou")
+	ErrReaberturaDeOutroUsuario      = errors.New("apenas o criador do chamado ou a equipe técnica podem reabrir o chamado")
+	ErrAgrupamentoReaberturaInvalido = errors.New("agrupamento inválido: o agrupamento deve ser uma das seguintes opções: TECNICO, CATEGORIA, MES")
+)
+
+// Reabertura representa o pedido de reabertura de um chamado resolvido ou fechado
+type Reabertura struct {
+	ChamadoID      string        `json:"chamadoId"`
+	StatusAnterior StatusChamado `json:"statusAnterior"`
+	Motivo         string        `json:"motivo"`
+	TecnicoID      *string       `json:"tecnicoId"` // técnico que recebe o chamado reaberto; nulo quando volta para a fila
+}
+
+// ValidarReabertura exige o motivo da reabertura
+func ValidarReabertura(r *Reabertura) error {
+	if strings.TrimSpace(r.Motivo) == "" {
+		return fmt.Errorf("[model.ValidarReabertura] erros de validação: %w", ErrMotivoReaberturaObrigatorio)
+	}
+	return nil
+}
+
+// PodeSerReaberto verifica se o chamado está resolvido ou fechado e se a
+// reabertura ocorre dentro do prazo, contado a partir da última solução.
+func (c *Chamado) PodeSerReaberto(prazo time.Duration, agora time.Time) error {
+	if c.Status != StatusResolvido && c.Status != StatusFechado {
+		return fmt.Errorf("[model.PodeSerReaberto] status %s: %w", c.Status, ErrChamadoNaoReabrivel)
+	}
+
+	referencia := c.SolucionadoEm
+	if referencia == nil {
+		referencia = c.FechadoEm
+	}
+	if referencia != nil && agora.After(referencia.Add(prazo)) {
+		return fmt.Errorf("[model.PodeSerReaberto] solucionado em %s: %w", referencia.Format(time.RFC3339), ErrPrazoReaberturaExpirado)
+	}
+	return nil
+}
+
+// AgrupamentoReabertura define o critério de agregação das reaberturas
+type AgrupamentoReabertura string
+
+const (
+	ReaberturaPorTecnico   AgrupamentoReabertura = "TECNICO"
+	ReaberturaPorCategoria AgrupamentoReabertura = "CATEGORIA"
+	ReaberturaPorMes       AgrupamentoReabertura = "MES"
+)
+
+// ValidarAgrupamentoReabertura verifica se o agrupamento informado é válido
+func ValidarAgrupamentoReabertura(a AgrupamentoReabertura) error {
+	switch a {
+	case ReaberturaPorTecnico, ReaberturaPorCategoria, ReaberturaPorMes:
+		return nil
+	}
+	return ErrAgrupamentoReaberturaInvalido
+}
+
+// ReaberturaFiltro representa os filtros para agregar as reaberturas
+type ReaberturaFiltro struct {
+	AgruparPor AgrupamentoReabertura
+	Inicio     *time.Time // chamados criados a partir de
+	Fim        *time.Time // chamados criados antes de
+}
+
+// TotalReabertura representa as reaberturas agregadas de um técnico, categoria ou mês
+type TotalReabertura struct {
+	ID             string  `json:"id"`
+	Nome           string  `json:"nome"`         // nome do técnico, da categoria ou o mês (AAAA-MM)
+	Solucionados   int     `json:"solucionados"` // chamados que receberam ao menos uma solução
+	Reabertos      int     `json:"reabertos"`    // chamados reabertos ao menos uma vez
+	Reaberturas    int     `json:"reaberturas"`  // total de reaberturas
+	TaxaReabertura float64 `json:"taxaReabertura"`
+}
+
+// CalcularTaxa preenche o percentual de chamados solucionados que foram reabertos.
+func (t *TotalReabertura) CalcularTaxa() {
+	if t.Solucionados == 0 {
+		t.TaxaReabertura = 0
+		return
+	}
+	t.TaxaReabertura = float64(t.Reabertos) * 100 / float64(t.Solucionados)
+}
+
+// String retorna uma representação de Reabertura para fins de logging.
+func (r *Reabertura) String() string {
+	tecnicoID := ""
+	if r.TecnicoID != nil {
+		tecnicoID = *r.TecnicoID
+	}
+	return fmt.Sprintf(
+		"[ChamadoID=%s | StatusAnterior=%s | TecnicoID=%s | Motivo=%s]",
+		r.ChamadoID, r.StatusAnterior, tecnicoID, r.Motivo,
+	)
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChamadoPodeSerReaberto(t *testing.T) {
+	const prazo = 72 * time.Hour
+	agora := time.Date(2025, 6, 10, 12, 0, 0, 0, FusoSaoPaulo)
+	dentroDoPrazo := agora.Add(-24 * time.Hour)
+	foraDoPrazo := agora.Add(-96 * time.Hour)
+
+	casos := []struct {
+		nome     string
+		chamado  Chamado
+		esperado error
+	}{
+		{"resolvido dentro do prazo", Chamado{Status: StatusResolvido, SolucionadoEm: &dentroDoPrazo}, nil},
+		{"fechado dentro do prazo da solução", Chamado{Status: StatusFechado, SolucionadoEm: &dentroDoPrazo, FechadoEm: &dentroDoPrazo}, nil},
+		{"resolvido fora do prazo", Chamado{Status: StatusResolvido, SolucionadoEm: &foraDoPrazo}, ErrPrazoReaberturaExpirado},
+		{"fechado sem solução conta do fechamento", Chamado{Status: StatusFechado, FechadoEm: &foraDoPrazo}, ErrPrazoReaberturaExpirado},
+		{"sem instante de referência", Chamado{Status: StatusResolvido}, nil},
+		{"chamado atribuído", Chamado{Status: StatusAtribuido}, ErrChamadoNaoReabrivel},
+		{"chamado rejeitado", Chamado{Status: StatusRejeitado, FechadoEm: &dentroDoPrazo}, ErrChamadoNaoReabrivel},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			err := c.chamado.PodeSerReaberto(prazo, agora)
+			if c.esperado == nil && err != nil {
+				t.Fatalf("PodeSerReaberto = %v, esperado nil", err)
+			}
+			if c.esperado != nil && !errors.Is(err, c.esperado) {
+				t.Fatalf("PodeSerReaberto = %v, esperado %v", err, c.esperado)
+			}
+		})
+	}
+}
+
+func TestTotalReaberturaCalcularTaxa(t *testing.T) {
+	casos := []struct {
+		nome     string
+		total    TotalReabertura
+		esperado float64
+	}{
+		{"um em cada quatro reabertos", TotalReabertura{Solucionados: 4, Reabertos: 1, Reaberturas: 3}, 25},
+		{"nenhum reaberto", TotalReabertura{Solucionados: 5}, 0},
+		{"sem chamados solucionados", TotalReabertura{Reabertos: 2}, 0},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			c.total.CalcularTaxa()
+			if c.total.TaxaReabertura != c.esperado {
+				t.Errorf("TaxaReabertura = %v, esperado %v", c.total.TaxaReabertura, c.esperado)
+			}
+		})
+	}
+}
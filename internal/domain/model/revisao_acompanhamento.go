@@ -0,0 +1,93 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Erros de validação específicos para a edição e remoção de acompanhamentos
+var (
+	ErrEdicaoDeOutroUsuario   = errors.New("apenas o autor pode editar o acompanhamento")
+	ErrPrazoEdicaoExpirado    = errors.New("o prazo para editar o acompanhamento expirou")
+	ErrRemocaoDeOutroUsuario  = errors.New("apenas o autor ou um administrador pode remover o acompanhamento")
+	ErrAcompanhamentoRemovido = errors.New("o acompanhamento foi removido")
+)
+
+// MensagemAcompanhamentoRemovido substitui o conteúdo dos acompanhamentos removidos
+const MensagemAcompanhamentoRemovido = "mensagem removida"
+
+// TipoRevisao indica a operação que originou a revisão do acompanhamento
+type TipoRevisao string
+
+const (
+	// RevisaoEdicao registra o conteúdo anterior a uma edição.
+	RevisaoEdicao TipoRevisao = "EDICAO"
+
+	// RevisaoRemocao registra o conteúdo anterior à remoção.
+	RevisaoRemocao TipoRevisao = "REMOCAO"
+)
+
+// RevisaoAcompanhamento guarda o estado de um acompanhamento antes de uma edição ou remoção
+type RevisaoAcompanhamento struct {
+	ID                   string                     `json:"id"`
+	AcompanhamentoID     string                     `json:"acompanhamentoId"`
+	Tipo                 TipoRevisao                `json:"tipo"`
+	ConteudoAnterior     string                     `json:"conteudoAnterior"`
+	VisibilidadeAnterior VisibilidadeAcompanhamento `json:"visibilidadeAnterior"`
+	AlteradoPorID        string                     `json:"alteradoPorId"`
+	CriadoEm             time.Time                  `json:"criadoEm"`
+}
+
+// NewRevisaoAcompanhamento registra o estado atual do acompanhamento antes da operação informada.
+func NewRevisaoAcompanhamento(id string, a *Acompanhamento, tipo TipoRevisao, alteradoPorID string) *RevisaoAcompanhamento {
+	return &RevisaoAcompanhamento{
+		ID:                   id,
+		AcompanhamentoID:     a.ID,
+		Tipo:                 tipo,
+		ConteudoAnterior:     a.Conteudo,
+		VisibilidadeAnterior: a.Visibilidade,
+		AlteradoPorID:        alteradoPorID,
+		CriadoEm:             time.Now(),
+	}
+}
+
+// Removido indica se o acompanhamento foi removido.
+func (a *Acompanhamento) Removido() bool {
+	return a.RemovidoEm != nil
+}
+
+// PodeSerEditado verifica se o acompanhamento não foi removido, se quem edita é o
+// autor e se a edição ocorre dentro do prazo, contado a partir da criação.
+func (a *Acompanhamento) PodeSerEditado(usuarioID string, prazo time.Duration, agora time.Time) error {
+	if a.Removido() {
+		return fmt.Errorf("[model.PodeSerEditado] %w", ErrAcompanhamentoRemovido)
+	}
+	if a.UsuarioID != usuarioID {
+		return fmt.Errorf("[model.PodeSerEditado] %w", ErrEdicaoDeOutroUsuario)
+	}
+	if agora.After(a.CriadoEm.Add(prazo)) {
+		return fmt.Errorf("[model.PodeSerEditado] criado em %s: %w", a.CriadoEm.Format(time.RFC3339), ErrPrazoEdicaoExpirado)
+	}
+	return nil
+}
+
+// PodeSerRemovido verifica se o acompanhamento ainda não foi removido e se quem
+// remove é o autor ou um administrador.
+func (a *Acompanhamento) PodeSerRemovido(usuarioID string, permissao Permissao) error {
+	if a.Removido() {
+		return fmt.Errorf("[model.PodeSerRemovido] %w", ErrAcompanhamentoRemovido)
+	}
+	if a.UsuarioID != usuarioID && permissao != PermADM {
+		return fmt.Errorf("[model.PodeSerRemovido] %w", ErrRemocaoDeOutroUsuario)
+	}
+	return nil
+}
+
+// String retorna uma representação de RevisaoAcompanhamento para fins de logging.
+func (r *RevisaoAcompanhamento) String() string {
+	return fmt.Sprintf(
+		"[ID=%s | AcompanhamentoID=%s | Tipo=%s | AlteradoPorID=%s]",
+		r.ID, r.AcompanhamentoID, r.Tipo, r.AlteradoPorID,
+	)
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Erros de validação específicos para as sessões
+var (
+	ErrSessaoIDInvalido  = errors.New("ID da sessão inválido")
+	ErrReusoRefreshToken = errors.New("token de refresh já utilizado: a sessão foi encerrada por segurança")
+)
+
+// tamanhoMaximoDispositivo é o tamanho máximo do dispositivo (user agent) guardado na sessão.
+const tamanhoMaximoDispositivo = 255
+
+// OrigemSessao identifica de onde partiu o login ou o refresh da sessão
+type OrigemSessao struct {
+	Dispositivo string // user agent do cliente
+	IP          string
+}
+
+// Sessao representa uma sessão do usuário, aberta no login e mantida pelos tokens de refresh.
+// Cada refresh substitui o token de refresh da sessão; a reapresentação de um token já
+// substituído indica o seu roubo e encerra a sessão.
+type Sessao struct {
+	ID          string     `json:"id"`
+	UsuarioID   string     `json:"usuarioId"`
+	Dispositivo *string    `json:"dispositivo,omitempty"`
+	IP          *string    `json:"ip,omitempty"`
+	RefreshJTI  string     `json:"-"`     // jti do único token de refresh válido da sessão
+	Atual       bool       `json:"atual"` // sessão do token usado na consulta
+	CriadoEm    time.Time  `json:"criadoEm"`
+	UltimoUsoEm time.Time  `json:"ultimoUsoEm"`
+	ExpiraEm    time.Time  `json:"expiraEm"`
+	RevogadaEm  *time.Time `json:"revogadaEm,omitempty"`
+}
+
+// NewSessao cria uma nova instância de Sessao, validando os dados.
+func NewSessao(id, usuarioID, refreshJTI string, origem OrigemSessao, expiraEm time.Time) (*Sessao, error) {
+	if id == "" {
+		return nil, fmt.Errorf("[model.NewSessao]: %w", ErrSessaoIDInvalido)
+	}
+	if usuarioID == "" {
+		return nil, fmt.Errorf("[model.NewSessao]: %w", ErrIDInvalido)
+	}
+	if refreshJTI == "" {
+		return nil, fmt.Errorf("[model.NewSessao]: %w", ErrJTIInvalido)
+	}
+
+	agora := time.Now()
+	return &Sessao{
+		ID:          id,
+		UsuarioID:   usuarioID,
+		Dispositivo: origem.dispositivo(),
+		IP:          origem.ip(),
+		RefreshJTI:  refreshJTI,
+		CriadoEm:    agora,
+		UltimoUsoEm: agora,
+		ExpiraEm:    expiraEm,
+	}, nil
+}
+
+// Rotacionar substitui o token de refresh da sessão pelo novo token emitido no refresh,
+// registrando a origem e o instante do uso.
+func (s *Sessao) Rotacionar(novoRefreshJTI string, origem OrigemSessao, expiraEm time.Time) {
+	s.RefreshJTI = novoRefreshJTI
+	s.Dispositivo = origem.dispositivo()
+	s.IP = origem.ip()
+	s.UltimoUsoEm = time.Now()
+	s.ExpiraEm = expiraEm
+}
+
+// String retorna uma representação em string da sessão
+func (s *Sessao) String() string {
+	return fmt.Sprintf(
+		"[ID=%s | UsuarioID=%s | ExpiraEm=%s]",
+		s.ID, s.UsuarioID, s.ExpiraEm.Format(time.RFC3339),
+	)
+}
+
+// dispositivo retorna o user agent da origem, truncado ao tamanho da coluna; nil quando vazio.
+func (o OrigemSessao) dispositivo() *string {
+	if o.Dispositivo == "" {
+		return nil
+	}
+	dispositivo := []rune(o.Dispositivo)
+	if len(dispositivo) > tamanhoMaximoDispositivo {
+		dispositivo = dispositivo[:tamanhoMaximoDispositivo]
+	}
+	d := string(dispositivo)
+	return &d
+}
+
+// ip retorna o IP da origem; nil quando vazio.
+func (o OrigemSessao) ip() *string {
+	if o.IP == "" {
+		return nil
+	}
+	ip := o.IP
+	return &ip
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Erros de validação específicos para a revogação de tokens
+var (
+	ErrTokenRevogado     = errors.New("o token foi revogado")
+	ErrJTIInvalido       = errors.New("identificador do token (jti) inválido")
+	ErrUsuarioInativo    = errors.New("o usuário está desativado")
+	ErrTokenOutroUsuario = errors.New("o token pertence a outro usuário")
+	ErrRefreshInvalido   = errors.New("token de refresh inválido")
+)
+
+// TokenRevogado representa um token de acesso ou de refresh revogado antes da expiração,
+// pelo logout do usuário. Depois de expirar, o token já é recusado pela validação do JWT
+// e a revogação pode ser excluída.
+type TokenRevogado struct {
+	JTI        string    `json:"jti"`
+	UsuarioID  string    `json:"usuarioId"`
+	ExpiraEm   time.Time `json:"expiraEm"`
+	RevogadoEm time.Time `json:"revogadoEm"`
+}
+
+// RevogacoesUsuario reúne as revogações que recusam os tokens de um usuário: os tokens
+// revogados individualmente, as sessões encerradas e o instante até o qual todos os tokens
+// emitidos foram revogados.
+type RevogacoesUsuario struct {
+	RevogadoEm *time.Time
+	Tokens     map[string]struct{}
+	Sessoes    map[string]struct{}
+}
+
+// NewTokenRevogado cria uma nova instância de TokenRevogado, validando os dados.
+func NewTokenRevogado(jti, usuarioID string, expiraEm time.Time) (*TokenRevogado, error) {
+	if jti == "" {
+		return nil, fmt.Errorf("[model.NewTokenRevogado]: %w", ErrJTIInvalido)
+	}
+	if usuarioID == "" {
+		return nil, fmt.Errorf("[model.NewTokenRevogado]: %w", ErrIDInvalido)
+	}
+
+	return &TokenRevogado{
+		JTI:        jti,
+		UsuarioID:  usuarioID,
+		ExpiraEm:   expiraEm,
+		RevogadoEm: time.Now(),
+	}, nil
+}
+
+// Revogado indica se o token, identificado pela sessão, pelo jti e pelo instante de emissão,
+// está revogado. Tokens sem o instante de emissão são recusados quando há revogação de todos
+// os tokens do usuário.
+func (r *RevogacoesUsuario) Revogado(sessaoID, jti string, emitidoEm *time.Time) bool {
+	if r.RevogadoEm != nil && (emitidoEm == nil || !emitidoEm.After(*r.RevogadoEm)) {
+		return true
+	}
+	if _, revogada := r.Sessoes[sessaoID]; sessaoID != "" && revogada {
+		return true
+	}
+	_, revogado := r.Tokens[jti]
+	return revogado
+}
@@ -49,6 +49,7 @@ type Usuario struct {
 	Login        string    `json:"login"`
 	Email        string    `json:"email"`
 	Permissao    Permissao `json:"permissao"`
+	Papel        *string   `json:"papel,omitempty"` // papel personalizado; nulo para os papéis do sistema
 	Status       bool      `json:"status"`
 	Avatar       *string   `json:"avatar,omitempty"`
 	UltimoLogin  time.Time `json:"ultimoLogin"`
@@ -119,6 +120,15 @@ func ValidarPermissao(permissao Permissao) error {
 	return fmt.Errorf("[model.ValidarPermissao]: %w", ErrPermissaoInvalida)
 }
 
+// PapelEfetivo retorna o código do papel do usuário, que define as permissões nomeadas
+// concedidas a ele. Sem papel personalizado, vale o papel do sistema da sua permissão.
+func (u *Usuario) PapelEfetivo() string {
+	if u.Papel != nil && *u.Papel != "" {
+		return *u.Papel
+	}
+	return string(u.Permissao)
+}
+
 // UsuarioFiltro representa os filtros para listar usuários.
 type UsuarioFiltro struct {
 	Pagina    int
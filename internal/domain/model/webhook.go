@@ -0,0 +1,278 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+)
+
+// Erros de validação específicos para os webhooks
+var (
+	ErrWebhookIDInvalido         = errors.New("ID do webhook não pode ser vazio")
+	ErrURLWebhookInvalida        = errors.New("a URL do webhook deve ser um endereço http ou https válido")
+	ErrSegredoWebhookInvalido    = errors.New("o segredo do webhook deve ter entre 16 e 255 caracteres")
+	ErrTiposEventoWebhookVazio   = errors.New("o webhook deve assinar ao menos um tipo de evento")
+	ErrTipoEventoWebhookInvalido = errors.New("tipo de evento inválido: o tipo deve ser um dos seguintes: CHAMADO_CRIADO, ACOMPANHAMENTO_CRIADO, STATUS_ALTERADO, CHAMADO_ATRIBUIDO, SLA_EM_RISCO, SLA_VIOLADO")
+	ErrWebhookInativo            = errors.New("o webhook está desativado")
+	ErrEntregaWebhookIDInvalido  = errors.New("ID da entrega do webhook não pode ser vazio")
+	ErrStatusEntregaInvalido     = errors.New("status de entrega inválido: o status deve ser um dos seguintes: PENDENTE, ENTREGUE, FALHOU")
+)
+
+// Limites do segredo usado na assinatura das entregas
+const (
+	TamanhoMinimoSegredoWebhook = 16
+	TamanhoMaximoSegredoWebhook = 255
+)
+
+// TiposEventoWebhook lista os eventos que podem ser assinados pelos webhooks
+var TiposEventoWebhook = []TipoEvento{
+	EventoChamadoCriado,
+	EventoAcompanhamentoCriado,
+	EventoStatusAlterado,
+	EventoChamadoAtribuido,
+	EventoSLAEmRisco,
+	EventoSLAViolado,
+}
+
+// StatusEntregaWebhook define a situação de uma entrega na fila de envio
+type StatusEntregaWebhook string
+
+const (
+	EntregaWebhookPendente StatusEntregaWebhook = "PENDENTE"
+	EntregaWebhookEntregue StatusEntregaWebhook = "ENTREGUE"
+	EntregaWebhookFalhou   StatusEntregaWebhook = "FALHOU"
+)
+
+// Webhook representa a inscrição de um sistema externo nos eventos dos chamados
+type Webhook struct {
+	ID           string       `json:"id"`
+	URL          string       `json:"url"`
+	Segredo      string       `json:"segredo,omitempty"` // usado na assinatura HMAC-SHA256; exibido apenas na criação
+	TiposEvento  []TipoEvento `json:"tiposEvento"`
+	Status       bool         `json:"status"`
+	CriadoEm     time.Time    `json:"criadoEm"`
+	AtualizadoEm time.Time    `json:"atualizadoEm"`
+}
+
+// NewWebhook cria uma nova instância de Webhook com os dados fornecidos
+func NewWebhook(id, endereco, segredo string, tiposEvento []TipoEvento, status bool) (*Webhook, error) {
+	now := time.Now()
+	webhook := &Webhook{
+		ID:           id,
+		URL:          endereco,
+		Segredo:      segredo,
+		TiposEvento:  tiposEvento,
+		Status:       status,
+		CriadoEm:     now,
+		AtualizadoEm: now,
+	}
+
+	if err := ValidarWebhook(webhook); err != nil {
+		return nil, fmt.Errorf("[model.NewWebhook]: %w", err)
+	}
+	return webhook, nil
+}
+
+// ValidarWebhook valida os campos do webhook. O segredo vazio é aceito, pois é gerado na
+// criação e mantido na atualização.
+func ValidarWebhook(w *Webhook) error {
+	var erros []error
+
+	if u, err := url.Parse(w.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		erros = append(erros, ErrURLWebhookInvalida)
+	}
+	if tamanho := utf8.RuneCountInString(w.Segredo); w.Segredo != "" &&
+		(tamanho < TamanhoMinimoSegredoWebhook || tamanho > TamanhoMaximoSegredoWebhook) {
+		erros = append(erros, ErrSegredoWebhookInvalido)
+	}
+	if len(w.TiposEvento) == 0 {
+		erros = append(erros, ErrTiposEventoWebhookVazio)
+	}
+	for _, tipo := range w.TiposEvento {
+		if err := ValidarTipoEventoWebhook(tipo); err != nil {
+			erros = append(erros, err)
+			break
+		}
+	}
+	if len(erros) > 0 {
+		return fmt.Errorf("[model.ValidarWebhook] erros de validação: %w", errors.Join(erros...))
+	}
+	return nil
+}
+
+// ValidarTipoEventoWebhook valida se o tipo é um dos eventos que podem ser assinados.
+func ValidarTipoEventoWebhook(tipo TipoEvento) error {
+	for _, t := range TiposEventoWebhook {
+		if t == tipo {
+			return nil
+		}
+	}
+	return fmt.Errorf("[model.ValidarTipoEventoWebhook]: %w", ErrTipoEventoWebhookInvalido)
+}
+
+// ValidarStatusEntregaWebhook valida se o status é um dos status de entrega permitidos.
+func ValidarStatusEntregaWebhook(status StatusEntregaWebhook) error {
+	switch status {
+	case EntregaWebhookPendente, EntregaWebhookEntregue, EntregaWebhookFalhou:
+		return nil
+	}
+	return fmt.Errorf("[model.ValidarStatusEntregaWebhook]: %w", ErrStatusEntregaInvalido)
+}
+
+// EventoEnviadoPorWebhook indica se o evento é enviado aos webhooks. Notas internas não são
+// enviadas, pois os sistemas externos não fazem parte da equipe técnica.
+func EventoEnviadoPorWebhook(e *Evento) bool {
+	if e.Tipo == EventoAcompanhamentoCriado && e.Interno {
+		return false
+	}
+	return ValidarTipoEventoWebhook(e.Tipo) == nil
+}
+
+// PayloadWebhook é o corpo JSON enviado aos webhooks, com o chamado no momento do evento. O
+// ID se mantém nos reenvios, para que o sistema de destino descarte as entregas repetidas.
+type PayloadWebhook struct {
+	ID         string     `json:"id"`
+	Evento     TipoEvento `json:"evento"`
+	ChamadoID  string     `json:"chamadoId"`
+	Chamado    *Chamado   `json:"chamado,omitempty"`
+	Dados      any        `json:"dados,omitempty"`
+	OcorridoEm time.Time  `json:"ocorridoEm"`
+}
+
+// EntregaWebhook representa o envio de um evento a um webhook, mantido na fila até ser
+// entregue ou esgotar as tentativas
+type EntregaWebhook struct {
+	ID                 string               `json:"id"`
+	WebhookID          string               `json:"webhookId"`
+	ReenvioDeID        *string              `json:"reenvioDeId,omitempty"` // entrega original, nos reenvios manuais
+	TipoEvento         TipoEvento           `json:"tipoEvento"`
+	ChamadoID          string               `json:"chamadoId"`
+	Payload            json.RawMessage      `json:"payload,omitempty"` // omitido nas listagens
+	Status             StatusEntregaWebhook `json:"status"`
+	Tentativas         int                  `json:"tentativas"`
+	ProximaTentativaEm *time.Time           `json:"proximaTentativaEm,omitempty"`
+	UltimoStatusHTTP   *int                 `json:"ultimoStatusHttp,omitempty"`
+	UltimoErro         *string              `json:"ultimoErro,omitempty"`
+	EntregueEm         *time.Time           `json:"entregueEm,omitempty"`
+	CriadoEm           time.Time            `json:"criadoEm"`
+	AtualizadoEm       time.Time            `json:"atualizadoEm"`
+
+	TentativasRealizadas []TentativaEntregaWebhook `json:"tentativasRealizadas,omitempty"`
+}
+
+// NewEntregaWebhook cria a entrega do evento ao webhook, pendente para envio imediato.
+func NewEntregaWebhook(id, webhookID string, e *Evento, chamado *Chamado) (*EntregaWebhook, error) {
+	payload, err := json.Marshal(PayloadWebhook{
+		ID:         id,
+		Evento:     e.Tipo,
+		ChamadoID:  e.ChamadoID,
+		Chamado:    chamado,
+		Dados:      e.Dados,
+		OcorridoEm: e.CriadoEm,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[model.NewEntregaWebhook]: %w", err)
+	}
+
+	now := time.Now()
+	return &EntregaWebhook{
+		ID:                 id,
+		WebhookID:          webhookID,
+		TipoEvento:         e.Tipo,
+		ChamadoID:          e.ChamadoID,
+		Payload:            payload,
+		Status:             EntregaWebhookPendente,
+		ProximaTentativaEm: &now,
+		CriadoEm:           now,
+		AtualizadoEm:       now,
+	}, nil
+}
+
+// NewReenvioEntregaWebhook cria uma nova entrega com o mesmo conteúdo da entrega original,
+// pendente para envio imediato.
+func NewReenvioEntregaWebhook(id string, original *EntregaWebhook) *EntregaWebhook {
+	now := time.Now()
+	return &EntregaWebhook{
+		ID:                 id,
+		WebhookID:          original.WebhookID,
+		ReenvioDeID:        &original.ID,
+		TipoEvento:         original.TipoEvento,
+		ChamadoID:          original.ChamadoID,
+		Payload:            original.Payload,
+		Status:             EntregaWebhookPendente,
+		ProximaTentativaEm: &now,
+		CriadoEm:           now,
+		AtualizadoEm:       now,
+	}
+}
+
+// TentativaEntregaWebhook registra o resultado de uma tentativa de envio
+type TentativaEntregaWebhook struct {
+	Numero      int       `json:"numero"`
+	StatusHTTP  *int      `json:"statusHttp,omitempty"`
+	Erro        *string   `json:"erro,omitempty"`
+	DuracaoMs   int64     `json:"duracaoMs"`
+	RealizadaEm time.Time `json:"realizadaEm"`
+}
+
+// RegistrarTentativa atualiza a entrega com o resultado da tentativa. Em caso de falha, a
+// entrega volta à fila após a espera informada ou, sem novas tentativas, é encerrada como falha.
+func (e *EntregaWebhook) RegistrarTentativa(t *TentativaEntregaWebhook, tentativasMaximas int, espera time.Duration) {
+	e.Tentativas++
+	t.Numero = e.Tentativas
+	e.UltimoStatusHTTP = t.StatusHTTP
+	e.UltimoErro = t.Erro
+	e.AtualizadoEm = t.RealizadaEm
+
+	switch {
+	case t.Erro == nil:
+		e.Status = EntregaWebhookEntregue
+		e.EntregueEm = &t.RealizadaEm
+		e.ProximaTentativaEm = nil
+	case e.Tentativas >= tentativasMaximas:
+		e.Status = EntregaWebhookFalhou
+		e.ProximaTentativaEm = nil
+	default:
+		proxima := t.RealizadaEm.Add(espera)
+		e.Status = EntregaWebhookPendente
+		e.ProximaTentativaEm = &proxima
+	}
+}
+
+// WebhookFiltro representa os critérios de filtro para listar webhooks
+type WebhookFiltro struct {
+	Pagina     int
+	Limite     int
+	TipoEvento *TipoEvento
+	Status     *bool
+}
+
+// EntregaWebhookFiltro representa os critérios de filtro para listar as entregas dos webhooks
+type EntregaWebhookFiltro struct {
+	Pagina     int
+	Limite     int
+	WebhookID  *string
+	ChamadoID  *string
+	TipoEvento *TipoEvento
+	Status     *StatusEntregaWebhook
+}
+
+// String retorna uma representação de Webhook para fins de logging, sem o segredo.
+func (w *Webhook) String() string {
+	return fmt.Sprintf("[ID=%s | URL=%s | TiposEvento=%v | Status=%t]", w.ID, w.URL, w.TiposEvento, w.Status)
+}
+
+// String retorna uma representação de EntregaWebhook para fins de logging.
+func (e *EntregaWebhook) String() string {
+	reenvioDeID := ""
+	if e.ReenvioDeID != nil {
+		reenvioDeID = *e.ReenvioDeID
+	}
+	return fmt.Sprintf(
+		"ID(%s) | Webhook(%s) | Evento(%s) | Chamado(%s) | Status(%s) | Tentativas(%d) | ReenvioDe(%s)",
+		e.ID, e.WebhookID, e.TipoEvento, e.ChamadoID, e.Status, e.Tentativas, reenvioDeID,
+	)
+}
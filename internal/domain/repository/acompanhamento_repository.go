@@ -11,8 +11,9 @@ type BuscarAcompanhamento interface {
 	// BuscarPorID retorna um acompanhamento pelo seu ID
 	BuscarPorID(ctx context.Context, id string) (*model.Acompanhamento, error)
 
-	// BuscarPorChamadoID retorna uma lista de acompanhamentos pelo ID do chamado
-	BuscarPorChamadoID(ctx context.Context, chamadoID string) ([]model.Acompanhamento, error)
+	// BuscarPorChamadoID retorna uma lista de acompanhamentos pelo ID do chamado,
+	// incluindo as notas internas apenas quando solicitado
+	BuscarPorChamadoID(ctx context.Context, chamadoID string, incluirInternos bool) ([]model.Acompanhamento, error)
 }
 
 // ArmazenarAcompanhamento define métodos para armazenamento do acompanhamento
@@ -20,11 +21,19 @@ type ArmazenarAcompanhamento interface {
 	// Salvar insere um novo acompanhamento no repositório
 	Salvar(ctx context.Context, a *model.Acompanhamento) error
 
-	// Atualizar modifica os dados de um acompanhamento existente
-	Atualizar(ctx context.Context, id string, a *model.Acompanhamento) error
+	// Atualizar modifica o conteúdo e a visibilidade de um acompanhamento existente,
+	// registrando a revisão com o estado anterior na mesma transação
+	Atualizar(ctx context.Context, id string, a *model.Acompanhamento, revisao *model.RevisaoAcompanhamento) error
 
-	// Deletar remove um acompanhamento do repositório pelo seu ID
-	Deletar(ctx context.Context, id string) error
+	// Deletar substitui o conteúdo do acompanhamento pela mensagem de remoção, registrando a
+	// revisão com o conteúdo anterior e removendo os metadados de seus anexos na mesma transação
+	Deletar(ctx context.Context, id string, revisao *model.RevisaoAcompanhamento) error
+}
+
+// HistoricoAcompanhamento define métodos de consulta das revisões do acompanhamento
+type HistoricoAcompanhamento interface {
+	// ListarRevisoes retorna as revisões do acompanhamento, da mais antiga à mais recente
+	ListarRevisoes(ctx context.Context, acompanhamentoID string) ([]model.RevisaoAcompanhamento, error)
 }
 
 // ListarAcompanhamento define métodos para listagem e busca filtrada
@@ -38,4 +47,5 @@ type AcompanhamentoRepository interface {
 	BuscarAcompanhamento
 	ArmazenarAcompanhamento
 	ListarAcompanhamento
+	HistoricoAcompanhamento
 }
\ No newline at end of file
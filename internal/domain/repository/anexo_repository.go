@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"io"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarAnexo define métodos de busca dos metadados do anexo
+type BuscarAnexo interface {
+	// BuscarPorID retorna os metadados de um anexo pelo seu ID
+	BuscarPorID(ctx context.Context, id string) (*model.Anexo, error)
+}
+
+// ArmazenarAnexo define métodos para armazenamento dos metadados do anexo
+type ArmazenarAnexo interface {
+	// Salvar insere os metadados de um novo anexo no repositório
+	Salvar(ctx context.Context, a *model.Anexo) error
+
+	// Deletar remove os metadados de um anexo pelo seu ID
+	Deletar(ctx context.Context, id string) error
+}
+
+// ListarAnexo define métodos para listagem dos anexos
+type ListarAnexo interface {
+	// ListarPorChamado retorna os anexos do chamado e de seus acompanhamentos, do mais antigo ao mais recente,
+	// incluindo os anexos de notas internas apenas quando solicitado
+	ListarPorChamado(ctx context.Context, chamadoID string, incluirInternos bool) ([]model.Anexo, error)
+
+	// ListarPorAcompanhamento retorna os anexos do acompanhamento
+	ListarPorAcompanhamento(ctx context.Context, acompanhamentoID string) ([]model.Anexo, error)
+}
+
+// AnexoRepository é uma composição de todas as interfaces acima
+type AnexoRepository interface {
+	BuscarAnexo
+	ArmazenarAnexo
+	ListarAnexo
+}
+
+// ArmazenamentoArquivo define o armazenamento do conteúdo dos arquivos, identificado
+// por uma chave. Permite trocar o sistema de arquivos local por um armazenamento de
+// objetos (compatível com S3) sem alterar os casos de uso.
+type ArmazenamentoArquivo interface {
+	// Salvar grava o conteúdo lido de r sob a chave informada
+	Salvar(ctx context.Context, chave string, r io.Reader) error
+
+	// Abrir retorna o conteúdo armazenado sob a chave; quem chama deve fechá-lo
+	Abrir(ctx context.Context, chave string) (io.ReadCloser, error)
+
+	// Remover apaga o conteúdo armazenado sob a chave; chaves inexistentes não geram erro
+	Remover(ctx context.Context, chave string) error
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarApontamento define métodos de busca do apontamento
+type BuscarApontamento interface {
+	// BuscarPorID retorna um apontamento pelo seu ID
+	BuscarPorID(ctx context.Context, id string) (*model.Apontamento, error)
+
+	// BuscarEmAndamento retorna o apontamento em andamento do técnico ou nil, caso não haja
+	BuscarEmAndamento(ctx context.Context, usuarioID string) (*model.Apontamento, error)
+}
+
+// ArmazenarApontamento define métodos para armazenamento do apontamento
+type ArmazenarApontamento interface {
+	// Salvar insere um novo apontamento no repositório
+	Salvar(ctx context.Context, a *model.Apontamento) error
+
+	// Atualizar modifica o período, a descrição e o faturamento de um apontamento existente
+	Atualizar(ctx context.Context, id string, a *model.Apontamento) error
+
+	// Deletar remove um apontamento pelo seu ID
+	Deletar(ctx context.Context, id string) error
+}
+
+// ListarApontamento define métodos para listagem e totalização dos apontamentos
+type ListarApontamento interface {
+	// Listar retorna uma lista de apontamentos com base em filtros e paginação
+	Listar(ctx context.Context, filtro model.ApontamentoFiltro) ([]model.Apontamento, int, error)
+
+	// Totalizar soma os minutos apontados agrupados por chamado, categoria ou técnico
+	Totalizar(ctx context.Context, filtro model.TotaisApontamentoFiltro) ([]model.TotalApontamento, error)
+}
+
+// ApontamentoRepository é uma composição de todas as interfaces acima
+type ApontamentoRepository interface {
+	BuscarApontamento
+	ArmazenarApontamento
+	ListarApontamento
+}
@@ -10,21 +10,35 @@ import (
 type BuscarAtendimento interface {
 	// BuscarPorID retorna um atendimento pelo seu ID
 	BuscarPorID(ctx context.Context, id string) (*model.Atendimento, error)
+
+	// BuscarAtivo retorna o atendimento vigente do chamado ou nil, caso o chamado esteja na fila
+	BuscarAtivo(ctx context.Context, chamadoID string) (*model.Atendimento, error)
 }
 
-// ArmazenarAtendimento define métodos para armazenamento do atendimento
+// ArmazenarAtendimento define métodos para armazenamento do atendimento.
+// Os atendimentos não são sobrescritos: cada mudança de técnico finaliza o
+// atendimento vigente e, na transferência, inicia um novo.
 type ArmazenarAtendimento interface {
 	// Salvar insere um novo atendimento no repositório
 	Salvar(ctx context.Context, a *model.Atendimento) error
 
-	// Atualizar modifica os dados de um atendimento existente
-	Atualizar(ctx context.Context, id string, a *model.Atendimento) error
+	// Finalizar encerra o atendimento vigente, registrando quem o encerrou e o motivo
+	Finalizar(ctx context.Context, id string, finalizadoPorID *string, motivo string) error
+
+	// Transferir finaliza o atendimento vigente e insere o novo atendimento em uma única transação
+	Transferir(ctx context.Context, atualID string, finalizadoPorID *string, motivo string, novo *model.Atendimento) error
 }
 
 // ListarAtendimento define métodos para listagem e busca filtrada
 type ListarAtendimento interface {
 	// Listar retorna uma lista de atendimentos com base em filtros e paginação
 	Listar(ctx context.Context, filtro model.AtendimentoFiltro) ([]model.Atendimento, int, error)
+
+	// ListarPorChamado retorna a linha do tempo de atendimentos do chamado, do mais antigo ao mais recente
+	ListarPorChamado(ctx context.Context, chamadoID string) ([]model.Atendimento, error)
+
+	// ListarChamadosAtribuidos retorna os IDs dos chamados que já foram atribuídos ao técnico
+	ListarChamadosAtribuidos(ctx context.Context, atribuidoID string) ([]string, error)
 }
 
 // AtendimentoRepository é uma composição de todas as interfaces acima
@@ -33,4 +47,3 @@ type AtendimentoRepository interface {
 	ArmazenarAtendimento
 	ListarAtendimento
 }
-
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// AtribuicaoRepository define métodos de consulta para a atribuição automática de chamados
+type AtribuicaoRepository interface {
+	// ListarCandidatos retorna os técnicos ativos com a carga atual de chamados em andamento,
+	// a última atribuição recebida na categoria e se possuem permissão na categoria.
+	ListarCandidatos(ctx context.Context, categoriaID string) ([]model.CandidatoAtribuicao, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarAvaliacao define métodos de busca das avaliações
+type BuscarAvaliacao interface {
+	// ListarPorChamado retorna as avaliações do chamado, da mais antiga à mais recente
+	ListarPorChamado(ctx context.Context, chamadoID string) ([]model.Avaliacao, error)
+}
+
+// ArmazenarAvaliacao define métodos para armazenamento das avaliações
+type ArmazenarAvaliacao interface {
+	// Salvar insere uma nova avaliação no repositório
+	Salvar(ctx context.Context, a *model.Avaliacao) error
+}
+
+// AgregarAvaliacao define métodos de agregação da satisfação dos usuários
+type AgregarAvaliacao interface {
+	// AgregarCSAT conta as avaliações agrupadas por técnico, categoria ou mês
+	AgregarCSAT(ctx context.Context, filtro model.CSATFiltro) ([]model.TotalCSAT, error)
+}
+
+// AvaliacaoRepository é uma composição de todas as interfaces acima
+type AvaliacaoRepository interface {
+	BuscarAvaliacao
+	ArmazenarAvaliacao
+	AgregarAvaliacao
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarCalendario define métodos de busca do calendário
+type BuscarCalendario interface {
+	// BuscarPorID busca um calendário pelo seu ID, com expedientes e feriados.
+	BuscarPorID(ctx context.Context, id string) (*model.Calendario, error)
+
+	// BuscarVigente busca o calendário ativo da categoria informada, recorrendo
+	// ao calendário padrão quando a categoria não possuir um próprio.
+	// Retorna nil quando nenhum calendário estiver ativo.
+	BuscarVigente(ctx context.Context, categoriaID string) (*model.Calendario, error)
+}
+
+// ArmazenarCalendario define métodos para salvar/atualizar/ativar/desativar calendários
+type ArmazenarCalendario interface {
+	// Salvar cria um novo calendário com seus expedientes.
+	Salvar(ctx context.Context, c *model.Calendario) error
+
+	// Atualizar atualiza as informações e os expedientes de um calendário existente.
+	Atualizar(ctx context.Context, id string, c *model.Calendario) error
+
+	// Ativar ativa um calendário pelo seu ID.
+	Ativar(ctx context.Context, id string) error
+
+	// Desativar desativa um calendário pelo seu ID.
+	Desativar(ctx context.Context, id string) error
+}
+
+// FeriadosCalendario define métodos para manter os feriados de um calendário
+type FeriadosCalendario interface {
+	// SalvarFeriados insere os feriados no calendário, atualizando a descrição
+	// das datas já cadastradas. Retorna a quantidade de feriados processados.
+	SalvarFeriados(ctx context.Context, calendarioID string, feriados []model.Feriado) (int, error)
+
+	// RemoverFeriado remove um feriado do calendário pela data (AAAA-MM-DD).
+	RemoverFeriado(ctx context.Context, calendarioID, data string) error
+}
+
+// ListarCalendario define métodos para listagem e busca filtrada
+type ListarCalendario interface {
+	// Listar lista calendários com paginação e filtros opcionais.
+	Listar(ctx context.Context, filtro model.CalendarioFiltro) ([]model.Calendario, int, error)
+}
+
+// CalendarioRepository é uma composição de todas as interfaces acima
+type CalendarioRepository interface {
+	BuscarCalendario
+	ArmazenarCalendario
+	FeriadosCalendario
+	ListarCalendario
+}
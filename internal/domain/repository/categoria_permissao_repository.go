@@ -24,8 +24,15 @@ type ListarCategoriaPermissao interface {
 	Listar(ctx context.Context, filtro model.CategoriaPermissaoFiltro) ([]model.CategoriaPermissao, int, error)
 }
 
+// BuscarCategoriaPermissao define métodos para consulta das permissões de um usuário
+type BuscarCategoriaPermissao interface {
+	// ListarPorUsuario retorna todas as permissões concedidas ao usuário nas categorias
+	ListarPorUsuario(ctx context.Context, usuarioID string) ([]model.CategoriaPermissao, error)
+}
+
 // CategoriaPermissaoRepository é uma composição de todas as interfaces acima
 type CategoriaPermissaoRepository interface {
 	ArmazenarCategoriaPermissao
 	ListarCategoriaPermissao
+	BuscarCategoriaPermissao
 }
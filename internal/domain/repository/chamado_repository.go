@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 )
@@ -10,6 +11,9 @@ import (
 type BuscarChamado interface {
 	// BuscarPorID busca um chamado pelo seu ID.
 	BuscarPorID(ctx context.Context, id string) (*model.Chamado, error)
+
+	// Visivel indica se o chamado pertence ao escopo de chamados visíveis ao usuário.
+	Visivel(ctx context.Context, id string, escopo *model.EscopoChamados) (bool, error)
 }
 
 // ArmazenarChamado define métodos para salvar/atualizar/excluir chamados
@@ -29,20 +33,72 @@ type ArmazenarChamado interface {
 
 // AtualizarChamado define métodos específicos de atualização
 type AtualizarChamado interface {
-	// AtualizarStatus atualiza o status de um chamado, podendo incluir uma solução.
-	AtualizarStatus(ctx context.Context, id string, status string, solucao *string) error
+	// AtualizarStatus atualiza o status de um chamado que ainda esteja no status de origem,
+	// podendo incluir uma solução.
+	AtualizarStatus(ctx context.Context, id string, origem, status string, solucao *string) error
+
+	// AtualizarPrioridade atualiza o impacto, a urgência e a prioridade de um chamado.
+	AtualizarPrioridade(ctx context.Context, id string, impacto model.Impacto, urgencia model.Urgencia, prioridade model.Prioridade) error
+
+	// Reabrir devolve o chamado resolvido ou fechado ao status informado, descartando a
+	// solução e incrementando o contador de reaberturas.
+	Reabrir(ctx context.Context, id string, status model.StatusChamado) error
+}
+
+// SLAChamado define métodos de persistência dos prazos e violações de SLA
+type SLAChamado interface {
+	// AtualizarSLA persiste os prazos de SLA, a primeira resposta e a pausa do chamado.
+	AtualizarSLA(ctx context.Context, id string, c *model.Chamado) error
+
+	// MarcarViolacaoSLA marca o prazo informado como violado caso esteja vencido e
+	// ainda não registrado, retornando true apenas quando a marcação ocorrer.
+	MarcarViolacaoSLA(ctx context.Context, id string, tipo model.TipoSLA) (bool, error)
+
+	// MarcarAvisoSLA registra o aviso de prazo de solução em risco caso ainda não
+	// registrado, retornando true apenas quando a marcação ocorrer.
+	MarcarAvisoSLA(ctx context.Context, id string) (bool, error)
+}
+
+// TemposChamado define métodos de persistência do controle de tempo do chamado
+type TemposChamado interface {
+	// AtualizarTempoAtribuido persiste o início do período atual no status
+	// ATRIBUIDO e o tempo útil acumulado em períodos anteriores.
+	AtualizarTempoAtribuido(ctx context.Context, id string, c *model.Chamado) error
 }
 
 // ListarChamado define métodos para listagem e busca filtrada
 type ListarChamado interface {
 	// Listar lista chamados com paginação e filtros opcionais.
 	Listar(ctx context.Context, filtro model.ChamadoFiltro) ([]model.Chamado, int, error)
+
+	// AgregarReaberturas conta os chamados solucionados e reabertos agrupados por técnico, categoria ou mês.
+	AgregarReaberturas(ctx context.Context, filtro model.ReaberturaFiltro) ([]model.TotalReabertura, error)
+}
+
+// ManutencaoChamado define métodos de busca usados pelas rotinas automáticas
+type ManutencaoChamado interface {
+	// ListarResolvidosAntesDe lista os chamados não arquivados resolvidos até o instante limite.
+	ListarResolvidosAntesDe(ctx context.Context, limite time.Time) ([]model.Chamado, error)
+
+	// ListarFechadosAntesDe lista os chamados não arquivados fechados até o instante limite.
+	ListarFechadosAntesDe(ctx context.Context, limite time.Time) ([]model.Chamado, error)
+
+	// ListarSLAEmRisco lista os chamados em atendimento, ainda não avisados, cujo prazo
+	// de solução vence até o instante limite.
+	ListarSLAEmRisco(ctx context.Context, limite time.Time) ([]model.Chamado, error)
+
+	// ListarSLAVencido lista os chamados em atendimento, com o SLA em curso, cujo prazo de
+	// primeira resposta ou de solução venceu sem que a violação tenha sido registrada.
+	ListarSLAVencido(ctx context.Context) ([]model.Chamado, error)
 }
 
 // ChamadoRepository é uma composição de todas as interfaces acima
 type ChamadoRepository interface {
 	BuscarChamado
-	ArmazenarChamado	
+	ArmazenarChamado
 	AtualizarChamado
+	SLAChamado
+	TemposChamado
 	ListarChamado
+	ManutencaoChamado
 }
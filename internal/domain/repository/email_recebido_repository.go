@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// EmailRecebidoRepository define métodos de persistência das mensagens recebidas por e-mail
+type EmailRecebidoRepository interface {
+	// BuscarPorMessageID retorna o registro da mensagem já processada, ou nil se ainda não foi
+	BuscarPorMessageID(ctx context.Context, messageID string) (*model.RegistroEmailRecebido, error)
+
+	// Salvar registra a mensagem processada
+	Salvar(ctx context.Context, r *model.RegistroEmailRecebido) error
+}
+
+// CaixaEntradaEmail define a leitura das mensagens enviadas ao atendimento por e-mail
+type CaixaEntradaEmail interface {
+	// ListarPendentes retorna até limite mensagens ainda não processadas, das mais antigas às mais novas
+	ListarPendentes(ctx context.Context, limite int) ([]model.EmailRecebido, error)
+
+	// Concluir retira a mensagem das pendentes; rejeitada indica que ela não pôde ser processada
+	Concluir(ctx context.Context, chave string, rejeitada bool) error
+}
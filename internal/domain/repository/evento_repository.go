@@ -0,0 +1,13 @@
+package repository
+
+import "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+
+// BarramentoEventos define o meio de distribuição dos eventos de domínio aos assinantes
+type BarramentoEventos interface {
+	// Publicar atribui o ID ao evento, guarda-o no buffer e o entrega aos assinantes
+	Publicar(e *model.Evento)
+
+	// Assinar inscreve um assinante que recebe apenas os eventos aceitos pelo filtro,
+	// repassando os eventos do buffer publicados após ultimoID
+	Assinar(ultimoID uint64, filtro func(*model.Evento) bool) *model.AssinaturaEventos
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// MatrizPrioridadeRepository define métodos de persistência da matriz de prioridade
+type MatrizPrioridadeRepository interface {
+	// Buscar retorna todas as combinações da matriz de prioridade.
+	Buscar(ctx context.Context) (model.MatrizPrioridade, error)
+
+	// Salvar inclui ou atualiza as combinações informadas na matriz de prioridade.
+	Salvar(ctx context.Context, matriz model.MatrizPrioridade) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarMencao define métodos de busca das menções
+type BuscarMencao interface {
+	// ListarPorAcompanhamento retorna as menções registradas no acompanhamento
+	ListarPorAcompanhamento(ctx context.Context, acompanhamentoID string) ([]model.Mencao, error)
+
+	// ListarChamadosMencionado retorna os IDs dos chamados em que o usuário foi mencionado
+	ListarChamadosMencionado(ctx context.Context, usuarioID string) ([]string, error)
+
+	// UsuarioMencionado indica se o usuário foi mencionado em algum acompanhamento do chamado
+	UsuarioMencionado(ctx context.Context, chamadoID, usuarioID string) (bool, error)
+}
+
+// ArmazenarMencao define métodos para armazenamento das menções
+type ArmazenarMencao interface {
+	// Salvar registra as menções, ignorando as que já foram registradas
+	Salvar(ctx context.Context, mencoes []model.Mencao) error
+}
+
+// MencaoRepository agrega os métodos das menções
+type MencaoRepository interface {
+	BuscarMencao
+	ArmazenarMencao
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// PreferenciaNotificacaoRepository define métodos de persistência das preferências de notificação
+type PreferenciaNotificacaoRepository interface {
+	// ListarPreferencias retorna as preferências configuradas pelo usuário
+	ListarPreferencias(ctx context.Context, usuarioID string) ([]model.PreferenciaNotificacao, error)
+
+	// SalvarPreferencias insere ou atualiza as preferências informadas do usuário
+	SalvarPreferencias(ctx context.Context, usuarioID string, preferencias []model.PreferenciaNotificacao) error
+
+	// EmailHabilitado indica se o usuário recebe o tipo de notificação por e-mail;
+	// tipos não configurados são enviados por padrão
+	EmailHabilitado(ctx context.Context, usuarioID string, tipo model.TipoNotificacao) (bool, error)
+}
+
+// EntregaNotificacao define o meio de entrega das notificações aos usuários
+type EntregaNotificacao interface {
+	// Entregar agenda a entrega da notificação, sem aguardar o envio
+	Entregar(n *model.Notificacao) error
+}
+
+// NotificacaoUsuarioRepository define métodos de persistência da central de notificações dos usuários
+type NotificacaoUsuarioRepository interface {
+	// Salvar insere uma nova notificação
+	Salvar(ctx context.Context, n *model.NotificacaoUsuario) error
+
+	// BuscarPorID retorna a notificação pelo ID
+	BuscarPorID(ctx context.Context, id string) (*model.NotificacaoUsuario, error)
+
+	// Listar retorna as notificações do usuário, das mais recentes às mais antigas
+	Listar(ctx context.Context, filtro model.NotificacaoUsuarioFiltro) ([]model.NotificacaoUsuario, int, error)
+
+	// ContarNaoLidas retorna a quantidade de notificações não lidas do usuário
+	ContarNaoLidas(ctx context.Context, usuarioID string) (int, error)
+
+	// MarcarLida marca a notificação como lida, mantendo a data de uma leitura anterior
+	MarcarLida(ctx context.Context, id string) error
+
+	// MarcarTodasLidas marca como lidas as notificações não lidas do usuário e retorna quantas foram marcadas
+	MarcarTodasLidas(ctx context.Context, usuarioID string) (int, error)
+
+	// RemoverAnteriores exclui as notificações criadas antes do limite e retorna quantas foram excluídas
+	RemoverAnteriores(ctx context.Context, limite time.Time) (int, error)
+}
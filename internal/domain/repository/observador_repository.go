@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarObservador define métodos de busca dos observadores
+type BuscarObservador interface {
+	// ListarPorChamado retorna os observadores do chamado, do mais antigo ao mais recente
+	ListarPorChamado(ctx context.Context, chamadoID string) ([]model.Observador, error)
+
+	// ListarChamadosObservados retorna os IDs dos chamados que o usuário observa
+	ListarChamadosObservados(ctx context.Context, usuarioID string) ([]string, error)
+}
+
+// ArmazenarObservador define métodos para armazenamento dos observadores
+type ArmazenarObservador interface {
+	// Salvar registra o observador, ignorando-o se já observa o chamado
+	Salvar(ctx context.Context, o *model.Observador) error
+
+	// Remover retira o usuário dos observadores do chamado
+	Remover(ctx context.Context, chamadoID, usuarioID string) error
+}
+
+// ObservadorRepository é uma composição de todas as interfaces acima
+type ObservadorRepository interface {
+	BuscarObservador
+	ArmazenarObservador
+}
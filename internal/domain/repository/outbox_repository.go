@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// OutboxRepository define a gravação das mensagens da outbox na transação da alteração que
+// as originou e a sua leitura pelo despacho
+type OutboxRepository interface {
+	// Salvar grava a mensagem, participando da unidade de trabalho em andamento no contexto.
+	Salvar(ctx context.Context, m *model.MensagemOutbox) error
+
+	// ReservarPendentes retorna até limite mensagens disponíveis, na ordem de gravação,
+	// adiando-as pela duração da reserva para que não sejam publicadas em duplicidade.
+	ReservarPendentes(ctx context.Context, limite int, reserva time.Duration) ([]model.MensagemOutbox, error)
+
+	// Remover exclui a mensagem publicada.
+	Remover(ctx context.Context, id string) error
+
+	// RegistrarFalha salva as tentativas, o erro e a nova disponibilidade da mensagem.
+	RegistrarFalha(ctx context.Context, m *model.MensagemOutbox) error
+}
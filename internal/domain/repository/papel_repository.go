@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarPapel define métodos de busca dos papéis
+type BuscarPapel interface {
+	// BuscarPorCodigo busca um papel pelo seu código, com as permissões concedidas.
+	BuscarPorCodigo(ctx context.Context, codigo string) (*model.Papel, error)
+
+	// ListarPermissoes retorna as permissões nomeadas concedidas ao papel.
+	ListarPermissoes(ctx context.Context, codigo string) ([]model.PermissaoNomeada, error)
+}
+
+// ArmazenarPapel define métodos para salvar, atualizar e remover papéis
+type ArmazenarPapel interface {
+	// Salvar cria um novo papel com as permissões concedidas.
+	Salvar(ctx context.Context, p *model.Papel) error
+
+	// Atualizar atualiza o nome, a descrição e a permissão base do papel, estendendo a nova
+	// permissão base aos usuários do papel.
+	Atualizar(ctx context.Context, codigo string, p *model.Papel) error
+
+	// AtualizarPermissoes substitui as permissões concedidas ao papel.
+	AtualizarPermissoes(ctx context.Context, codigo string, permissoes []model.PermissaoNomeada) error
+
+	// Deletar remove um papel sem usuários.
+	Deletar(ctx context.Context, codigo string) error
+}
+
+// ListarPapel define métodos para listagem dos papéis
+type ListarPapel interface {
+	// Listar retorna todos os papéis, com as permissões concedidas.
+	Listar(ctx context.Context) ([]model.Papel, error)
+}
+
+// AtribuirPapel define a atribuição dos papéis aos usuários
+type AtribuirPapel interface {
+	// AtribuirAoUsuario define o papel do usuário e a sua permissão, que passa a ser a
+	// permissão base do papel.
+	AtribuirAoUsuario(ctx context.Context, usuarioID string, p *model.Papel) error
+}
+
+// PapelRepository é uma composição de todas as interfaces acima
+type PapelRepository interface {
+	BuscarPapel
+	ArmazenarPapel
+	ListarPapel
+	AtribuirPapel
+}
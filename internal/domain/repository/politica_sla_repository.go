@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarPoliticaSLA define métodos de busca da política de SLA
+type BuscarPoliticaSLA interface {
+	// BuscarPorID busca uma política de SLA pelo seu ID.
+	BuscarPorID(ctx context.Context, id string) (*model.PoliticaSLA, error)
+
+	// BuscarVigente busca a política ativa aplicável à subcategoria informada,
+	// recorrendo à política geral da categoria quando não houver uma específica.
+	// Retorna nil quando nenhuma política for aplicável.
+	BuscarVigente(ctx context.Context, categoriaID, subcategoriaID string) (*model.PoliticaSLA, error)
+}
+
+// ArmazenarPoliticaSLA define métodos para salvar/atualizar/ativar/desativar políticas de SLA
+type ArmazenarPoliticaSLA interface {
+	// Salvar cria uma nova política de SLA.
+	Salvar(ctx context.Context, p *model.PoliticaSLA) error
+
+	// Atualizar atualiza as informações de uma política de SLA existente.
+	Atualizar(ctx context.Context, id string, p *model.PoliticaSLA) error
+
+	// Ativar ativa uma política de SLA pelo seu ID.
+	Ativar(ctx context.Context, id string) error
+
+	// Desativar desativa uma política de SLA pelo seu ID.
+	Desativar(ctx context.Context, id string) error
+}
+
+// ListarPoliticaSLA define métodos para listagem e busca filtrada
+type ListarPoliticaSLA interface {
+	// Listar lista políticas de SLA com paginação e filtros opcionais.
+	Listar(ctx context.Context, filtro model.PoliticaSLAFiltro) ([]model.PoliticaSLA, int, error)
+}
+
+// PoliticaSLARepository é uma composição de todas as interfaces acima
+type PoliticaSLARepository interface {
+	BuscarPoliticaSLA
+	ArmazenarPoliticaSLA
+	ListarPoliticaSLA
+}
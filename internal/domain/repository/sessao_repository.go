@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarSessao define métodos de consulta das sessões
+type BuscarSessao interface {
+	// BuscarPorID retorna a sessão pelo ID
+	BuscarPorID(ctx context.Context, id string) (*model.Sessao, error)
+
+	// ListarAtivasPorUsuario retorna as sessões não encerradas e não expiradas do usuário, das mais recentes às mais antigas
+	ListarAtivasPorUsuario(ctx context.Context, usuarioID string) ([]model.Sessao, error)
+}
+
+// ArmazenarSessao define métodos de escrita das sessões
+type ArmazenarSessao interface {
+	// Salvar insere uma nova sessão
+	Salvar(ctx context.Context, s *model.Sessao) error
+
+	// Rotacionar grava o novo token de refresh da sessão se o token vigente ainda for o
+	// anterior e a sessão não tiver sido encerrada; retorna false caso contrário
+	Rotacionar(ctx context.Context, s *model.Sessao, refreshJTIAnterior string) (bool, error)
+
+	// Revogar encerra a sessão
+	Revogar(ctx context.Context, id string, revogadaEm time.Time) error
+
+	// RevogarTodasDoUsuario encerra todas as sessões abertas do usuário
+	RevogarTodasDoUsuario(ctx context.Context, usuarioID string, revogadaEm time.Time) error
+
+	// RemoverExpiradas exclui as sessões expiradas antes do limite e retorna quantas foram excluídas
+	RemoverExpiradas(ctx context.Context, limite time.Time) (int, error)
+}
+
+// SessaoRepository define métodos de persistência das sessões dos usuários
+type SessaoRepository interface {
+	BuscarSessao
+	ArmazenarSessao
+}
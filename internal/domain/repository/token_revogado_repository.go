@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// TokenRevogadoRepository define métodos de persistência das revogações de tokens
+type TokenRevogadoRepository interface {
+	// Salvar registra a revogação de um token; revogar de novo o mesmo token não tem efeito
+	Salvar(ctx context.Context, t *model.TokenRevogado) error
+
+	// RevogarTodosDoUsuario revoga todos os tokens do usuário emitidos até o instante informado
+	RevogarTodosDoUsuario(ctx context.Context, usuarioID string, revogadoEm time.Time) error
+
+	// BuscarRevogacoesUsuario retorna as revogações vigentes dos tokens do usuário
+	BuscarRevogacoesUsuario(ctx context.Context, usuarioID string) (*model.RevogacoesUsuario, error)
+
+	// RemoverExpirados exclui as revogações dos tokens expirados antes do limite e retorna quantas foram excluídas
+	RemoverExpirados(ctx context.Context, limite time.Time) (int, error)
+}
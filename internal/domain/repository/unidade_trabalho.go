@@ -0,0 +1,19 @@
+package repository
+
+import "context"
+
+// UnidadeDeTrabalho define a execução de várias operações dos repositórios em uma única transação
+type UnidadeDeTrabalho interface {
+	// Executar executa a operação em uma transação, confirmada apenas se a operação não
+	// retornar erro. Os repositórios chamados com o contexto recebido pela operação participam
+	// da transação; chamadas aninhadas reaproveitam a transação em andamento.
+	Executar(ctx context.Context, operacao func(ctx context.Context) error) error
+
+	// AposConfirmar registra uma ação executada após a confirmação da transação em andamento
+	// no contexto. Fora de uma unidade de trabalho, a ação é executada de imediato.
+	AposConfirmar(ctx context.Context, acao func())
+
+	// AposDesfazer registra uma ação executada após o desfazimento da transação em andamento
+	// no contexto. Fora de uma unidade de trabalho, não há o que desfazer e a ação é ignorada.
+	AposDesfazer(ctx context.Context, acao func())
+}
@@ -13,6 +13,9 @@ type BuscarUsuario interface {
 
 	// BuscarPorLogin retorna um usuário pelo seu login
 	BuscarPorLogin(ctx context.Context, login string) (*model.Usuario, error)
+
+	// BuscarPorEmail retorna um usuário pelo seu e-mail
+	BuscarPorEmail(ctx context.Context, email string) (*model.Usuario, error)
 }
 
 // ArmazenarUsuario define métodos para armazenamento do usuário
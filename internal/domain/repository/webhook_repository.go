@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarWebhook define métodos de busca de webhooks
+type BuscarWebhook interface {
+	// BuscarPorID busca um webhook pelo seu ID, incluindo o segredo.
+	BuscarPorID(ctx context.Context, id string) (*model.Webhook, error)
+
+	// ListarAtivosPorTipoEvento retorna os webhooks ativos que assinam o tipo de evento.
+	ListarAtivosPorTipoEvento(ctx context.Context, tipo model.TipoEvento) ([]model.Webhook, error)
+}
+
+// ArmazenarWebhook define métodos para salvar/atualizar/ativar/desativar webhooks
+type ArmazenarWebhook interface {
+	// Salvar cria um novo webhook com os tipos de evento assinados.
+	Salvar(ctx context.Context, w *model.Webhook) error
+
+	// Atualizar atualiza a URL e os tipos de evento do webhook; o segredo é mantido quando vazio.
+	Atualizar(ctx context.Context, id string, w *model.Webhook) error
+
+	// Ativar ativa um webhook pelo seu ID.
+	Ativar(ctx context.Context, id string) error
+
+	// Desativar desativa um webhook pelo seu ID.
+	Desativar(ctx context.Context, id string) error
+}
+
+// ListarWebhook define métodos para listagem e busca filtrada
+type ListarWebhook interface {
+	// Listar lista webhooks com paginação e filtros opcionais.
+	Listar(ctx context.Context, filtro model.WebhookFiltro) ([]model.Webhook, int, error)
+}
+
+// WebhookRepository é uma composição de todas as interfaces acima
+type WebhookRepository interface {
+	BuscarWebhook
+	ArmazenarWebhook
+	ListarWebhook
+}
+
+// EntregaWebhookRepository define a fila persistente de entregas dos webhooks e o registro das tentativas
+type EntregaWebhookRepository interface {
+	// Salvar inclui as entregas na fila.
+	Salvar(ctx context.Context, entregas []model.EntregaWebhook) error
+
+	// BuscarPorID busca uma entrega pelo seu ID, incluindo as tentativas realizadas.
+	BuscarPorID(ctx context.Context, id string) (*model.EntregaWebhook, error)
+
+	// Listar lista as entregas com paginação e filtros opcionais, das mais recentes às mais antigas.
+	Listar(ctx context.Context, filtro model.EntregaWebhookFiltro) ([]model.EntregaWebhook, int, error)
+
+	// ReservarPendentes retorna até limite entregas pendentes cuja próxima tentativa já venceu,
+	// adiando-as pela duração da reserva para que não sejam enviadas em duplicidade.
+	ReservarPendentes(ctx context.Context, limite int, reserva time.Duration) ([]model.EntregaWebhook, error)
+
+	// RegistrarTentativa salva a situação da entrega e a tentativa realizada na mesma transação.
+	RegistrarTentativa(ctx context.Context, e *model.EntregaWebhook, t *model.TentativaEntregaWebhook) error
+}
+
+// EnvioWebhook define o envio de uma entrega ao endereço do webhook
+type EnvioWebhook interface {
+	// Enviar envia o payload da entrega assinado com o segredo do webhook e retorna o status
+	// HTTP da resposta. Respostas fora da faixa 2xx são retornadas como erro.
+	Enviar(ctx context.Context, w *model.Webhook, e *model.EntregaWebhook) (int, error)
+}
@@ -20,13 +20,19 @@ type ArmazenarAcompanhamento interface {
 	// CriarAcompanhamento cria um novo acompanhamento.
 	CriarAcompanhamento(ctx context.Context, a *model.Acompanhamento) error
 
-	// AtualizarAcompanhamento atualiza as informações de um acompanhamento existente.
+	// AtualizarAcompanhamento edita o conteúdo de um acompanhamento, mantendo o estado anterior como revisão.
 	AtualizarAcompanhamento(ctx context.Context, id string, a *model.Acompanhamento) error
 
-	// DeletarAcompanhamento remove um acompanhamento pelo ID.
+	// DeletarAcompanhamento substitui o conteúdo do acompanhamento pela mensagem de remoção.
 	DeletarAcompanhamento(ctx context.Context, id string) error
 }
 
+// HistoricoAcompanhamento é a interface que define os métodos de consulta das revisões de acompanhamentos.
+type HistoricoAcompanhamento interface {
+	// ListarRevisoesAcompanhamento lista as revisões do acompanhamento.
+	ListarRevisoesAcompanhamento(ctx context.Context, id string) ([]model.RevisaoAcompanhamento, error)
+}
+
 // ListarAcompanhamentos é a interface que define os métodos para listar e buscar acompanhamentos com filtros.
 type ListarAcompanhamentos interface {
 	// ListarAcompanhamentos lista acompanhamentos com paginação e filtros opcionais.
@@ -38,4 +44,5 @@ type AcompanhamentoUsecase interface {
 	BuscarAcompanhamento
 	ArmazenarAcompanhamento
 	ListarAcompanhamentos
+	HistoricoAcompanhamento
 }
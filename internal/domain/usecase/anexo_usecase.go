@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// EnviarAnexo define métodos para o envio de arquivos
+type EnviarAnexo interface {
+	// EnviarAnexo valida e armazena o conteúdo enviado, registrando seus metadados
+	EnviarAnexo(ctx context.Context, envio *model.EnvioAnexo, conteudo io.Reader) (*model.Anexo, error)
+}
+
+// BuscarAnexo define métodos de busca e download dos anexos
+type BuscarAnexo interface {
+	// AbrirAnexo retorna os metadados e o conteúdo do anexo; quem chama deve fechar o conteúdo
+	AbrirAnexo(ctx context.Context, id string) (*model.Anexo, io.ReadCloser, error)
+
+	// BuscarAnexosPorChamado retorna os anexos do chamado e de seus acompanhamentos
+	BuscarAnexosPorChamado(ctx context.Context, chamadoID string) ([]model.Anexo, error)
+}
+
+// RemoverAnexo define métodos para remoção dos anexos
+type RemoverAnexo interface {
+	// DeletarAnexo remove os metadados e o conteúdo do anexo
+	DeletarAnexo(ctx context.Context, id string) error
+}
+
+// AnexoUsecase é uma composição de todas as interfaces acima
+type AnexoUsecase interface {
+	EnviarAnexo
+	BuscarAnexo
+	RemoverAnexo
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarApontamento define métodos de busca do apontamento
+type BuscarApontamento interface {
+	// BuscarApontamentoPorID retorna um apontamento pelo seu ID
+	BuscarApontamentoPorID(ctx context.Context, id string) (*model.Apontamento, error)
+
+	// BuscarApontamentoEmAndamento retorna o cronômetro em andamento do usuário autenticado
+	BuscarApontamentoEmAndamento(ctx context.Context) (*model.Apontamento, error)
+}
+
+// ArmazenarApontamento define métodos para armazenamento do apontamento
+type ArmazenarApontamento interface {
+	// CriarApontamento registra um apontamento já concluído para o usuário autenticado
+	CriarApontamento(ctx context.Context, a *model.Apontamento) error
+
+	// AtualizarApontamento modifica um apontamento existente
+	AtualizarApontamento(ctx context.Context, id string, a *model.Apontamento) error
+
+	// DeletarApontamento remove um apontamento existente
+	DeletarApontamento(ctx context.Context, id string) error
+}
+
+// CronometroApontamento define métodos do cronômetro de apontamentos de cada técnico
+type CronometroApontamento interface {
+	// IniciarApontamento inicia o cronômetro do usuário autenticado em um atendimento
+	IniciarApontamento(ctx context.Context, a *model.Apontamento) error
+
+	// PararApontamento encerra o cronômetro em andamento do usuário autenticado
+	PararApontamento(ctx context.Context) (*model.Apontamento, error)
+}
+
+// ListarApontamento define métodos para listagem e totalização dos apontamentos
+type ListarApontamento interface {
+	// ListarApontamentos retorna uma lista de apontamentos com base em filtros e paginação
+	ListarApontamentos(ctx context.Context, filtro model.ApontamentoFiltro) ([]model.Apontamento, int, model.ApontamentoFiltro, error)
+
+	// TotalizarApontamentos soma os minutos apontados agrupados por chamado, categoria ou técnico
+	TotalizarApontamentos(ctx context.Context, filtro model.TotaisApontamentoFiltro) ([]model.TotalApontamento, error)
+}
+
+// ApontamentoUsecase é uma composição de todas as interfaces acima
+type ApontamentoUsecase interface {
+	BuscarApontamento
+	ArmazenarApontamento
+	CronometroApontamento
+	ListarApontamento
+}
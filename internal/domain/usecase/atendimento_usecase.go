@@ -17,8 +17,11 @@ type ArmazenarAtendimento interface {
 	// CriarAtendimento insere um novo atendimento no repositório
 	CriarAtendimento(ctx context.Context, a *model.Atendimento) error
 
-	// AtualizarAtendimento modifica os dados de um atendimento existente
-	AtualizarAtendimento(ctx context.Context, id string, a *model.Atendimento) error
+	// TransferirChamado finaliza o atendimento vigente do chamado e o atribui a outro técnico
+	TransferirChamado(ctx context.Context, chamadoID string, t *model.TransferenciaAtendimento) (*model.Atendimento, error)
+
+	// DevolverChamado finaliza o atendimento vigente e devolve o chamado para a fila
+	DevolverChamado(ctx context.Context, chamadoID string, d *model.DevolucaoAtendimento) error
 }
 
 // ListarAtendimento define métodos para listagem e busca filtrada
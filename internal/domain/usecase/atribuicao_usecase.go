@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// AtribuicaoUsecase é a interface que define os casos de uso da atribuição automática de chamados.
+type AtribuicaoUsecase interface {
+	// SugerirTecnico aplica a estratégia de atribuição da categoria e retorna o técnico
+	// escolhido, o motivo da escolha e os candidatos avaliados.
+	SugerirTecnico(ctx context.Context, categoriaID string) (*model.SugestaoAtribuicao, error)
+}
@@ -2,20 +2,28 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
 )
 
 // AuthInternoUsecase é a interface para casos de uso de autenticação
 type AuthInternoUsecase interface {
 	// Login realiza a autenticação de um usuário e gera um token.
-	Login(ctx context.Context, login, senha string) (*response.TokenPair, error)
+	Login(ctx context.Context, login, senha string, origem model.OrigemSessao) (*response.TokenPair, error)
 
 	// Refresh renova um token de acesso usando um token de atualização.
-	Refresh(ctx context.Context, refreshToken string) (*response.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string, origem model.OrigemSessao) (*response.TokenPair, error)
 
 	// Me retorna os dados do usuário autenticado.
 	Me(ctx context.Context, userID string) (*response.UsuarioResponse, error)
+
+	// Logout encerra a sessão do token de acesso e revoga, quando informado, o token de atualização.
+	Logout(ctx context.Context, usuarioID, sessaoID, jti string, expiraEm time.Time, refreshToken string) error
+
+	// LogoutTodasSessoes encerra todas as sessões e revoga todos os tokens emitidos para o usuário.
+	LogoutTodasSessoes(ctx context.Context, usuarioID string) error
 }
 
 // AuthExterno é a interface para sistemas externos de autenticação (LDAP, OAuth, etc.)
@@ -25,4 +33,7 @@ type AuthExternoUsecase interface {
 
 	// PesquisarPorLogin busca informações de um usuário no sistema externo pelo login.
 	PesquisarPorLogin(login string) (nome, email, outLogin string, err error)
+
+	// PesquisarPorEmail busca informações de um usuário no sistema externo pelo e-mail.
+	PesquisarPorEmail(email string) (nome, outEmail, login string, err error)
 }
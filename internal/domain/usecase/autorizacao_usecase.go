@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// AutorizarOperacao define métodos para autorização das operações sobre os chamados
+type AutorizarOperacao interface {
+	// Autorizar verifica se o usuário, com a permissão global e o papel informados, pode
+	// realizar a operação nos chamados da categoria, e retorna a sua permissão efetiva na categoria
+	Autorizar(ctx context.Context, usuarioID string, permissao model.Permissao, papel, categoriaID string, operacao model.OperacaoChamado) (model.Permissao, error)
+
+	// AutorizarOperacao aplica Autorizar ao usuário autenticado
+	AutorizarOperacao(ctx context.Context, categoriaID string, operacao model.OperacaoChamado) (model.Permissao, error)
+
+	// PermiteOperacaoNaCategoria indica se o usuário autenticado pode realizar a operação nos
+	// chamados da categoria
+	PermiteOperacaoNaCategoria(ctx context.Context, categoriaID string, operacao model.OperacaoChamado) (bool, error)
+
+	// PermissaoNaCategoria retorna a permissão efetiva do usuário autenticado na categoria
+	PermissaoNaCategoria(ctx context.Context, categoriaID string) (model.Permissao, error)
+}
+
+// ListarPermissoesEfetivas define métodos para consulta das permissões efetivas
+type ListarPermissoesEfetivas interface {
+	// ListarPermissoesEfetivas retorna a permissão efetiva do usuário autenticado em cada
+	// categoria ativa e as operações que ela permite
+	ListarPermissoesEfetivas(ctx context.Context) (*model.PermissoesEfetivas, error)
+}
+
+// AutorizacaoUsecase é a interface que agrega os casos de uso da autorização
+type AutorizacaoUsecase interface {
+	AutorizarOperacao
+	ListarPermissoesEfetivas
+}
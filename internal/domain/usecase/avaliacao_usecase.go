@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// ResponderAvaliacao define métodos para responder a pesquisa de satisfação
+type ResponderAvaliacao interface {
+	// ResponderAvaliacao registra a avaliação do criador e fecha ou reabre o chamado resolvido
+	ResponderAvaliacao(ctx context.Context, chamadoID string, resposta *model.RespostaAvaliacao) (*model.Avaliacao, error)
+}
+
+// BuscarAvaliacao define métodos de busca das avaliações
+type BuscarAvaliacao interface {
+	// BuscarAvaliacoesPorChamado retorna as avaliações do chamado
+	BuscarAvaliacoesPorChamado(ctx context.Context, chamadoID string) ([]model.Avaliacao, error)
+}
+
+// AgregarAvaliacao define métodos de agregação da satisfação dos usuários
+type AgregarAvaliacao interface {
+	// AgregarCSAT calcula a satisfação agrupada por técnico, categoria ou mês
+	AgregarCSAT(ctx context.Context, filtro model.CSATFiltro) ([]model.TotalCSAT, error)
+}
+
+// AvaliacaoUsecase é uma composição de todas as interfaces acima
+type AvaliacaoUsecase interface {
+	ResponderAvaliacao
+	BuscarAvaliacao
+	AgregarAvaliacao
+}
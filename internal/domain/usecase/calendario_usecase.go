@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarCalendario é a interface que define os métodos para obter informações de calendários.
+type BuscarCalendario interface {
+	// BuscarCalendarioPorID busca um calendário pelo ID.
+	BuscarCalendarioPorID(ctx context.Context, id string) (*model.Calendario, error)
+}
+
+// ArmazenarCalendario é a interface que define os métodos para criar, atualizar, ativar e desativar calendários.
+type ArmazenarCalendario interface {
+	// CriarCalendario cria um novo calendário.
+	CriarCalendario(ctx context.Context, c *model.Calendario) error
+
+	// AtualizarCalendario atualiza as informações de um calendário existente.
+	AtualizarCalendario(ctx context.Context, id string, c *model.Calendario) error
+
+	// AtivarCalendario ativa um calendário.
+	AtivarCalendario(ctx context.Context, id string) error
+
+	// DesativarCalendario desativa um calendário.
+	DesativarCalendario(ctx context.Context, id string) error
+}
+
+// FeriadosCalendario é a interface que define os métodos para manter os feriados de um calendário.
+type FeriadosCalendario interface {
+	// ImportarFeriados inclui ou atualiza os feriados informados no calendário.
+	ImportarFeriados(ctx context.Context, calendarioID string, feriados []model.Feriado) (int, error)
+
+	// RemoverFeriado remove um feriado do calendário.
+	RemoverFeriado(ctx context.Context, calendarioID, data string) error
+}
+
+// TempoUtil é a interface que define os cálculos de tempo em horário de expediente.
+type TempoUtil interface {
+	// CalcularTempoUtil calcula o tempo de expediente entre dois instantes,
+	// usando o calendário vigente para a categoria informada.
+	CalcularTempoUtil(ctx context.Context, categoriaID string, inicio, fim time.Time) (time.Duration, error)
+}
+
+// ListarCalendarios é a interface que define os métodos para listar calendários com filtros.
+type ListarCalendarios interface {
+	// ListarCalendarios lista calendários com paginação e filtros opcionais.
+	ListarCalendarios(ctx context.Context, filtro model.CalendarioFiltro) ([]model.Calendario, int, model.CalendarioFiltro, error)
+}
+
+// CalendarioUsecase é a interface que agrega os casos de uso relacionados a calendários.
+type CalendarioUsecase interface {
+	BuscarCalendario
+	ArmazenarCalendario
+	FeriadosCalendario
+	TempoUtil
+	ListarCalendarios
+}
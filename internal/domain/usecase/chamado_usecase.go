@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 )
@@ -31,12 +32,46 @@ type ArmazenarChamado interface {
 type AtualizarChamado interface {
 	// AtualizarStatusChamado atualiza o status de um chamado, podendo incluir uma solução.
 	AtualizarStatusChamado(ctx context.Context, id string, status string, solucao *string) error
+
+	// AtualizarPrioridadeChamado altera o impacto e a urgência de um chamado,
+	// derivando a nova prioridade pela matriz de prioridade.
+	AtualizarPrioridadeChamado(ctx context.Context, id string, alteracao *model.AlteracaoPrioridade) error
+
+	// ReabrirChamado reabre um chamado resolvido ou fechado dentro do prazo de reabertura,
+	// devolvendo-o ao último técnico que o atendeu.
+	ReabrirChamado(ctx context.Context, id string, reabertura *model.Reabertura) error
+}
+
+// SLAChamado é a interface que define os métodos de acompanhamento do SLA dos chamados.
+type SLAChamado interface {
+	// RegistrarPrimeiraResposta registra a primeira resposta da equipe técnica ao chamado.
+	RegistrarPrimeiraResposta(ctx context.Context, id string) error
 }
 
 // ListarChamados é a interface que define os métodos para listar e buscar chamados com filtros.
 type ListarChamados interface {
 	// ListarChamados lista chamados com paginação e filtros opcionais.
 	ListarChamados(ctx context.Context, filtro model.ChamadoFiltro) ([]model.Chamado, int, model.ChamadoFiltro, error)
+
+	// AgregarReaberturas calcula as reaberturas agrupadas por técnico, categoria ou mês.
+	AgregarReaberturas(ctx context.Context, filtro model.ReaberturaFiltro) ([]model.TotalReabertura, error)
+}
+
+// ManutencaoChamado é a interface que define as rotinas automáticas de manutenção dos chamados.
+type ManutencaoChamado interface {
+	// FecharChamadosResolvidos fecha os chamados resolvidos há mais de diasUteis dias úteis.
+	FecharChamadosResolvidos(ctx context.Context, diasUteis int) ([]model.Chamado, error)
+
+	// ArquivarChamadosFechados arquiva os chamados fechados há mais de dias dias corridos.
+	ArquivarChamadosFechados(ctx context.Context, dias int) ([]model.Chamado, error)
+
+	// AvisarSLAEmRisco avisa a equipe técnica dos chamados cujo prazo de solução vence
+	// dentro da antecedência informada.
+	AvisarSLAEmRisco(ctx context.Context, antecedencia time.Duration) ([]model.Chamado, error)
+
+	// RegistrarSLAVencido registra as violações dos prazos de SLA que venceram sem que o
+	// chamado fosse consultado ou alterado.
+	RegistrarSLAVencido(ctx context.Context) ([]model.Chamado, error)
 }
 
 // ChamadoUsecase é a interface que agrega os casos de uso relacionados a chamados.
@@ -44,5 +79,7 @@ type ChamadoUsecase interface {
 	BuscarChamado
 	ArmazenarChamado
 	AtualizarChamado
+	SLAChamado
 	ListarChamados
+	ManutencaoChamado
 }
\ No newline at end of file
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// ProcessarEmailRecebido define a abertura e a resposta de chamados por e-mail
+type ProcessarEmailRecebido interface {
+	// ProcessarEmailRecebido abre um chamado para a mensagem ou, quando o assunto referencia
+	// um chamado, inclui a mensagem como acompanhamento
+	ProcessarEmailRecebido(ctx context.Context, e *model.EmailRecebido) (*model.RegistroEmailRecebido, error)
+}
+
+// EntradaEmailUsecase define o processamento da caixa de entrada do atendimento
+type EntradaEmailUsecase interface {
+	ProcessarEmailRecebido
+
+	// ProcessarCaixaEntrada processa as mensagens pendentes da caixa de entrada e retorna
+	// quantas foram processadas e quantas foram rejeitadas
+	ProcessarCaixaEntrada(ctx context.Context) (processadas, rejeitadas int, err error)
+}
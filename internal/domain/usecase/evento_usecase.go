@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// PublicarEvento define métodos para a publicação de eventos de domínio
+type PublicarEvento interface {
+	// PublicarEvento grava o evento na outbox, na transação em andamento no contexto; o
+	// despacho da outbox o distribui depois aos assinantes que podem vê-lo
+	PublicarEvento(ctx context.Context, e *model.Evento) error
+}
+
+// AssinarEventos define métodos para o acompanhamento de eventos em tempo real
+type AssinarEventos interface {
+	// AssinarEventos inscreve o usuário do contexto nos eventos visíveis a ele,
+	// repassando os eventos publicados após ultimoEventoID ainda disponíveis
+	AssinarEventos(ctx context.Context, ultimoEventoID uint64) (*model.AssinaturaEventos, error)
+}
+
+// ConsumirEvento define o tratamento durável dos eventos despachados da outbox
+type ConsumirEvento interface {
+	// ConsumirEvento grava os registros originados pelo evento na transação em andamento no
+	// contexto, a mesma que remove o evento da outbox
+	ConsumirEvento(ctx context.Context, e *model.Evento) error
+}
+
+// EventoUsecase é uma composição de todas as interfaces acima
+type EventoUsecase interface {
+	PublicarEvento
+	AssinarEventos
+}
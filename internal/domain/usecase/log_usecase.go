@@ -14,8 +14,11 @@ type BuscarLog interface {
 
 // ArmazenarLog é a interface que define os métodos para criar logs.
 type ArmazenarLog interface {
-	// CriarLog cria um novo log.
+	// CriarLog grava o log na outbox, na transação em andamento no contexto.
 	CriarLog(ctx context.Context, acao model.Acao, entidade, detalhes string) error
+
+	// CriarLogChamado grava na outbox o log referente ao chamado informado.
+	CriarLogChamado(ctx context.Context, acao model.Acao, entidade, chamadoID, detalhes string) error
 }
 
 // ListarLogs é a interface que define os métodos para listar e buscar logs com filtros.
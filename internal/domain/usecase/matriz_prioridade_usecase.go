@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// MatrizPrioridadeUsecase é a interface que define os casos de uso da matriz de prioridade.
+type MatrizPrioridadeUsecase interface {
+	// BuscarMatrizPrioridade retorna a matriz de prioridade vigente.
+	BuscarMatrizPrioridade(ctx context.Context) (model.MatrizPrioridade, error)
+
+	// AtualizarMatrizPrioridade inclui ou atualiza combinações da matriz de prioridade.
+	// Os chamados existentes mantêm a prioridade já derivada.
+	AtualizarMatrizPrioridade(ctx context.Context, matriz model.MatrizPrioridade) error
+}
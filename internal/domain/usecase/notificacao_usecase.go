@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// PreferenciaNotificacaoUsecase define métodos para as preferências de notificação do usuário autenticado
+type PreferenciaNotificacaoUsecase interface {
+	// BuscarPreferenciasNotificacao retorna as preferências de todos os tipos de notificação
+	BuscarPreferenciasNotificacao(ctx context.Context) ([]model.PreferenciaNotificacao, error)
+
+	// AtualizarPreferenciasNotificacao salva as preferências informadas, mantendo as demais
+	AtualizarPreferenciasNotificacao(ctx context.Context, preferencias []model.PreferenciaNotificacao) ([]model.PreferenciaNotificacao, error)
+}
+
+// NotificacaoUsecase define a notificação dos usuários a partir dos eventos de domínio
+// despachados da outbox
+type NotificacaoUsecase interface {
+	ConsumirEvento
+}
+
+// CentralNotificacoesUsecase define métodos para a central de notificações do usuário autenticado
+type CentralNotificacoesUsecase interface {
+	// ListarNotificacoes retorna as notificações do usuário, das mais recentes às mais antigas
+	ListarNotificacoes(ctx context.Context, filtro model.NotificacaoUsuarioFiltro) ([]model.NotificacaoUsuario, int, model.NotificacaoUsuarioFiltro, error)
+
+	// ContarNotificacoesNaoLidas retorna a quantidade de notificações não lidas do usuário
+	ContarNotificacoesNaoLidas(ctx context.Context) (int, error)
+
+	// MarcarNotificacaoLida marca como lida uma notificação do usuário
+	MarcarNotificacaoLida(ctx context.Context, id string) (*model.NotificacaoUsuario, error)
+
+	// MarcarTodasNotificacoesLidas marca como lidas todas as notificações do usuário e retorna quantas foram marcadas
+	MarcarTodasNotificacoesLidas(ctx context.Context) (int, error)
+}
+
+// ExpurgoNotificacoes define a remoção das notificações antigas da central
+type ExpurgoNotificacoes interface {
+	// ExpurgarNotificacoes exclui as notificações criadas há mais dias que a retenção e retorna quantas foram excluídas
+	ExpurgarNotificacoes(ctx context.Context, diasRetencao int) (int, error)
+}
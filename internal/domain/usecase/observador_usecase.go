@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// ArmazenarObservador define métodos para incluir e retirar os observadores dos chamados
+type ArmazenarObservador interface {
+	// AdicionarObservador inclui o usuário, ou o usuário autenticado, nos observadores do chamado
+	AdicionarObservador(ctx context.Context, chamadoID, usuarioID string) (*model.Observador, error)
+
+	// RemoverObservador retira o usuário, ou o usuário autenticado, dos observadores do chamado
+	RemoverObservador(ctx context.Context, chamadoID, usuarioID string) error
+}
+
+// BuscarObservador define métodos de busca dos observadores
+type BuscarObservador interface {
+	// ListarObservadores retorna os observadores do chamado
+	ListarObservadores(ctx context.Context, chamadoID string) ([]model.Observador, error)
+}
+
+// ObservadorUsecase é uma composição de todas as interfaces acima
+type ObservadorUsecase interface {
+	ArmazenarObservador
+	BuscarObservador
+}
@@ -0,0 +1,10 @@
+package usecase
+
+import "context"
+
+// DespachoOutbox é a interface que define a publicação das mensagens gravadas na outbox.
+type DespachoOutbox interface {
+	// Despachar publica as mensagens disponíveis da outbox e retorna quantas foram
+	// publicadas e quantas falharam.
+	Despachar(ctx context.Context) (publicadas, falhas int, err error)
+}
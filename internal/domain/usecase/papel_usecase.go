@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarPapel é a interface que define os métodos para obter informações dos papéis.
+type BuscarPapel interface {
+	// BuscarPapelPorCodigo busca um papel pelo código, com as permissões concedidas.
+	BuscarPapelPorCodigo(ctx context.Context, codigo string) (*model.Papel, error)
+
+	// ListarPapeis lista todos os papéis, com as permissões concedidas.
+	ListarPapeis(ctx context.Context) ([]model.Papel, error)
+
+	// ListarPermissoesDisponiveis lista as permissões nomeadas que podem ser concedidas aos papéis.
+	ListarPermissoesDisponiveis() []model.DefinicaoPermissao
+}
+
+// ArmazenarPapel é a interface que define os métodos para criar, atualizar e remover papéis.
+type ArmazenarPapel interface {
+	// CriarPapel cria um novo papel com as permissões informadas.
+	CriarPapel(ctx context.Context, p *model.Papel) error
+
+	// AtualizarPapel atualiza o nome, a descrição e a permissão base de um papel.
+	AtualizarPapel(ctx context.Context, codigo string, p *model.Papel) error
+
+	// AtualizarPermissoesPapel substitui as permissões concedidas a um papel.
+	AtualizarPermissoesPapel(ctx context.Context, codigo string, permissoes []model.PermissaoNomeada) error
+
+	// DeletarPapel remove um papel personalizado sem usuários.
+	DeletarPapel(ctx context.Context, codigo string) error
+}
+
+// AtribuirPapelUsuario é a interface que define a atribuição dos papéis aos usuários.
+type AtribuirPapelUsuario interface {
+	// AtribuirPapelUsuario define o papel do usuário.
+	AtribuirPapelUsuario(ctx context.Context, usuarioID, codigo string) error
+}
+
+// VerificarPermissaoPapel é a interface usada pelo middleware para autorizar as rotas.
+type VerificarPermissaoPapel interface {
+	// PapelPossuiPermissao indica se o papel concede a permissão nomeada.
+	PapelPossuiPermissao(ctx context.Context, codigo string, permissao model.PermissaoNomeada) (bool, error)
+}
+
+// PapelUsecase é a interface que agrega os casos de uso relacionados aos papéis.
+type PapelUsecase interface {
+	BuscarPapel
+	ArmazenarPapel
+	AtribuirPapelUsuario
+	VerificarPermissaoPapel
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarPoliticaSLA é a interface que define os métodos para obter informações de políticas de SLA.
+type BuscarPoliticaSLA interface {
+	// BuscarPoliticaSLAPorID busca uma política de SLA pelo ID.
+	BuscarPoliticaSLAPorID(ctx context.Context, id string) (*model.PoliticaSLA, error)
+}
+
+// ArmazenarPoliticaSLA é a interface que define os métodos para criar, atualizar, ativar e desativar políticas de SLA.
+type ArmazenarPoliticaSLA interface {
+	// CriarPoliticaSLA cria uma nova política de SLA.
+	CriarPoliticaSLA(ctx context.Context, p *model.PoliticaSLA) error
+
+	// AtualizarPoliticaSLA atualiza as informações de uma política de SLA existente.
+	AtualizarPoliticaSLA(ctx context.Context, id string, p *model.PoliticaSLA) error
+
+	// AtivarPoliticaSLA ativa uma política de SLA.
+	AtivarPoliticaSLA(ctx context.Context, id string) error
+
+	// DesativarPoliticaSLA desativa uma política de SLA.
+	DesativarPoliticaSLA(ctx context.Context, id string) error
+}
+
+// ListarPoliticasSLA é a interface que define os métodos para listar políticas de SLA com filtros.
+type ListarPoliticasSLA interface {
+	// ListarPoliticasSLA lista políticas de SLA com paginação e filtros opcionais.
+	ListarPoliticasSLA(ctx context.Context, filtro model.PoliticaSLAFiltro) ([]model.PoliticaSLA, int, model.PoliticaSLAFiltro, error)
+}
+
+// PoliticaSLAUsecase é a interface que agrega os casos de uso relacionados a políticas de SLA.
+type PoliticaSLAUsecase interface {
+	BuscarPoliticaSLA
+	ArmazenarPoliticaSLA
+	ListarPoliticasSLA
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+// VerificarRevogacaoToken define a verificação, a cada requisição, dos tokens revogados
+type VerificarRevogacaoToken interface {
+	// TokenRevogado indica se o token do usuário, identificado pela sessão, pelo jti e pelo instante de emissão, foi revogado
+	TokenRevogado(ctx context.Context, usuarioID, sessaoID, jti string, emitidoEm *time.Time) (bool, error)
+}
+
+// RevogarTokens define a revogação dos tokens antes da expiração
+type RevogarTokens interface {
+	// RevogarToken revoga um único token, no logout da sessão
+	RevogarToken(ctx context.Context, usuarioID, jti string, expiraEm time.Time) error
+
+	// RevogarSessao encerra a sessão do usuário, revogando os tokens emitidos para ela
+	RevogarSessao(ctx context.Context, usuarioID, sessaoID string) error
+
+	// RevogarTokensUsuario revoga todos os tokens emitidos até agora para o usuário e encerra as suas sessões
+	RevogarTokensUsuario(ctx context.Context, usuarioID string) error
+}
+
+// ExpurgoTokensRevogados define a remoção das revogações dos tokens já expirados
+type ExpurgoTokensRevogados interface {
+	// ExpurgarTokensRevogados exclui as revogações dos tokens e as sessões expirados e retorna quantos foram excluídos
+	ExpurgarTokensRevogados(ctx context.Context) (int, error)
+}
+
+// RevogacaoTokenUsecase define métodos para a revogação dos tokens de acesso e de refresh
+type RevogacaoTokenUsecase interface {
+	VerificarRevogacaoToken
+	RevogarTokens
+	ExpurgoTokensRevogados
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// ManterSessao define a abertura das sessões no login e a rotação dos tokens de refresh
+type ManterSessao interface {
+	// IniciarSessao registra a sessão aberta no login, com o token de refresh emitido
+	IniciarSessao(ctx context.Context, sessaoID, usuarioID, refreshJTI string, origem model.OrigemSessao, expiraEm time.Time) error
+
+	// RotacionarSessao substitui o token de refresh apresentado pelo novo token. A reapresentação
+	// de um token já substituído encerra a sessão
+	RotacionarSessao(ctx context.Context, sessaoID, usuarioID, refreshJTI, novoRefreshJTI string, origem model.OrigemSessao, expiraEm time.Time) error
+}
+
+// SessoesUsuario define a consulta e o encerramento das sessões dos usuários
+type SessoesUsuario interface {
+	// ListarSessoesUsuario retorna as sessões ativas do usuário, marcando a sessão atual
+	ListarSessoesUsuario(ctx context.Context, usuarioID, sessaoAtual string) ([]model.Sessao, error)
+
+	// EncerrarSessaoPropria encerra uma sessão do próprio usuário
+	EncerrarSessaoPropria(ctx context.Context, usuarioID, sessaoID string) error
+
+	// EncerrarSessao encerra a sessão de qualquer usuário
+	EncerrarSessao(ctx context.Context, sessaoID string) error
+}
+
+// SessaoUsecase define métodos para as sessões dos usuários
+type SessaoUsecase interface {
+	ManterSessao
+	SessoesUsuario
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// BuscarWebhook é a interface que define os métodos para obter informações de webhooks.
+type BuscarWebhook interface {
+	// BuscarWebhookPorID busca um webhook pelo ID, sem o segredo.
+	BuscarWebhookPorID(ctx context.Context, id string) (*model.Webhook, error)
+}
+
+// ArmazenarWebhook é a interface que define os métodos para criar, atualizar, ativar e desativar webhooks.
+type ArmazenarWebhook interface {
+	// CriarWebhook cria um novo webhook, gerando o segredo quando não informado.
+	CriarWebhook(ctx context.Context, w *model.Webhook) error
+
+	// AtualizarWebhook atualiza as informações de um webhook existente.
+	AtualizarWebhook(ctx context.Context, id string, w *model.Webhook) error
+
+	// AtivarWebhook ativa um webhook.
+	AtivarWebhook(ctx context.Context, id string) error
+
+	// DesativarWebhook desativa um webhook.
+	DesativarWebhook(ctx context.Context, id string) error
+}
+
+// ListarWebhooks é a interface que define os métodos para listar webhooks com filtros.
+type ListarWebhooks interface {
+	// ListarWebhooks lista webhooks com paginação e filtros opcionais.
+	ListarWebhooks(ctx context.Context, filtro model.WebhookFiltro) ([]model.Webhook, int, model.WebhookFiltro, error)
+}
+
+// EntregasWebhook é a interface que define os métodos de consulta e reenvio das entregas dos webhooks.
+type EntregasWebhook interface {
+	// BuscarEntregaWebhookPorID busca uma entrega pelo ID, com as tentativas realizadas.
+	BuscarEntregaWebhookPorID(ctx context.Context, id string) (*model.EntregaWebhook, error)
+
+	// ListarEntregasWebhook lista as entregas com paginação e filtros opcionais.
+	ListarEntregasWebhook(ctx context.Context, filtro model.EntregaWebhookFiltro) ([]model.EntregaWebhook, int, model.EntregaWebhookFiltro, error)
+
+	// ReenviarEntregaWebhook inclui na fila uma nova entrega com o conteúdo da entrega informada.
+	ReenviarEntregaWebhook(ctx context.Context, id string) (*model.EntregaWebhook, error)
+}
+
+// WebhookUsecase é a interface que agrega os casos de uso relacionados a webhooks.
+type WebhookUsecase interface {
+	BuscarWebhook
+	ArmazenarWebhook
+	ListarWebhooks
+	EntregasWebhook
+}
+
+// DisparoWebhook é a interface que define o envio das entregas pendentes dos webhooks.
+type DisparoWebhook interface {
+	// EntregarPendentes envia as entregas pendentes cuja próxima tentativa já venceu e
+	// retorna quantas foram entregues e quantas falharam.
+	EntregarPendentes(ctx context.Context) (entregues, falhas int, err error)
+}
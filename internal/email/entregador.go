@@ -0,0 +1,74 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// timeoutEnvio limita o envio de cada mensagem, incluindo as novas tentativas.
+const timeoutEnvio = 2 * time.Minute
+
+// Entregador entrega as notificações por e-mail em segundo plano: a mensagem é
+// renderizada na chamada e enviada por uma fila, sem bloquear quem a originou.
+type Entregador struct {
+	modelos  *Modelos
+	enviador Enviador
+	fila     chan *Mensagem
+}
+
+// NewEntregador cria uma nova instância de Entregador com uma fila de até capacidade mensagens.
+func NewEntregador(modelos *Modelos, enviador Enviador, capacidade int) *Entregador {
+	return &Entregador{
+		modelos:  modelos,
+		enviador: enviador,
+		fila:     make(chan *Mensagem, capacidade),
+	}
+}
+
+// Entregar renderiza a notificação e a coloca na fila de envio. Destinatários inativos
+// ou sem e-mail são ignorados; com a fila cheia, a notificação é descartada.
+func (e *Entregador) Entregar(n *model.Notificacao) error {
+	if !n.PodeReceberEmail() {
+		return nil
+	}
+
+	mensagem, err := e.modelos.Renderizar(n)
+	if err != nil {
+		return fmt.Errorf("[email.Entregar]: %w", err)
+	}
+
+	select {
+	case e.fila <- mensagem:
+		return nil
+	default:
+		return fmt.Errorf("[email.Entregar]: %s: %w", n.String(), model.ErrFilaNotificacaoCheia)
+	}
+}
+
+// Iniciar envia as mensagens da fila, uma de cada vez, até que o contexto seja cancelado.
+// Bloqueia a goroutine chamadora.
+func (e *Entregador) Iniciar(ctx context.Context) {
+	log.Println("[email.Entregador] envio de e-mails iniciado")
+
+	for {
+		select {
+		case <-ctx.Done():
+			if pendentes := len(e.fila); pendentes > 0 {
+				log.Printf("[email.Entregador] %d e-mail(s) pendente(s) descartado(s) no encerramento", pendentes)
+			}
+			log.Println("[email.Entregador] envio de e-mails encerrado")
+			return
+
+		case mensagem := <-e.fila:
+			ctxEnvio, cancel := context.WithTimeout(ctx, timeoutEnvio)
+			if err := e.enviador.Enviar(ctxEnvio, mensagem); err != nil {
+				log.Printf("[email.Entregador] falha ao enviar %q para %s: %v", mensagem.Assunto, mensagem.ParaEmail, err)
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package email
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+var (
+	ErrMensagemInvalida = errors.New("a mensagem de e-mail recebida é inválida")
+)
+
+// profundidadeMaximaPartes limita o aninhamento de partes multipart lidas de uma mensagem.
+const profundidadeMaximaPartes = 10
+
+var (
+	tagsQuebraLinha = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|tr|h[1-6])>`)
+	tagsRemovidas   = regexp.MustCompile(`(?is)<(script|style|head)[^>]*>.*?</(script|style|head)>`)
+	tagsHTML        = regexp.MustCompile(`<[^>]*>`)
+)
+
+// decodificadorCabecalho decodifica as palavras codificadas (RFC 2047) dos cabeçalhos.
+var decodificadorCabecalho = &mime.WordDecoder{CharsetReader: leitorCharset}
+
+// conteudoMensagem acumula o texto e os anexos encontrados nas partes da mensagem.
+type conteudoMensagem struct {
+	texto  string
+	html   string
+	anexos []model.AnexoEmail
+}
+
+// LerMensagem interpreta uma mensagem no formato RFC 5322, extraindo o remetente, o assunto,
+// o texto da resposta, sem citações e assinatura, e os anexos.
+func LerMensagem(r io.Reader) (*model.EmailRecebido, error) {
+	mensagem, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("[email.LerMensagem]: %w: %v", ErrMensagemInvalida, err)
+	}
+	cabecalho := mensagem.Header
+
+	remetente, err := remetenteMensagem(cabecalho)
+	if err != nil {
+		return nil, fmt.Errorf("[email.LerMensagem]: %w: %v", ErrMensagemInvalida, err)
+	}
+
+	assunto, err := decodificadorCabecalho.DecodeHeader(cabecalho.Get("Subject"))
+	if err != nil {
+		assunto = cabecalho.Get("Subject")
+	}
+
+	recebidoEm, err := cabecalho.Date()
+	if err != nil {
+		recebidoEm = time.Now()
+	}
+
+	var conteudo conteudoMensagem
+	err = lerParte(&conteudo, cabecalho.Get("Content-Type"), cabecalho.Get("Content-Transfer-Encoding"), cabecalho.Get("Content-Disposition"), mensagem.Body, 0)
+	if err != nil {
+		return nil, fmt.Errorf("[email.LerMensagem]: %w: %v", ErrMensagemInvalida, err)
+	}
+
+	texto := conteudo.texto
+	if strings.TrimSpace(texto) == "" && conteudo.html != "" {
+		texto = htmlParaTexto(conteudo.html)
+	}
+
+	return &model.EmailRecebido{
+		MessageID:      strings.Trim(strings.TrimSpace(cabecalho.Get("Message-Id")), "<>"),
+		RemetenteNome:  remetente.Name,
+		RemetenteEmail: strings.ToLower(remetente.Address),
+		Assunto:        strings.TrimSpace(assunto),
+		Corpo:          LimparResposta(texto),
+		Anexos:         conteudo.anexos,
+		Automatico:     mensagemAutomatica(cabecalho),
+		RecebidoEm:     recebidoEm,
+	}, nil
+}
+
+// Metodos auxiliares
+
+// remetenteMensagem retorna o endereço de quem deve receber a resposta: o Reply-To, quando
+// informado, ou o From.
+func remetenteMensagem(cabecalho mail.Header) (*mail.Address, error) {
+	parser := &mail.AddressParser{WordDecoder: decodificadorCabecalho}
+
+	if replyTo := cabecalho.Get("Reply-To"); replyTo != "" {
+		if enderecos, err := parser.ParseList(replyTo); err == nil && len(enderecos) == 1 {
+			return enderecos[0], nil
+		}
+	}
+
+	enderecos, err := parser.ParseList(cabecalho.Get("From"))
+	if err != nil {
+		return nil, err
+	}
+	if len(enderecos) == 0 {
+		return nil, errors.New("mensagem sem remetente")
+	}
+	return enderecos[0], nil
+}
+
+// mensagemAutomatica indica respostas automáticas, avisos de entrega e mensagens de listas,
+// que não devem abrir chamados nem responder aos chamados abertos.
+func mensagemAutomatica(cabecalho mail.Header) bool {
+	if autoSubmitted := strings.ToLower(cabecalho.Get("Auto-Submitted")); autoSubmitted != "" && autoSubmitted != "no" {
+		return true
+	}
+	switch strings.ToLower(cabecalho.Get("Precedence")) {
+	case "bulk", "junk", "list", "auto_reply":
+		return true
+	}
+	if cabecalho.Get("X-Autoreply") != "" || cabecalho.Get("X-Autorespond") != "" || cabecalho.Get("List-Id") != "" {
+		return true
+	}
+
+	tipo, _, _ := mime.ParseMediaType(cabecalho.Get("Content-Type"))
+	return tipo == "multipart/report"
+}
+
+// lerParte interpreta uma parte da mensagem, percorrendo as partes multipart e separando
+// o texto dos anexos.
+func lerParte(conteudo *conteudoMensagem, tipoConteudo, codificacao, disposicao string, corpo io.Reader, profundidade int) error {
+	if profundidade > profundidadeMaximaPartes {
+		return errors.New("partes multipart aninhadas em excesso")
+	}
+
+	tipo, parametros, err := mime.ParseMediaType(tipoConteudo)
+	if err != nil || tipo == "" {
+		tipo, parametros = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(tipo, "multipart/") {
+		partes := multipart.NewReader(corpo, parametros["boundary"])
+		for {
+			parte, err := partes.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			err = lerParte(
+				conteudo,
+				parte.Header.Get("Content-Type"),
+				parte.Header.Get("Content-Transfer-Encoding"),
+				parte.Header.Get("Content-Disposition"),
+				parte,
+				profundidade+1,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	dados, err := io.ReadAll(decodificarTransferencia(corpo, codificacao))
+	if err != nil {
+		return err
+	}
+
+	nomeArquivo := nomeAnexo(disposicao, parametros)
+	tipoDisposicao, _, _ := mime.ParseMediaType(disposicao)
+
+	if nomeArquivo == "" && tipoDisposicao != "attachment" {
+		switch tipo {
+		case "text/plain":
+			conteudo.texto += converterParaUTF8(dados, parametros["charset"])
+			return nil
+		case "text/html":
+			conteudo.html += converterParaUTF8(dados, parametros["charset"])
+			return nil
+		}
+	}
+
+	if len(dados) == 0 {
+		return nil
+	}
+	if nomeArquivo == "" {
+		nomeArquivo = "anexo"
+		if extensoes, _ := mime.ExtensionsByType(tipo); len(extensoes) > 0 {
+			nomeArquivo += extensoes[0]
+		}
+	}
+	conteudo.anexos = append(conteudo.anexos, model.AnexoEmail{NomeArquivo: nomeArquivo, Conteudo: dados})
+	return nil
+}
+
+// decodificarTransferencia desfaz a codificação de transferência da parte.
+func decodificarTransferencia(corpo io.Reader, codificacao string) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(codificacao)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, corpo)
+	case "quoted-printable":
+		return quotedprintable.NewReader(corpo)
+	default:
+		return corpo
+	}
+}
+
+// nomeAnexo retorna o nome do arquivo informado na disposição ou no tipo da parte.
+func nomeAnexo(disposicao string, parametrosTipo map[string]string) string {
+	nome := ""
+	if _, parametros, err := mime.ParseMediaType(disposicao); err == nil {
+		nome = parametros["filename"]
+	}
+	if nome == "" {
+		nome = parametrosTipo["name"]
+	}
+	if decodificado, err := decodificadorCabecalho.DecodeHeader(nome); err == nil {
+		nome = decodificado
+	}
+
+	nome = strings.TrimSpace(strings.ReplaceAll(nome, "\\", "/"))
+	if nome == "" {
+		return ""
+	}
+	return path.Base(nome)
+}
+
+// htmlParaTexto converte o HTML da mensagem em texto simples, mantendo as quebras de linha.
+func htmlParaTexto(conteudo string) string {
+	conteudo = tagsRemovidas.ReplaceAllString(conteudo, "")
+	conteudo = tagsQuebraLinha.ReplaceAllString(conteudo, "\n")
+	conteudo = tagsHTML.ReplaceAllString(conteudo, "")
+	return html.UnescapeString(conteudo)
+}
+
+// converterParaUTF8 converte o texto da parte para UTF-8. O windows-1252 é tratado como
+// ISO-8859-1, que difere apenas em símbolos tipográficos; nos conjuntos de caracteres não
+// suportados, as sequências inválidas são substituídas.
+func converterParaUTF8(dados []byte, charset string) string {
+	switch strings.ToLower(strings.TrimSpace(charset)) {
+	case "iso-8859-1", "latin1", "iso-8859-15", "windows-1252", "cp1252":
+		convertido := make([]byte, 0, len(dados)*2)
+		for _, b := range dados {
+			convertido = utf8.AppendRune(convertido, rune(b))
+		}
+		return string(convertido)
+	default:
+		return strings.ToValidUTF8(string(dados), "\uFFFD")
+	}
+}
+
+// leitorCharset adapta converterParaUTF8 aos cabeçalhos codificados em outros conjuntos de caracteres.
+func leitorCharset(charset string, entrada io.Reader) (io.Reader, error) {
+	dados, err := io.ReadAll(entrada)
+	if err != nil {
+		return nil, err
+	}
+	return strings.NewReader(converterParaUTF8(dados, charset)), nil
+}
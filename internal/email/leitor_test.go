@@ -0,0 +1,317 @@
+package email
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// mensagemEmail monta a mensagem com as quebras de linha CRLF do protocolo.
+func mensagemEmail(linhas ...string) string {
+	return strings.Join(linhas, "\r\n")
+}
+
+// referencia é um ID de chamado usado nas referências dos assuntos.
+const referencia = "0192f7e4-5b6a-7c8d-9e0f-1a2b3c4d5e6f"
+
+func TestLerMensagem(t *testing.T) {
+	casos := []struct {
+		nome       string
+		mensagem   string
+		remetente  string
+		assunto    string
+		corpo      string
+		referencia string
+		automatico bool
+		anexos     []string
+	}{
+		{
+			nome: "resposta do Gmail com a referência no assunto",
+			mensagem: mensagemEmail(
+				"From: Maria Souza <Maria.Souza@exemplo.gov.br>",
+				"To: atendimento@exemplo.gov.br",
+				"Subject: Re: [#"+referencia+"] Impressora sem toner",
+				"Message-ID: <CAF1234@mail.gmail.com>",
+				"Date: Mon, 7 Oct 2024 10:15:00 -0300",
+				"MIME-Version: 1.0",
+				`Content-Type: multipart/alternative; boundary="000000000000abc"`,
+				"",
+				"--000000000000abc",
+				`Content-Type: text/plain; charset="UTF-8"`,
+				"Content-Transfer-Encoding: quoted-printable",
+				"",
+				"O toner foi trocado, obrigada!",
+				"",
+				"Em seg., 7 de out. de 2024 =C3=A0s 09:00, Atendimento <",
+				"atendimento@exemplo.gov.br> escreveu:",
+				"",
+				"> Seu chamado foi atualizado.",
+				"--000000000000abc",
+				`Content-Type: text/html; charset="UTF-8"`,
+				"",
+				"<div>O toner foi trocado, obrigada!</div>",
+				"--000000000000abc--",
+			),
+			remetente:  "maria.souza@exemplo.gov.br",
+			assunto:    "Re: [#" + referencia + "] Impressora sem toner",
+			corpo:      "O toner foi trocado, obrigada!",
+			referencia: referencia,
+		},
+		{
+			nome: "resposta do Outlook com o assunto em ISO-8859-1",
+			mensagem: mensagemEmail(
+				"From: =?iso-8859-1?Q?Jo=E3o_Lima?= <joao.lima@exemplo.gov.br>",
+				"Subject: =?iso-8859-1?Q?RES:_[#"+referencia+"]_Acesso_=E0_VPN?=",
+				"Message-ID: <DM6PR01MB1234@namprd01.prod.outlook.com>",
+				"Content-Type: text/plain; charset=\"iso-8859-1\"",
+				"Content-Transfer-Encoding: quoted-printable",
+				"",
+				"Pode fechar o chamado.",
+				"",
+				"Atenciosamente,",
+				"Jo=E3o",
+				"",
+				"De: Atendimento <atendimento@exemplo.gov.br>",
+				"Enviado: segunda-feira, 7 de outubro de 2024 09:00",
+				"Para: Jo=E3o Lima <joao.lima@exemplo.gov.br>",
+				"Assunto: Acesso =E0 VPN",
+			),
+			remetente:  "joao.lima@exemplo.gov.br",
+			assunto:    "RES: [#" + referencia + "] Acesso à VPN",
+			corpo:      "Pode fechar o chamado.\n\nAtenciosamente,\nJoão",
+			referencia: referencia,
+		},
+		{
+			nome: "nova mensagem em ISO-8859-1 sem a referência no assunto",
+			mensagem: mensagemEmail(
+				"From: ana@exemplo.gov.br",
+				"Reply-To: Ana <ana.respostas@exemplo.gov.br>",
+				"Subject: =?ISO-8859-1?Q?Instala=E7=E3o_de_programa?=",
+				"Content-Type: text/plain; charset=ISO-8859-1",
+				"Content-Transfer-Encoding: 8bit",
+				"",
+				"Solicito a instala\xe7\xe3o do programa na m\xe1quina da recep\xe7\xe3o.",
+			),
+			remetente: "ana.respostas@exemplo.gov.br",
+			assunto:   "Instalação de programa",
+			corpo:     "Solicito a instalação do programa na máquina da recepção.",
+		},
+		{
+			nome: "anexos em base64",
+			mensagem: mensagemEmail(
+				"From: carlos@exemplo.gov.br",
+				"Subject: Erro no sistema",
+				`Content-Type: multipart/mixed; boundary="limite"`,
+				"",
+				"--limite",
+				"Content-Type: text/plain; charset=utf-8",
+				"",
+				"Segue o relatório do erro.",
+				"--limite",
+				`Content-Type: application/pdf; name="relatorio.pdf"`,
+				`Content-Disposition: attachment; filename="=?UTF-8?Q?relat=C3=B3rio.pdf?="`,
+				"Content-Transfer-Encoding: base64",
+				"",
+				"JVBERi0xLjQK",
+				"--limite",
+				"Content-Type: image/png",
+				"Content-Disposition: attachment",
+				"Content-Transfer-Encoding: BASE64",
+				"",
+				"iVBORw0KGgo=",
+				"--limite--",
+			),
+			remetente: "carlos@exemplo.gov.br",
+			assunto:   "Erro no sistema",
+			corpo:     "Segue o relatório do erro.",
+			anexos:    []string{"relatório.pdf", "anexo.png"},
+		},
+		{
+			nome: "mensagem apenas em HTML",
+			mensagem: mensagemEmail(
+				"From: bia@exemplo.gov.br",
+				"Subject: Sistema fora do ar",
+				"Content-Type: text/html; charset=utf-8",
+				"",
+				"<html><head><style>p{color:red}</style></head><body><p>Bom dia,</p><p>O sistema est&aacute; fora do ar.</p></body></html>",
+			),
+			remetente: "bia@exemplo.gov.br",
+			assunto:   "Sistema fora do ar",
+			corpo:     "Bom dia,\nO sistema está fora do ar.",
+		},
+		{
+			nome: "resposta automática do próprio atendimento",
+			mensagem: mensagemEmail(
+				"From: atendimento@exemplo.gov.br",
+				"Subject: =?UTF-8?Q?Resposta_autom=C3=A1tica:?= [#"+referencia+"] Impressora sem toner",
+				"Auto-Submitted: auto-replied",
+				"",
+				"Estou em férias até 14/10.",
+			),
+			remetente:  "atendimento@exemplo.gov.br",
+			assunto:    "Resposta automática: [#" + referencia + "] Impressora sem toner",
+			corpo:      "Estou em férias até 14/10.",
+			referencia: referencia,
+			automatico: true,
+		},
+		{
+			nome: "Auto-Submitted no é mensagem de pessoa",
+			mensagem: mensagemEmail(
+				"From: pedro@exemplo.gov.br",
+				"Subject: Teclado quebrado",
+				"Auto-Submitted: no",
+				"",
+				"O teclado parou de funcionar.",
+			),
+			remetente: "pedro@exemplo.gov.br",
+			assunto:   "Teclado quebrado",
+			corpo:     "O teclado parou de funcionar.",
+		},
+		{
+			nome: "aviso de entrega",
+			mensagem: mensagemEmail(
+				"From: MAILER-DAEMON@exemplo.gov.br",
+				"Subject: Undelivered Mail Returned to Sender",
+				`Content-Type: multipart/report; report-type=delivery-status; boundary="aviso"`,
+				"",
+				"--aviso",
+				"Content-Type: text/plain",
+				"",
+				"This is the mail system.",
+				"--aviso--",
+			),
+			remetente:  "mailer-daemon@exemplo.gov.br",
+			assunto:    "Undelivered Mail Returned to Sender",
+			corpo:      "This is the mail system.",
+			automatico: true,
+		},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			e, err := LerMensagem(strings.NewReader(c.mensagem))
+			if err != nil {
+				t.Fatalf("LerMensagem = %v, esperado nil", err)
+			}
+
+			if e.RemetenteEmail != c.remetente {
+				t.Errorf("remetente = %q, esperado %q", e.RemetenteEmail, c.remetente)
+			}
+			if e.Assunto != c.assunto {
+				t.Errorf("assunto = %q, esperado %q", e.Assunto, c.assunto)
+			}
+			if e.Corpo != c.corpo {
+				t.Errorf("corpo = %q, esperado %q", e.Corpo, c.corpo)
+			}
+			if obtida := e.ExtrairReferenciaChamado(); obtida != c.referencia {
+				t.Errorf("referência = %q, esperado %q", obtida, c.referencia)
+			}
+			if e.Automatico != c.automatico {
+				t.Errorf("automático = %t, esperado %t", e.Automatico, c.automatico)
+			}
+
+			if len(e.Anexos) != len(c.anexos) {
+				t.Fatalf("anexos = %d, esperado %v", len(e.Anexos), c.anexos)
+			}
+			for i, anexo := range e.Anexos {
+				if anexo.NomeArquivo != c.anexos[i] || len(anexo.Conteudo) == 0 {
+					t.Errorf("anexo %d = %q (%d bytes), esperado %q com conteúdo", i, anexo.NomeArquivo, len(anexo.Conteudo), c.anexos[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLerMensagemInvalida(t *testing.T) {
+	casos := []struct {
+		nome     string
+		mensagem string
+	}{
+		{"sem cabeçalhos", "texto solto sem cabeçalhos"},
+		{"sem remetente", mensagemEmail("Subject: Sem remetente", "", "corpo")},
+		{"multipart sem nenhuma parte", mensagemEmail(
+			"From: ana@exemplo.gov.br",
+			`Content-Type: multipart/mixed; boundary="limite"`,
+			"",
+			"corpo sem o delimitador das partes",
+		)},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if _, err := LerMensagem(strings.NewReader(c.mensagem)); !errors.Is(err, ErrMensagemInvalida) {
+				t.Errorf("LerMensagem = %v, esperado %v", err, ErrMensagemInvalida)
+			}
+		})
+	}
+}
+
+func TestLerParteProfundidadeMaxima(t *testing.T) {
+	// cada parte multipart contém a seguinte, além do limite de aninhamento
+	corpo := "texto"
+	tipo := "text/plain"
+	for i := 0; i <= profundidadeMaximaPartes+1; i++ {
+		limite := "nivel" + strings.Repeat("x", i)
+		corpo = mensagemEmail("--"+limite, "Content-Type: "+tipo, "", corpo, "--"+limite+"--")
+		tipo = `multipart/mixed; boundary="` + limite + `"`
+	}
+
+	var conteudo conteudoMensagem
+	if err := lerParte(&conteudo, tipo, "", "", strings.NewReader(corpo), 0); err == nil {
+		t.Error("lerParte = nil, esperado o erro de aninhamento em excesso")
+	}
+}
+
+func TestDecodificarTransferencia(t *testing.T) {
+	casos := []struct {
+		nome        string
+		codificacao string
+		corpo       string
+		esperado    string
+	}{
+		{"base64", "base64", "T2zDoSwgbXVuZG8h", "Olá, mundo!"},
+		{"base64 em maiúsculas e com espaços", " BASE64 ", "T2zDoSwgbXVuZG8h", "Olá, mundo!"},
+		{"base64 quebrado em linhas", "base64", "T2zDoSwg\r\nbXVuZG8h", "Olá, mundo!"},
+		{"quoted-printable", "quoted-printable", "Ol=C3=A1, mun=\r\ndo!", "Olá, mundo!"},
+		{"7bit", "7bit", "Ola, mundo!", "Ola, mundo!"},
+		{"sem codificação", "", "Olá, mundo!", "Olá, mundo!"},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			dados, err := io.ReadAll(decodificarTransferencia(strings.NewReader(c.corpo), c.codificacao))
+			if err != nil {
+				t.Fatalf("decodificarTransferencia = %v, esperado nil", err)
+			}
+			if string(dados) != c.esperado {
+				t.Errorf("decodificarTransferencia = %q, esperado %q", dados, c.esperado)
+			}
+		})
+	}
+}
+
+func TestConverterParaUTF8(t *testing.T) {
+	casos := []struct {
+		nome     string
+		dados    string
+		charset  string
+		esperado string
+	}{
+		{"ISO-8859-1", "A\xe7\xe3o conclu\xedda", "ISO-8859-1", "Ação concluída"},
+		{"latin1", "S\xe3o Paulo", "latin1", "São Paulo"},
+		{"windows-1252", "Servi\xe7o", "Windows-1252", "Serviço"},
+		{"UTF-8", "Ação concluída", "utf-8", "Ação concluída"},
+		{"sem charset", "Ação", "", "Ação"},
+		{"UTF-8 inválido", "A\xe7\xe3o", "utf-8", "A�o"},
+		{"charset não suportado", "caf\xe9", "koi8-r", "caf�"},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if obtido := converterParaUTF8([]byte(c.dados), c.charset); obtido != c.esperado {
+				t.Errorf("converterParaUTF8 = %q, esperado %q", obtido, c.esperado)
+			}
+		})
+	}
+}
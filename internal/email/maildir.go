@@ -0,0 +1,156 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+var (
+	ErrLerCaixaEntrada       = errors.New("erro ao ler a caixa de entrada de e-mails")
+	ErrConcluirMensagem      = errors.New("erro ao concluir a mensagem na caixa de entrada de e-mails")
+	ErrChaveMensagemInvalida = errors.New("a chave da mensagem não pertence à caixa de entrada")
+)
+
+// tamanhoMaximoMensagem limita as mensagens lidas da caixa de entrada, incluindo os anexos codificados.
+const tamanhoMaximoMensagem = 40 << 20
+
+// Marcadores do Maildir: mensagens lidas (S) e, entre elas, as rejeitadas (F), que ficam
+// destacadas para conferência em qualquer cliente de e-mail.
+const (
+	marcadoresProcessada = ":2,S"
+	marcadoresRejeitada  = ":2,FS"
+)
+
+// CaixaMaildir lê as mensagens de uma caixa de entrada no formato Maildir, alimentada pelo
+// servidor de e-mail ou por um sincronizador IMAP como o fetchmail ou o mbsync. As mensagens
+// pendentes são as de new/ e as de cur/ ainda não marcadas como lidas.
+type CaixaMaildir struct {
+	diretorio string
+}
+
+// NewCaixaMaildir cria uma nova instância de CaixaMaildir, criando a estrutura do Maildir se necessário.
+func NewCaixaMaildir(diretorio string) (*CaixaMaildir, error) {
+	for _, sub := range []string{"new", "cur", "tmp"} {
+		if err := os.MkdirAll(filepath.Join(diretorio, sub), 0o750); err != nil {
+			return nil, fmt.Errorf("[email.NewCaixaMaildir]: %w: %v", ErrLerCaixaEntrada, err)
+		}
+	}
+	return &CaixaMaildir{diretorio: diretorio}, nil
+}
+
+// ListarPendentes retorna até limite mensagens pendentes, das mais antigas às mais novas.
+// Mensagens grandes demais ou que não são e-mails válidos são rejeitadas na leitura.
+func (c *CaixaMaildir) ListarPendentes(ctx context.Context, limite int) ([]model.EmailRecebido, error) {
+	const metodo = "[email.ListarPendentes]"
+
+	chaves, err := c.chavesPendentes()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %v", metodo, ErrLerCaixaEntrada, err)
+	}
+
+	pendentes := make([]model.EmailRecebido, 0, min(limite, len(chaves)))
+	for _, chave := range chaves {
+		if len(pendentes) >= limite {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+
+		email, err := c.ler(chave)
+		if errors.Is(err, ErrMensagemInvalida) {
+			log.Printf("%s mensagem %s rejeitada: %v", metodo, chave, err)
+			if err := c.Concluir(ctx, chave, true); err != nil {
+				return nil, fmt.Errorf("%s: %w", metodo, err)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w: %v", metodo, ErrLerCaixaEntrada, err)
+		}
+
+		pendentes = append(pendentes, *email)
+	}
+
+	return pendentes, nil
+}
+
+// Concluir move a mensagem para cur/ marcada como lida e, se rejeitada, também como destacada.
+func (c *CaixaMaildir) Concluir(ctx context.Context, chave string, rejeitada bool) error {
+	const metodo = "[email.Concluir]"
+
+	pasta, nome := filepath.Split(filepath.Clean(chave))
+	pasta = filepath.Clean(pasta)
+	if (pasta != "new" && pasta != "cur") || nome == "" || strings.HasPrefix(nome, ".") {
+		return fmt.Errorf("%s: %w: %s", metodo, ErrChaveMensagemInvalida, chave)
+	}
+
+	base, _, _ := strings.Cut(nome, ":")
+	marcadores := marcadoresProcessada
+	if rejeitada {
+		marcadores = marcadoresRejeitada
+	}
+
+	origem := filepath.Join(c.diretorio, pasta, nome)
+	destino := filepath.Join(c.diretorio, "cur", base+marcadores)
+	if err := os.Rename(origem, destino); err != nil {
+		return fmt.Errorf("%s: %w: %v", metodo, ErrConcluirMensagem, err)
+	}
+	return nil
+}
+
+// Metodos auxiliares
+
+// chavesPendentes lista as mensagens de new/ e as não lidas de cur/. Os nomes do Maildir
+// começam pelo horário de entrega, o que mantém a ordem de chegada.
+func (c *CaixaMaildir) chavesPendentes() ([]string, error) {
+	var chaves []string
+	for _, pasta := range []string{"new", "cur"} {
+		entradas, err := os.ReadDir(filepath.Join(c.diretorio, pasta))
+		if err != nil {
+			return nil, err
+		}
+		for _, entrada := range entradas {
+			nome := entrada.Name()
+			if !entrada.Type().IsRegular() || strings.HasPrefix(nome, ".") {
+				continue
+			}
+			if _, marcadores, ok := strings.Cut(nome, ":2,"); pasta == "cur" && ok && strings.Contains(marcadores, "S") {
+				continue
+			}
+			chaves = append(chaves, filepath.Join(pasta, nome))
+		}
+	}
+	return chaves, nil
+}
+
+// ler interpreta a mensagem armazenada na chave informada.
+func (c *CaixaMaildir) ler(chave string) (*model.EmailRecebido, error) {
+	arquivo, err := os.Open(filepath.Join(c.diretorio, chave))
+	if err != nil {
+		return nil, err
+	}
+	defer arquivo.Close()
+
+	info, err := arquivo.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > tamanhoMaximoMensagem {
+		return nil, fmt.Errorf("%w: a mensagem tem %d bytes, acima do máximo de %d", ErrMensagemInvalida, info.Size(), tamanhoMaximoMensagem)
+	}
+
+	email, err := LerMensagem(arquivo)
+	if err != nil {
+		return nil, err
+	}
+	email.Chave = chave
+	return email, nil
+}
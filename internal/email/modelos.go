@@ -0,0 +1,138 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+var (
+	ErrModeloNaoEncontrado = errors.New("modelo de e-mail não encontrado para o tipo de notificação")
+	ErrRenderizarModelo    = errors.New("erro ao renderizar o modelo de e-mail")
+)
+
+//go:embed modelos/*.html modelos/*.txt
+var arquivosModelos embed.FS
+
+// formatoPrazo é o formato das datas exibidas nas mensagens.
+const formatoPrazo = "02/01/2006 15:04"
+
+// assuntos define o assunto de cada tipo de notificação; %s recebe o título do chamado.
+var assuntos = map[model.TipoNotificacao]string{
+	model.NotificacaoChamadoCriado:      "Chamado aberto: %s",
+	model.NotificacaoChamadoAtribuido:   "Chamado atribuído a você: %s",
+	model.NotificacaoNovoAcompanhamento: "Nova mensagem no chamado: %s",
+	model.NotificacaoChamadoResolvido:   "Chamado resolvido: %s",
+	model.NotificacaoSLAEmRisco:         "Prazo de solução perto de vencer: %s",
+	model.NotificacaoMencao:             "Você foi mencionado no chamado: %s",
+}
+
+// Mensagem representa um e-mail pronto para envio, com as versões em texto e em HTML.
+type Mensagem struct {
+	ParaNome  string
+	ParaEmail string
+	Assunto   string
+	Texto     string
+	HTML      string
+}
+
+// dadosModelo são os dados disponíveis aos modelos de e-mail.
+type dadosModelo struct {
+	Assunto        string
+	Destinatario   string
+	Chamado        *model.Chamado
+	Acompanhamento *model.Acompanhamento
+	Prazo          string
+	Link           string
+	AceitaResposta bool
+}
+
+// modeloTipo reúne as versões em texto e em HTML do modelo de um tipo de notificação.
+type modeloTipo struct {
+	texto *texttemplate.Template
+	html  *htmltemplate.Template
+}
+
+// Modelos renderiza as mensagens de cada tipo de notificação a partir dos modelos embutidos.
+type Modelos struct {
+	modelos        map[model.TipoNotificacao]modeloTipo
+	urlFrontend    string
+	aceitaResposta bool
+}
+
+// NewModelos carrega os modelos de todos os tipos de notificação. Com urlFrontend
+// preenchida, as mensagens incluem o link para o chamado; com aceitaResposta, informam que
+// a resposta ao e-mail é registrada no chamado.
+func NewModelos(urlFrontend string, aceitaResposta bool) (*Modelos, error) {
+	m := &Modelos{
+		modelos:        make(map[model.TipoNotificacao]modeloTipo, len(model.TiposNotificacao)),
+		urlFrontend:    strings.TrimRight(urlFrontend, "/"),
+		aceitaResposta: aceitaResposta,
+	}
+
+	for _, tipo := range model.TiposNotificacao {
+		nome := strings.ToLower(string(tipo))
+
+		texto, err := texttemplate.ParseFS(arquivosModelos, "modelos/base.txt", "modelos/"+nome+".txt")
+		if err != nil {
+			return nil, fmt.Errorf("[email.NewModelos]: %s: %w", tipo, err)
+		}
+
+		html, err := htmltemplate.ParseFS(arquivosModelos, "modelos/base.html", "modelos/"+nome+".html")
+		if err != nil {
+			return nil, fmt.Errorf("[email.NewModelos]: %s: %w", tipo, err)
+		}
+
+		m.modelos[tipo] = modeloTipo{texto: texto, html: html}
+	}
+
+	return m, nil
+}
+
+// Renderizar monta a mensagem da notificação para o seu destinatário. O assunto termina com a
+// referência do chamado, que associa as respostas recebidas por e-mail ao chamado.
+func (m *Modelos) Renderizar(n *model.Notificacao) (*Mensagem, error) {
+	modelo, ok := m.modelos[n.Tipo]
+	if !ok {
+		return nil, fmt.Errorf("[email.Renderizar]: %s: %w", n.Tipo, ErrModeloNaoEncontrado)
+	}
+
+	dados := dadosModelo{
+		Assunto:        fmt.Sprintf(assuntos[n.Tipo], n.Chamado.Titulo) + " " + model.ReferenciaChamado(n.Chamado.ID),
+		Destinatario:   n.Destinatario.Nome,
+		Chamado:        n.Chamado,
+		Acompanhamento: n.Acompanhamento,
+		AceitaResposta: m.aceitaResposta,
+	}
+	if n.Prazo != nil {
+		dados.Prazo = n.Prazo.In(time.Local).Format(formatoPrazo)
+	} else if n.Chamado.PrazoSolucao != nil {
+		dados.Prazo = n.Chamado.PrazoSolucao.In(time.Local).Format(formatoPrazo)
+	}
+	if m.urlFrontend != "" {
+		dados.Link = m.urlFrontend + "/chamados/" + n.Chamado.ID
+	}
+
+	var texto, html bytes.Buffer
+	if err := modelo.texto.ExecuteTemplate(&texto, "base", dados); err != nil {
+		return nil, fmt.Errorf("[email.Renderizar]: %w: %v", ErrRenderizarModelo, err)
+	}
+	if err := modelo.html.ExecuteTemplate(&html, "base", dados); err != nil {
+		return nil, fmt.Errorf("[email.Renderizar]: %w: %v", ErrRenderizarModelo, err)
+	}
+
+	return &Mensagem{
+		ParaNome:  n.Destinatario.Nome,
+		ParaEmail: n.Destinatario.Email,
+		Assunto:   dados.Assunto,
+		Texto:     texto.String(),
+		HTML:      html.String(),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package email
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// cabecalhoCitacao identifica a linha que introduz a mensagem citada, como em
+	// "Em seg., 7 de out. de 2024 às 10:00, Fulano <fulano@exemplo.gov.br> escreveu:"
+	cabecalhoCitacao       = regexp.MustCompile(`(?i)^(em|on|le|el)\s.+\s(escreveu|wrote|a écrit|escribió)\s*:$`)
+	inicioCabecalhoCitacao = regexp.MustCompile(`(?i)^(em|on|le|el)\s`)
+
+	// separadorMensagemOriginal identifica os separadores usados pelo Outlook e por outros clientes
+	separadorMensagemOriginal = regexp.MustCompile(`(?i)^-{2,}\s*(mensagem original|original message)\s*-{2,}$|^_{20,}$`)
+
+	// cabecalhoOutlook identifica o bloco "De:/Enviado:" que o Outlook insere antes da mensagem citada
+	cabecalhoOutlook = regexp.MustCompile(`(?i)^\*?(de|from)\s*:\*?\s`)
+	camposOutlook    = regexp.MustCompile(`(?i)^\*?(enviado|enviada em|sent|data|date|para|to|assunto|subject)\s*:`)
+
+	// assinaturaDispositivo identifica as assinaturas incluídas pelos aplicativos de celular
+	assinaturaDispositivo = regexp.MustCompile(`(?i)^(enviado do meu|enviado de meu|sent from my|obter o outlook para|get outlook for)\b`)
+)
+
+// linhasCabecalhoCitacao é o máximo de linhas em que o cliente de e-mail quebra o cabeçalho da citação.
+const linhasCabecalhoCitacao = 3
+
+// LimparResposta retorna apenas o texto escrito pelo remetente, removendo a mensagem citada,
+// as linhas citadas com ">" e a assinatura.
+func LimparResposta(texto string) string {
+	texto = strings.ReplaceAll(texto, "\r\n", "\n")
+	linhas := strings.Split(texto, "\n")
+
+	for i := range linhas {
+		if inicioCitacaoOuAssinatura(linhas, i) {
+			linhas = linhas[:i]
+			break
+		}
+	}
+
+	resposta := make([]string, 0, len(linhas))
+	for _, linha := range linhas {
+		if strings.HasPrefix(strings.TrimSpace(linha), ">") {
+			continue
+		}
+		resposta = append(resposta, strings.TrimRight(linha, " \t"))
+	}
+
+	return strings.TrimSpace(strings.Join(resposta, "\n"))
+}
+
+// Metodos auxiliares
+
+// inicioCitacaoOuAssinatura indica se a linha i inicia a mensagem citada ou a assinatura.
+func inicioCitacaoOuAssinatura(linhas []string, i int) bool {
+	linha := strings.TrimSpace(linhas[i])
+
+	// delimitador padrão de assinatura (RFC 3676)
+	if linhas[i] == "-- " || linha == "--" {
+		return true
+	}
+
+	if separadorMensagemOriginal.MatchString(linha) || assinaturaDispositivo.MatchString(linha) {
+		return true
+	}
+
+	// o cabeçalho da citação pode ter sido quebrado em mais de uma linha
+	if inicioCabecalhoCitacao.MatchString(linha) {
+		juntas := linha
+		for j := i + 1; j < len(linhas) && j < i+linhasCabecalhoCitacao; j++ {
+			if cabecalhoCitacao.MatchString(juntas) {
+				break
+			}
+			juntas += " " + strings.TrimSpace(linhas[j])
+		}
+		if cabecalhoCitacao.MatchString(juntas) {
+			return true
+		}
+	}
+
+	if cabecalhoOutlook.MatchString(linha) {
+		for j := i + 1; j < len(linhas) && j <= i+linhasCabecalhoCitacao; j++ {
+			if camposOutlook.MatchString(strings.TrimSpace(linhas[j])) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package email
+
+import "testing"
+
+func TestLimparResposta(t *testing.T) {
+	casos := []struct {
+		nome     string
+		texto    string
+		esperado string
+	}{
+		{
+			"citação do Gmail em português",
+			"Obrigada, funcionou.\r\n\r\nEm seg., 7 de out. de 2024 às 10:00, Atendimento <atendimento@exemplo.gov.br> escreveu:\r\n\r\n> Seu chamado foi atualizado.\r\n",
+			"Obrigada, funcionou.",
+		},
+		{
+			"cabeçalho da citação do Gmail quebrado em duas linhas",
+			"Pode fechar.\n\nEm seg., 7 de out. de 2024 às 10:00, Atendimento <\natendimento@exemplo.gov.br> escreveu:\n\n> Seu chamado foi resolvido.\n",
+			"Pode fechar.",
+		},
+		{
+			"citação do Gmail em inglês",
+			"Thanks!\n\nOn Mon, Oct 7, 2024 at 10:00 AM Atendimento <atendimento@exemplo.gov.br>\nwrote:\n\n> Your ticket was updated.\n",
+			"Thanks!",
+		},
+		{
+			"cabeçalho do Outlook em português",
+			"Segue o print do erro.\n\nAtenciosamente,\nJoão\n\nDe: Atendimento <atendimento@exemplo.gov.br>\nEnviado: segunda-feira, 7 de outubro de 2024 09:00\nPara: João Lima <joao.lima@exemplo.gov.br>\nAssunto: Acesso à VPN\n\nSeu chamado foi aberto.\n",
+			"Segue o print do erro.\n\nAtenciosamente,\nJoão",
+		},
+		{
+			"cabeçalho do Outlook em negrito e em inglês",
+			"Done.\n\n*From:* Atendimento <atendimento@exemplo.gov.br>\n*Sent:* Monday, October 7, 2024 9:00 AM\n*To:* John\n",
+			"Done.",
+		},
+		{
+			"separador de mensagem original do Outlook",
+			"Reenvio o anexo.\n\n-----Mensagem original-----\nDe: Atendimento\n",
+			"Reenvio o anexo.",
+		},
+		{
+			"separador sublinhado do Outlook",
+			"Ok.\n________________________________\nDe: Atendimento\n",
+			"Ok.",
+		},
+		{
+			"assinatura padrão",
+			"Bom dia, o sistema voltou.\n-- \nMaria Souza\nDivisão de TI\n",
+			"Bom dia, o sistema voltou.",
+		},
+		{
+			"assinatura do celular",
+			"Estou sem acesso.\n\nEnviado do meu iPhone\n",
+			"Estou sem acesso.",
+		},
+		{
+			"linhas citadas intercaladas com a resposta",
+			"> Qual o patrimônio do equipamento?\n12345\n> E a sala?\nSala 402\n",
+			"12345\nSala 402",
+		},
+		{
+			"linha iniciada por Em que não é citação",
+			"Em anexo o relatório.\nEm breve envio o restante.\n",
+			"Em anexo o relatório.\nEm breve envio o restante.",
+		},
+		{
+			"linha iniciada por De que não é cabeçalho",
+			"De acordo com a chefia.\nPode prosseguir.\n",
+			"De acordo com a chefia.\nPode prosseguir.",
+		},
+		{
+			"mensagem apenas com a citação",
+			"On Mon, Oct 7, 2024 at 10:00 AM Atendimento wrote:\n> texto\n",
+			"",
+		},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			if obtido := LimparResposta(c.texto); obtido != c.esperado {
+				t.Errorf("LimparResposta = %q, esperado %q", obtido, c.esperado)
+			}
+		})
+	}
+}
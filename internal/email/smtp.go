@@ -0,0 +1,226 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEnvioEmail        = errors.New("erro ao enviar e-mail pelo servidor SMTP")
+	ErrDestinatarioEmail = errors.New("o e-mail do destinatário é inválido")
+	ErrConfiguracaoSMTP  = errors.New("configuração do servidor SMTP inválida")
+)
+
+// timeoutConexaoSMTP limita cada tentativa de envio quando o contexto não define prazo.
+const timeoutConexaoSMTP = 30 * time.Second
+
+// Enviador define o envio de uma mensagem já renderizada.
+type Enviador interface {
+	Enviar(ctx context.Context, m *Mensagem) error
+}
+
+// ConfigSMTP reúne os parâmetros de conexão com o servidor SMTP.
+type ConfigSMTP struct {
+	Host          string
+	Porta         string
+	Usuario       string // vazio para servidores sem autenticação, como um SMTP local de testes
+	Senha         string
+	Remetente     string        // endereço do remetente, com ou sem nome: "Gestor de Chamados <nao-responda@exemplo.gov.br>"
+	ResponderPara string        // endereço que recebe as respostas (Reply-To); vazio para não aceitar respostas
+	Tentativas    int           // tentativas por mensagem, incluindo a primeira
+	Espera        time.Duration // espera antes da segunda tentativa, dobrada a cada nova falha
+}
+
+// EnviadorSMTP envia as mensagens por um servidor SMTP, repetindo o envio em falhas temporárias.
+type EnviadorSMTP struct {
+	cfg           ConfigSMTP
+	remetente     *mail.Address
+	responderPara *mail.Address
+}
+
+// NewEnviadorSMTP cria uma nova instância de EnviadorSMTP.
+func NewEnviadorSMTP(cfg ConfigSMTP) (*EnviadorSMTP, error) {
+	if cfg.Host == "" || cfg.Porta == "" {
+		return nil, fmt.Errorf("[email.NewEnviadorSMTP]: %w: host e porta são obrigatórios", ErrConfiguracaoSMTP)
+	}
+
+	remetente, err := mail.ParseAddress(cfg.Remetente)
+	if err != nil {
+		return nil, fmt.Errorf("[email.NewEnviadorSMTP]: %w: remetente %q: %v", ErrConfiguracaoSMTP, cfg.Remetente, err)
+	}
+
+	var responderPara *mail.Address
+	if cfg.ResponderPara != "" {
+		responderPara, err = mail.ParseAddress(cfg.ResponderPara)
+		if err != nil {
+			return nil, fmt.Errorf("[email.NewEnviadorSMTP]: %w: responder para %q: %v", ErrConfiguracaoSMTP, cfg.ResponderPara, err)
+		}
+	}
+
+	if cfg.Tentativas < 1 {
+		cfg.Tentativas = 1
+	}
+
+	return &EnviadorSMTP{cfg: cfg, remetente: remetente, responderPara: responderPara}, nil
+}
+
+// Enviar envia a mensagem, repetindo o envio com espera exponencial enquanto a falha for
+// temporária. Respostas permanentes do servidor (5xx) não são repetidas.
+func (e *EnviadorSMTP) Enviar(ctx context.Context, m *Mensagem) error {
+	destinatario := &mail.Address{Name: m.ParaNome, Address: m.ParaEmail}
+	if _, err := mail.ParseAddress(destinatario.Address); err != nil {
+		return fmt.Errorf("[email.Enviar]: %w: %v", ErrDestinatarioEmail, err)
+	}
+
+	conteudo, err := e.montar(destinatario, m)
+	if err != nil {
+		return fmt.Errorf("[email.Enviar]: %w", err)
+	}
+
+	espera := e.cfg.Espera
+	for tentativa := 1; ; tentativa++ {
+		err = e.enviarUmaVez(ctx, destinatario.Address, conteudo)
+		if err == nil {
+			return nil
+		}
+
+		var erroSMTP *textproto.Error
+		permanente := errors.As(err, &erroSMTP) && erroSMTP.Code >= 500
+		if permanente || tentativa >= e.cfg.Tentativas {
+			return fmt.Errorf("[email.Enviar]: %w após %d tentativa(s): %v", ErrEnvioEmail, tentativa, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("[email.Enviar]: %w", ctx.Err())
+		case <-time.After(espera):
+		}
+		espera *= 2
+	}
+}
+
+// Metodos auxiliares
+
+// enviarUmaVez abre a conexão com o servidor e entrega o conteúdo ao destinatário. O
+// STARTTLS é usado sempre que o servidor o oferece.
+func (e *EnviadorSMTP) enviarUmaVez(ctx context.Context, para string, conteudo []byte) error {
+	prazo, ok := ctx.Deadline()
+	if !ok {
+		prazo = time.Now().Add(timeoutConexaoSMTP)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(e.cfg.Host, e.cfg.Porta))
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(prazo); err != nil {
+		conn.Close()
+		return err
+	}
+
+	cliente, err := smtp.NewClient(conn, e.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer cliente.Close()
+
+	if ok, _ := cliente.Extension("STARTTLS"); ok {
+		if err := cliente.StartTLS(&tls.Config{ServerName: e.cfg.Host}); err != nil {
+			return err
+		}
+	}
+
+	if e.cfg.Usuario != "" {
+		if err := cliente.Auth(smtp.PlainAuth("", e.cfg.Usuario, e.cfg.Senha, e.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := cliente.Mail(e.remetente.Address); err != nil {
+		return err
+	}
+	if err := cliente.Rcpt(para); err != nil {
+		return err
+	}
+
+	w, err := cliente.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(conteudo); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return cliente.Quit()
+}
+
+// montar gera a mensagem MIME com as versões em texto e em HTML.
+func (e *EnviadorSMTP) montar(destinatario *mail.Address, m *Mensagem) ([]byte, error) {
+	var corpo bytes.Buffer
+	partes := multipart.NewWriter(&corpo)
+
+	for _, parte := range []struct{ tipo, conteudo string }{
+		{"text/plain; charset=utf-8", m.Texto},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		cabecalho := textproto.MIMEHeader{}
+		cabecalho.Set("Content-Type", parte.tipo)
+		cabecalho.Set("Content-Transfer-Encoding", "quoted-printable")
+
+		w, err := partes.CreatePart(cabecalho)
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(parte.conteudo)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := partes.Close(); err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	dominio := e.remetente.Address[strings.LastIndex(e.remetente.Address, "@")+1:]
+
+	var mensagem bytes.Buffer
+	fmt.Fprintf(&mensagem, "From: %s\r\n", e.remetente.String())
+	fmt.Fprintf(&mensagem, "To: %s\r\n", destinatario.String())
+	if e.responderPara != nil {
+		fmt.Fprintf(&mensagem, "Reply-To: %s\r\n", e.responderPara.String())
+	}
+	fmt.Fprintf(&mensagem, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Assunto))
+	fmt.Fprintf(&mensagem, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&mensagem, "Message-ID: <%s@%s>\r\n", hex.EncodeToString(id), dominio)
+	fmt.Fprintf(&mensagem, "Auto-Submitted: auto-generated\r\n")
+	fmt.Fprintf(&mensagem, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&mensagem, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", partes.Boundary())
+	mensagem.Write(corpo.Bytes())
+
+	return mensagem.Bytes(), nil
+}
@@ -0,0 +1,224 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// servidorSMTPFake atende o protocolo SMTP na interface local, respondendo ao RCPT de cada
+// conexão com a resposta configurada para ela. A última resposta vale para as conexões seguintes.
+type servidorSMTPFake struct {
+	host, porta   string
+	respostasRcpt []string
+
+	mu        sync.Mutex
+	conexoes  int
+	mensagens [][]byte
+}
+
+func iniciarServidorSMTPFake(t *testing.T, respostasRcpt ...string) *servidorSMTPFake {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen = %v, esperado nil", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	host, porta, _ := net.SplitHostPort(listener.Addr().String())
+	s := &servidorSMTPFake{host: host, porta: porta, respostasRcpt: respostasRcpt}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.atender(conn)
+		}
+	}()
+	return s
+}
+
+func (s *servidorSMTPFake) atender(conn net.Conn) {
+	s.mu.Lock()
+	resposta := s.respostasRcpt[min(s.conexoes, len(s.respostasRcpt)-1)]
+	s.conexoes++
+	s.mu.Unlock()
+
+	tp := textproto.NewConn(conn)
+	defer tp.Close()
+
+	tp.PrintfLine("220 localhost ESMTP")
+	for {
+		linha, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		comando, _, _ := strings.Cut(strings.ToUpper(linha), " ")
+		switch comando {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			tp.PrintfLine("250 2.1.0 OK")
+		case "RCPT":
+			tp.PrintfLine("%s", resposta)
+		case "DATA":
+			tp.PrintfLine("354 envie a mensagem")
+			dados, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.mensagens = append(s.mensagens, dados)
+			s.mu.Unlock()
+			tp.PrintfLine("250 2.0.0 OK")
+		case "QUIT":
+			tp.PrintfLine("221 2.0.0 até logo")
+			return
+		default:
+			tp.PrintfLine("502 5.5.1 comando não implementado")
+		}
+	}
+}
+
+// recebido retorna o número de conexões atendidas e as mensagens entregues.
+func (s *servidorSMTPFake) recebido() (int, [][]byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conexoes, s.mensagens
+}
+
+func (s *servidorSMTPFake) enviador(t *testing.T, tentativas int) *EnviadorSMTP {
+	t.Helper()
+	e, err := NewEnviadorSMTP(ConfigSMTP{
+		Host:          s.host,
+		Porta:         s.porta,
+		Remetente:     "Gestor de Chamados <nao-responda@exemplo.gov.br>",
+		ResponderPara: "atendimento@exemplo.gov.br",
+		Tentativas:    tentativas,
+		Espera:        time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("NewEnviadorSMTP = %v, esperado nil", err)
+	}
+	return e
+}
+
+func TestEnviadorSMTPEnviar(t *testing.T) {
+	casos := []struct {
+		nome          string
+		respostasRcpt []string
+		paraEmail     string
+		erro          error
+		conexoes      int
+		entregues     int
+	}{
+		{"entregue na primeira tentativa", []string{"250 2.1.5 OK"}, "maria@exemplo.gov.br", nil, 1, 1},
+		{"falha temporária é repetida", []string{"451 4.3.0 tente mais tarde", "250 2.1.5 OK"}, "maria@exemplo.gov.br", nil, 2, 1},
+		{"falhas temporárias esgotam as tentativas", []string{"421 4.7.0 servidor ocupado"}, "maria@exemplo.gov.br", ErrEnvioEmail, 3, 0},
+		{"falha permanente não é repetida", []string{"550 5.1.1 usuário desconhecido"}, "maria@exemplo.gov.br", ErrEnvioEmail, 1, 0},
+		{"destinatário inválido", []string{"250 2.1.5 OK"}, "maria.exemplo.gov.br", ErrDestinatarioEmail, 0, 0},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			servidor := iniciarServidorSMTPFake(t, c.respostasRcpt...)
+			m := &Mensagem{ParaNome: "Maria", ParaEmail: c.paraEmail, Assunto: "Chamado atualizado", Texto: "texto", HTML: "<p>texto</p>"}
+
+			err := servidor.enviador(t, 3).Enviar(context.Background(), m)
+			if !errors.Is(err, c.erro) || (c.erro == nil) != (err == nil) {
+				t.Errorf("Enviar = %v, esperado %v", err, c.erro)
+			}
+
+			conexoes, mensagens := servidor.recebido()
+			if conexoes != c.conexoes || len(mensagens) != c.entregues {
+				t.Errorf("conexões = %d e entregues = %d, esperado %d e %d", conexoes, len(mensagens), c.conexoes, c.entregues)
+			}
+		})
+	}
+}
+
+func TestEnviadorSMTPMontar(t *testing.T) {
+	servidor := iniciarServidorSMTPFake(t, "250 2.1.5 OK")
+	m := &Mensagem{
+		ParaNome:  "João Lima",
+		ParaEmail: "joao.lima@exemplo.gov.br",
+		Assunto:   "[#0192f7e4-5b6a-7c8d-9e0f-1a2b3c4d5e6f] Solicitação concluída",
+		Texto:     "Seu chamado foi concluído.\nSolução: troca do cabo de rede.",
+		HTML:      "<p>Seu chamado foi <strong>concluído</strong>.</p>",
+	}
+
+	if err := servidor.enviador(t, 1).Enviar(context.Background(), m); err != nil {
+		t.Fatalf("Enviar = %v, esperado nil", err)
+	}
+	_, mensagens := servidor.recebido()
+	if len(mensagens) != 1 {
+		t.Fatalf("entregues = %d, esperado 1", len(mensagens))
+	}
+
+	mensagem, err := mail.ReadMessage(strings.NewReader(string(mensagens[0])))
+	if err != nil {
+		t.Fatalf("mail.ReadMessage = %v, esperado nil", err)
+	}
+	cabecalho := mensagem.Header
+
+	// o assunto com acentos segue codificado em Q, e volta ao original ao decodificar
+	assunto := cabecalho.Get("Subject")
+	if !strings.HasPrefix(assunto, "=?utf-8?q?") {
+		t.Errorf("Subject = %q, esperado codificado em Q", assunto)
+	}
+	if decodificado, err := new(mime.WordDecoder).DecodeHeader(assunto); err != nil || decodificado != m.Assunto {
+		t.Errorf("Subject decodificado = %q (%v), esperado %q", decodificado, err, m.Assunto)
+	}
+
+	for campo, esperado := range map[string]string{
+		"From":           `"Gestor de Chamados" <nao-responda@exemplo.gov.br>`,
+		"To":             "=?utf-8?q?Jo=C3=A3o_Lima?= <joao.lima@exemplo.gov.br>",
+		"Reply-To":       "<atendimento@exemplo.gov.br>",
+		"Auto-Submitted": "auto-generated",
+		"MIME-Version":   "1.0",
+	} {
+		if obtido := cabecalho.Get(campo); obtido != esperado {
+			t.Errorf("%s = %q, esperado %q", campo, obtido, esperado)
+		}
+	}
+	if id := cabecalho.Get("Message-ID"); !strings.HasSuffix(id, "@exemplo.gov.br>") {
+		t.Errorf("Message-ID = %q, esperado no domínio do remetente", id)
+	}
+
+	// o corpo traz as versões em texto e em HTML, nessa ordem
+	tipo, parametros, err := mime.ParseMediaType(cabecalho.Get("Content-Type"))
+	if err != nil || tipo != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q (%v), esperado multipart/alternative", cabecalho.Get("Content-Type"), err)
+	}
+
+	partes := multipart.NewReader(mensagem.Body, parametros["boundary"])
+	for _, esperada := range []struct{ tipo, conteudo string }{
+		{"text/plain; charset=utf-8", m.Texto},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		parte, err := partes.NextPart()
+		if err != nil {
+			t.Fatalf("NextPart = %v, esperado a parte %s", err, esperada.tipo)
+		}
+		conteudo, err := io.ReadAll(parte)
+		if err != nil {
+			t.Fatalf("io.ReadAll = %v, esperado nil", err)
+		}
+		if parte.Header.Get("Content-Type") != esperada.tipo || string(conteudo) != esperada.conteudo {
+			t.Errorf("parte %q = %q, esperado %q = %q", parte.Header.Get("Content-Type"), conteudo, esperada.tipo, esperada.conteudo)
+		}
+	}
+	if _, err := partes.NextPart(); err != io.EOF {
+		t.Errorf("NextPart = %v, esperado o fim das partes", err)
+	}
+}
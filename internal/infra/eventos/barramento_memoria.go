@@ -0,0 +1,133 @@
+package eventos
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// capacidadeCanalAssinante é a quantidade de eventos que um assinante pode acumular
+// sem consumir antes de ser desconectado.
+const capacidadeCanalAssinante = 64
+
+// assinante representa um cliente inscrito no barramento.
+type assinante struct {
+	canal  chan model.Evento
+	filtro func(*model.Evento) bool
+}
+
+// BarramentoMemoria distribui os eventos entre os assinantes do próprio processo e
+// guarda os eventos mais recentes em um buffer circular para reenvio na reconexão.
+type BarramentoMemoria struct {
+	mu         sync.Mutex
+	ultimoID   uint64
+	buffer     []model.Evento // buffer circular com os eventos mais recentes
+	inicio     int            // posição do evento mais antigo no buffer
+	tamanho    int            // quantidade de eventos no buffer
+	assinantes map[*assinante]struct{}
+}
+
+// NewBarramentoMemoria cria uma nova instância de BarramentoMemoria que guarda até
+// capacidade eventos para reenvio.
+func NewBarramentoMemoria(capacidade int) *BarramentoMemoria {
+	return &BarramentoMemoria{
+		// os IDs partem do instante de criação para que continuem crescentes após
+		// um reinício e IDs de processos anteriores sejam tratados como antigos
+		ultimoID:   uint64(time.Now().UnixMicro()),
+		buffer:     make([]model.Evento, capacidade),
+		assinantes: make(map[*assinante]struct{}),
+	}
+}
+
+// Publicar atribui o ID ao evento, guarda-o no buffer e o entrega aos assinantes. Assinantes
+// que não consomem os eventos a tempo são desconectados, para que a publicação nunca
+// bloqueie; ao reconectar, recebem os eventos perdidos a partir do buffer.
+func (b *BarramentoMemoria) Publicar(e *model.Evento) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.ultimoID++
+	e.ID = b.ultimoID
+
+	if len(b.buffer) > 0 {
+		fim := (b.inicio + b.tamanho) % len(b.buffer)
+		b.buffer[fim] = *e
+		if b.tamanho < len(b.buffer) {
+			b.tamanho++
+		} else {
+			b.inicio = (b.inicio + 1) % len(b.buffer)
+		}
+	}
+
+	for a := range b.assinantes {
+		if !a.filtro(e) {
+			continue
+		}
+		select {
+		case a.canal <- *e:
+		default:
+			b.remover(a)
+		}
+	}
+}
+
+// Assinar inscreve um assinante que recebe apenas os eventos aceitos pelo filtro. Os eventos
+// do buffer publicados após ultimoID são devolvidos como pendentes; com ultimoID zero,
+// a assinatura recebe apenas os novos eventos.
+func (b *BarramentoMemoria) Assinar(ultimoID uint64, filtro func(*model.Evento) bool) *model.AssinaturaEventos {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	a := &assinante{
+		canal:  make(chan model.Evento, capacidadeCanalAssinante),
+		filtro: filtro,
+	}
+	b.assinantes[a] = struct{}{}
+
+	assinatura := &model.AssinaturaEventos{
+		Pendentes: []model.Evento{},
+		Completa:  true,
+		Eventos:   a.canal,
+		Cancelar: func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			b.remover(a)
+		},
+	}
+
+	if ultimoID == 0 || ultimoID == b.ultimoID {
+		return assinatura
+	}
+
+	// um ID posterior ao último publicado não foi emitido por este processo
+	if ultimoID > b.ultimoID {
+		assinatura.Completa = false
+		return assinatura
+	}
+
+	// o reenvio só é completo se o evento seguinte ao último recebido ainda estiver no buffer
+	maisAntigo := b.ultimoID + 1
+	if b.tamanho > 0 {
+		maisAntigo = b.buffer[b.inicio].ID
+	}
+	assinatura.Completa = ultimoID+1 >= maisAntigo
+
+	for i := 0; i < b.tamanho; i++ {
+		e := &b.buffer[(b.inicio+i)%len(b.buffer)]
+		if e.ID > ultimoID && filtro(e) {
+			assinatura.Pendentes = append(assinatura.Pendentes, *e)
+		}
+	}
+
+	return assinatura
+}
+
+// remover desinscreve o assinante e fecha o seu canal. Deve ser chamado com o mutex obtido.
+func (b *BarramentoMemoria) remover(a *assinante) {
+	if _, ok := b.assinantes[a]; !ok {
+		return
+	}
+	delete(b.assinantes, a)
+	close(a.canal)
+}
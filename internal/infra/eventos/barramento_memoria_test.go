@@ -0,0 +1,110 @@
+package eventos
+
+import (
+	"testing"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// todos aceita todos os eventos.
+func todos(*model.Evento) bool { return true }
+
+// publicarEventos publica um evento para cada chamado e retorna os IDs atribuídos.
+func publicarEventos(b *BarramentoMemoria, chamados ...string) []uint64 {
+	ids := make([]uint64, len(chamados))
+	for i, chamadoID := range chamados {
+		e := &model.Evento{Tipo: model.EventoStatusAlterado, ChamadoID: chamadoID}
+		b.Publicar(e)
+		ids[i] = e.ID
+	}
+	return ids
+}
+
+func TestBarramentoMemoriaAssinarReenvio(t *testing.T) {
+	b := NewBarramentoMemoria(3)
+	ids := publicarEventos(b, "ch-1", "ch-2", "ch-3", "ch-4", "ch-5")
+
+	casos := []struct {
+		nome      string
+		ultimoID  uint64
+		filtro    func(*model.Evento) bool
+		pendentes []string
+		completa  bool
+	}{
+		{"sem último evento recebe apenas os novos", 0, todos, nil, true},
+		{"em dia com o último publicado", ids[4], todos, nil, true},
+		{"reenvio dos eventos perdidos", ids[2], todos, []string{"ch-4", "ch-5"}, true},
+		{"reenvio a partir do mais antigo do buffer", ids[1], todos, []string{"ch-3", "ch-4", "ch-5"}, true},
+		{"eventos perdidos fora do buffer", ids[0], todos, []string{"ch-3", "ch-4", "ch-5"}, false},
+		{"reenvio respeita o filtro", ids[1], func(e *model.Evento) bool { return e.ChamadoID != "ch-4" }, []string{"ch-3", "ch-5"}, true},
+		{"ID de outro processo", ids[4] + 100, todos, nil, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			assinatura := b.Assinar(c.ultimoID, c.filtro)
+			defer assinatura.Cancelar()
+
+			if assinatura.Completa != c.completa {
+				t.Errorf("Completa = %t, esperado %t", assinatura.Completa, c.completa)
+			}
+			if len(assinatura.Pendentes) != len(c.pendentes) {
+				t.Fatalf("pendentes = %v, esperado %v", assinatura.Pendentes, c.pendentes)
+			}
+			for i, e := range assinatura.Pendentes {
+				if e.ChamadoID != c.pendentes[i] {
+					t.Errorf("pendente %d = %s, esperado %s", i, e.ChamadoID, c.pendentes[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBarramentoMemoriaPublicar(t *testing.T) {
+	b := NewBarramentoMemoria(10)
+	assinatura := b.Assinar(0, func(e *model.Evento) bool { return e.ChamadoID == "ch-1" })
+
+	ids := publicarEventos(b, "ch-1", "ch-2", "ch-1")
+	if !(ids[0] < ids[1] && ids[1] < ids[2]) {
+		t.Errorf("IDs = %v, esperado crescentes", ids)
+	}
+
+	// o assinante recebe apenas os eventos aceitos pelo filtro, em ordem
+	for _, esperado := range []uint64{ids[0], ids[2]} {
+		if e := <-assinatura.Eventos; e.ID != esperado {
+			t.Errorf("evento = %d, esperado %d", e.ID, esperado)
+		}
+	}
+
+	assinatura.Cancelar()
+	if _, aberto := <-assinatura.Eventos; aberto {
+		t.Error("canal aberto após o cancelamento, esperado fechado")
+	}
+}
+
+func TestBarramentoMemoriaDesconectaAssinanteLento(t *testing.T) {
+	b := NewBarramentoMemoria(capacidadeCanalAssinante * 2)
+	assinatura := b.Assinar(0, todos)
+
+	// a publicação não bloqueia quando o assinante não consome os eventos
+	chamados := make([]string, capacidadeCanalAssinante+1)
+	for i := range chamados {
+		chamados[i] = "ch-1"
+	}
+	ids := publicarEventos(b, chamados...)
+
+	recebidos := 0
+	for range assinatura.Eventos {
+		recebidos++
+	}
+	if recebidos != capacidadeCanalAssinante {
+		t.Errorf("recebidos = %d, esperado %d antes da desconexão", recebidos, capacidadeCanalAssinante)
+	}
+
+	// ao reconectar, o assinante recebe o evento perdido a partir do buffer
+	reconexao := b.Assinar(ids[recebidos-1], todos)
+	defer reconexao.Cancelar()
+	if !reconexao.Completa || len(reconexao.Pendentes) != 1 || reconexao.Pendentes[0].ID != ids[recebidos] {
+		t.Errorf("reconexão = %d pendente(s) (completa %t), esperado o evento %d", len(reconexao.Pendentes), reconexao.Completa, ids[recebidos])
+	}
+}
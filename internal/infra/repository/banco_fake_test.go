@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+)
+
+// comandoFake registra um comando recebido pelo banco falso.
+type comandoFake struct {
+	query string
+	args  []any
+}
+
+// bancoFake é um driver database/sql em memória para os testes dos repositórios. As
+// consultas devolvem o resultado de consultar e os comandos, o de executar; os comandos e
+// as transações ficam registrados para as verificações.
+type bancoFake struct {
+	mu          sync.Mutex
+	consultar   func(query string, args []any) ([]string, [][]driver.Value, error)
+	executar    func(query string, args []any) (int64, error)
+	comandos    []comandoFake
+	confirmadas int
+	desfeitas   int
+}
+
+// abrir retorna um *sql.DB ligado ao banco falso.
+func (b *bancoFake) abrir() *sql.DB {
+	return sql.OpenDB(b)
+}
+
+// Connect implementa driver.Connector.
+func (b *bancoFake) Connect(context.Context) (driver.Conn, error) {
+	return &conexaoFake{banco: b}, nil
+}
+
+// Driver implementa driver.Connector.
+func (b *bancoFake) Driver() driver.Driver {
+	return nil
+}
+
+// conexaoFake é uma conexão com o banco falso.
+type conexaoFake struct {
+	banco *bancoFake
+}
+
+func (c *conexaoFake) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("bancoFake: Prepare não suportado")
+}
+
+func (c *conexaoFake) Close() error { return nil }
+
+func (c *conexaoFake) Begin() (driver.Tx, error) {
+	return &transacaoFake{banco: c.banco}, nil
+}
+
+func (c *conexaoFake) ExecContext(_ context.Context, query string, nomeados []driver.NamedValue) (driver.Result, error) {
+	args := valores(nomeados)
+
+	c.banco.mu.Lock()
+	c.banco.comandos = append(c.banco.comandos, comandoFake{query: query, args: args})
+	executar := c.banco.executar
+	c.banco.mu.Unlock()
+
+	if executar == nil {
+		return driver.RowsAffected(1), nil
+	}
+	linhas, err := executar(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(linhas), nil
+}
+
+func (c *conexaoFake) QueryContext(_ context.Context, query string, nomeados []driver.NamedValue) (driver.Rows, error) {
+	if c.banco.consultar == nil {
+		return nil, errors.New("bancoFake: consulta não esperada")
+	}
+	colunas, linhas, err := c.banco.consultar(query, valores(nomeados))
+	if err != nil {
+		return nil, err
+	}
+	return &linhasFake{colunas: colunas, linhas: linhas}, nil
+}
+
+// transacaoFake conta as confirmações e os desfazimentos.
+type transacaoFake struct {
+	banco *bancoFake
+}
+
+func (t *transacaoFake) Commit() error {
+	t.banco.mu.Lock()
+	defer t.banco.mu.Unlock()
+	t.banco.confirmadas++
+	return nil
+}
+
+func (t *transacaoFake) Rollback() error {
+	t.banco.mu.Lock()
+	defer t.banco.mu.Unlock()
+	t.banco.desfeitas++
+	return nil
+}
+
+// linhasFake devolve as linhas de uma consulta.
+type linhasFake struct {
+	colunas []string
+	linhas  [][]driver.Value
+}
+
+func (l *linhasFake) Columns() []string { return l.colunas }
+
+func (l *linhasFake) Close() error { return nil }
+
+func (l *linhasFake) Next(destino []driver.Value) error {
+	if len(l.linhas) == 0 {
+		return io.EOF
+	}
+	copy(destino, l.linhas[0])
+	l.linhas = l.linhas[1:]
+	return nil
+}
+
+// valores extrai os valores dos argumentos nomeados.
+func valores(nomeados []driver.NamedValue) []any {
+	args := make([]any, len(nomeados))
+	for i, n := range nomeados {
+		args[i] = n.Value
+	}
+	return args
+}
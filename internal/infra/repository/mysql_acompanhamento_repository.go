@@ -16,6 +16,10 @@ var (
 	ErrAcompanhamentoNaoEncontrado = errors.New("acompanhamento não encontrado no banco de dados MySQL")
 )
 
+// colunasAcompanhamento lista as colunas lidas por scanAcompanhamento, na mesma ordem.
+const colunasAcompanhamento = `id, conteudo, chamado_id, usuario_id, remetente, visibilidade,
+	criado_em, atualizado_em, editado_em, removido_em, removido_por`
+
 // MySQLAcompanhamentoRepository é a implementação do repositório de acompanhamento para MySQL.
 type MySQLAcompanhamentoRepository struct {
 	db *sql.DB
@@ -30,8 +34,7 @@ func NewMySQLAcompanhamentoRepository(db *sql.DB) *MySQLAcompanhamentoRepository
 func (r *MySQLAcompanhamentoRepository) BuscarPorID(ctx context.Context, id string) (*model.Acompanhamento, error) {
 	acompanhamento, err := r.buscar(
 		ctx,
-		`SELECT id, conteudo, chamado_id, usuario_id, 
-		remetente, criado_em, atualizado_em 
+		`SELECT `+colunasAcompanhamento+`
 		FROM acompanhamentos 
 		WHERE id = ?`,
 		id,
@@ -52,18 +55,18 @@ func (r *MySQLAcompanhamentoRepository) BuscarPorID(ctx context.Context, id stri
 	return acompanhamento, nil
 }
 
-// BuscarPorChamadoID retorna uma lista de acompanhamentos pelo ID do chamado.
-func (r *MySQLAcompanhamentoRepository) BuscarPorChamadoID(ctx context.Context, chamadoID string) ([]model.Acompanhamento, error) {
+// BuscarPorChamadoID retorna uma lista de acompanhamentos pelo ID do chamado,
+// incluindo as notas internas apenas quando solicitado.
+func (r *MySQLAcompanhamentoRepository) BuscarPorChamadoID(ctx context.Context, chamadoID string, incluirInternos bool) ([]model.Acompanhamento, error) {
 	const metodo = "[MySQLAcompanhamentoRepository.BuscarPorChamadoID]"
 
 	query := `
-		SELECT 
-			id, conteudo, chamado_id, usuario_id, remetente, criado_em, atualizado_em
+		SELECT ` + colunasAcompanhamento + `
 		FROM acompanhamentos
-		WHERE chamado_id = ?
+		WHERE chamado_id = ? AND (? OR visibilidade = ?)
 		ORDER BY criado_em ASC
 	`
-	rows, err := r.db.QueryContext(ctx, query, chamadoID)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query, chamadoID, incluirInternos, model.VisibilidadePublico)
 	if err != nil {
 		return nil, utils.NewAppError(
 			metodo,
@@ -99,12 +102,12 @@ func (r *MySQLAcompanhamentoRepository) BuscarPorChamadoID(ctx context.Context,
 func (r *MySQLAcompanhamentoRepository) Salvar(ctx context.Context, a *model.Acompanhamento) error {
 	const metodo = "[MySQLAcompanhamentoRepository.Salvar]"
 
-	resultado, err := r.db.ExecContext(
+	resultado, err := conexao(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO acompanhamentos (
-		id, conteudo, chamado_id, usuario_id, remetente, criado_em, atualizado_em
-		) VALUES (?, ?, ?, ?, ?, NOW(), NOW())`,
-		a.ID, a.Conteudo, a.ChamadoID, a.UsuarioID, a.Remetente,
+		id, conteudo, chamado_id, usuario_id, remetente, visibilidade, criado_em, atualizado_em
+		) VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())`,
+		a.ID, a.Conteudo, a.ChamadoID, a.UsuarioID, a.Remetente, a.Visibilidade,
 	)
 	if err != nil {
 		return utils.NewAppError(
@@ -136,29 +139,28 @@ func (r *MySQLAcompanhamentoRepository) Salvar(ctx context.Context, a *model.Aco
 	return nil
 }
 
-// Atualizar modifica os dados de um acompanhamento existente.
-func (r *MySQLAcompanhamentoRepository) Atualizar(ctx context.Context, id string, a *model.Acompanhamento) error {
+// Atualizar modifica o conteúdo e a visibilidade de um acompanhamento existente,
+// registrando a revisão com o estado anterior na mesma transação.
+func (r *MySQLAcompanhamentoRepository) Atualizar(ctx context.Context, id string, a *model.Acompanhamento, revisao *model.RevisaoAcompanhamento) error {
 	const metodo = "[MySQLAcompanhamentoRepository.Atualizar]"
 
-	existe, err := ExisteAcompanhamentoPorID(ctx, r.db, id)
+	tx, err := iniciarTransacao(ctx, r.db)
 	if err != nil {
-		return fmt.Errorf("%s: %w", metodo, err)
-	}
-	if !existe {
 		return utils.NewAppError(
 			metodo,
-			utils.LevelInfo,
-			"o acompanhamento a ser atualizado não foi encontrado",
-			ErrAcompanhamentoNaoEncontrado,
+			utils.LevelError,
+			"falha ao iniciar transação ao atualizar acompanhamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
 		)
 	}
+	defer tx.Rollback()
 
-	_, err = r.db.ExecContext(
+	resultado, err := tx.ExecContext(
 		ctx,
 		`UPDATE acompanhamentos 
-		SET conteudo = ?, chamado_id = ?, usuario_id = ?, remetente = ?, atualizado_em = NOW() 
-		WHERE id = ?`,
-		a.Conteudo, a.ChamadoID, a.UsuarioID, a.Remetente, id,
+		SET conteudo = ?, visibilidade = ?, editado_em = NOW(), atualizado_em = NOW() 
+		WHERE id = ? AND removido_em IS NULL`,
+		a.Conteudo, a.Visibilidade, id,
 	)
 	if err != nil {
 		return utils.NewAppError(
@@ -168,54 +170,160 @@ func (r *MySQLAcompanhamentoRepository) Atualizar(ctx context.Context, id string
 			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
 		)
 	}
+	if err := verificarAcompanhamentoAlterado(metodo, resultado); err != nil {
+		return err
+	}
+
+	if err := salvarRevisaoAcompanhamento(ctx, tx, revisao); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao atualizar acompanhamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
 
 	return nil
 }
 
-// Deletar remove um acompanhamento pelo seu ID.
-func (r *MySQLAcompanhamentoRepository) Deletar(ctx context.Context, id string) error {
-	existe, err := ExisteAcompanhamentoPorID(ctx, r.db, id)
+// Deletar substitui o conteúdo do acompanhamento pela mensagem de remoção, mantendo-o na
+// conversa. A revisão com o conteúdo anterior é registrada e os metadados dos anexos são
+// removidos na mesma transação; o conteúdo dos arquivos é removido pelo caso de uso.
+func (r *MySQLAcompanhamentoRepository) Deletar(ctx context.Context, id string, revisao *model.RevisaoAcompanhamento) error {
+	const metodo = "[MySQLAcompanhamentoRepository.Deletar]"
+
+	tx, err := iniciarTransacao(ctx, r.db)
 	if err != nil {
-		return fmt.Errorf("[MySQLAcompanhamentoRepository.Deletar]: %w", err)
-	}
-	if !existe {
 		return utils.NewAppError(
-			"[MySQLAcompanhamentoRepository.Deletar]",
-			utils.LevelInfo,
-			"o acompanhamento a ser deletado não foi encontrado",
-			ErrAcompanhamentoNaoEncontrado,
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao deletar acompanhamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
 		)
 	}
+	defer tx.Rollback()
 
-	_, err = r.db.ExecContext(
+	resultado, err := tx.ExecContext(
 		ctx,
-		`DELETE FROM acompanhamentos
-		WHERE id = ?`,
-		id,
+		`UPDATE acompanhamentos
+		SET conteudo = ?, removido_em = NOW(), removido_por = ?, atualizado_em = NOW()
+		WHERE id = ? AND removido_em IS NULL`,
+		model.MensagemAcompanhamentoRemovido, revisao.AlteradoPorID, id,
 	)
 	if err != nil {
 		return utils.NewAppError(
-			"[MySQLAcompanhamentoRepository.Deletar]",
+			metodo,
 			utils.LevelError,
 			"erro inesperado ao deletar o acompanhamento no banco de dados",
 			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
 		)
 	}
+	if err := verificarAcompanhamentoAlterado(metodo, resultado); err != nil {
+		return err
+	}
+
+	if err := salvarRevisaoAcompanhamento(ctx, tx, revisao); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM anexos WHERE acompanhamento_id = ?`, id)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro inesperado ao deletar os anexos do acompanhamento no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao deletar acompanhamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
 
 	return nil
 }
 
+// ListarRevisoes retorna as revisões do acompanhamento, da mais antiga à mais recente.
+func (r *MySQLAcompanhamentoRepository) ListarRevisoes(ctx context.Context, acompanhamentoID string) ([]model.RevisaoAcompanhamento, error) {
+	const metodo = "[MySQLAcompanhamentoRepository.ListarRevisoes]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT id, acompanhamento_id, tipo, conteudo_anterior, visibilidade_anterior, alterado_por, criado_em
+		FROM acompanhamento_revisoes
+		WHERE acompanhamento_id = ?
+		ORDER BY criado_em ASC, id ASC`,
+		acompanhamentoID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao listar as revisões do acompanhamento no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	revisoes := []model.RevisaoAcompanhamento{}
+	for rows.Next() {
+		var revisao model.RevisaoAcompanhamento
+		err := rows.Scan(
+			&revisao.ID,
+			&revisao.AcompanhamentoID,
+			&revisao.Tipo,
+			&revisao.ConteudoAnterior,
+			&revisao.VisibilidadeAnterior,
+			&revisao.AlteradoPorID,
+			&revisao.CriadoEm,
+		)
+		if err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao escanear a revisão do acompanhamento",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerAcompanhamento, err),
+			)
+		}
+		revisoes = append(revisoes, revisao)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre as revisões do acompanhamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return revisoes, nil
+}
+
 // Listar retorna uma lista paginada de acompanhamentos, com filtros opcionais.
 func (r *MySQLAcompanhamentoRepository) Listar(ctx context.Context, filtro model.AcompanhamentoFiltro) ([]model.Acompanhamento, int, error) {
 	var query strings.Builder
 	args := []any{}
 
 	query.WriteString(`
-		SELECT SQL_CALC_FOUND_ROWS
-			id, conteudo, chamado_id, usuario_id, remetente, criado_em, atualizado_em
+		SELECT SQL_CALC_FOUND_ROWS ` + colunasAcompanhamento + `
 		FROM acompanhamentos
 		WHERE 1=1`)
 
+	if !filtro.IncluirInternos {
+		query.WriteString(" AND visibilidade = ?")
+		args = append(args, model.VisibilidadePublico)
+	}
+
 	if filtro.ChamadoID != nil && *filtro.ChamadoID != "" {
 		query.WriteString(" AND chamado_id = ?")
 		args = append(args, *filtro.ChamadoID)
@@ -226,10 +334,15 @@ func (r *MySQLAcompanhamentoRepository) Listar(ctx context.Context, filtro model
 		args = append(args, *filtro.UsuarioID)
 	}
 
+	if condicao, argsEscopo := condicaoChamadoNoEscopo("chamado_id", filtro.Escopo); condicao != "" {
+		query.WriteString(" AND " + condicao)
+		args = append(args, argsEscopo...)
+	}
+
 	query.WriteString(" ORDER BY criado_em ASC LIMIT ? OFFSET ?")
 	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
 
-	rows, err := r.db.QueryContext(ctx, query.String(), args...)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLAcompanhamentoRepository.Listar]",
@@ -250,7 +363,7 @@ func (r *MySQLAcompanhamentoRepository) Listar(ctx context.Context, filtro model
 	}
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLAcompanhamentoRepository.Listar]",
 			utils.LevelError,
@@ -266,7 +379,7 @@ func (r *MySQLAcompanhamentoRepository) Listar(ctx context.Context, filtro model
 
 // buscar executa uma consulta que retorna um único acompanhamento.
 func (r *MySQLAcompanhamentoRepository) buscar(ctx context.Context, query string, args ...any) (*model.Acompanhamento, error) {
-	row := r.db.QueryRowContext(ctx, query, args...)
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
 	acompanhamento, err := scanAcompanhamento(row)
 	if err != nil {
 		return nil, fmt.Errorf("[MySQLAcompanhamentoRepository.buscar]: %w", err)
@@ -274,10 +387,53 @@ func (r *MySQLAcompanhamentoRepository) buscar(ctx context.Context, query string
 	return acompanhamento, nil
 }
 
+// salvarRevisaoAcompanhamento insere a revisão do acompanhamento dentro da transação informada.
+func salvarRevisaoAcompanhamento(ctx context.Context, tx executorSQL, revisao *model.RevisaoAcompanhamento) error {
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO acompanhamento_revisoes (
+		id, acompanhamento_id, tipo, conteudo_anterior, visibilidade_anterior, alterado_por, criado_em
+		) VALUES (?, ?, ?, ?, ?, ?, NOW())`,
+		revisao.ID, revisao.AcompanhamentoID, revisao.Tipo, revisao.ConteudoAnterior,
+		revisao.VisibilidadeAnterior, revisao.AlteradoPorID,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLAcompanhamentoRepository.salvarRevisaoAcompanhamento]",
+			utils.LevelError,
+			"erro ao salvar a revisão do acompanhamento no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+	return nil
+}
+
+// verificarAcompanhamentoAlterado garante que a alteração atingiu um acompanhamento ainda não removido.
+func verificarAcompanhamentoAlterado(metodo string, resultado sql.Result) error {
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao alterar o acompanhamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o acompanhamento não foi encontrado ou já foi removido",
+			ErrAcompanhamentoNaoEncontrado,
+		)
+	}
+	return nil
+}
+
 // ExisteAcompanhamentoPorID verifica se um acompanhamento existe pelo seu ID.
 func ExisteAcompanhamentoPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
 	var exists bool
-	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM acompanhamentos WHERE id = ?)", id).Scan(&exists)
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM acompanhamentos WHERE id = ?)", id).Scan(&exists)
 	if err != nil {
 		return false, utils.NewAppError(
 			"[MySQLAcompanhamentoRepository.ExisteAcompanhamentoPorID]",
@@ -301,8 +457,12 @@ func scanAcompanhamento(scanner interface{ Scan(dest ...any) error }) (*model.Ac
 		&acompanhamento.ChamadoID,
 		&acompanhamento.UsuarioID,
 		&acompanhamento.Remetente,
+		&acompanhamento.Visibilidade,
 		&acompanhamento.CriadoEm,
 		&acompanhamento.AtualizadoEm,
+		&acompanhamento.EditadoEm,
+		&acompanhamento.RemovidoEm,
+		&acompanhamento.RemovidoPorID,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerAnexo       = errors.New("erro ao escanear anexo do banco de dados MySQL")
+	ErrAnexoNaoEncontrado = errors.New("anexo não encontrado no banco de dados MySQL")
+)
+
+// colunasAnexo lista as colunas lidas por scanAnexo, na mesma ordem.
+const colunasAnexo = `id, chamado_id, acompanhamento_id, nome_arquivo, tipo_mime, tamanho, hash, chave, enviado_por, criado_em`
+
+// colunasAnexoQualificadas são as mesmas colunas de colunasAnexo, prefixadas pelo alias an,
+// para consultas com junções.
+const colunasAnexoQualificadas = `an.id, an.chamado_id, an.acompanhamento_id, an.nome_arquivo, an.tipo_mime,
+	an.tamanho, an.hash, an.chave, an.enviado_por, an.criado_em`
+
+// MySQLAnexoRepository é a implementação do repositório de anexos para MySQL.
+type MySQLAnexoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLAnexoRepository cria uma nova instância de MySQLAnexoRepository.
+func NewMySQLAnexoRepository(db *sql.DB) *MySQLAnexoRepository {
+	return &MySQLAnexoRepository{db: db}
+}
+
+// BuscarPorID retorna os metadados de um anexo pelo seu ID.
+func (r *MySQLAnexoRepository) BuscarPorID(ctx context.Context, id string) (*model.Anexo, error) {
+	row := conexao(ctx, r.db).QueryRowContext(ctx, `SELECT `+colunasAnexo+` FROM anexos WHERE id = ?`, id)
+	anexo, err := scanAnexo(row)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLAnexoRepository.BuscarPorID]: %w", err)
+	}
+
+	if anexo == nil {
+		return nil, utils.NewAppError(
+			"[MySQLAnexoRepository.BuscarPorID]",
+			utils.LevelInfo,
+			"a busca por ID do anexo não retornou resultados",
+			ErrAnexoNaoEncontrado,
+		)
+	}
+
+	return anexo, nil
+}
+
+// Salvar insere os metadados de um novo anexo no repositório.
+func (r *MySQLAnexoRepository) Salvar(ctx context.Context, a *model.Anexo) error {
+	const metodo = "[MySQLAnexoRepository.Salvar]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO anexos (
+		id, chamado_id, acompanhamento_id, nome_arquivo, tipo_mime, tamanho, hash, chave, enviado_por, criado_em
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NOW())`,
+		a.ID, a.ChamadoID, a.AcompanhamentoID, a.NomeArquivo, a.TipoMIME, a.Tamanho, a.Hash, a.Chave, a.EnviadoPorID,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar o anexo no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter número de linhas afetadas após salvar o anexo",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"nenhuma linha foi afetada ao salvar o anexo",
+			ErrExecContext,
+		)
+	}
+
+	return nil
+}
+
+// Deletar remove os metadados de um anexo pelo seu ID.
+func (r *MySQLAnexoRepository) Deletar(ctx context.Context, id string) error {
+	const metodo = "[MySQLAnexoRepository.Deletar]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(ctx, `DELETE FROM anexos WHERE id = ?`, id)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao deletar o anexo no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter número de linhas afetadas após deletar o anexo",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o anexo a ser deletado não foi encontrado",
+			ErrAnexoNaoEncontrado,
+		)
+	}
+
+	return nil
+}
+
+// ListarPorChamado retorna os anexos do chamado e de seus acompanhamentos, do mais antigo ao mais recente,
+// incluindo os anexos de notas internas apenas quando solicitado.
+func (r *MySQLAnexoRepository) ListarPorChamado(ctx context.Context, chamadoID string, incluirInternos bool) ([]model.Anexo, error) {
+	anexos, err := r.listar(
+		ctx,
+		`SELECT `+colunasAnexoQualificadas+`
+		FROM anexos an
+		LEFT JOIN acompanhamentos ac ON ac.id = an.acompanhamento_id
+		WHERE an.chamado_id = ? AND (? OR ac.id IS NULL OR ac.visibilidade = ?)
+		ORDER BY an.criado_em ASC, an.id ASC`,
+		chamadoID, incluirInternos, model.VisibilidadePublico,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLAnexoRepository.ListarPorChamado]: %w", err)
+	}
+	return anexos, nil
+}
+
+// ListarPorAcompanhamento retorna os anexos do acompanhamento.
+func (r *MySQLAnexoRepository) ListarPorAcompanhamento(ctx context.Context, acompanhamentoID string) ([]model.Anexo, error) {
+	anexos, err := r.listar(
+		ctx,
+		`SELECT `+colunasAnexo+`
+		FROM anexos
+		WHERE acompanhamento_id = ?
+		ORDER BY criado_em ASC, id ASC`,
+		acompanhamentoID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLAnexoRepository.ListarPorAcompanhamento]: %w", err)
+	}
+	return anexos, nil
+}
+
+// Metodos auxiliares
+
+// listar executa uma consulta que retorna uma lista de anexos.
+func (r *MySQLAnexoRepository) listar(ctx context.Context, query string, args ...any) ([]model.Anexo, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLAnexoRepository.listar]",
+			utils.LevelError,
+			"erro ao listar os anexos no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	anexos := []model.Anexo{}
+	for rows.Next() {
+		anexo, err := scanAnexo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[MySQLAnexoRepository.listar]: %w", err)
+		}
+		anexos = append(anexos, *anexo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLAnexoRepository.listar]",
+			utils.LevelError,
+			"erro ao iterar sobre os resultados de anexos",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return anexos, nil
+}
+
+// scanAnexo mapeia os dados de uma linha do resultado da consulta para um modelo de Anexo.
+func scanAnexo(scanner interface{ Scan(dest ...any) error }) (*model.Anexo, error) {
+	var anexo model.Anexo
+	err := scanner.Scan(
+		&anexo.ID,
+		&anexo.ChamadoID,
+		&anexo.AcompanhamentoID,
+		&anexo.NomeArquivo,
+		&anexo.TipoMIME,
+		&anexo.Tamanho,
+		&anexo.Hash,
+		&anexo.Chave,
+		&anexo.EnviadoPorID,
+		&anexo.CriadoEm,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLAnexoRepository.scanAnexo]",
+			utils.LevelError,
+			"o scanner falhou ao escanear o anexo",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerAnexo, err),
+		)
+	}
+	return &anexo, nil
+}
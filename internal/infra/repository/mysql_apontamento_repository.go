@@ -0,0 +1,403 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerApontamento       = errors.New("erro ao escanear apontamento do banco de dados MySQL")
+	ErrApontamentoNaoEncontrado = errors.New("apontamento não encontrado no banco de dados MySQL")
+)
+
+// colunasApontamento lista as colunas lidas por scanApontamento, na mesma ordem.
+// O chamado é obtido do atendimento ao qual o apontamento pertence.
+const colunasApontamento = `ap.id, ap.atendimento_id, at.chamado_id, ap.usuario_id, ap.iniciado_em,
+	ap.finalizado_em, ap.minutos, ap.descricao, ap.faturavel, ap.criado_em, ap.atualizado_em`
+
+// MySQLApontamentoRepository é a implementação do repositório de apontamentos para MySQL.
+type MySQLApontamentoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLApontamentoRepository cria uma nova instância de MySQLApontamentoRepository.
+func NewMySQLApontamentoRepository(db *sql.DB) *MySQLApontamentoRepository {
+	return &MySQLApontamentoRepository{db: db}
+}
+
+// BuscarPorID retorna um apontamento pelo seu ID.
+func (r *MySQLApontamentoRepository) BuscarPorID(ctx context.Context, id string) (*model.Apontamento, error) {
+	apontamento, err := r.buscar(
+		ctx,
+		`SELECT `+colunasApontamento+`
+		FROM apontamentos ap
+		INNER JOIN atendimentos at ON at.id = ap.atendimento_id
+		WHERE ap.id = ?`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLApontamentoRepository.BuscarPorID]: %w", err)
+	}
+
+	if apontamento == nil {
+		return nil, utils.NewAppError(
+			"[MySQLApontamentoRepository.BuscarPorID]",
+			utils.LevelInfo,
+			"a busca por ID do apontamento não retornou resultados",
+			ErrApontamentoNaoEncontrado,
+		)
+	}
+
+	return apontamento, nil
+}
+
+// BuscarEmAndamento retorna o apontamento em andamento do técnico ou nil, caso não haja.
+func (r *MySQLApontamentoRepository) BuscarEmAndamento(ctx context.Context, usuarioID string) (*model.Apontamento, error) {
+	apontamento, err := r.buscar(
+		ctx,
+		`SELECT `+colunasApontamento+`
+		FROM apontamentos ap
+		INNER JOIN atendimentos at ON at.id = ap.atendimento_id
+		WHERE ap.usuario_id = ? AND ap.finalizado_em IS NULL
+		ORDER BY ap.iniciado_em DESC
+		LIMIT 1`,
+		usuarioID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLApontamentoRepository.BuscarEmAndamento]: %w", err)
+	}
+	return apontamento, nil
+}
+
+// Salvar insere um novo apontamento no repositório.
+func (r *MySQLApontamentoRepository) Salvar(ctx context.Context, a *model.Apontamento) error {
+	const metodo = "[MySQLApontamentoRepository.Salvar]"
+
+	existeAtendimento, err := ExisteAtendimentoPorID(ctx, r.db, a.AtendimentoID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existeAtendimento {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o atendimento associado ao apontamento não existe",
+			ErrAtendimentoNaoEncontrado,
+		)
+	}
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO apontamentos (
+		id, atendimento_id, usuario_id, iniciado_em, finalizado_em, minutos, descricao, faturavel, criado_em, atualizado_em
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())`,
+		a.ID, a.AtendimentoID, a.UsuarioID, a.IniciadoEm, a.FinalizadoEm, a.Minutos, a.Descricao, a.Faturavel,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar o apontamento no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter número de linhas afetadas após salvar o apontamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"nenhuma linha foi afetada ao salvar o apontamento",
+			ErrExecContext,
+		)
+	}
+
+	return nil
+}
+
+// Atualizar modifica o período, a descrição e o faturamento de um apontamento existente.
+func (r *MySQLApontamentoRepository) Atualizar(ctx context.Context, id string, a *model.Apontamento) error {
+	const metodo = "[MySQLApontamentoRepository.Atualizar]"
+
+	existe, err := ExisteApontamentoPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o apontamento a ser atualizado não foi encontrado",
+			ErrApontamentoNaoEncontrado,
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE apontamentos
+		SET iniciado_em = ?, finalizado_em = ?, minutos = ?, descricao = ?, faturavel = ?, atualizado_em = NOW()
+		WHERE id = ?`,
+		a.IniciadoEm, a.FinalizadoEm, a.Minutos, a.Descricao, a.Faturavel, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro inesperado ao atualizar o apontamento no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// Deletar remove um apontamento pelo seu ID.
+func (r *MySQLApontamentoRepository) Deletar(ctx context.Context, id string) error {
+	const metodo = "[MySQLApontamentoRepository.Deletar]"
+
+	existe, err := ExisteApontamentoPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o apontamento a ser deletado não foi encontrado",
+			ErrApontamentoNaoEncontrado,
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(ctx, `DELETE FROM apontamentos WHERE id = ?`, id)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro inesperado ao deletar o apontamento no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// Listar retorna uma lista de apontamentos com base em filtros e paginação.
+func (r *MySQLApontamentoRepository) Listar(ctx context.Context, filtro model.ApontamentoFiltro) ([]model.Apontamento, int, error) {
+	var query strings.Builder
+	args := []any{}
+
+	query.WriteString(`
+		SELECT SQL_CALC_FOUND_ROWS
+		` + colunasApontamento + `
+		FROM apontamentos ap
+		INNER JOIN atendimentos at ON at.id = ap.atendimento_id
+		WHERE 1=1
+	`)
+
+	if filtro.AtendimentoID != nil && *filtro.AtendimentoID != "" {
+		query.WriteString(" AND ap.atendimento_id = ?")
+		args = append(args, *filtro.AtendimentoID)
+	}
+
+	if filtro.ChamadoID != nil && *filtro.ChamadoID != "" {
+		query.WriteString(" AND at.chamado_id = ?")
+		args = append(args, *filtro.ChamadoID)
+	}
+
+	if filtro.UsuarioID != nil && *filtro.UsuarioID != "" {
+		query.WriteString(" AND ap.usuario_id = ?")
+		args = append(args, *filtro.UsuarioID)
+	}
+
+	if filtro.Faturavel != nil {
+		query.WriteString(" AND ap.faturavel = ?")
+		args = append(args, *filtro.Faturavel)
+	}
+
+	if filtro.Inicio != nil {
+		query.WriteString(" AND ap.iniciado_em >= ?")
+		args = append(args, *filtro.Inicio)
+	}
+
+	if filtro.Fim != nil {
+		query.WriteString(" AND ap.iniciado_em < ?")
+		args = append(args, *filtro.Fim)
+	}
+
+	query.WriteString(" ORDER BY ap.iniciado_em DESC LIMIT ? OFFSET ?")
+	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, 0, utils.NewAppError(
+			"[MySQLApontamentoRepository.Listar]",
+			utils.LevelError,
+			"erro ao listar os apontamentos no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	var apontamentos []model.Apontamento
+	for rows.Next() {
+		apontamento, err := scanApontamento(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("[MySQLApontamentoRepository.Listar]: %w", err)
+		}
+		apontamentos = append(apontamentos, *apontamento)
+	}
+
+	var total int
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+		return nil, 0, utils.NewAppError(
+			"[MySQLApontamentoRepository.Listar]",
+			utils.LevelError,
+			"erro ao obter total de apontamentos",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+	return apontamentos, total, nil
+}
+
+// Totalizar soma os minutos dos apontamentos concluídos agrupados por chamado, categoria ou técnico.
+func (r *MySQLApontamentoRepository) Totalizar(ctx context.Context, filtro model.TotaisApontamentoFiltro) ([]model.TotalApontamento, error) {
+	var agrupamento string
+	switch filtro.AgruparPor {
+	case model.AgruparPorChamado:
+		agrupamento = "c.id, c.titulo"
+	case model.AgruparPorCategoria:
+		agrupamento = "cat.id, cat.nome"
+	default:
+		agrupamento = "u.id, u.nome"
+	}
+
+	var query strings.Builder
+	args := []any{}
+
+	query.WriteString(`
+		SELECT ` + agrupamento + `, COUNT(*),
+			COALESCE(SUM(ap.minutos), 0),
+			COALESCE(SUM(CASE WHEN ap.faturavel THEN ap.minutos ELSE 0 END), 0)
+		FROM apontamentos ap
+		INNER JOIN atendimentos at ON at.id = ap.atendimento_id
+		INNER JOIN chamados c ON c.id = at.chamado_id
+		INNER JOIN categorias cat ON cat.id = c.categoria_id
+		INNER JOIN usuarios u ON u.id = ap.usuario_id
+		WHERE ap.finalizado_em IS NOT NULL
+	`)
+
+	if filtro.Faturavel != nil {
+		query.WriteString(" AND ap.faturavel = ?")
+		args = append(args, *filtro.Faturavel)
+	}
+
+	if filtro.Inicio != nil {
+		query.WriteString(" AND ap.iniciado_em >= ?")
+		args = append(args, *filtro.Inicio)
+	}
+
+	if filtro.Fim != nil {
+		query.WriteString(" AND ap.iniciado_em < ?")
+		args = append(args, *filtro.Fim)
+	}
+
+	query.WriteString(" GROUP BY " + agrupamento + " ORDER BY 4 DESC")
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLApontamentoRepository.Totalizar]",
+			utils.LevelError,
+			"erro ao totalizar os apontamentos no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	totais := []model.TotalApontamento{}
+	for rows.Next() {
+		var t model.TotalApontamento
+		if err := rows.Scan(&t.ID, &t.Nome, &t.Apontamentos, &t.Minutos, &t.MinutosFaturaveis); err != nil {
+			return nil, utils.NewAppError(
+				"[MySQLApontamentoRepository.Totalizar]",
+				utils.LevelError,
+				"o scanner falhou ao escanear os totais de apontamentos",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerApontamento, err),
+			)
+		}
+		totais = append(totais, t)
+	}
+
+	return totais, nil
+}
+
+// Metodos auxiliares
+
+// buscar executa uma consulta que retorna um único apontamento.
+func (r *MySQLApontamentoRepository) buscar(ctx context.Context, query string, args ...any) (*model.Apontamento, error) {
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
+	apontamento, err := scanApontamento(row)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLApontamentoRepository.buscar]: %w", err)
+	}
+	return apontamento, nil
+}
+
+// ExisteApontamentoPorID verifica se um apontamento existe pelo seu ID.
+func ExisteApontamentoPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
+	var exists bool
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM apontamentos WHERE id = ?)", id).Scan(&exists)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLApontamentoRepository.ExisteApontamentoPorID]",
+			utils.LevelError,
+			"erro ao verificar existência do apontamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerApontamento, err),
+		)
+	}
+	return exists, nil
+}
+
+// scanApontamento mapeia os dados de uma linha do resultado da consulta para um modelo de Apontamento.
+func scanApontamento(scanner interface{ Scan(dest ...any) error }) (*model.Apontamento, error) {
+	var apontamento model.Apontamento
+	err := scanner.Scan(
+		&apontamento.ID,
+		&apontamento.AtendimentoID,
+		&apontamento.ChamadoID,
+		&apontamento.UsuarioID,
+		&apontamento.IniciadoEm,
+		&apontamento.FinalizadoEm,
+		&apontamento.Minutos,
+		&apontamento.Descricao,
+		&apontamento.Faturavel,
+		&apontamento.CriadoEm,
+		&apontamento.AtualizadoEm,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLApontamentoRepository.scanApontamento]",
+			utils.LevelError,
+			"o scanner falhou ao escanear o apontamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerApontamento, err),
+		)
+	}
+	return &apontamento, nil
+}
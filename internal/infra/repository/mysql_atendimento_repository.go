@@ -16,6 +16,10 @@ var (
 	ErrAtendimentoNaoEncontrado = errors.New("atendimento não encontrado no banco de dados MySQL")
 )
 
+// colunasAtendimento lista as colunas lidas por scanAtendimento, na mesma ordem.
+const colunasAtendimento = `id, atribuido_id, chamado_id, criado_em, atualizado_em,
+	atribuido_por, motivo, finalizado_em, finalizado_por, motivo_finalizacao`
+
 // MySQLAtendimentoRepository é a implementação do repositório de atendimentos para MySQL.
 type MySQLAtendimentoRepository struct {
 	db *sql.DB
@@ -30,7 +34,7 @@ func NewMySQLAtendimentoRepository(db *sql.DB) *MySQLAtendimentoRepository {
 func (r *MySQLAtendimentoRepository) BuscarPorID(ctx context.Context, id string) (*model.Atendimento, error) {
 	atendimento, err := r.buscar(
 		ctx,
-		`SELECT id, atribuido_id, chamado_id, criado_em, atualizado_em
+		`SELECT `+colunasAtendimento+`
 		FROM atendimentos 
 		WHERE id = ?`,
 		id,
@@ -81,12 +85,12 @@ func (r *MySQLAtendimentoRepository) Salvar(ctx context.Context, a *model.Atendi
 		)
 	}
 
-	resultado, err := r.db.ExecContext(
+	resultado, err := conexao(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO atendimentos (
-		id, atribuido_id, chamado_id, criado_em, atualizado_em
-		) VALUES (?, ?, ?, NOW(), NOW())`,
-		a.ID, a.AtribuidoID, a.ChamadoID,
+		id, atribuido_id, chamado_id, atribuido_por, motivo, criado_em, atualizado_em
+		) VALUES (?, ?, ?, ?, ?, NOW(), NOW())`,
+		a.ID, a.AtribuidoID, a.ChamadoID, a.AtribuidoPorID, a.Motivo,
 	)
 	if err != nil {
 		return utils.NewAppError(
@@ -118,42 +122,198 @@ func (r *MySQLAtendimentoRepository) Salvar(ctx context.Context, a *model.Atendi
 	return nil
 }
 
-// Atualizar modifica os dados de um atendimento existente.
-func (r *MySQLAtendimentoRepository) Atualizar(ctx context.Context, id string, a *model.Atendimento) error {
-	const metodo = "[MySQLAtendimentoRepository.Atualizar]"
+// BuscarAtivo retorna o atendimento vigente do chamado ou nil, caso o chamado esteja na fila.
+func (r *MySQLAtendimentoRepository) BuscarAtivo(ctx context.Context, chamadoID string) (*model.Atendimento, error) {
+	atendimento, err := r.buscar(
+		ctx,
+		`SELECT `+colunasAtendimento+`
+		FROM atendimentos
+		WHERE chamado_id = ? AND finalizado_em IS NULL
+		ORDER BY criado_em DESC
+		LIMIT 1`,
+		chamadoID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLAtendimentoRepository.BuscarAtivo]: %w", err)
+	}
+	return atendimento, nil
+}
+
+// Finalizar encerra o atendimento vigente, registrando quem o encerrou e o motivo.
+func (r *MySQLAtendimentoRepository) Finalizar(ctx context.Context, id string, finalizadoPorID *string, motivo string) error {
+	const metodo = "[MySQLAtendimentoRepository.Finalizar]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE atendimentos
+		SET finalizado_em = NOW(), finalizado_por = ?, motivo_finalizacao = ?, atualizado_em = NOW()
+		WHERE id = ? AND finalizado_em IS NULL`,
+		finalizadoPorID, motivo, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao finalizar o atendimento no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return verificarAtendimentoFinalizado(metodo, resultado)
+}
+
+// Transferir finaliza o atendimento vigente e insere o novo atendimento em uma única transação.
+func (r *MySQLAtendimentoRepository) Transferir(ctx context.Context, atualID string, finalizadoPorID *string, motivo string, novo *model.Atendimento) error {
+	const metodo = "[MySQLAtendimentoRepository.Transferir]"
 
-	existe, err := ExisteAtendimentoPorID(ctx, r.db, id)
+	existeTecnico, err := ExisteUsuarioPorID(ctx, r.db, novo.AtribuidoID)
 	if err != nil {
 		return fmt.Errorf("%s: %w", metodo, err)
 	}
-	if !existe {
+	if !existeTecnico {
 		return utils.NewAppError(
 			metodo,
 			utils.LevelInfo,
-			"o atendimento que se tentou atualizar não existe",
-			ErrAtendimentoNaoEncontrado,
+			"o técnico de destino da transferência não existe",
+			ErrUsuarioNaoEncontrado,
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao transferir atendimento",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	resultado, err := tx.ExecContext(
 		ctx,
 		`UPDATE atendimentos
-		SET atribuido_id = ?, chamado_id = ?, atualizado_em = NOW()
-		WHERE id = ?`,
-		a.AtribuidoID, a.ChamadoID, id,
+		SET finalizado_em = NOW(), finalizado_por = ?, motivo_finalizacao = ?, atualizado_em = NOW()
+		WHERE id = ? AND finalizado_em IS NULL`,
+		finalizadoPorID, motivo, atualID,
 	)
 	if err != nil {
 		return utils.NewAppError(
 			metodo,
 			utils.LevelError,
-			"erro ao atualizar o atendimento no banco de dados",
+			"erro ao finalizar o atendimento transferido no banco de dados",
 			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
 		)
 	}
+	if err := verificarAtendimentoFinalizado(metodo, resultado); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO atendimentos (
+		id, atribuido_id, chamado_id, atribuido_por, motivo, criado_em, atualizado_em
+		) VALUES (?, ?, ?, ?, ?, NOW(), NOW())`,
+		novo.ID, novo.AtribuidoID, novo.ChamadoID, novo.AtribuidoPorID, novo.Motivo,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar o novo atendimento no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao transferir atendimento",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
 
 	return nil
 }
 
+// ListarPorChamado retorna a linha do tempo de atendimentos do chamado, do mais antigo ao mais recente.
+func (r *MySQLAtendimentoRepository) ListarPorChamado(ctx context.Context, chamadoID string) ([]model.Atendimento, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT `+colunasAtendimento+`
+		FROM atendimentos
+		WHERE chamado_id = ?
+		ORDER BY criado_em ASC, id ASC`,
+		chamadoID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLAtendimentoRepository.ListarPorChamado]",
+			utils.LevelError,
+			"erro ao listar os atendimentos do chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	atendimentos := []model.Atendimento{}
+	for rows.Next() {
+		atendimento, err := scanAtendimento(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[MySQLAtendimentoRepository.ListarPorChamado]: %w", err)
+		}
+		atendimentos = append(atendimentos, *atendimento)
+	}
+
+	return atendimentos, nil
+}
+
+// ListarChamadosAtribuidos retorna os IDs dos chamados que já foram atribuídos ao técnico.
+func (r *MySQLAtendimentoRepository) ListarChamadosAtribuidos(ctx context.Context, atribuidoID string) ([]string, error) {
+	const metodo = "[MySQLAtendimentoRepository.ListarChamadosAtribuidos]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT DISTINCT chamado_id FROM atendimentos WHERE atribuido_id = ?`,
+		atribuidoID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao listar os chamados atribuídos ao técnico no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	chamados := []string{}
+	for rows.Next() {
+		var chamadoID string
+		if err := rows.Scan(&chamadoID); err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao scanear o chamado do atendimento",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerAtendimento, err),
+			)
+		}
+		chamados = append(chamados, chamadoID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os chamados atribuídos ao técnico",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return chamados, nil
+}
+
 // Listar retorna uma lista de atendimentos com base em filtros e paginação.
 func (r *MySQLAtendimentoRepository) Listar(ctx context.Context, filtro model.AtendimentoFiltro) ([]model.Atendimento, int, error) {
 	var query strings.Builder
@@ -161,7 +321,7 @@ func (r *MySQLAtendimentoRepository) Listar(ctx context.Context, filtro model.At
 
 	query.WriteString(`
 		SELECT SQL_CALC_FOUND_ROWS
-		id, atribuido_id, chamado_id, criado_em, atualizado_em
+		` + colunasAtendimento + `
 		FROM atendimentos
 		WHERE 1=1
 	`)
@@ -176,10 +336,15 @@ func (r *MySQLAtendimentoRepository) Listar(ctx context.Context, filtro model.At
 		args = append(args, *filtro.AtribuidoID)
 	}
 
+	if condicao, argsEscopo := condicaoChamadoNoEscopo("chamado_id", filtro.Escopo); condicao != "" {
+		query.WriteString(" AND " + condicao)
+		args = append(args, argsEscopo...)
+	}
+
 	query.WriteString(" ORDER BY criado_em DESC LIMIT ? OFFSET ?")
 	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
 
-	rows, err := r.db.QueryContext(ctx, query.String(), args...)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLAtendimentoRepository.Listar]",
@@ -200,7 +365,7 @@ func (r *MySQLAtendimentoRepository) Listar(ctx context.Context, filtro model.At
 	}
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLAtendimentoRepository.Listar]",
 			utils.LevelError,
@@ -215,7 +380,7 @@ func (r *MySQLAtendimentoRepository) Listar(ctx context.Context, filtro model.At
 
 // buscar executa uma consulta que retorna um único atendimento.
 func (r *MySQLAtendimentoRepository) buscar(ctx context.Context, query string, args ...any) (*model.Atendimento, error) {
-	row := r.db.QueryRowContext(ctx, query, args...)
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
 	atendimento, err := scanAtendimento(row)
 	if err != nil {
 		return nil, fmt.Errorf("[MySQLAtendimentoRepository.buscar]: %w", err)
@@ -226,7 +391,7 @@ func (r *MySQLAtendimentoRepository) buscar(ctx context.Context, query string, a
 // ExisteAtendimentoPorID verifica se um atendimento existe pelo seu ID.
 func ExisteAtendimentoPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
 	var exists bool
-	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM atendimentos WHERE id = ?)", id).Scan(&exists)
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM atendimentos WHERE id = ?)", id).Scan(&exists)
 	if err != nil {
 		return false, utils.NewAppError(
 			"[MySQLAtendimentoRepository.ExisteAtendimentoPorID]",
@@ -241,6 +406,29 @@ func ExisteAtendimentoPorID(ctx context.Context, db *sql.DB, id string) (bool, e
 	return true, nil
 }
 
+// verificarAtendimentoFinalizado confere se o atendimento vigente foi de fato
+// finalizado; nenhuma linha afetada indica que ele não existe ou já foi encerrado.
+func verificarAtendimentoFinalizado(metodo string, resultado sql.Result) error {
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter número de linhas afetadas após finalizar o atendimento",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o atendimento não existe ou já foi finalizado",
+			ErrAtendimentoNaoEncontrado,
+		)
+	}
+	return nil
+}
+
 // scanAtendimento mapeia os dados de uma linha do resultado da consulta para um modelo de Atendimento.
 func scanAtendimento(scanner interface{ Scan(dest ...any) error }) (*model.Atendimento, error) {
 	var acompanhamento model.Atendimento
@@ -250,6 +438,11 @@ func scanAtendimento(scanner interface{ Scan(dest ...any) error }) (*model.Atend
 		&acompanhamento.ChamadoID,
 		&acompanhamento.CriadoEm,
 		&acompanhamento.AtualizadoEm,
+		&acompanhamento.AtribuidoPorID,
+		&acompanhamento.Motivo,
+		&acompanhamento.FinalizadoEm,
+		&acompanhamento.FinalizadoPorID,
+		&acompanhamento.MotivoFinalizacao,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerCandidatoAtribuicao = errors.New("erro ao scanear candidato à atribuição do banco de dados MySQL")
+)
+
+// MySQLAtribuicaoRepository é a implementação do repositório de atribuição automática para o MySQL.
+type MySQLAtribuicaoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLAtribuicaoRepository cria uma nova instância de MySQLAtribuicaoRepository.
+func NewMySQLAtribuicaoRepository(db *sql.DB) *MySQLAtribuicaoRepository {
+	return &MySQLAtribuicaoRepository{db: db}
+}
+
+// ListarCandidatos retorna os técnicos ativos com a carga atual de chamados em andamento,
+// a última atribuição recebida na categoria e se possuem permissão na categoria.
+// A carga considera apenas o atendimento mais recente de cada chamado, para que
+// chamados reatribuídos não sejam contados para o técnico anterior.
+func (r *MySQLAtribuicaoRepository) ListarCandidatos(ctx context.Context, categoriaID string) ([]model.CandidatoAtribuicao, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT u.id, u.nome,
+			(SELECT COUNT(*)
+			FROM atendimentos a
+			INNER JOIN chamados c ON c.id = a.chamado_id
+			WHERE a.atribuido_id = u.id
+			AND c.status IN (?, ?)
+			AND a.criado_em = (
+				SELECT MAX(a2.criado_em) FROM atendimentos a2 WHERE a2.chamado_id = a.chamado_id
+			)) AS chamados_em_andamento,
+			(SELECT MAX(a.criado_em)
+			FROM atendimentos a
+			INNER JOIN chamados c ON c.id = a.chamado_id
+			WHERE a.atribuido_id = u.id
+			AND c.categoria_id = ?) AS ultima_atribuicao,
+			EXISTS(
+				SELECT 1 FROM categoria_permissoes cp
+				WHERE cp.categoria_id = ? AND cp.usuario_id = u.id AND cp.permissao = ?
+			) AS permissao_categoria
+		FROM usuarios u
+		WHERE u.permissao = ? AND u.status = TRUE
+		ORDER BY u.nome ASC`,
+		model.StatusAtribuido, model.StatusAguardando,
+		categoriaID,
+		categoriaID, model.PermTEC,
+		model.PermTEC,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLAtribuicaoRepository.ListarCandidatos]",
+			utils.LevelError,
+			"falha ao listar os candidatos à atribuição no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	candidatos := []model.CandidatoAtribuicao{}
+	for rows.Next() {
+		var candidato model.CandidatoAtribuicao
+		err := rows.Scan(
+			&candidato.UsuarioID,
+			&candidato.Nome,
+			&candidato.ChamadosEmAndamento,
+			&candidato.UltimaAtribuicao,
+			&candidato.PermissaoCategoria,
+		)
+		if err != nil {
+			return nil, utils.NewAppError(
+				"[MySQLAtribuicaoRepository.ListarCandidatos]",
+				utils.LevelError,
+				"o scanner falhou ao scanear o candidato à atribuição",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerCandidatoAtribuicao, err),
+			)
+		}
+		candidatos = append(candidatos, candidato)
+	}
+
+	return candidatos, nil
+}
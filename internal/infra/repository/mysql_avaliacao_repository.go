@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerAvaliacao = errors.New("erro ao escanear avaliação do banco de dados MySQL")
+)
+
+// colunasAvaliacao lista as colunas lidas por scanAvaliacao, na mesma ordem.
+const colunasAvaliacao = `id, chamado_id, avaliador_id, tecnico_id, nota, comentario, solucao_aceita, criado_em`
+
+// MySQLAvaliacaoRepository é a implementação do repositório de avaliações para MySQL.
+type MySQLAvaliacaoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLAvaliacaoRepository cria uma nova instância de MySQLAvaliacaoRepository.
+func NewMySQLAvaliacaoRepository(db *sql.DB) *MySQLAvaliacaoRepository {
+	return &MySQLAvaliacaoRepository{db: db}
+}
+
+// ListarPorChamado retorna as avaliações do chamado, da mais antiga à mais recente.
+func (r *MySQLAvaliacaoRepository) ListarPorChamado(ctx context.Context, chamadoID string) ([]model.Avaliacao, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT `+colunasAvaliacao+`
+		FROM avaliacoes
+		WHERE chamado_id = ?
+		ORDER BY criado_em ASC, id ASC`,
+		chamadoID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLAvaliacaoRepository.ListarPorChamado]",
+			utils.LevelError,
+			"erro ao listar as avaliações do chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	avaliacoes := []model.Avaliacao{}
+	for rows.Next() {
+		avaliacao, err := scanAvaliacao(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[MySQLAvaliacaoRepository.ListarPorChamado]: %w", err)
+		}
+		avaliacoes = append(avaliacoes, *avaliacao)
+	}
+
+	return avaliacoes, nil
+}
+
+// Salvar insere uma nova avaliação no repositório.
+func (r *MySQLAvaliacaoRepository) Salvar(ctx context.Context, a *model.Avaliacao) error {
+	const metodo = "[MySQLAvaliacaoRepository.Salvar]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO avaliacoes (
+		id, chamado_id, avaliador_id, tecnico_id, nota, comentario, solucao_aceita, criado_em
+		) VALUES (?, ?, ?, ?, ?, ?, ?, NOW())`,
+		a.ID, a.ChamadoID, a.AvaliadorID, a.TecnicoID, a.Nota, a.Comentario, a.SolucaoAceita,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar a avaliação no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter número de linhas afetadas após salvar a avaliação",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"nenhuma linha foi afetada ao salvar a avaliação",
+			ErrExecContext,
+		)
+	}
+
+	return nil
+}
+
+// AgregarCSAT conta as avaliações agrupadas por técnico, categoria ou mês.
+// Avaliações sem técnico não entram no agrupamento por técnico.
+func (r *MySQLAvaliacaoRepository) AgregarCSAT(ctx context.Context, filtro model.CSATFiltro) ([]model.TotalCSAT, error) {
+	var chave, nome, juncao string
+	switch filtro.AgruparPor {
+	case model.CSATPorCategoria:
+		chave, nome = "cat.id", "cat.nome"
+		juncao = `INNER JOIN chamados c ON c.id = av.chamado_id
+		INNER JOIN categorias cat ON cat.id = c.categoria_id`
+	case model.CSATPorMes:
+		chave = "DATE_FORMAT(av.criado_em, '%Y-%m')"
+		nome = chave
+	default:
+		chave, nome = "u.id", "u.nome"
+		juncao = `INNER JOIN usuarios u ON u.id = av.tecnico_id`
+	}
+
+	var query strings.Builder
+	args := []any{}
+
+	query.WriteString(`
+		SELECT ` + chave + `, ` + nome + `, COUNT(*),
+			SUM(CASE WHEN av.nota >= ? THEN 1 ELSE 0 END),
+			SUM(CASE WHEN av.solucao_aceita THEN 0 ELSE 1 END),
+			AVG(av.nota)
+		FROM avaliacoes av
+		` + juncao + `
+		WHERE 1=1
+	`)
+	args = append(args, model.NotaSatisfeito)
+
+	if filtro.Inicio != nil {
+		query.WriteString(" AND av.criado_em >= ?")
+		args = append(args, *filtro.Inicio)
+	}
+
+	if filtro.Fim != nil {
+		query.WriteString(" AND av.criado_em < ?")
+		args = append(args, *filtro.Fim)
+	}
+
+	query.WriteString(" GROUP BY " + chave + ", " + nome + " ORDER BY 2 ASC")
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLAvaliacaoRepository.AgregarCSAT]",
+			utils.LevelError,
+			"erro ao agregar as avaliações no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	totais := []model.TotalCSAT{}
+	for rows.Next() {
+		var t model.TotalCSAT
+		if err := rows.Scan(&t.ID, &t.Nome, &t.Avaliacoes, &t.Satisfeitos, &t.SolucoesRejeitadas, &t.NotaMedia); err != nil {
+			return nil, utils.NewAppError(
+				"[MySQLAvaliacaoRepository.AgregarCSAT]",
+				utils.LevelError,
+				"o scanner falhou ao escanear a agregação das avaliações",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerAvaliacao, err),
+			)
+		}
+		totais = append(totais, t)
+	}
+
+	return totais, nil
+}
+
+// Metodos auxiliares
+
+// scanAvaliacao mapeia os dados de uma linha do resultado da consulta para um modelo de Avaliacao.
+func scanAvaliacao(scanner interface{ Scan(dest ...any) error }) (*model.Avaliacao, error) {
+	var avaliacao model.Avaliacao
+	err := scanner.Scan(
+		&avaliacao.ID,
+		&avaliacao.ChamadoID,
+		&avaliacao.AvaliadorID,
+		&avaliacao.TecnicoID,
+		&avaliacao.Nota,
+		&avaliacao.Comentario,
+		&avaliacao.SolucaoAceita,
+		&avaliacao.CriadoEm,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLAvaliacaoRepository.scanAvaliacao]",
+			utils.LevelError,
+			"o scanner falhou ao escanear a avaliação",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerAvaliacao, err),
+		)
+	}
+	return &avaliacao, nil
+}
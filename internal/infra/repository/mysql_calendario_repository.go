@@ -0,0 +1,607 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerCalendario       = errors.New("erro ao scanear calendário do banco de dados MySQL")
+	ErrCalendarioNaoEncontrado = errors.New("calendário não encontrado no banco de dados MySQL")
+	ErrCalendarioJaExiste      = errors.New("já existe um calendário para a categoria no banco de dados MySQL")
+	ErrFeriadoNaoEncontrado    = errors.New("feriado não encontrado no banco de dados MySQL")
+	ErrTransacao               = errors.New("erro ao controlar transação no banco de dados MySQL")
+)
+
+// colunasCalendario lista as colunas lidas por scanCalendario, na mesma ordem.
+const colunasCalendario = `id, nome, categoria_id, status, criado_em, atualizado_em`
+
+// MySQLCalendarioRepository é a implementação do repositório de calendários para o MySQL.
+type MySQLCalendarioRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLCalendarioRepository cria uma nova instância de MySQLCalendarioRepository.
+func NewMySQLCalendarioRepository(db *sql.DB) *MySQLCalendarioRepository {
+	return &MySQLCalendarioRepository{db: db}
+}
+
+// BuscarPorID busca um calendário pelo seu ID, com expedientes e feriados.
+func (r *MySQLCalendarioRepository) BuscarPorID(ctx context.Context, id string) (*model.Calendario, error) {
+	calendario, err := r.buscar(
+		ctx,
+		`SELECT `+colunasCalendario+`
+		FROM calendarios
+		WHERE id=?`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLCalendarioRepository.BuscarPorID]: %w", err)
+	}
+
+	if calendario == nil {
+		return nil, utils.NewAppError(
+			"[MySQLCalendarioRepository.BuscarPorID]",
+			utils.LevelInfo,
+			"a busca por ID não retornou resultados",
+			ErrCalendarioNaoEncontrado,
+		)
+	}
+
+	return calendario, nil
+}
+
+// BuscarVigente busca o calendário ativo da categoria, recorrendo ao calendário padrão.
+func (r *MySQLCalendarioRepository) BuscarVigente(ctx context.Context, categoriaID string) (*model.Calendario, error) {
+	calendario, err := r.buscar(
+		ctx,
+		`SELECT `+colunasCalendario+`
+		FROM calendarios
+		WHERE status = TRUE AND (categoria_id = ? OR categoria_id IS NULL)
+		ORDER BY categoria_id IS NULL ASC, criado_em ASC
+		LIMIT 1`,
+		categoriaID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLCalendarioRepository.BuscarVigente]: %w", err)
+	}
+
+	return calendario, nil
+}
+
+// Salvar cria um novo calendário com seus expedientes.
+func (r *MySQLCalendarioRepository) Salvar(ctx context.Context, c *model.Calendario) error {
+	const metodo = "[MySQLCalendarioRepository.Salvar]"
+
+	existe, err := ExisteCalendarioPorCategoria(ctx, r.db, c.CategoriaID, "")
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível salvar o calendário",
+			ErrCalendarioJaExiste,
+		)
+	}
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao salvar calendário",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO calendarios (
+		id, nome, categoria_id, status, criado_em, atualizado_em
+		) VALUES (?, ?, ?, ?, NOW(), NOW())`,
+		c.ID, c.Nome, c.CategoriaID, c.Status,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar o calendário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := salvarExpedientes(ctx, tx, c.ID, c.Expedientes); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao salvar calendário",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// Atualizar atualiza as informações e substitui os expedientes de um calendário existente.
+func (r *MySQLCalendarioRepository) Atualizar(ctx context.Context, id string, c *model.Calendario) error {
+	const metodo = "[MySQLCalendarioRepository.Atualizar]"
+
+	existe, err := ExisteCalendarioPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível atualizar o calendário",
+			ErrCalendarioNaoEncontrado,
+		)
+	}
+
+	existe, err = ExisteCalendarioPorCategoria(ctx, r.db, c.CategoriaID, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível atualizar o calendário",
+			ErrCalendarioJaExiste,
+		)
+	}
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao atualizar calendário",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE calendarios
+		SET nome=?, categoria_id=?, status=?, atualizado_em=NOW()
+		WHERE id=?`,
+		c.Nome, c.CategoriaID, c.Status, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao atualizar o calendário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM calendario_expedientes WHERE calendario_id=?`, id)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao remover os expedientes do calendário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := salvarExpedientes(ctx, tx, id, c.Expedientes); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao atualizar calendário",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// Ativar ativa um calendário pelo seu ID.
+func (r *MySQLCalendarioRepository) Ativar(ctx context.Context, id string) error {
+	return r.alterarStatus(ctx, "[MySQLCalendarioRepository.Ativar]", id, true)
+}
+
+// Desativar desativa um calendário pelo seu ID.
+func (r *MySQLCalendarioRepository) Desativar(ctx context.Context, id string) error {
+	return r.alterarStatus(ctx, "[MySQLCalendarioRepository.Desativar]", id, false)
+}
+
+// SalvarFeriados insere os feriados no calendário, atualizando a descrição das datas já cadastradas.
+func (r *MySQLCalendarioRepository) SalvarFeriados(ctx context.Context, calendarioID string, feriados []model.Feriado) (int, error) {
+	const metodo = "[MySQLCalendarioRepository.SalvarFeriados]"
+
+	existe, err := ExisteCalendarioPorID(ctx, r.db, calendarioID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível importar os feriados",
+			ErrCalendarioNaoEncontrado,
+		)
+	}
+
+	if len(feriados) == 0 {
+		return 0, nil
+	}
+
+	var query strings.Builder
+	args := make([]any, 0, len(feriados)*3)
+
+	query.WriteString(`INSERT INTO calendario_feriados (calendario_id, data, descricao) VALUES `)
+	for i, f := range feriados {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?)")
+		args = append(args, calendarioID, f.Data, f.Descricao)
+	}
+	query.WriteString(` ON DUPLICATE KEY UPDATE descricao = VALUES(descricao)`)
+
+	_, err = conexao(ctx, r.db).ExecContext(ctx, query.String(), args...)
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao salvar os feriados do calendário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return len(feriados), nil
+}
+
+// RemoverFeriado remove um feriado do calendário pela data.
+func (r *MySQLCalendarioRepository) RemoverFeriado(ctx context.Context, calendarioID, data string) error {
+	const metodo = "[MySQLCalendarioRepository.RemoverFeriado]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`DELETE FROM calendario_feriados
+		WHERE calendario_id = ? AND data = ?`,
+		calendarioID, data,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao remover o feriado do calendário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao obter o número de linhas afetadas ao remover feriado",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível remover o feriado",
+			ErrFeriadoNaoEncontrado,
+		)
+	}
+
+	return nil
+}
+
+// Listar lista calendários com paginação e filtros opcionais.
+// Os feriados não são carregados na listagem.
+func (r *MySQLCalendarioRepository) Listar(ctx context.Context, filtro model.CalendarioFiltro) ([]model.Calendario, int, error) {
+	var query strings.Builder
+	args := []any{}
+
+	query.WriteString(
+		`SELECT SQL_CALC_FOUND_ROWS ` + colunasCalendario + `
+		FROM calendarios
+		WHERE 1=1`,
+	)
+
+	if filtro.Busca != nil && *filtro.Busca != "" {
+		query.WriteString(" AND nome LIKE ?")
+		args = append(args, "%"+*filtro.Busca+"%")
+	}
+
+	if filtro.CategoriaID != nil && *filtro.CategoriaID != "" {
+		query.WriteString(" AND categoria_id = ?")
+		args = append(args, *filtro.CategoriaID)
+	}
+
+	if filtro.Status != nil {
+		query.WriteString(" AND status = ?")
+		args = append(args, *filtro.Status)
+	}
+
+	query.WriteString(" ORDER BY nome ASC LIMIT ? OFFSET ?")
+	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, 0, utils.NewAppError(
+			"[MySQLCalendarioRepository.Listar]",
+			utils.LevelError,
+			"falha ao listar calendários no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	var calendarios []model.Calendario
+	for rows.Next() {
+		calendario, err := scanCalendario(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("[MySQLCalendarioRepository.Listar]: %w", err)
+		}
+		calendarios = append(calendarios, *calendario)
+	}
+	rows.Close()
+
+	var total int
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+		return nil, 0, utils.NewAppError(
+			"[MySQLCalendarioRepository.Listar]",
+			utils.LevelError,
+			"erro ao obter total de calendários",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+
+	for i := range calendarios {
+		expedientes, err := r.buscarExpedientes(ctx, calendarios[i].ID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("[MySQLCalendarioRepository.Listar]: %w", err)
+		}
+		calendarios[i].Expedientes = expedientes
+	}
+
+	return calendarios, total, nil
+}
+
+// Metodos auxiliares
+
+// buscar executa uma consulta que retorna um único calendário e carrega seus
+// expedientes e feriados. Retorna nil quando nenhum calendário for encontrado.
+func (r *MySQLCalendarioRepository) buscar(ctx context.Context, query string, args ...any) (*model.Calendario, error) {
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
+	calendario, err := scanCalendario(row)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLCalendarioRepository.buscar]: %w", err)
+	}
+	if calendario == nil {
+		return nil, nil
+	}
+
+	calendario.Expedientes, err = r.buscarExpedientes(ctx, calendario.ID)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLCalendarioRepository.buscar]: %w", err)
+	}
+
+	calendario.Feriados, err = r.buscarFeriados(ctx, calendario.ID)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLCalendarioRepository.buscar]: %w", err)
+	}
+
+	return calendario, nil
+}
+
+// buscarExpedientes carrega os expedientes do calendário ordenados pelo dia da semana.
+func (r *MySQLCalendarioRepository) buscarExpedientes(ctx context.Context, calendarioID string) ([]model.Expediente, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT dia_semana, inicio, fim
+		FROM calendario_expedientes
+		WHERE calendario_id = ?
+		ORDER BY dia_semana ASC`,
+		calendarioID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLCalendarioRepository.buscarExpedientes]",
+			utils.LevelError,
+			"falha ao buscar os expedientes do calendário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	expedientes := []model.Expediente{}
+	for rows.Next() {
+		var e model.Expediente
+		if err := rows.Scan(&e.DiaSemana, &e.Inicio, &e.Fim); err != nil {
+			return nil, utils.NewAppError(
+				"[MySQLCalendarioRepository.buscarExpedientes]",
+				utils.LevelError,
+				"o scanner falhou ao scanear o expediente do calendário",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerCalendario, err),
+			)
+		}
+		expedientes = append(expedientes, e)
+	}
+
+	return expedientes, nil
+}
+
+// buscarFeriados carrega os feriados do calendário ordenados pela data.
+func (r *MySQLCalendarioRepository) buscarFeriados(ctx context.Context, calendarioID string) ([]model.Feriado, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT DATE_FORMAT(data, '%Y-%m-%d'), descricao
+		FROM calendario_feriados
+		WHERE calendario_id = ?
+		ORDER BY data ASC`,
+		calendarioID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLCalendarioRepository.buscarFeriados]",
+			utils.LevelError,
+			"falha ao buscar os feriados do calendário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	var feriados []model.Feriado
+	for rows.Next() {
+		var f model.Feriado
+		if err := rows.Scan(&f.Data, &f.Descricao); err != nil {
+			return nil, utils.NewAppError(
+				"[MySQLCalendarioRepository.buscarFeriados]",
+				utils.LevelError,
+				"o scanner falhou ao scanear o feriado do calendário",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerCalendario, err),
+			)
+		}
+		feriados = append(feriados, f)
+	}
+
+	return feriados, nil
+}
+
+// alterarStatus ativa ou desativa um calendário pelo seu ID.
+func (r *MySQLCalendarioRepository) alterarStatus(ctx context.Context, metodo, id string, status bool) error {
+	existe, err := ExisteCalendarioPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível alterar o status do calendário",
+			ErrCalendarioNaoEncontrado,
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE calendarios
+		SET status=?, atualizado_em=NOW()
+		WHERE id=?`,
+		status, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao alterar o status do calendário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// salvarExpedientes insere os expedientes do calendário dentro da transação informada.
+func salvarExpedientes(ctx context.Context, tx executorSQL, calendarioID string, expedientes []model.Expediente) error {
+	for _, e := range expedientes {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO calendario_expedientes (calendario_id, dia_semana, inicio, fim)
+			VALUES (?, ?, ?, ?)`,
+			calendarioID, e.DiaSemana, e.Inicio, e.Fim,
+		)
+		if err != nil {
+			return utils.NewAppError(
+				"[MySQLCalendarioRepository.salvarExpedientes]",
+				utils.LevelError,
+				"falha ao salvar o expediente do calendário no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+			)
+		}
+	}
+	return nil
+}
+
+// ExisteCalendarioPorID verifica se um calendário existe pelo seu ID.
+func ExisteCalendarioPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
+	var existe bool
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM calendarios WHERE id = ?)", id).Scan(&existe)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLCalendarioRepository.ExisteCalendarioPorID]",
+			utils.LevelError,
+			"falha ao verificar existência do calendário por ID",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	return existe, nil
+}
+
+// ExisteCalendarioPorCategoria verifica se já existe outro calendário para a
+// categoria informada. Uma categoria nula verifica o calendário padrão.
+func ExisteCalendarioPorCategoria(ctx context.Context, db *sql.DB, categoriaID *string, ignorarID string) (bool, error) {
+	var existe bool
+	err := conexao(ctx, db).QueryRowContext(
+		ctx,
+		`SELECT EXISTS(
+		SELECT 1 FROM calendarios
+		WHERE categoria_id <=> ? AND id <> ?)`,
+		categoriaID, ignorarID,
+	).Scan(&existe)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLCalendarioRepository.ExisteCalendarioPorCategoria]",
+			utils.LevelError,
+			"falha ao verificar existência do calendário por categoria",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	return existe, nil
+}
+
+// scanCalendario mapeia os dados de um scanner (row ou rows) para uma struct Calendario.
+func scanCalendario(scanner interface{ Scan(dest ...any) error }) (*model.Calendario, error) {
+	var calendario model.Calendario
+	err := scanner.Scan(
+		&calendario.ID,
+		&calendario.Nome,
+		&calendario.CategoriaID,
+		&calendario.Status,
+		&calendario.CriadoEm,
+		&calendario.AtualizadoEm,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLCalendarioRepository.scanCalendario]",
+			utils.LevelError,
+			"O scanner falhou ao scanear o calendário",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerCalendario, err),
+		)
+	}
+	return &calendario, nil
+}
@@ -31,7 +31,7 @@ func NewMySQLCategoriaPermissaoRepository(db *sql.DB) *MySQLCategoriaPermissaoRe
 func (r *MySQLCategoriaPermissaoRepository) Salvar(ctx context.Context, c *model.CategoriaPermissao) error {
 	const metodo = "[MySQLCategoriaPermissaoRepository.Salvar]"
 
-	resultado, err := r.db.ExecContext(
+	resultado, err := conexao(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO categoria_permissoes (
 		categoria_id, usuario_id, permissao, criado_em, atualizado_em
@@ -86,7 +86,7 @@ func (r *MySQLCategoriaPermissaoRepository) Atualizar(ctx context.Context, categ
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE categoria_permissoes
 		SET permissao = ?, atualizado_em = NOW() 
@@ -120,7 +120,7 @@ func (r *MySQLCategoriaPermissaoRepository) Deletar(ctx context.Context, categor
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`DELETE FROM categoria_permissoes 
 		WHERE categoria_id = ? AND usuario_id = ?`,
@@ -168,7 +168,7 @@ func (r *MySQLCategoriaPermissaoRepository) Listar(ctx context.Context, filtro m
 	query.WriteString(" ORDER BY nome ASC LIMIT ? OFFSET ?")
 	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
 
-	rows, err := r.db.QueryContext(ctx, query.String(), args...)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLCategoriaPermissaoRepository.Listar]",
@@ -190,7 +190,7 @@ func (r *MySQLCategoriaPermissaoRepository) Listar(ctx context.Context, filtro m
 	}
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLCategoriaPermissaoRepository.Listar]",
 			utils.LevelError,
@@ -202,12 +202,54 @@ func (r *MySQLCategoriaPermissaoRepository) Listar(ctx context.Context, filtro m
 	return categoriasPermissoes, total, nil
 }
 
+// ListarPorUsuario retorna todas as permissões concedidas ao usuário nas categorias.
+func (r *MySQLCategoriaPermissaoRepository) ListarPorUsuario(ctx context.Context, usuarioID string) ([]model.CategoriaPermissao, error) {
+	const metodo = "[MySQLCategoriaPermissaoRepository.ListarPorUsuario]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT categoria_id, usuario_id, permissao, criado_em, atualizado_em
+		FROM categoria_permissoes
+		WHERE usuario_id = ?`,
+		usuarioID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar as permissões do usuário nas categorias",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	categoriasPermissoes := []model.CategoriaPermissao{}
+	for rows.Next() {
+		categoriaPermissao, err := scanCategoriaPermissao(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		categoriasPermissoes = append(categoriasPermissoes, *categoriaPermissao)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre as permissões do usuário nas categorias",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return categoriasPermissoes, nil
+}
+
 // metodos auxiliares
 
 // ExisteCategoriaPermissaoPorID verifica se uma categoria e permissão existe pelo seu ID de usuário e ID de categoria.
 func ExisteCategoriaPermissaoPorID(ctx context.Context, db *sql.DB, usuarioID, categoriaID string) (bool, error) {
 	var exists bool
-	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM categoria_permissoes WHERE usuario_id = ? AND categoria_id = ?)", usuarioID, categoriaID).Scan(&exists)
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM categoria_permissoes WHERE usuario_id = ? AND categoria_id = ?)", usuarioID, categoriaID).Scan(&exists)
 	if err != nil {
 		return false, utils.NewAppError(
 			"[MySQLCategoriaPermissaoRepository.ExisteCategoriaPermissaoPorID]",
@@ -31,7 +31,7 @@ func NewMySQLCategoriaRepository(db *sql.DB) *MySQLCategoriaRepository {
 func (r *MySQLCategoriaRepository) BuscarPorID(ctx context.Context, id string) (*model.Categoria, error) {
 	categoria, err := r.buscar(
 		ctx,
-		`SELECT id, nome, status, criado_em, atualizado_em 
+		`SELECT id, nome, status, estrategia_atribuicao, criado_em, atualizado_em 
 		FROM categorias 
 		WHERE id=?`,
 		id,
@@ -56,7 +56,7 @@ func (r *MySQLCategoriaRepository) BuscarPorID(ctx context.Context, id string) (
 func (r *MySQLCategoriaRepository) BuscarPorNome(ctx context.Context, nome string) (*model.Categoria, error) {
 	categoria, err := r.buscar(
 		ctx,
-		`SELECT id, nome, status, criado_em, atualizado_em 
+		`SELECT id, nome, status, estrategia_atribuicao, criado_em, atualizado_em 
 		FROM categorias 
 		WHERE nome=?`,
 		nome,
@@ -82,12 +82,12 @@ func (r *MySQLCategoriaRepository) BuscarPorNome(ctx context.Context, nome strin
 func (r *MySQLCategoriaRepository) Salvar(ctx context.Context, c *model.Categoria) error {
 	const metodo = "[MySQLCategoriaRepository.Salvar]"
 
-	resultado, err := r.db.ExecContext(
+	resultado, err := conexao(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO categorias (
-		id, nome, status, criado_em, atualizado_em
-		) VALUES (?, ?, ?, NOW(), NOW())`,
-		c.ID, c.Nome, c.Status,
+		id, nome, status, estrategia_atribuicao, criado_em, atualizado_em
+		) VALUES (?, ?, ?, ?, NOW(), NOW())`,
+		c.ID, c.Nome, c.Status, c.EstrategiaAtribuicao,
 	)
 	if err != nil {
 		if strings.Contains(err.Error(), "Duplicate entry") {
@@ -143,12 +143,12 @@ func (r *MySQLCategoriaRepository) Atualizar(ctx context.Context, id string, c *
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE categorias 
-		SET nome=?, status=?, atualizado_em=NOW() 
+		SET nome=?, status=?, estrategia_atribuicao=?, atualizado_em=NOW() 
 		WHERE id=?`,
-		c.Nome, c.Status, id,
+		c.Nome, c.Status, c.EstrategiaAtribuicao, id,
 	)
 	if err != nil {
 		if strings.Contains(err.Error(), "Duplicate entry") {
@@ -189,7 +189,7 @@ func (r *MySQLCategoriaRepository) Ativar(ctx context.Context, id string) error
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE categorias 
 		SET status=true, atualizado_em=NOW() 
@@ -223,7 +223,7 @@ func (r *MySQLCategoriaRepository) Desativar(ctx context.Context, id string) err
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE categorias 
 		SET status=false, atualizado_em=NOW() 
@@ -250,7 +250,7 @@ func (r *MySQLCategoriaRepository) Listar(ctx context.Context, filtro model.Cate
 	// TODO nao trazer os arquivados, incluir flag para exibir ou nao status false
 	query.WriteString(
 		`SELECT SQL_CALC_FOUND_ROWS
-		id, nome, status, criado_em, atualizado_em 
+		id, nome, status, estrategia_atribuicao, criado_em, atualizado_em 
 		FROM categorias 
 		WHERE 1=1`,
 	)
@@ -269,7 +269,7 @@ func (r *MySQLCategoriaRepository) Listar(ctx context.Context, filtro model.Cate
 	query.WriteString(" ORDER BY nome ASC LIMIT ? OFFSET ?")
 	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
 
-	rows, err := r.db.QueryContext(ctx, query.String(), args...)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLCategoriaRepository.Listar]",
@@ -290,7 +290,7 @@ func (r *MySQLCategoriaRepository) Listar(ctx context.Context, filtro model.Cate
 	}
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLCategoriaRepository.Listar]",
 			utils.LevelError,
@@ -306,7 +306,7 @@ func (r *MySQLCategoriaRepository) Listar(ctx context.Context, filtro model.Cate
 
 // buscar executa uma consulta que retorna uma única categoria.
 func (r *MySQLCategoriaRepository) buscar(ctx context.Context, query string, args ...any) (*model.Categoria, error) {
-	row := r.db.QueryRowContext(ctx, query, args...)
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
 	categoria, err := scanCategoria(row)
 	if err != nil {
 		return nil, fmt.Errorf("[MySQLCategoriaRepository.buscar]: %w", err)
@@ -317,7 +317,7 @@ func (r *MySQLCategoriaRepository) buscar(ctx context.Context, query string, arg
 // ExisteCategoriaPorID verifica se uma categoria existe pelo seu ID.
 func ExisteCategoriaPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
 	var exists bool
-	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM categorias WHERE id = ?)", id).Scan(&exists)
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM categorias WHERE id = ?)", id).Scan(&exists)
 	if err != nil {
 		return false, utils.NewAppError(
 			"[MySQLCategoriaRepository.ExisteCategoriaPorID]",
@@ -336,6 +336,7 @@ func scanCategoria(scanner interface{ Scan(dest ...any) error }) (*model.Categor
 		&categoria.ID,
 		&categoria.Nome,
 		&categoria.Status,
+		&categoria.EstrategiaAtribuicao,
 		&categoria.CriadoEm,
 		&categoria.AtualizadoEm,
 	)
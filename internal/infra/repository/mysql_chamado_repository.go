@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
@@ -16,6 +17,38 @@ var (
 	ErrScannerChamado       = errors.New("erro ao escanear chamado do banco de dados MySQL")
 )
 
+// Expressões que indicam se os prazos de SLA estão violados, seja por violação já
+// registrada ou por prazo vencido enquanto o chamado segue em atendimento.
+const (
+	exprSLAPrimeiraRespostaViolado = `(sla_primeira_resposta_violado OR (
+		primeira_resposta_em IS NULL
+		AND status IN ('ABERTO', 'ATRIBUIDO', 'AGUARDANDO_USUARIO')
+		AND COALESCE(prazo_primeira_resposta < NOW(), FALSE)))`
+
+	exprSLASolucaoViolado = `(sla_solucao_violado OR (
+		solucionado_em IS NULL AND sla_pausado_em IS NULL
+		AND status IN ('ABERTO', 'ATRIBUIDO', 'AGUARDANDO_USUARIO')
+		AND COALESCE(prazo_solucao < NOW(), FALSE)))`
+)
+
+// colunasOrdenacaoChamado mapeia os campos de ordenação aceitos para as colunas da
+// tabela, impedindo que valores arbitrários sejam concatenados ao ORDER BY.
+var colunasOrdenacaoChamado = map[model.CampoOrdenacaoChamado]string{
+	model.OrdenarPorCriadoEm:     "criado_em",
+	model.OrdenarPorAtualizadoEm: "atualizado_em",
+	model.OrdenarPorPrioridade:   "prioridade",
+	model.OrdenarPorPrazoSolucao: "prazo_solucao",
+}
+
+// colunasChamado lista as colunas lidas por scanChamado, na mesma ordem.
+const colunasChamado = `id, titulo, descricao, status, criado_em,
+	atualizado_em, solucionado_em, solucao, fechado_em,
+	categoria_id, subcategoria_id, criador_id, arquivado,
+	impacto, urgencia, prioridade, politica_sla_id, prazo_primeira_resposta, prazo_solucao,
+	primeira_resposta_em, sla_pausado_em, atribuido_em,
+	tempo_atribuido_segundos, reaberturas, reaberto_em, ` +
+	exprSLAPrimeiraRespostaViolado + `, ` + exprSLASolucaoViolado
+
 // MySQLChamadoRepository implementa a interface ChamadoRepository para MySQL.
 type MySQLChamadoRepository struct {
 	db *sql.DB
@@ -30,9 +63,7 @@ func NewMySQLChamadoRepository(db *sql.DB) *MySQLChamadoRepository {
 func (r *MySQLChamadoRepository) BuscarPorID(ctx context.Context, id string) (*model.Chamado, error) {
 	chamado, err := r.buscar(
 		ctx,
-		`SELECT id, titulo, descricao, status, arquivado, criado_em, 
-		 atualizado_em, solucionado_em, solucao, fechado_em, 
-		 categoria_id, subcategoria_id, criador_id
+		`SELECT `+colunasChamado+`
 		 FROM chamados 
 		 WHERE id=?`,
 		id,
@@ -54,17 +85,44 @@ func (r *MySQLChamadoRepository) BuscarPorID(ctx context.Context, id string) (*m
 	return chamado, nil
 }
 
+// Visivel indica se o chamado pertence ao escopo de chamados visíveis ao usuário.
+func (r *MySQLChamadoRepository) Visivel(ctx context.Context, id string, escopo *model.EscopoChamados) (bool, error) {
+	condicao, args := condicaoEscopoChamados(escopo)
+	if condicao == "" {
+		return true, nil
+	}
+
+	var visivel bool
+	err := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT EXISTS(SELECT 1 FROM chamados WHERE chamados.id = ? AND `+condicao+`)`,
+		append([]any{id}, args...)...,
+	).Scan(&visivel)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLChamadoRepository.Visivel]",
+			utils.LevelError,
+			"erro ao verificar a visibilidade do chamado",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+
+	return visivel, nil
+}
+
 // Salvar cria um novo chamado.
 func (r *MySQLChamadoRepository) Salvar(ctx context.Context, c *model.Chamado) error {
 	const metodo = "[MySQLChamadoRepository.Salvar]"
 
-	resultado, err := r.db.ExecContext(
+	resultado, err := conexao(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO chamados (
 		 id, titulo, descricao, status, arquivado, categoria_id, 
-		 subcategoria_id, criador_id, criado_em, atualizado_em
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())`,
+		 subcategoria_id, criador_id, impacto, urgencia, prioridade,
+		 politica_sla_id, prazo_primeira_resposta, prazo_solucao, criado_em, atualizado_em
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())`,
 		c.ID, c.Titulo, c.Descricao, c.Status, c.Arquivado, c.CategoriaID, c.SubcategoriaID, c.CriadorID,
+		c.Impacto, c.Urgencia, c.Prioridade, c.PoliticaSLAID, c.PrazoPrimeiraResposta, c.PrazoSolucao,
 	)
 
 	if err != nil {
@@ -113,13 +171,13 @@ func (r *MySQLChamadoRepository) Atualizar(ctx context.Context, id string, c *mo
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE chamados 
-		 SET titulo=?, descricao=?, status=?, arquivado=?, categoria_id=?, 
+		 SET titulo=?, descricao=?, arquivado=?, categoria_id=?, 
 		 subcategoria_id=?, atualizado_em=NOW()
 		 WHERE id=?`,
-		c.Titulo, c.Descricao, c.Status, c.Arquivado, c.CategoriaID, c.SubcategoriaID, id,
+		c.Titulo, c.Descricao, c.Arquivado, c.CategoriaID, c.SubcategoriaID, id,
 	)
 	if err != nil {
 		return utils.NewAppError(
@@ -148,7 +206,7 @@ func (r *MySQLChamadoRepository) Arquivar(ctx context.Context, id string) error
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE chamados 
 		 SET arquivado=true, atualizado_em=NOW()
@@ -182,7 +240,7 @@ func (r *MySQLChamadoRepository) Desarquivar(ctx context.Context, id string) err
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE chamados 
 		 SET arquivado=false, atualizado_em=NOW()
@@ -202,15 +260,19 @@ func (r *MySQLChamadoRepository) Desarquivar(ctx context.Context, id string) err
 	return nil
 }
 
-// AtualizarStatus atualiza o status de um chamado, podendo incluir uma solução.
-func (r *MySQLChamadoRepository) AtualizarStatus(ctx context.Context, id string, status string, solucao *string) error {
+// AtualizarStatus atualiza o status de um chamado, podendo incluir uma solução. A
+// atualização só ocorre se o chamado ainda estiver no status de origem, para que duas
+// transições concorrentes não partam do mesmo status.
+func (r *MySQLChamadoRepository) AtualizarStatus(ctx context.Context, id string, origem, status string, solucao *string) error {
+	const metodo = "[MySQLChamadoRepository.AtualizarStatus]"
+
 	existe, err := ExisteChamadoPorID(ctx, r.db, id)
 	if err != nil {
 		return fmt.Errorf("[MySQLChamadoRepository.AtualizarStatus]: %w", err)
 	}
 	if !existe {
 		return utils.NewAppError(
-			"[MySQLChamadoRepository.AtualizarStatus]",
+			metodo,
 			utils.LevelInfo,
 			"não foi possível atualizar o status do chamado",
 			ErrChamadoNaoEncontrado,
@@ -220,31 +282,257 @@ func (r *MySQLChamadoRepository) AtualizarStatus(ctx context.Context, id string,
 	var query string
 	var args []any
 
-	if solucao != nil {
+	switch {
+	case solucao != nil:
 		query = `UPDATE chamados 
 		SET status=?, solucao=?, solucionado_em=NOW(), atualizado_em=NOW() 
-		WHERE id=?`
-		args = []any{status, *solucao, id}
-	} else {
+		WHERE id=? AND status=?`
+		args = []any{status, *solucao, id, origem}
+	case status == string(model.StatusFechado):
+		query = `UPDATE chamados 
+		SET status=?, fechado_em=NOW(), atualizado_em=NOW() 
+		WHERE id=? AND status=?`
+		args = []any{status, id, origem}
+	default:
 		query = `UPDATE chamados 
 		SET status=?, atualizado_em=NOW() 
-		WHERE id=?`
-		args = []any{status, id}
+		WHERE id=? AND status=?`
+		args = []any{status, id, origem}
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	resultado, err := conexao(ctx, r.db).ExecContext(ctx, query, args...)
 	if err != nil {
 		return utils.NewAppError(
-			"[MySQLChamadoRepository.AtualizarStatus]",
+			metodo,
 			utils.LevelError,
 			"erro ao atualizar status do chamado no banco de dados",
 			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
 		)
 	}
 
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao atualizar o status do chamado",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		// o chamado deixou o status de origem desde a última leitura
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			fmt.Sprintf("o chamado não está mais no status %s", origem),
+			model.ErrTransicaoStatusInvalida,
+		)
+	}
+
+	return nil
+}
+
+// Reabrir devolve o chamado resolvido ou fechado ao status informado, descartando a
+// solução e o fechamento e incrementando o contador de reaberturas.
+func (r *MySQLChamadoRepository) Reabrir(ctx context.Context, id string, status model.StatusChamado) error {
+	const metodo = "[MySQLChamadoRepository.Reabrir]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE chamados
+		SET status=?, solucao=NULL, solucionado_em=NULL, fechado_em=NULL,
+			reaberturas=reaberturas+1, reaberto_em=NOW(), atualizado_em=NOW()
+		WHERE id=? AND status IN (?, ?)`,
+		status, id, model.StatusResolvido, model.StatusFechado,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao reabrir o chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao reabrir o chamado",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		// o chamado deixou de estar resolvido ou fechado desde a última leitura
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"nenhuma linha foi afetada ao reabrir o chamado",
+			model.ErrChamadoNaoReabrivel,
+		)
+	}
+
+	return nil
+}
+
+// AtualizarPrioridade atualiza o impacto, a urgência e a prioridade de um chamado.
+func (r *MySQLChamadoRepository) AtualizarPrioridade(ctx context.Context, id string, impacto model.Impacto, urgencia model.Urgencia, prioridade model.Prioridade) error {
+	existe, err := ExisteChamadoPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("[MySQLChamadoRepository.AtualizarPrioridade]: %w", err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			"[MySQLChamadoRepository.AtualizarPrioridade]",
+			utils.LevelInfo,
+			"não foi possível atualizar a prioridade do chamado",
+			ErrChamadoNaoEncontrado,
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE chamados 
+		 SET impacto=?, urgencia=?, prioridade=?, atualizado_em=NOW() 
+		 WHERE id=?`,
+		impacto, urgencia, prioridade, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLChamadoRepository.AtualizarPrioridade]",
+			utils.LevelError,
+			"erro ao atualizar a prioridade do chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
 	return nil
 }
 
+// AtualizarSLA persiste os prazos de SLA, a primeira resposta e a pausa do chamado.
+// Um novo prazo de solução descarta o aviso de risco do prazo anterior; a atribuição
+// do aviso vem antes da do prazo, pois o MySQL avalia as atribuições em ordem.
+func (r *MySQLChamadoRepository) AtualizarSLA(ctx context.Context, id string, c *model.Chamado) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE chamados 
+		 SET sla_aviso_em = IF(prazo_solucao <=> ?, sla_aviso_em, NULL),
+		 prazo_primeira_resposta=?, prazo_solucao=?, primeira_resposta_em=?, 
+		 sla_pausado_em=?, atualizado_em=NOW()
+		 WHERE id=?`,
+		c.PrazoSolucao, c.PrazoPrimeiraResposta, c.PrazoSolucao, c.PrimeiraRespostaEm, c.SLAPausadoEm, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLChamadoRepository.AtualizarSLA]",
+			utils.LevelError,
+			"erro ao atualizar o SLA do chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// AtualizarTempoAtribuido persiste o controle do tempo útil em que o chamado permaneceu atribuído.
+func (r *MySQLChamadoRepository) AtualizarTempoAtribuido(ctx context.Context, id string, c *model.Chamado) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE chamados 
+		 SET atribuido_em=?, tempo_atribuido_segundos=?
+		 WHERE id=?`,
+		c.AtribuidoEm, c.TempoAtribuidoSegundos, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLChamadoRepository.AtualizarTempoAtribuido]",
+			utils.LevelError,
+			"erro ao atualizar o tempo atribuído do chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// MarcarViolacaoSLA marca o prazo de SLA informado como violado caso esteja vencido
+// e a violação ainda não tenha sido registrada. A atualização condicional garante
+// que cada violação seja marcada uma única vez.
+func (r *MySQLChamadoRepository) MarcarViolacaoSLA(ctx context.Context, id string, tipo model.TipoSLA) (bool, error) {
+	const metodo = "[MySQLChamadoRepository.MarcarViolacaoSLA]"
+
+	var query string
+	switch tipo {
+	case model.SLAPrimeiraResposta:
+		query = `UPDATE chamados 
+		 SET sla_primeira_resposta_violado = TRUE 
+		 WHERE id=? AND sla_primeira_resposta_violado = FALSE 
+		 AND prazo_primeira_resposta < COALESCE(primeira_resposta_em, NOW())`
+	case model.SLASolucao:
+		query = `UPDATE chamados 
+		 SET sla_solucao_violado = TRUE 
+		 WHERE id=? AND sla_solucao_violado = FALSE AND sla_pausado_em IS NULL 
+		 AND prazo_solucao < COALESCE(solucionado_em, NOW())`
+	default:
+		return false, nil
+	}
+
+	resultado, err := conexao(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return false, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao marcar violação de SLA do chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return false, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao marcar violação de SLA",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	return linhasAfetadas > 0, nil
+}
+
+// MarcarAvisoSLA registra o aviso de prazo de solução em risco caso ainda não tenha
+// sido registrado. A atualização condicional garante que cada prazo seja avisado uma única vez.
+func (r *MySQLChamadoRepository) MarcarAvisoSLA(ctx context.Context, id string) (bool, error) {
+	const metodo = "[MySQLChamadoRepository.MarcarAvisoSLA]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE chamados SET sla_aviso_em = NOW() WHERE id=? AND sla_aviso_em IS NULL`,
+		id,
+	)
+	if err != nil {
+		return false, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao marcar aviso de SLA do chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return false, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao marcar aviso de SLA",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	return linhasAfetadas > 0, nil
+}
+
 // Listar lista chamados com paginação e filtros opcionais.
 func (r *MySQLChamadoRepository) Listar(ctx context.Context, filtro model.ChamadoFiltro) ([]model.Chamado, int, error) {
 	var query strings.Builder
@@ -252,10 +540,7 @@ func (r *MySQLChamadoRepository) Listar(ctx context.Context, filtro model.Chamad
 
 	// Não trazer os arquivados por padrão
 	query.WriteString(
-		`SELECT SQL_CALC_FOUND_ROWS
-		id, titulo, descricao, status, criado_em, 
-		atualizado_em, solucionado_em, solucao, fechado_em, 
-		categoria_id, subcategoria_id, criador_id, arquivado
+		`SELECT SQL_CALC_FOUND_ROWS ` + colunasChamado + `
 		FROM chamados WHERE arquivado = FALSE`,
 	)
 
@@ -285,10 +570,45 @@ func (r *MySQLChamadoRepository) Listar(ctx context.Context, filtro model.Chamad
 		args = append(args, *filtro.CriadorID)
 	}
 
-	query.WriteString(" ORDER BY criado_em DESC LIMIT ? OFFSET ?")
+	if filtro.SLAPrimeiraRespostaViolado != nil {
+		query.WriteString(" AND " + exprSLAPrimeiraRespostaViolado + " = ?")
+		args = append(args, *filtro.SLAPrimeiraRespostaViolado)
+	}
+
+	if filtro.SLASolucaoViolado != nil {
+		query.WriteString(" AND " + exprSLASolucaoViolado + " = ?")
+		args = append(args, *filtro.SLASolucaoViolado)
+	}
+
+	if filtro.Impacto != nil && *filtro.Impacto != "" {
+		query.WriteString(" AND impacto = ?")
+		args = append(args, *filtro.Impacto)
+	}
+
+	if filtro.Urgencia != nil && *filtro.Urgencia != "" {
+		query.WriteString(" AND urgencia = ?")
+		args = append(args, *filtro.Urgencia)
+	}
+
+	if filtro.Prioridade != nil && *filtro.Prioridade != "" {
+		query.WriteString(" AND prioridade = ?")
+		args = append(args, *filtro.Prioridade)
+	}
+
+	if filtro.ReaberturasMin != nil {
+		query.WriteString(" AND reaberturas >= ?")
+		args = append(args, *filtro.ReaberturasMin)
+	}
+
+	if condicao, argsEscopo := condicaoEscopoChamados(filtro.Escopo); condicao != "" {
+		query.WriteString(" AND " + condicao)
+		args = append(args, argsEscopo...)
+	}
+
+	query.WriteString(" ORDER BY " + ordenacaoChamado(filtro) + " LIMIT ? OFFSET ?")
 	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
 
-	rows, err := r.db.QueryContext(ctx, query.String(), args...)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLChamadoRepository.Listar]",
@@ -309,7 +629,7 @@ func (r *MySQLChamadoRepository) Listar(ctx context.Context, filtro model.Chamad
 	}
 
 	var total int
-	err = r.db.QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total)
+	err = conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLChamadoRepository.Listar]",
@@ -322,11 +642,205 @@ func (r *MySQLChamadoRepository) Listar(ctx context.Context, filtro model.Chamad
 	return chamados, total, nil
 }
 
+// ListarResolvidosAntesDe lista os chamados não arquivados resolvidos até o instante limite.
+func (r *MySQLChamadoRepository) ListarResolvidosAntesDe(ctx context.Context, limite time.Time) ([]model.Chamado, error) {
+	chamados, err := r.listarPorStatusAntesDe(ctx, model.StatusResolvido, "solucionado_em", limite)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLChamadoRepository.ListarResolvidosAntesDe]: %w", err)
+	}
+	return chamados, nil
+}
+
+// ListarFechadosAntesDe lista os chamados não arquivados fechados até o instante limite.
+func (r *MySQLChamadoRepository) ListarFechadosAntesDe(ctx context.Context, limite time.Time) ([]model.Chamado, error) {
+	chamados, err := r.listarPorStatusAntesDe(ctx, model.StatusFechado, "fechado_em", limite)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLChamadoRepository.ListarFechadosAntesDe]: %w", err)
+	}
+	return chamados, nil
+}
+
+// ListarSLAEmRisco lista os chamados em atendimento, com o prazo de solução correndo e
+// ainda não avisados, cujo prazo de solução vence até o instante limite.
+func (r *MySQLChamadoRepository) ListarSLAEmRisco(ctx context.Context, limite time.Time) ([]model.Chamado, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT `+colunasChamado+`
+		FROM chamados
+		WHERE arquivado = FALSE AND status IN ('ABERTO', 'ATRIBUIDO')
+		AND sla_pausado_em IS NULL AND sla_solucao_violado = FALSE AND sla_aviso_em IS NULL
+		AND prazo_solucao IS NOT NULL AND prazo_solucao > NOW() AND prazo_solucao <= ?
+		ORDER BY prazo_solucao ASC, id ASC`,
+		limite,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLChamadoRepository.ListarSLAEmRisco]",
+			utils.LevelError,
+			"erro ao listar chamados com SLA em risco no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	chamados := []model.Chamado{}
+	for rows.Next() {
+		chamado, err := scanChamado(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[MySQLChamadoRepository.ListarSLAEmRisco]: %w", err)
+		}
+		chamados = append(chamados, *chamado)
+	}
+
+	return chamados, nil
+}
+
+// ListarSLAVencido lista os chamados em atendimento, com o SLA em curso, cujo prazo de
+// primeira resposta ou de solução venceu sem que a violação tenha sido registrada.
+func (r *MySQLChamadoRepository) ListarSLAVencido(ctx context.Context) ([]model.Chamado, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT `+colunasChamado+`
+		FROM chamados
+		WHERE arquivado = FALSE AND status IN ('ABERTO', 'ATRIBUIDO') AND sla_pausado_em IS NULL
+		AND (
+			(sla_primeira_resposta_violado = FALSE AND primeira_resposta_em IS NULL
+				AND prazo_primeira_resposta IS NOT NULL AND prazo_primeira_resposta < NOW())
+			OR (sla_solucao_violado = FALSE AND prazo_solucao IS NOT NULL AND prazo_solucao < NOW())
+		)
+		ORDER BY id ASC`,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLChamadoRepository.ListarSLAVencido]",
+			utils.LevelError,
+			"erro ao listar chamados com SLA vencido no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	chamados := []model.Chamado{}
+	for rows.Next() {
+		chamado, err := scanChamado(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[MySQLChamadoRepository.ListarSLAVencido]: %w", err)
+		}
+		chamados = append(chamados, *chamado)
+	}
+
+	return chamados, nil
+}
+
+// AgregarReaberturas conta os chamados solucionados e reabertos agrupados por técnico,
+// categoria ou mês de abertura. O técnico considerado é o do atendimento mais recente;
+// chamados sem atendimento não entram no agrupamento por técnico.
+func (r *MySQLChamadoRepository) AgregarReaberturas(ctx context.Context, filtro model.ReaberturaFiltro) ([]model.TotalReabertura, error) {
+	var chave, nome, juncao string
+	switch filtro.AgruparPor {
+	case model.ReaberturaPorCategoria:
+		chave, nome = "cat.id", "cat.nome"
+		juncao = `INNER JOIN categorias cat ON cat.id = c.categoria_id`
+	case model.ReaberturaPorMes:
+		chave = "DATE_FORMAT(c.criado_em, '%Y-%m')"
+		nome = chave
+	default:
+		chave, nome = "u.id", "u.nome"
+		juncao = `INNER JOIN atendimentos at ON at.chamado_id = c.id
+			AND at.criado_em = (SELECT MAX(a2.criado_em) FROM atendimentos a2 WHERE a2.chamado_id = c.id)
+		INNER JOIN usuarios u ON u.id = at.atribuido_id`
+	}
+
+	var query strings.Builder
+	args := []any{}
+
+	query.WriteString(`
+		SELECT ` + chave + `, ` + nome + `, COUNT(*),
+			SUM(CASE WHEN c.reaberturas > 0 THEN 1 ELSE 0 END),
+			COALESCE(SUM(c.reaberturas), 0)
+		FROM chamados c
+		` + juncao + `
+		WHERE (c.solucionado_em IS NOT NULL OR c.reaberturas > 0)
+	`)
+
+	if filtro.Inicio != nil {
+		query.WriteString(" AND c.criado_em >= ?")
+		args = append(args, *filtro.Inicio)
+	}
+
+	if filtro.Fim != nil {
+		query.WriteString(" AND c.criado_em < ?")
+		args = append(args, *filtro.Fim)
+	}
+
+	query.WriteString(" GROUP BY " + chave + ", " + nome + " ORDER BY 2 ASC")
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLChamadoRepository.AgregarReaberturas]",
+			utils.LevelError,
+			"erro ao agregar as reaberturas no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	totais := []model.TotalReabertura{}
+	for rows.Next() {
+		var t model.TotalReabertura
+		if err := rows.Scan(&t.ID, &t.Nome, &t.Solucionados, &t.Reabertos, &t.Reaberturas); err != nil {
+			return nil, utils.NewAppError(
+				"[MySQLChamadoRepository.AgregarReaberturas]",
+				utils.LevelError,
+				"o scanner falhou ao escanear a agregação das reaberturas",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerChamado, err),
+			)
+		}
+		totais = append(totais, t)
+	}
+
+	return totais, nil
+}
+
 // Métodos auxiliares
 
+// listarPorStatusAntesDe lista os chamados não arquivados no status informado cuja
+// coluna de data não ultrapassa o instante limite.
+func (r *MySQLChamadoRepository) listarPorStatusAntesDe(ctx context.Context, status model.StatusChamado, coluna string, limite time.Time) ([]model.Chamado, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT `+colunasChamado+`
+		FROM chamados
+		WHERE arquivado = FALSE AND status = ? AND `+coluna+` IS NOT NULL AND `+coluna+` <= ?
+		ORDER BY `+coluna+` ASC, id ASC`,
+		status, limite,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLChamadoRepository.listarPorStatusAntesDe]",
+			utils.LevelError,
+			"erro ao listar chamados por status no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	chamados := []model.Chamado{}
+	for rows.Next() {
+		chamado, err := scanChamado(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[MySQLChamadoRepository.listarPorStatusAntesDe]: %w", err)
+		}
+		chamados = append(chamados, *chamado)
+	}
+
+	return chamados, nil
+}
+
 // buscar é um método auxiliar para buscar um chamado com base em uma consulta SQL.
 func (r *MySQLChamadoRepository) buscar(ctx context.Context, query string, args ...any) (*model.Chamado, error) {
-	row := r.db.QueryRowContext(ctx, query, args...)
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
 	chamado, err := scanChamado(row)
 	if err != nil {
 		return nil, fmt.Errorf("[MySQLChamadoRepository.buscar]: %w", err)
@@ -337,7 +851,7 @@ func (r *MySQLChamadoRepository) buscar(ctx context.Context, query string, args
 // ExisteChamadoPorID verifica se um chamado existe pelo ID.
 func ExisteChamadoPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
 	var existe bool
-	err := db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM chamados WHERE id=?)`, id).Scan(&existe)
+	err := conexao(ctx, db).QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM chamados WHERE id=?)`, id).Scan(&existe)
 	if err != nil {
 		return false, utils.NewAppError(
 			"[MySQLChamadoRepository.ExisteChamadoPorID]",
@@ -354,6 +868,25 @@ func ExisteChamadoPorID(ctx context.Context, db *sql.DB, id string) (bool, error
 	return true, nil
 }
 
+// ordenacaoChamado monta a cláusula ORDER BY da listagem de chamados. Chamados
+// sem prazo ficam por último e o desempate é feito pelos mais antigos.
+func ordenacaoChamado(filtro model.ChamadoFiltro) string {
+	coluna, ok := colunasOrdenacaoChamado[filtro.OrdenarPor]
+	if !ok {
+		return "criado_em DESC"
+	}
+
+	direcao := "DESC"
+	if filtro.Ordem == model.OrdemAsc {
+		direcao = "ASC"
+	}
+
+	if coluna == "criado_em" {
+		return coluna + " " + direcao
+	}
+	return coluna + " IS NULL, " + coluna + " " + direcao + ", criado_em ASC"
+}
+
 // scanChamado mapeia os dados de um scanner (row ou rows) para uma struct Chamado.
 func scanChamado(scanner interface{ Scan(dest ...any) error }) (*model.Chamado, error) {
 	var chamado model.Chamado
@@ -371,6 +904,20 @@ func scanChamado(scanner interface{ Scan(dest ...any) error }) (*model.Chamado,
 		&chamado.SubcategoriaID,
 		&chamado.CriadorID,
 		&chamado.Arquivado,
+		&chamado.Impacto,
+		&chamado.Urgencia,
+		&chamado.Prioridade,
+		&chamado.PoliticaSLAID,
+		&chamado.PrazoPrimeiraResposta,
+		&chamado.PrazoSolucao,
+		&chamado.PrimeiraRespostaEm,
+		&chamado.SLAPausadoEm,
+		&chamado.AtribuidoEm,
+		&chamado.TempoAtribuidoSegundos,
+		&chamado.Reaberturas,
+		&chamado.ReabertoEm,
+		&chamado.SLAPrimeiraRespostaViolado,
+		&chamado.SLASolucaoViolado,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
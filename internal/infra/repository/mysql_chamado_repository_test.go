@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+func TestMySQLChamadoRepositoryAtualizarStatus(t *testing.T) {
+	solucao := "reinstalado o driver"
+
+	casos := []struct {
+		nome           string
+		existe         bool
+		linhasAfetadas int64
+		status         model.StatusChamado
+		solucao        *string
+		esperado       error
+	}{
+		{"chamado ainda no status de origem", true, 1, model.StatusAguardando, nil, nil},
+		{"resolução com solução", true, 1, model.StatusResolvido, &solucao, nil},
+		{"chamado saiu do status de origem", true, 0, model.StatusAguardando, nil, model.ErrTransicaoStatusInvalida},
+		{"chamado inexistente", false, 0, model.StatusAguardando, nil, ErrChamadoNaoEncontrado},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			banco := &bancoFake{
+				consultar: func(string, []any) ([]string, [][]driver.Value, error) {
+					return []string{"existe"}, [][]driver.Value{{c.existe}}, nil
+				},
+				executar: func(string, []any) (int64, error) {
+					return c.linhasAfetadas, nil
+				},
+			}
+			r := NewMySQLChamadoRepository(banco.abrir())
+
+			err := r.AtualizarStatus(context.Background(), "ch-1", string(model.StatusAtribuido), string(c.status), c.solucao)
+			if !errors.Is(err, c.esperado) || (c.esperado == nil && err != nil) {
+				t.Fatalf("AtualizarStatus = %v, esperado %v", err, c.esperado)
+			}
+			if !c.existe {
+				if len(banco.comandos) != 0 {
+					t.Errorf("comandos = %d, esperado nenhum para o chamado inexistente", len(banco.comandos))
+				}
+				return
+			}
+
+			// a atualização é condicionada ao status de origem
+			comando := banco.comandos[0]
+			if !strings.Contains(comando.query, "WHERE id=? AND status=?") {
+				t.Errorf("query = %q, esperado a condição pelo status de origem", comando.query)
+			}
+			if origem := comando.args[len(comando.args)-1]; origem != string(model.StatusAtribuido) {
+				t.Errorf("status de origem = %v, esperado %s", origem, model.StatusAtribuido)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerEmailRecebido = errors.New("erro ao escanear e-mail recebido do banco de dados MySQL")
+)
+
+// MySQLEmailRecebidoRepository é a implementação do repositório de e-mails recebidos para MySQL.
+type MySQLEmailRecebidoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLEmailRecebidoRepository cria uma nova instância de MySQLEmailRecebidoRepository.
+func NewMySQLEmailRecebidoRepository(db *sql.DB) *MySQLEmailRecebidoRepository {
+	return &MySQLEmailRecebidoRepository{db: db}
+}
+
+// BuscarPorMessageID retorna o registro da mensagem já processada, ou nil se ainda não foi.
+func (r *MySQLEmailRecebidoRepository) BuscarPorMessageID(ctx context.Context, messageID string) (*model.RegistroEmailRecebido, error) {
+	var registro model.RegistroEmailRecebido
+	err := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT message_id, chamado_id, acompanhamento_id, remetente_id, recebido_em, processado_em
+		FROM emails_recebidos WHERE message_id = ?`,
+		messageID,
+	).Scan(
+		&registro.MessageID,
+		&registro.ChamadoID,
+		&registro.AcompanhamentoID,
+		&registro.RemetenteID,
+		&registro.RecebidoEm,
+		&registro.ProcessadoEm,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLEmailRecebidoRepository.BuscarPorMessageID]",
+			utils.LevelError,
+			"erro ao buscar o e-mail recebido no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerEmailRecebido, err),
+		)
+	}
+
+	return &registro, nil
+}
+
+// Salvar registra a mensagem processada.
+func (r *MySQLEmailRecebidoRepository) Salvar(ctx context.Context, registro *model.RegistroEmailRecebido) error {
+	const metodo = "[MySQLEmailRecebidoRepository.Salvar]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO emails_recebidos (
+		message_id, chamado_id, acompanhamento_id, remetente_id, recebido_em, processado_em
+		) VALUES (?, ?, ?, ?, ?, ?)`,
+		registro.MessageID,
+		registro.ChamadoID,
+		registro.AcompanhamentoID,
+		registro.RemetenteID,
+		registro.RecebidoEm,
+		registro.ProcessadoEm,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar o e-mail recebido no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter número de linhas afetadas após salvar o e-mail recebido",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"nenhuma linha foi afetada ao salvar o e-mail recebido",
+			ErrExecContext,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,418 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerEntregaWebhook       = errors.New("erro ao scanear entrega de webhook do banco de dados MySQL")
+	ErrEntregaWebhookNaoEncontrada = errors.New("entrega de webhook não encontrada no banco de dados MySQL")
+)
+
+// colunasEntregaWebhook lista as colunas lidas por scanEntregaWebhook, na mesma ordem.
+const colunasEntregaWebhook = `id, webhook_id, reenvio_de_id, tipo_evento, chamado_id, payload, status,
+	tentativas, proxima_tentativa_em, ultimo_status_http, ultimo_erro, entregue_em, criado_em, atualizado_em`
+
+// colunasEntregaWebhookSemPayload lista as mesmas colunas de colunasEntregaWebhook, sem o
+// payload, para as listagens.
+const colunasEntregaWebhookSemPayload = `id, webhook_id, reenvio_de_id, tipo_evento, chamado_id, NULL, status,
+	tentativas, proxima_tentativa_em, ultimo_status_http, ultimo_erro, entregue_em, criado_em, atualizado_em`
+
+// MySQLEntregaWebhookRepository é a implementação da fila de entregas dos webhooks para o MySQL.
+type MySQLEntregaWebhookRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLEntregaWebhookRepository cria uma nova instância de MySQLEntregaWebhookRepository.
+func NewMySQLEntregaWebhookRepository(db *sql.DB) *MySQLEntregaWebhookRepository {
+	return &MySQLEntregaWebhookRepository{db: db}
+}
+
+// Salvar inclui as entregas na fila em uma única transação.
+func (r *MySQLEntregaWebhookRepository) Salvar(ctx context.Context, entregas []model.EntregaWebhook) error {
+	const metodo = "[MySQLEntregaWebhookRepository.Salvar]"
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao salvar entregas de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	for _, e := range entregas {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO entregas_webhook (
+			id, webhook_id, reenvio_de_id, tipo_evento, chamado_id, payload, status,
+			tentativas, proxima_tentativa_em, criado_em, atualizado_em
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			e.ID, e.WebhookID, e.ReenvioDeID, e.TipoEvento, e.ChamadoID, string(e.Payload), e.Status,
+			e.Tentativas, e.ProximaTentativaEm, e.CriadoEm, e.AtualizadoEm,
+		)
+		if err != nil {
+			return utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"erro ao salvar a entrega de webhook no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+			)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao salvar entregas de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// BuscarPorID busca uma entrega pelo seu ID, incluindo as tentativas realizadas.
+func (r *MySQLEntregaWebhookRepository) BuscarPorID(ctx context.Context, id string) (*model.EntregaWebhook, error) {
+	const metodo = "[MySQLEntregaWebhookRepository.BuscarPorID]"
+
+	row := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT `+colunasEntregaWebhook+`
+		FROM entregas_webhook
+		WHERE id = ?`,
+		id,
+	)
+	entrega, err := scanEntregaWebhook(row)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	if entrega == nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"a busca por ID não retornou resultados",
+			ErrEntregaWebhookNaoEncontrada,
+		)
+	}
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT numero, status_http, erro, duracao_ms, realizada_em
+		FROM tentativas_webhook
+		WHERE entrega_id = ?
+		ORDER BY numero ASC`,
+		id,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar as tentativas da entrega de webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	entrega.TentativasRealizadas = []model.TentativaEntregaWebhook{}
+	for rows.Next() {
+		var t model.TentativaEntregaWebhook
+		if err := rows.Scan(&t.Numero, &t.StatusHTTP, &t.Erro, &t.DuracaoMs, &t.RealizadaEm); err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao scanear a tentativa da entrega de webhook",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerEntregaWebhook, err),
+			)
+		}
+		entrega.TentativasRealizadas = append(entrega.TentativasRealizadas, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre as tentativas da entrega de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return entrega, nil
+}
+
+// Listar lista as entregas com paginação e filtros opcionais, das mais recentes às mais antigas.
+// O payload não é incluído na listagem.
+func (r *MySQLEntregaWebhookRepository) Listar(ctx context.Context, filtro model.EntregaWebhookFiltro) ([]model.EntregaWebhook, int, error) {
+	const metodo = "[MySQLEntregaWebhookRepository.Listar]"
+
+	var query strings.Builder
+	args := []any{}
+
+	query.WriteString(
+		`SELECT SQL_CALC_FOUND_ROWS ` + colunasEntregaWebhookSemPayload + `
+		FROM entregas_webhook
+		WHERE 1=1`,
+	)
+
+	if filtro.WebhookID != nil && *filtro.WebhookID != "" {
+		query.WriteString(" AND webhook_id = ?")
+		args = append(args, *filtro.WebhookID)
+	}
+
+	if filtro.ChamadoID != nil && *filtro.ChamadoID != "" {
+		query.WriteString(" AND chamado_id = ?")
+		args = append(args, *filtro.ChamadoID)
+	}
+
+	if filtro.TipoEvento != nil && *filtro.TipoEvento != "" {
+		query.WriteString(" AND tipo_evento = ?")
+		args = append(args, *filtro.TipoEvento)
+	}
+
+	if filtro.Status != nil && *filtro.Status != "" {
+		query.WriteString(" AND status = ?")
+		args = append(args, *filtro.Status)
+	}
+
+	query.WriteString(" ORDER BY criado_em DESC LIMIT ? OFFSET ?")
+	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar entregas de webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	entregas := []model.EntregaWebhook{}
+	for rows.Next() {
+		entrega, err := scanEntregaWebhook(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", metodo, err)
+		}
+		entregas = append(entregas, *entrega)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os resultados de entregas de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	var total int
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+		return nil, 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter total de entregas de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+
+	return entregas, total, nil
+}
+
+// ReservarPendentes retorna até limite entregas pendentes cuja próxima tentativa já venceu,
+// adiando-as pela duração da reserva. As linhas bloqueadas por outra instância são ignoradas,
+// e uma entrega reservada por uma instância interrompida volta à fila ao fim da reserva.
+func (r *MySQLEntregaWebhookRepository) ReservarPendentes(ctx context.Context, limite int, reserva time.Duration) ([]model.EntregaWebhook, error) {
+	const metodo = "[MySQLEntregaWebhookRepository.ReservarPendentes]"
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao reservar entregas de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	agora := time.Now()
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT `+colunasEntregaWebhook+`
+		FROM entregas_webhook
+		WHERE status = ? AND proxima_tentativa_em <= ?
+		ORDER BY proxima_tentativa_em ASC
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED`,
+		model.EntregaWebhookPendente, agora, limite,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao buscar entregas de webhook pendentes no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	entregas := []model.EntregaWebhook{}
+	for rows.Next() {
+		entrega, err := scanEntregaWebhook(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		entregas = append(entregas, *entrega)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre as entregas de webhook pendentes",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	reservadaAte := agora.Add(reserva)
+	for i := range entregas {
+		_, err := tx.ExecContext(
+			ctx,
+			`UPDATE entregas_webhook SET proxima_tentativa_em = ? WHERE id = ?`,
+			reservadaAte, entregas[i].ID,
+		)
+		if err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"erro ao reservar a entrega de webhook no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+			)
+		}
+		entregas[i].ProximaTentativaEm = &reservadaAte
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao reservar entregas de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return entregas, nil
+}
+
+// RegistrarTentativa salva a situação da entrega e a tentativa realizada na mesma transação.
+func (r *MySQLEntregaWebhookRepository) RegistrarTentativa(ctx context.Context, e *model.EntregaWebhook, t *model.TentativaEntregaWebhook) error {
+	const metodo = "[MySQLEntregaWebhookRepository.RegistrarTentativa]"
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao registrar tentativa de entrega de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE entregas_webhook
+		SET status = ?, tentativas = ?, proxima_tentativa_em = ?, ultimo_status_http = ?,
+		ultimo_erro = ?, entregue_em = ?, atualizado_em = ?
+		WHERE id = ?`,
+		e.Status, e.Tentativas, e.ProximaTentativaEm, e.UltimoStatusHTTP,
+		e.UltimoErro, e.EntregueEm, e.AtualizadoEm, e.ID,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao atualizar a entrega de webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO tentativas_webhook (entrega_id, numero, status_http, erro, duracao_ms, realizada_em)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		e.ID, t.Numero, t.StatusHTTP, t.Erro, t.DuracaoMs, t.RealizadaEm,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar a tentativa de entrega de webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao registrar tentativa de entrega de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// Metodos auxiliares
+
+// scanEntregaWebhook mapeia os dados de um scanner (row ou rows) para uma struct EntregaWebhook.
+func scanEntregaWebhook(scanner interface{ Scan(dest ...any) error }) (*model.EntregaWebhook, error) {
+	var entrega model.EntregaWebhook
+	var payload sql.NullString
+	err := scanner.Scan(
+		&entrega.ID,
+		&entrega.WebhookID,
+		&entrega.ReenvioDeID,
+		&entrega.TipoEvento,
+		&entrega.ChamadoID,
+		&payload,
+		&entrega.Status,
+		&entrega.Tentativas,
+		&entrega.ProximaTentativaEm,
+		&entrega.UltimoStatusHTTP,
+		&entrega.UltimoErro,
+		&entrega.EntregueEm,
+		&entrega.CriadoEm,
+		&entrega.AtualizadoEm,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLEntregaWebhookRepository.scanEntregaWebhook]",
+			utils.LevelError,
+			"o scanner falhou ao scanear a entrega de webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerEntregaWebhook, err),
+		)
+	}
+
+	if payload.Valid {
+		entrega.Payload = []byte(payload.String)
+	}
+	return &entrega, nil
+}
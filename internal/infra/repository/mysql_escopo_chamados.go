@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// condicaoEscopoChamados retorna a condição SQL, sobre a tabela chamados, que restringe a
+// consulta aos chamados do escopo, e os argumentos da condição. O escopo irrestrito não
+// gera condição.
+func condicaoEscopoChamados(escopo *model.EscopoChamados) (string, []any) {
+	if escopo.Irrestrito() {
+		return "", nil
+	}
+
+	condicao := `(chamados.criador_id = ?
+		OR EXISTS (SELECT 1 FROM observadores WHERE observadores.chamado_id = chamados.id AND observadores.usuario_id = ?)
+		OR EXISTS (SELECT 1 FROM mencoes WHERE mencoes.chamado_id = chamados.id AND mencoes.usuario_id = ?)`
+	args := []any{escopo.UsuarioID, escopo.UsuarioID, escopo.UsuarioID}
+
+	if escopo.PorCategoria() {
+		condicao += `
+		OR EXISTS (SELECT 1 FROM categoria_permissoes WHERE categoria_permissoes.categoria_id = chamados.categoria_id AND categoria_permissoes.usuario_id = ?)
+		OR EXISTS (SELECT 1 FROM atendimentos WHERE atendimentos.chamado_id = chamados.id AND atendimentos.atribuido_id = ?)`
+		args = append(args, escopo.UsuarioID, escopo.UsuarioID)
+	} else {
+		condicao += `
+		OR EXISTS (SELECT 1 FROM categoria_permissoes WHERE categoria_permissoes.categoria_id = chamados.categoria_id AND categoria_permissoes.usuario_id = ? AND categoria_permissoes.permissao <> 'USR')`
+		args = append(args, escopo.UsuarioID)
+	}
+
+	return condicao + ")", args
+}
+
+// condicaoChamadoNoEscopo retorna a condição SQL que restringe a coluna com o ID do chamado
+// aos chamados do escopo, e os argumentos da condição. O escopo irrestrito não gera condição.
+func condicaoChamadoNoEscopo(coluna string, escopo *model.EscopoChamados) (string, []any) {
+	condicao, args := condicaoEscopoChamados(escopo)
+	if condicao == "" {
+		return "", nil
+	}
+	return coluna + " IN (SELECT chamados.id FROM chamados WHERE " + condicao + ")", args
+}
@@ -31,7 +31,7 @@ func NewMySQLLogRepository(db *sql.DB) *MySQLLogRepository {
 func (r *MySQLLogRepository) BuscarPorID(ctx context.Context, id string) (*model.Log, error) {
 	usuario, err := r.Buscar(
 		ctx,
-		`SELECT id, usuario_id, acao, entidade, detalhes, criado_em
+		`SELECT id, usuario_id, acao, entidade, chamado_id, detalhes, criado_em
 		FROM logs 
 		WHERE id = ?`,
 		id,
@@ -57,12 +57,12 @@ func (r *MySQLLogRepository) BuscarPorID(ctx context.Context, id string) (*model
 func (r *MySQLLogRepository) Salvar(ctx context.Context, l *model.Log) error {
 	const metodo = "[MySQLLogRepository.Salvar]"
 
-	resultado, err := r.db.ExecContext(
+	resultado, err := conexao(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO logs (
-		id, usuario_id, acao, entidade, detalhes, criado_em
-		) VALUES (?, ?, ?, ?, ?, NOW())`,
-		l.ID, l.UsuarioID, l.Acao, l.Entidade, l.Detalhes,
+		id, usuario_id, acao, entidade, chamado_id, detalhes, criado_em
+		) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		l.ID, l.UsuarioID, l.Acao, l.Entidade, l.ChamadoID, l.Detalhes, l.CriadoEm,
 	)
 	if err != nil {
 		return utils.NewAppError(
@@ -101,7 +101,7 @@ func (r *MySQLLogRepository) Listar(ctx context.Context, filtro model.LogFiltro)
 
 	query.WriteString(
 		`SELECT SQL_CALC_FOUND_ROWS
-		id, usuario_id, acao, entidade, detalhes, criado_em
+		id, usuario_id, acao, entidade, chamado_id, detalhes, criado_em
 		FROM logs 
 		WHERE 1=1`,
 	)
@@ -137,10 +137,16 @@ func (r *MySQLLogRepository) Listar(ctx context.Context, filtro model.LogFiltro)
 		args = append(args, *filtro.DataFim)
 	}
 
+	// os logs que não se referem a um chamado não são restringidos pelo escopo
+	if condicao, argsEscopo := condicaoChamadoNoEscopo("chamado_id", filtro.Escopo); condicao != "" {
+		query.WriteString(" AND (chamado_id IS NULL OR " + condicao + ")")
+		args = append(args, argsEscopo...)
+	}
+
 	query.WriteString(" ORDER BY criado_em DESC LIMIT ? OFFSET ?")
 	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
 
-	rows, err := r.db.QueryContext(ctx, query.String(), args...)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLLogRepository.Listar]",
@@ -161,7 +167,7 @@ func (r *MySQLLogRepository) Listar(ctx context.Context, filtro model.LogFiltro)
 	}
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLLogRepository.Listar]",
 			utils.LevelError,
@@ -177,7 +183,7 @@ func (r *MySQLLogRepository) Listar(ctx context.Context, filtro model.LogFiltro)
 
 // Buscar executa uma consulta que retorna um log.
 func (r *MySQLLogRepository) Buscar(ctx context.Context, query string, args ...any) (*model.Log, error) {
-row := r.db.QueryRowContext(ctx, query, args...)
+row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
 	log, err := scanLog(row)
 	if err != nil {
 		return nil, fmt.Errorf("[MySQLLogRepository.Buscar]: %w", err)
@@ -193,6 +199,7 @@ func scanLog(scanner interface{ Scan(dest ...any) error }) (*model.Log, error) {
 		&log.UsuarioID,
 		&log.Acao,
 		&log.Entidade,
+		&log.ChamadoID,
 		&log.Detalhes,
 		&log.CriadoEm,
 	)
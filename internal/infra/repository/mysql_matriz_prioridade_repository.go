@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerMatrizPrioridade = errors.New("erro ao scanear matriz de prioridade do banco de dados MySQL")
+)
+
+// MySQLMatrizPrioridadeRepository é a implementação do repositório da matriz de prioridade para o MySQL.
+type MySQLMatrizPrioridadeRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLMatrizPrioridadeRepository cria uma nova instância de MySQLMatrizPrioridadeRepository.
+func NewMySQLMatrizPrioridadeRepository(db *sql.DB) *MySQLMatrizPrioridadeRepository {
+	return &MySQLMatrizPrioridadeRepository{db: db}
+}
+
+// Buscar retorna todas as combinações da matriz de prioridade.
+func (r *MySQLMatrizPrioridadeRepository) Buscar(ctx context.Context) (model.MatrizPrioridade, error) {
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT impacto, urgencia, prioridade, atualizado_em
+		FROM matriz_prioridades
+		ORDER BY impacto DESC, urgencia DESC`,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"[MySQLMatrizPrioridadeRepository.Buscar]",
+			utils.LevelError,
+			"falha ao buscar a matriz de prioridade no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	matriz := model.MatrizPrioridade{}
+	for rows.Next() {
+		var celula model.CelulaMatrizPrioridade
+		err := rows.Scan(&celula.Impacto, &celula.Urgencia, &celula.Prioridade, &celula.AtualizadoEm)
+		if err != nil {
+			return nil, utils.NewAppError(
+				"[MySQLMatrizPrioridadeRepository.Buscar]",
+				utils.LevelError,
+				"o scanner falhou ao scanear a matriz de prioridade",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerMatrizPrioridade, err),
+			)
+		}
+		matriz = append(matriz, celula)
+	}
+
+	return matriz, nil
+}
+
+// Salvar inclui ou atualiza as combinações informadas na matriz de prioridade.
+func (r *MySQLMatrizPrioridadeRepository) Salvar(ctx context.Context, matriz model.MatrizPrioridade) error {
+	if len(matriz) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	args := make([]any, 0, len(matriz)*3)
+
+	query.WriteString(`INSERT INTO matriz_prioridades (impacto, urgencia, prioridade, atualizado_em) VALUES `)
+	for i, c := range matriz {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, NOW())")
+		args = append(args, c.Impacto, c.Urgencia, c.Prioridade)
+	}
+	query.WriteString(` ON DUPLICATE KEY UPDATE prioridade = VALUES(prioridade), atualizado_em = NOW()`)
+
+	_, err := conexao(ctx, r.db).ExecContext(ctx, query.String(), args...)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLMatrizPrioridadeRepository.Salvar]",
+			utils.LevelError,
+			"falha ao salvar a matriz de prioridade no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerMencao = errors.New("erro ao scanear menção do banco de dados MySQL")
+)
+
+// MySQLMencaoRepository é a implementação do repositório de menções para o MySQL.
+type MySQLMencaoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLMencaoRepository cria uma nova instância de MySQLMencaoRepository.
+func NewMySQLMencaoRepository(db *sql.DB) *MySQLMencaoRepository {
+	return &MySQLMencaoRepository{db: db}
+}
+
+// Salvar registra as menções, ignorando as que já foram registradas no acompanhamento.
+func (r *MySQLMencaoRepository) Salvar(ctx context.Context, mencoes []model.Mencao) error {
+	for _, m := range mencoes {
+		_, err := conexao(ctx, r.db).ExecContext(
+			ctx,
+			`INSERT IGNORE INTO mencoes (acompanhamento_id, usuario_id, chamado_id, criado_em)
+			VALUES (?, ?, ?, ?)`,
+			m.AcompanhamentoID, m.UsuarioID, m.ChamadoID, m.CriadoEm,
+		)
+		if err != nil {
+			return utils.NewAppError(
+				"[MySQLMencaoRepository.Salvar]",
+				utils.LevelError,
+				"erro ao salvar a menção no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// ListarPorAcompanhamento retorna as menções registradas no acompanhamento, na ordem de registro.
+func (r *MySQLMencaoRepository) ListarPorAcompanhamento(ctx context.Context, acompanhamentoID string) ([]model.Mencao, error) {
+	const metodo = "[MySQLMencaoRepository.ListarPorAcompanhamento]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT acompanhamento_id, chamado_id, usuario_id, criado_em
+		FROM mencoes
+		WHERE acompanhamento_id = ?
+		ORDER BY criado_em ASC`,
+		acompanhamentoID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar menções no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	mencoes := []model.Mencao{}
+	for rows.Next() {
+		var m model.Mencao
+		if err := rows.Scan(&m.AcompanhamentoID, &m.ChamadoID, &m.UsuarioID, &m.CriadoEm); err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao scanear a menção",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerMencao, err),
+			)
+		}
+		mencoes = append(mencoes, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os resultados de menções",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return mencoes, nil
+}
+
+// ListarChamadosMencionado retorna os IDs dos chamados em que o usuário foi mencionado.
+func (r *MySQLMencaoRepository) ListarChamadosMencionado(ctx context.Context, usuarioID string) ([]string, error) {
+	const metodo = "[MySQLMencaoRepository.ListarChamadosMencionado]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT DISTINCT chamado_id FROM mencoes WHERE usuario_id = ?`,
+		usuarioID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar os chamados com menções ao usuário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	chamados := []string{}
+	for rows.Next() {
+		var chamadoID string
+		if err := rows.Scan(&chamadoID); err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao scanear o chamado da menção",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerMencao, err),
+			)
+		}
+		chamados = append(chamados, chamadoID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os chamados com menções ao usuário",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return chamados, nil
+}
+
+// UsuarioMencionado indica se o usuário foi mencionado em algum acompanhamento do chamado.
+func (r *MySQLMencaoRepository) UsuarioMencionado(ctx context.Context, chamadoID, usuarioID string) (bool, error) {
+	var mencionado bool
+	err := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT EXISTS(SELECT 1 FROM mencoes WHERE chamado_id = ? AND usuario_id = ?)`,
+		chamadoID, usuarioID,
+	).Scan(&mencionado)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLMencaoRepository.UsuarioMencionado]",
+			utils.LevelError,
+			"erro ao verificar a menção ao usuário no chamado",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+
+	return mencionado, nil
+}
@@ -0,0 +1,270 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerNotificacaoUsuario = errors.New("erro ao scanear notificação do banco de dados MySQL")
+	ErrNotificacaoNaoEncontrada  = errors.New("notificação não encontrada no banco de dados MySQL")
+)
+
+// colunasNotificacaoUsuario são as colunas lidas por scanNotificacaoUsuario, na ordem do scan.
+const colunasNotificacaoUsuario = `id, usuario_id, tipo, chamado_id, acompanhamento_id, mensagem, lida_em, criado_em`
+
+// MySQLNotificacaoUsuarioRepository é a implementação da central de notificações para o MySQL.
+type MySQLNotificacaoUsuarioRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLNotificacaoUsuarioRepository cria uma nova instância de MySQLNotificacaoUsuarioRepository.
+func NewMySQLNotificacaoUsuarioRepository(db *sql.DB) *MySQLNotificacaoUsuarioRepository {
+	return &MySQLNotificacaoUsuarioRepository{db: db}
+}
+
+// Salvar insere uma nova notificação.
+func (r *MySQLNotificacaoUsuarioRepository) Salvar(ctx context.Context, n *model.NotificacaoUsuario) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO notificacoes (id, usuario_id, tipo, chamado_id, acompanhamento_id, mensagem, criado_em)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		n.ID, n.UsuarioID, n.Tipo, n.ChamadoID, n.AcompanhamentoID, n.Mensagem, n.CriadoEm,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLNotificacaoUsuarioRepository.Salvar]",
+			utils.LevelError,
+			"erro ao salvar a notificação no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// BuscarPorID busca uma notificação pelo seu ID.
+func (r *MySQLNotificacaoUsuarioRepository) BuscarPorID(ctx context.Context, id string) (*model.NotificacaoUsuario, error) {
+	row := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT `+colunasNotificacaoUsuario+`
+		FROM notificacoes
+		WHERE id = ?`,
+		id,
+	)
+	notificacao, err := scanNotificacaoUsuario(row)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLNotificacaoUsuarioRepository.BuscarPorID]: %w", err)
+	}
+
+	if notificacao == nil {
+		return nil, utils.NewAppError(
+			"[MySQLNotificacaoUsuarioRepository.BuscarPorID]",
+			utils.LevelInfo,
+			"a busca por ID não retornou resultados",
+			ErrNotificacaoNaoEncontrada,
+		)
+	}
+
+	return notificacao, nil
+}
+
+// Listar retorna as notificações do usuário com paginação, das mais recentes às mais antigas.
+func (r *MySQLNotificacaoUsuarioRepository) Listar(ctx context.Context, filtro model.NotificacaoUsuarioFiltro) ([]model.NotificacaoUsuario, int, error) {
+	const metodo = "[MySQLNotificacaoUsuarioRepository.Listar]"
+
+	var query strings.Builder
+	args := []any{filtro.UsuarioID}
+
+	query.WriteString(
+		`SELECT SQL_CALC_FOUND_ROWS ` + colunasNotificacaoUsuario + `
+		FROM notificacoes
+		WHERE usuario_id = ?`,
+	)
+
+	if filtro.Lida != nil {
+		if *filtro.Lida {
+			query.WriteString(" AND lida_em IS NOT NULL")
+		} else {
+			query.WriteString(" AND lida_em IS NULL")
+		}
+	}
+
+	query.WriteString(" ORDER BY criado_em DESC, id DESC LIMIT ? OFFSET ?")
+	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar notificações no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	notificacoes := []model.NotificacaoUsuario{}
+	for rows.Next() {
+		notificacao, err := scanNotificacaoUsuario(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", metodo, err)
+		}
+		notificacoes = append(notificacoes, *notificacao)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os resultados de notificações",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	var total int
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+		return nil, 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter total de notificações",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+
+	return notificacoes, total, nil
+}
+
+// ContarNaoLidas retorna a quantidade de notificações não lidas do usuário.
+func (r *MySQLNotificacaoUsuarioRepository) ContarNaoLidas(ctx context.Context, usuarioID string) (int, error) {
+	var total int
+	err := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM notificacoes WHERE usuario_id = ? AND lida_em IS NULL`,
+		usuarioID,
+	).Scan(&total)
+	if err != nil {
+		return 0, utils.NewAppError(
+			"[MySQLNotificacaoUsuarioRepository.ContarNaoLidas]",
+			utils.LevelError,
+			"erro ao contar as notificações não lidas",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+
+	return total, nil
+}
+
+// MarcarLida marca a notificação como lida, mantendo a data de uma leitura anterior.
+func (r *MySQLNotificacaoUsuarioRepository) MarcarLida(ctx context.Context, id string) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE notificacoes SET lida_em = ? WHERE id = ? AND lida_em IS NULL`,
+		time.Now(), id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLNotificacaoUsuarioRepository.MarcarLida]",
+			utils.LevelError,
+			"erro ao marcar a notificação como lida no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// MarcarTodasLidas marca como lidas as notificações não lidas do usuário e retorna quantas foram marcadas.
+func (r *MySQLNotificacaoUsuarioRepository) MarcarTodasLidas(ctx context.Context, usuarioID string) (int, error) {
+	const metodo = "[MySQLNotificacaoUsuarioRepository.MarcarTodasLidas]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE notificacoes SET lida_em = ? WHERE usuario_id = ? AND lida_em IS NULL`,
+		time.Now(), usuarioID,
+	)
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao marcar as notificações como lidas no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao marcar as notificações como lidas",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	return int(linhasAfetadas), nil
+}
+
+// RemoverAnteriores exclui as notificações criadas antes do limite e retorna quantas foram excluídas.
+func (r *MySQLNotificacaoUsuarioRepository) RemoverAnteriores(ctx context.Context, limite time.Time) (int, error) {
+	const metodo = "[MySQLNotificacaoUsuarioRepository.RemoverAnteriores]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(ctx, `DELETE FROM notificacoes WHERE criado_em < ?`, limite)
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao remover as notificações antigas do banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao remover as notificações antigas",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	return int(linhasAfetadas), nil
+}
+
+// Metodos auxiliares
+
+// scanNotificacaoUsuario mapeia os dados de um scanner (row ou rows) para uma struct NotificacaoUsuario.
+func scanNotificacaoUsuario(scanner interface{ Scan(dest ...any) error }) (*model.NotificacaoUsuario, error) {
+	var notificacao model.NotificacaoUsuario
+	err := scanner.Scan(
+		&notificacao.ID,
+		&notificacao.UsuarioID,
+		&notificacao.Tipo,
+		&notificacao.ChamadoID,
+		&notificacao.AcompanhamentoID,
+		&notificacao.Mensagem,
+		&notificacao.LidaEm,
+		&notificacao.CriadoEm,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLNotificacaoUsuarioRepository.scanNotificacaoUsuario]",
+			utils.LevelError,
+			"o scanner falhou ao scanear a notificação",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerNotificacaoUsuario, err),
+		)
+	}
+
+	return &notificacao, nil
+}
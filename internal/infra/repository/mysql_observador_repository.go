@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerObservador       = errors.New("erro ao escanear observador do banco de dados MySQL")
+	ErrObservadorNaoEncontrado = errors.New("observador não encontrado no banco de dados MySQL")
+)
+
+// MySQLObservadorRepository é a implementação do repositório de observadores para MySQL.
+type MySQLObservadorRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLObservadorRepository cria uma nova instância de MySQLObservadorRepository.
+func NewMySQLObservadorRepository(db *sql.DB) *MySQLObservadorRepository {
+	return &MySQLObservadorRepository{db: db}
+}
+
+// Salvar registra o observador, ignorando-o se já observa o chamado.
+func (r *MySQLObservadorRepository) Salvar(ctx context.Context, o *model.Observador) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT IGNORE INTO observadores (chamado_id, usuario_id, adicionado_por_id, criado_em)
+		VALUES (?, ?, ?, ?)`,
+		o.ChamadoID, o.UsuarioID, o.AdicionadoPorID, o.CriadoEm,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLObservadorRepository.Salvar]",
+			utils.LevelError,
+			"erro ao salvar o observador no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// Remover retira o usuário dos observadores do chamado.
+func (r *MySQLObservadorRepository) Remover(ctx context.Context, chamadoID, usuarioID string) error {
+	const metodo = "[MySQLObservadorRepository.Remover]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`DELETE FROM observadores WHERE chamado_id = ? AND usuario_id = ?`,
+		chamadoID, usuarioID,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao remover o observador no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter número de linhas afetadas após remover o observador",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			fmt.Sprintf("o usuário %s não observa o chamado %s", usuarioID, chamadoID),
+			ErrObservadorNaoEncontrado,
+		)
+	}
+
+	return nil
+}
+
+// ListarPorChamado retorna os observadores do chamado, do mais antigo ao mais recente.
+func (r *MySQLObservadorRepository) ListarPorChamado(ctx context.Context, chamadoID string) ([]model.Observador, error) {
+	const metodo = "[MySQLObservadorRepository.ListarPorChamado]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT chamado_id, usuario_id, adicionado_por_id, criado_em
+		FROM observadores
+		WHERE chamado_id = ?
+		ORDER BY criado_em ASC, usuario_id ASC`,
+		chamadoID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao listar os observadores do chamado no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	observadores := []model.Observador{}
+	for rows.Next() {
+		var o model.Observador
+		if err := rows.Scan(&o.ChamadoID, &o.UsuarioID, &o.AdicionadoPorID, &o.CriadoEm); err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao scanear o observador",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerObservador, err),
+			)
+		}
+		observadores = append(observadores, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os observadores do chamado",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return observadores, nil
+}
+
+// ListarChamadosObservados retorna os IDs dos chamados que o usuário observa.
+func (r *MySQLObservadorRepository) ListarChamadosObservados(ctx context.Context, usuarioID string) ([]string, error) {
+	const metodo = "[MySQLObservadorRepository.ListarChamadosObservados]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT chamado_id FROM observadores WHERE usuario_id = ?`,
+		usuarioID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao listar os chamados observados pelo usuário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	chamados := []string{}
+	for rows.Next() {
+		var chamadoID string
+		if err := rows.Scan(&chamadoID); err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao scanear o chamado observado",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerObservador, err),
+			)
+		}
+		chamados = append(chamados, chamadoID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os chamados observados pelo usuário",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return chamados, nil
+}
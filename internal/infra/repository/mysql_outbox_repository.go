@@ -0,0 +1,314 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerOutbox               = errors.New("erro ao scanear mensagem da outbox do banco de dados MySQL")
+	ErrConteudoOutbox              = errors.New("erro ao converter o conteúdo da mensagem da outbox")
+	ErrMensagemOutboxNaoEncontrada = errors.New("mensagem da outbox não encontrada no banco de dados MySQL")
+)
+
+// eventoOutbox é a forma gravada do evento de domínio, incluindo os campos de visibilidade
+// que não são expostos aos clientes.
+type eventoOutbox struct {
+	Tipo             model.TipoEvento `json:"tipo"`
+	ChamadoID        string           `json:"chamadoId"`
+	Dados            json.RawMessage  `json:"dados,omitempty"`
+	CriadoEm         time.Time        `json:"criadoEm"`
+	CriadorChamadoID string           `json:"criadorChamadoId"`
+	CategoriaID      string           `json:"categoriaId"`
+	Interno          bool             `json:"interno"`
+}
+
+// MySQLOutboxRepository é a implementação da outbox para o MySQL.
+type MySQLOutboxRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLOutboxRepository cria uma nova instância de MySQLOutboxRepository.
+func NewMySQLOutboxRepository(db *sql.DB) *MySQLOutboxRepository {
+	return &MySQLOutboxRepository{db: db}
+}
+
+// Salvar grava a mensagem, participando da unidade de trabalho em andamento no contexto.
+func (r *MySQLOutboxRepository) Salvar(ctx context.Context, m *model.MensagemOutbox) error {
+	const metodo = "[MySQLOutboxRepository.Salvar]"
+
+	conteudo, err := codificarConteudoOutbox(m)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao converter a mensagem da outbox",
+			fmt.Errorf(utils.FmtErroWrap, ErrConteudoOutbox, err),
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO outbox (id, destino, conteudo, tentativas, disponivel_em, criado_em)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		m.ID, m.Destino, conteudo, m.Tentativas, m.DisponivelEm, m.CriadoEm,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar a mensagem da outbox no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// ReservarPendentes retorna até limite mensagens disponíveis, na ordem de gravação, adiando-as
+// pela duração da reserva. As linhas bloqueadas por outra instância são ignoradas, e uma
+// mensagem reservada por uma instância interrompida volta a ficar disponível ao fim da reserva.
+func (r *MySQLOutboxRepository) ReservarPendentes(ctx context.Context, limite int, reserva time.Duration) ([]model.MensagemOutbox, error) {
+	const metodo = "[MySQLOutboxRepository.ReservarPendentes]"
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao reservar mensagens da outbox",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	agora := time.Now()
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT id, destino, conteudo, tentativas, disponivel_em, ultimo_erro, criado_em
+		FROM outbox
+		WHERE disponivel_em <= ?
+		ORDER BY id ASC
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED`,
+		agora, limite,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao buscar mensagens da outbox no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	mensagens := []model.MensagemOutbox{}
+	for rows.Next() {
+		mensagem, err := scanMensagemOutbox(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		mensagens = append(mensagens, *mensagem)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre as mensagens da outbox",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	reservadaAte := agora.Add(reserva)
+	for i := range mensagens {
+		_, err := tx.ExecContext(
+			ctx,
+			`UPDATE outbox SET disponivel_em = ? WHERE id = ?`,
+			reservadaAte, mensagens[i].ID,
+		)
+		if err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"erro ao reservar a mensagem da outbox no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+			)
+		}
+		mensagens[i].DisponivelEm = reservadaAte
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao reservar mensagens da outbox",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return mensagens, nil
+}
+
+// Remover exclui a mensagem publicada.
+func (r *MySQLOutboxRepository) Remover(ctx context.Context, id string) error {
+	const metodo = "[MySQLOutboxRepository.Remover]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(ctx, `DELETE FROM outbox WHERE id = ?`, id)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao remover a mensagem da outbox do banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao remover a mensagem da outbox",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"a mensagem da outbox já foi removida",
+			ErrMensagemOutboxNaoEncontrada,
+		)
+	}
+
+	return nil
+}
+
+// RegistrarFalha salva as tentativas, o erro e a nova disponibilidade da mensagem.
+func (r *MySQLOutboxRepository) RegistrarFalha(ctx context.Context, m *model.MensagemOutbox) error {
+	const metodo = "[MySQLOutboxRepository.RegistrarFalha]"
+
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE outbox
+		SET tentativas = ?, disponivel_em = ?, ultimo_erro = ?
+		WHERE id = ?`,
+		m.Tentativas, m.DisponivelEm, m.UltimoErro, m.ID,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao registrar a falha da mensagem da outbox no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// Metodos auxiliares
+
+// codificarConteudoOutbox converte o log ou o evento da mensagem para o JSON gravado.
+func codificarConteudoOutbox(m *model.MensagemOutbox) (string, error) {
+	if err := model.ValidarDestinoOutbox(m.Destino); err != nil {
+		return "", err
+	}
+
+	var conteudo any = m.Log
+	if m.Destino == model.OutboxEvento {
+		dados, err := json.Marshal(m.Evento.Dados)
+		if err != nil {
+			return "", err
+		}
+		conteudo = eventoOutbox{
+			Tipo:             m.Evento.Tipo,
+			ChamadoID:        m.Evento.ChamadoID,
+			Dados:            dados,
+			CriadoEm:         m.Evento.CriadoEm,
+			CriadorChamadoID: m.Evento.CriadorChamadoID,
+			CategoriaID:      m.Evento.CategoriaID,
+			Interno:          m.Evento.Interno,
+		}
+	}
+
+	bytes, err := json.Marshal(conteudo)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// scanMensagemOutbox mapeia os dados de um scanner (row ou rows) para uma struct MensagemOutbox.
+func scanMensagemOutbox(scanner interface{ Scan(dest ...any) error }) (*model.MensagemOutbox, error) {
+	var mensagem model.MensagemOutbox
+	var conteudo string
+	err := scanner.Scan(
+		&mensagem.ID,
+		&mensagem.Destino,
+		&conteudo,
+		&mensagem.Tentativas,
+		&mensagem.DisponivelEm,
+		&mensagem.UltimoErro,
+		&mensagem.CriadoEm,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLOutboxRepository.scanMensagemOutbox]",
+			utils.LevelError,
+			"o scanner falhou ao scanear a mensagem da outbox",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerOutbox, err),
+		)
+	}
+
+	// o conteúdo inválido não interrompe a leitura das demais mensagens: a mensagem é
+	// devolvida sem log e sem evento, e o despacho registra a falha
+	if err := decodificarConteudoOutbox(&mensagem, []byte(conteudo)); err != nil {
+		erro := fmt.Errorf(utils.FmtErroWrap, ErrConteudoOutbox, err).Error()
+		mensagem.Log, mensagem.Evento, mensagem.UltimoErro = nil, nil, &erro
+	}
+	return &mensagem, nil
+}
+
+// decodificarConteudoOutbox preenche o log ou o evento da mensagem a partir do JSON gravado.
+func decodificarConteudoOutbox(m *model.MensagemOutbox, conteudo []byte) error {
+	switch m.Destino {
+	case model.OutboxLog:
+		m.Log = &model.Log{}
+		return json.Unmarshal(conteudo, m.Log)
+
+	case model.OutboxEvento:
+		var gravado eventoOutbox
+		if err := json.Unmarshal(conteudo, &gravado); err != nil {
+			return err
+		}
+		dados, err := model.DecodificarDadosEvento(gravado.Tipo, gravado.Dados)
+		if err != nil {
+			return err
+		}
+		m.Evento = &model.Evento{
+			Tipo:             gravado.Tipo,
+			ChamadoID:        gravado.ChamadoID,
+			Dados:            dados,
+			CriadoEm:         gravado.CriadoEm,
+			CriadorChamadoID: gravado.CriadorChamadoID,
+			CategoriaID:      gravado.CategoriaID,
+			Interno:          gravado.Interno,
+		}
+		return nil
+	}
+	return model.ValidarDestinoOutbox(m.Destino)
+}
@@ -0,0 +1,523 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerPapel       = errors.New("erro ao scanear papel do banco de dados MySQL")
+	ErrPapelNaoEncontrado = errors.New("papel não encontrado no banco de dados MySQL")
+	ErrPapelJaExiste      = errors.New("já existe um papel com este código no banco de dados MySQL")
+	ErrPapelEmUso         = errors.New("o papel está atribuído a usuários e não pode ser removido")
+)
+
+// colunasPapel lista as colunas lidas por scanPapel, na mesma ordem. As permissões são
+// lidas em consulta separada, pois a lista completa excede o limite padrão do GROUP_CONCAT.
+const colunasPapel = `codigo, nome, descricao, permissao_base, sistema, criado_em, atualizado_em`
+
+// MySQLPapelRepository é a implementação do repositório de papéis para o MySQL.
+type MySQLPapelRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLPapelRepository cria uma nova instância de MySQLPapelRepository.
+func NewMySQLPapelRepository(db *sql.DB) *MySQLPapelRepository {
+	return &MySQLPapelRepository{db: db}
+}
+
+// BuscarPorCodigo busca um papel pelo seu código, com as permissões concedidas.
+func (r *MySQLPapelRepository) BuscarPorCodigo(ctx context.Context, codigo string) (*model.Papel, error) {
+	const metodo = "[MySQLPapelRepository.BuscarPorCodigo]"
+
+	row := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT `+colunasPapel+`
+		FROM papeis
+		WHERE codigo = ?`,
+		codigo,
+	)
+	papel, err := scanPapel(row)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if papel == nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"a busca por código não retornou resultados",
+			ErrPapelNaoEncontrado,
+		)
+	}
+
+	papel.Permissoes, err = r.ListarPermissoes(ctx, codigo)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	return papel, nil
+}
+
+// ListarPermissoes retorna as permissões nomeadas concedidas ao papel.
+func (r *MySQLPapelRepository) ListarPermissoes(ctx context.Context, codigo string) ([]model.PermissaoNomeada, error) {
+	permissoes, err := r.listarPermissoes(
+		ctx,
+		`SELECT papel, permissao FROM papel_permissoes WHERE papel = ? ORDER BY permissao`,
+		codigo,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLPapelRepository.ListarPermissoes]: %w", err)
+	}
+
+	if permissoes[codigo] == nil {
+		return []model.PermissaoNomeada{}, nil
+	}
+	return permissoes[codigo], nil
+}
+
+// Listar retorna todos os papéis, com as permissões concedidas, começando pelos papéis do sistema.
+func (r *MySQLPapelRepository) Listar(ctx context.Context) ([]model.Papel, error) {
+	const metodo = "[MySQLPapelRepository.Listar]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT `+colunasPapel+`
+		FROM papeis
+		ORDER BY sistema DESC, codigo ASC`,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar papéis no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	papeis := []model.Papel{}
+	for rows.Next() {
+		papel, err := scanPapel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		papeis = append(papeis, *papel)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os resultados de papéis",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	permissoes, err := r.listarPermissoes(ctx, `SELECT papel, permissao FROM papel_permissoes ORDER BY papel, permissao`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	for i := range papeis {
+		papeis[i].Permissoes = permissoes[papeis[i].Codigo]
+		if papeis[i].Permissoes == nil {
+			papeis[i].Permissoes = []model.PermissaoNomeada{}
+		}
+	}
+
+	return papeis, nil
+}
+
+// Salvar cria um novo papel com as permissões concedidas em uma única transação.
+func (r *MySQLPapelRepository) Salvar(ctx context.Context, p *model.Papel) error {
+	const metodo = "[MySQLPapelRepository.Salvar]"
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao salvar papel",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO papeis (codigo, nome, descricao, permissao_base, sistema, criado_em, atualizado_em)
+		VALUES (?, ?, ?, ?, ?, NOW(), NOW())`,
+		p.Codigo, p.Nome, p.Descricao, p.PermissaoBase, p.Sistema,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "Duplicate entry") {
+			return utils.NewAppError(
+				metodo,
+				utils.LevelWarning,
+				"erro ao salvar papel com código duplicado no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrPapelJaExiste, err),
+			)
+		}
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar o papel no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := salvarPermissoesPapel(ctx, tx, p.Codigo, p.Permissoes); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao salvar papel",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// Atualizar atualiza o nome, a descrição e a permissão base do papel. A permissão dos
+// usuários do papel acompanha a nova permissão base na mesma transação.
+func (r *MySQLPapelRepository) Atualizar(ctx context.Context, codigo string, p *model.Papel) error {
+	const metodo = "[MySQLPapelRepository.Atualizar]"
+
+	existe, err := ExistePapelPorCodigo(ctx, r.db, codigo)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível atualizar o papel",
+			ErrPapelNaoEncontrado,
+		)
+	}
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao atualizar papel",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE papeis
+		SET nome = ?, descricao = ?, permissao_base = ?, atualizado_em = NOW()
+		WHERE codigo = ?`,
+		p.Nome, p.Descricao, p.PermissaoBase, codigo,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao atualizar o papel no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE usuarios
+		SET permissao = ?, atualizado_em = NOW()
+		WHERE papel = ? AND permissao <> ?`,
+		p.PermissaoBase, codigo, p.PermissaoBase,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao atualizar a permissão dos usuários do papel no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao atualizar papel",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// AtualizarPermissoes substitui as permissões concedidas ao papel em uma única transação.
+func (r *MySQLPapelRepository) AtualizarPermissoes(ctx context.Context, codigo string, permissoes []model.PermissaoNomeada) error {
+	const metodo = "[MySQLPapelRepository.AtualizarPermissoes]"
+
+	existe, err := ExistePapelPorCodigo(ctx, r.db, codigo)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível atualizar as permissões do papel",
+			ErrPapelNaoEncontrado,
+		)
+	}
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao atualizar as permissões do papel",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM papel_permissoes WHERE papel = ?`, codigo)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao remover as permissões do papel no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := salvarPermissoesPapel(ctx, tx, codigo, permissoes); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE papeis SET atualizado_em = NOW() WHERE codigo = ?`, codigo)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao atualizar o papel no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao atualizar as permissões do papel",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// Deletar remove um papel sem usuários; as permissões concedidas são removidas em cascata.
+func (r *MySQLPapelRepository) Deletar(ctx context.Context, codigo string) error {
+	const metodo = "[MySQLPapelRepository.Deletar]"
+
+	existe, err := ExistePapelPorCodigo(ctx, r.db, codigo)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível remover o papel",
+			ErrPapelNaoEncontrado,
+		)
+	}
+
+	var emUso bool
+	err = conexao(ctx, r.db).QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM usuarios WHERE papel = ?)`, codigo).Scan(&emUso)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao verificar os usuários do papel",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	if emUso {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível remover o papel",
+			ErrPapelEmUso,
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(ctx, `DELETE FROM papeis WHERE codigo = ?`, codigo)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao remover o papel no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// AtribuirAoUsuario define o papel do usuário. Os papéis do sistema ficam registrados apenas
+// na permissão do usuário; os demais, na coluna papel, com a permissão base do papel.
+func (r *MySQLPapelRepository) AtribuirAoUsuario(ctx context.Context, usuarioID string, p *model.Papel) error {
+	const metodo = "[MySQLPapelRepository.AtribuirAoUsuario]"
+
+	existe, err := ExisteUsuarioPorID(ctx, r.db, usuarioID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível atribuir o papel ao usuário",
+			ErrUsuarioNaoEncontrado,
+		)
+	}
+
+	var papel *string
+	if !p.Sistema {
+		papel = &p.Codigo
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE usuarios
+		SET papel = ?, permissao = ?, atualizado_em = NOW()
+		WHERE id = ?`,
+		papel, p.PermissaoBase, usuarioID,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao atribuir o papel ao usuário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// Metodos auxiliares
+
+// listarPermissoes executa uma consulta que retorna pares (papel, permissão) e os agrupa por papel.
+func (r *MySQLPapelRepository) listarPermissoes(ctx context.Context, query string, args ...any) (map[string][]model.PermissaoNomeada, error) {
+	const metodo = "[MySQLPapelRepository.listarPermissoes]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar as permissões dos papéis no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	permissoes := map[string][]model.PermissaoNomeada{}
+	for rows.Next() {
+		var papel string
+		var permissao model.PermissaoNomeada
+		if err := rows.Scan(&papel, &permissao); err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao scanear a permissão do papel",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerPapel, err),
+			)
+		}
+		permissoes[papel] = append(permissoes[papel], permissao)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre as permissões dos papéis",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return permissoes, nil
+}
+
+// salvarPermissoesPapel insere as permissões concedidas ao papel dentro da transação.
+func salvarPermissoesPapel(ctx context.Context, tx executorSQL, codigo string, permissoes []model.PermissaoNomeada) error {
+	for _, permissao := range permissoes {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT IGNORE INTO papel_permissoes (papel, permissao) VALUES (?, ?)`,
+			codigo, permissao,
+		)
+		if err != nil {
+			return utils.NewAppError(
+				"[MySQLPapelRepository.salvarPermissoesPapel]",
+				utils.LevelError,
+				"erro ao salvar as permissões do papel no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+			)
+		}
+	}
+	return nil
+}
+
+// ExistePapelPorCodigo verifica se um papel existe pelo seu código.
+func ExistePapelPorCodigo(ctx context.Context, db *sql.DB, codigo string) (bool, error) {
+	var existe bool
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM papeis WHERE codigo = ?)", codigo).Scan(&existe)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLPapelRepository.ExistePapelPorCodigo]",
+			utils.LevelError,
+			"falha ao verificar existência do papel por código",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	return existe, nil
+}
+
+// scanPapel mapeia os dados de um scanner (row ou rows) para uma struct Papel, sem as permissões.
+func scanPapel(scanner interface{ Scan(dest ...any) error }) (*model.Papel, error) {
+	var papel model.Papel
+	err := scanner.Scan(
+		&papel.Codigo,
+		&papel.Nome,
+		&papel.Descricao,
+		&papel.PermissaoBase,
+		&papel.Sistema,
+		&papel.CriadoEm,
+		&papel.AtualizadoEm,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLPapelRepository.scanPapel]",
+			utils.LevelError,
+			"o scanner falhou ao scanear o papel",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerPapel, err),
+		)
+	}
+	return &papel, nil
+}
@@ -0,0 +1,369 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerPoliticaSLA       = errors.New("erro ao scanear política de SLA do banco de dados MySQL")
+	ErrPoliticaSLANaoEncontrada = errors.New("política de SLA não encontrada no banco de dados MySQL")
+	ErrPoliticaSLAJaExiste      = errors.New("já existe uma política de SLA para a categoria/subcategoria no banco de dados MySQL")
+)
+
+// colunasPoliticaSLA lista as colunas lidas por scanPoliticaSLA, na mesma ordem.
+const colunasPoliticaSLA = `id, nome, categoria_id, subcategoria_id, primeira_resposta_minutos,
+	solucao_minutos, pausar_aguardando_usuario, status, criado_em, atualizado_em`
+
+// MySQLPoliticaSLARepository é a implementação do repositório de políticas de SLA para o MySQL.
+type MySQLPoliticaSLARepository struct {
+	db *sql.DB
+}
+
+// NewMySQLPoliticaSLARepository cria uma nova instância de MySQLPoliticaSLARepository.
+func NewMySQLPoliticaSLARepository(db *sql.DB) *MySQLPoliticaSLARepository {
+	return &MySQLPoliticaSLARepository{db: db}
+}
+
+// BuscarPorID busca uma política de SLA pelo seu ID.
+func (r *MySQLPoliticaSLARepository) BuscarPorID(ctx context.Context, id string) (*model.PoliticaSLA, error) {
+	politica, err := r.buscar(
+		ctx,
+		`SELECT `+colunasPoliticaSLA+`
+		FROM politicas_sla
+		WHERE id=?`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLPoliticaSLARepository.BuscarPorID]: %w", err)
+	}
+
+	if politica == nil {
+		return nil, utils.NewAppError(
+			"[MySQLPoliticaSLARepository.BuscarPorID]",
+			utils.LevelInfo,
+			"a busca por ID não retornou resultados",
+			ErrPoliticaSLANaoEncontrada,
+		)
+	}
+
+	return politica, nil
+}
+
+// BuscarVigente busca a política ativa aplicável à subcategoria informada, priorizando
+// a política específica da subcategoria sobre a política geral da categoria.
+func (r *MySQLPoliticaSLARepository) BuscarVigente(ctx context.Context, categoriaID, subcategoriaID string) (*model.PoliticaSLA, error) {
+	politica, err := r.buscar(
+		ctx,
+		`SELECT `+colunasPoliticaSLA+`
+		FROM politicas_sla
+		WHERE status = TRUE AND categoria_id = ?
+		AND (subcategoria_id = ? OR subcategoria_id IS NULL)
+		ORDER BY subcategoria_id IS NULL ASC
+		LIMIT 1`,
+		categoriaID, subcategoriaID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLPoliticaSLARepository.BuscarVigente]: %w", err)
+	}
+
+	return politica, nil
+}
+
+// Salvar cria uma nova política de SLA.
+func (r *MySQLPoliticaSLARepository) Salvar(ctx context.Context, p *model.PoliticaSLA) error {
+	const metodo = "[MySQLPoliticaSLARepository.Salvar]"
+
+	existe, err := ExistePoliticaSLAPorEscopo(ctx, r.db, p.CategoriaID, p.SubcategoriaID, "")
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível salvar a política de SLA",
+			ErrPoliticaSLAJaExiste,
+		)
+	}
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO politicas_sla (
+		id, nome, categoria_id, subcategoria_id, primeira_resposta_minutos,
+		solucao_minutos, pausar_aguardando_usuario, status, criado_em, atualizado_em
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())`,
+		p.ID, p.Nome, p.CategoriaID, p.SubcategoriaID, p.PrimeiraRespostaMinutos,
+		p.SolucaoMinutos, p.PausarAguardandoUsuario, p.Status,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar a política de SLA no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao obter o número de linhas afetadas ao salvar política de SLA no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"nenhuma linha foi afetada ao salvar a política de SLA no banco de dados",
+			ErrExecContext,
+		)
+	}
+
+	return nil
+}
+
+// Atualizar atualiza as informações de uma política de SLA existente.
+func (r *MySQLPoliticaSLARepository) Atualizar(ctx context.Context, id string, p *model.PoliticaSLA) error {
+	const metodo = "[MySQLPoliticaSLARepository.Atualizar]"
+
+	existe, err := ExistePoliticaSLAPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível atualizar a política de SLA",
+			ErrPoliticaSLANaoEncontrada,
+		)
+	}
+
+	duplicada, err := ExistePoliticaSLAPorEscopo(ctx, r.db, p.CategoriaID, p.SubcategoriaID, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if duplicada {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível atualizar a política de SLA",
+			ErrPoliticaSLAJaExiste,
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE politicas_sla
+		SET nome=?, categoria_id=?, subcategoria_id=?, primeira_resposta_minutos=?,
+		solucao_minutos=?, pausar_aguardando_usuario=?, atualizado_em=NOW()
+		WHERE id=?`,
+		p.Nome, p.CategoriaID, p.SubcategoriaID, p.PrimeiraRespostaMinutos,
+		p.SolucaoMinutos, p.PausarAguardandoUsuario, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao atualizar a política de SLA no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// Ativar ativa uma política de SLA pelo seu ID.
+func (r *MySQLPoliticaSLARepository) Ativar(ctx context.Context, id string) error {
+	return r.alterarStatus(ctx, "[MySQLPoliticaSLARepository.Ativar]", id, true)
+}
+
+// Desativar desativa uma política de SLA pelo seu ID.
+func (r *MySQLPoliticaSLARepository) Desativar(ctx context.Context, id string) error {
+	return r.alterarStatus(ctx, "[MySQLPoliticaSLARepository.Desativar]", id, false)
+}
+
+// Listar lista políticas de SLA com paginação e filtros opcionais.
+func (r *MySQLPoliticaSLARepository) Listar(ctx context.Context, filtro model.PoliticaSLAFiltro) ([]model.PoliticaSLA, int, error) {
+	var query strings.Builder
+	args := []any{}
+
+	query.WriteString(
+		`SELECT SQL_CALC_FOUND_ROWS ` + colunasPoliticaSLA + `
+		FROM politicas_sla
+		WHERE 1=1`,
+	)
+
+	if filtro.CategoriaID != nil && *filtro.CategoriaID != "" {
+		query.WriteString(" AND categoria_id = ?")
+		args = append(args, *filtro.CategoriaID)
+	}
+
+	if filtro.SubcategoriaID != nil && *filtro.SubcategoriaID != "" {
+		query.WriteString(" AND subcategoria_id = ?")
+		args = append(args, *filtro.SubcategoriaID)
+	}
+
+	if filtro.Status != nil {
+		query.WriteString(" AND status = ?")
+		args = append(args, *filtro.Status)
+	}
+
+	query.WriteString(" ORDER BY nome ASC LIMIT ? OFFSET ?")
+	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return nil, 0, utils.NewAppError(
+			"[MySQLPoliticaSLARepository.Listar]",
+			utils.LevelError,
+			"falha ao listar políticas de SLA no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	var politicas []model.PoliticaSLA
+	for rows.Next() {
+		politica, err := scanPoliticaSLA(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("[MySQLPoliticaSLARepository.Listar]: %w", err)
+		}
+		politicas = append(politicas, *politica)
+	}
+
+	var total int
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+		return nil, 0, utils.NewAppError(
+			"[MySQLPoliticaSLARepository.Listar]",
+			utils.LevelError,
+			"erro ao obter total de políticas de SLA",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+
+	return politicas, total, nil
+}
+
+// Metodos auxiliares
+
+// alterarStatus ativa ou desativa uma política de SLA.
+func (r *MySQLPoliticaSLARepository) alterarStatus(ctx context.Context, metodo, id string, status bool) error {
+	existe, err := ExistePoliticaSLAPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível alterar o status da política de SLA",
+			ErrPoliticaSLANaoEncontrada,
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE politicas_sla
+		SET status=?, atualizado_em=NOW()
+		WHERE id=?`,
+		status, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao alterar o status da política de SLA no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// buscar executa uma consulta que retorna uma única política de SLA.
+func (r *MySQLPoliticaSLARepository) buscar(ctx context.Context, query string, args ...any) (*model.PoliticaSLA, error) {
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
+	politica, err := scanPoliticaSLA(row)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLPoliticaSLARepository.buscar]: %w", err)
+	}
+	return politica, nil
+}
+
+// ExistePoliticaSLAPorID verifica se uma política de SLA existe pelo seu ID.
+func ExistePoliticaSLAPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
+	var existe bool
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM politicas_sla WHERE id = ?)", id).Scan(&existe)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLPoliticaSLARepository.ExistePoliticaSLAPorID]",
+			utils.LevelError,
+			"falha ao verificar existência da política de SLA por ID",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	return existe, nil
+}
+
+// ExistePoliticaSLAPorEscopo verifica se já existe outra política de SLA para a mesma
+// categoria e subcategoria, ignorando a política de ID informado.
+func ExistePoliticaSLAPorEscopo(ctx context.Context, db *sql.DB, categoriaID string, subcategoriaID *string, ignorarID string) (bool, error) {
+	var existe bool
+	err := conexao(ctx, db).QueryRowContext(
+		ctx,
+		`SELECT EXISTS(
+		SELECT 1 FROM politicas_sla
+		WHERE categoria_id = ? AND subcategoria_id <=> ? AND id <> ?)`,
+		categoriaID, subcategoriaID, ignorarID,
+	).Scan(&existe)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLPoliticaSLARepository.ExistePoliticaSLAPorEscopo]",
+			utils.LevelError,
+			"falha ao verificar existência da política de SLA por categoria/subcategoria",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	return existe, nil
+}
+
+// scanPoliticaSLA mapeia os dados de um scanner (row ou rows) para uma struct PoliticaSLA.
+func scanPoliticaSLA(scanner interface{ Scan(dest ...any) error }) (*model.PoliticaSLA, error) {
+	var politica model.PoliticaSLA
+	err := scanner.Scan(
+		&politica.ID,
+		&politica.Nome,
+		&politica.CategoriaID,
+		&politica.SubcategoriaID,
+		&politica.PrimeiraRespostaMinutos,
+		&politica.SolucaoMinutos,
+		&politica.PausarAguardandoUsuario,
+		&politica.Status,
+		&politica.CriadoEm,
+		&politica.AtualizadoEm,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLPoliticaSLARepository.scanPoliticaSLA]",
+			utils.LevelError,
+			"o scanner falhou ao scanear a política de SLA",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerPoliticaSLA, err),
+		)
+	}
+	return &politica, nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerPreferenciaNotificacao = errors.New("erro ao escanear preferência de notificação do banco de dados MySQL")
+)
+
+// MySQLPreferenciaNotificacaoRepository é a implementação do repositório de preferências de notificação para MySQL.
+type MySQLPreferenciaNotificacaoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLPreferenciaNotificacaoRepository cria uma nova instância de MySQLPreferenciaNotificacaoRepository.
+func NewMySQLPreferenciaNotificacaoRepository(db *sql.DB) *MySQLPreferenciaNotificacaoRepository {
+	return &MySQLPreferenciaNotificacaoRepository{db: db}
+}
+
+// ListarPreferencias retorna as preferências configuradas pelo usuário.
+func (r *MySQLPreferenciaNotificacaoRepository) ListarPreferencias(ctx context.Context, usuarioID string) ([]model.PreferenciaNotificacao, error) {
+	const metodo = "[MySQLPreferenciaNotificacaoRepository.ListarPreferencias]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT tipo, email FROM preferencias_notificacao WHERE usuario_id = ? ORDER BY tipo ASC`,
+		usuarioID,
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao listar as preferências de notificação no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	preferencias := []model.PreferenciaNotificacao{}
+	for rows.Next() {
+		var p model.PreferenciaNotificacao
+		if err := rows.Scan(&p.Tipo, &p.Email); err != nil {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao escanear a preferência de notificação",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerPreferenciaNotificacao, err),
+			)
+		}
+		preferencias = append(preferencias, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os resultados de preferências de notificação",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return preferencias, nil
+}
+
+// SalvarPreferencias insere ou atualiza as preferências informadas do usuário em uma única transação.
+func (r *MySQLPreferenciaNotificacaoRepository) SalvarPreferencias(ctx context.Context, usuarioID string, preferencias []model.PreferenciaNotificacao) error {
+	const metodo = "[MySQLPreferenciaNotificacaoRepository.SalvarPreferencias]"
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iniciar a transação das preferências de notificação",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	for _, p := range preferencias {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO preferencias_notificacao (usuario_id, tipo, email, atualizado_em)
+			VALUES (?, ?, ?, NOW())
+			ON DUPLICATE KEY UPDATE email = VALUES(email), atualizado_em = NOW()`,
+			usuarioID, p.Tipo, p.Email,
+		)
+		if err != nil {
+			return utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"erro ao salvar a preferência de notificação no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+			)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao confirmar a transação das preferências de notificação",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// EmailHabilitado indica se o usuário recebe o tipo de notificação por e-mail. Tipos
+// não configurados são enviados por padrão.
+func (r *MySQLPreferenciaNotificacaoRepository) EmailHabilitado(ctx context.Context, usuarioID string, tipo model.TipoNotificacao) (bool, error) {
+	var email bool
+	err := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT email FROM preferencias_notificacao WHERE usuario_id = ? AND tipo = ?`,
+		usuarioID, tipo,
+	).Scan(&email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return true, nil
+	}
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLPreferenciaNotificacaoRepository.EmailHabilitado]",
+			utils.LevelError,
+			"erro ao buscar a preferência de notificação no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return email, nil
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerSessao       = errors.New("erro ao scanear sessão do banco de dados MySQL")
+	ErrSessaoNaoEncontrada = errors.New("sessão não encontrada no banco de dados MySQL")
+)
+
+// colunasSessao são as colunas lidas por scanSessao, na ordem do scan.
+const colunasSessao = `id, usuario_id, dispositivo, ip, refresh_jti, criado_em, ultimo_uso_em, expira_em, revogada_em`
+
+// MySQLSessaoRepository é a implementação das sessões dos usuários para o MySQL.
+type MySQLSessaoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLSessaoRepository cria uma nova instância de MySQLSessaoRepository.
+func NewMySQLSessaoRepository(db *sql.DB) *MySQLSessaoRepository {
+	return &MySQLSessaoRepository{db: db}
+}
+
+// Salvar insere uma nova sessão.
+func (r *MySQLSessaoRepository) Salvar(ctx context.Context, s *model.Sessao) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO sessoes (id, usuario_id, dispositivo, ip, refresh_jti, criado_em, ultimo_uso_em, expira_em)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		s.ID, s.UsuarioID, s.Dispositivo, s.IP, s.RefreshJTI, s.CriadoEm, s.UltimoUsoEm, s.ExpiraEm,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLSessaoRepository.Salvar]",
+			utils.LevelError,
+			"erro ao salvar a sessão no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// BuscarPorID busca uma sessão pelo seu ID.
+func (r *MySQLSessaoRepository) BuscarPorID(ctx context.Context, id string) (*model.Sessao, error) {
+	row := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT `+colunasSessao+`
+		FROM sessoes
+		WHERE id = ?`,
+		id,
+	)
+	sessao, err := scanSessao(row)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLSessaoRepository.BuscarPorID]: %w", err)
+	}
+
+	if sessao == nil {
+		return nil, utils.NewAppError(
+			"[MySQLSessaoRepository.BuscarPorID]",
+			utils.LevelInfo,
+			"a busca por ID não retornou resultados",
+			ErrSessaoNaoEncontrada,
+		)
+	}
+
+	return sessao, nil
+}
+
+// ListarAtivasPorUsuario retorna as sessões não encerradas e não expiradas do usuário, das
+// usadas mais recentemente às mais antigas.
+func (r *MySQLSessaoRepository) ListarAtivasPorUsuario(ctx context.Context, usuarioID string) ([]model.Sessao, error) {
+	const metodo = "[MySQLSessaoRepository.ListarAtivasPorUsuario]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(
+		ctx,
+		`SELECT `+colunasSessao+`
+		FROM sessoes
+		WHERE usuario_id = ? AND revogada_em IS NULL AND expira_em > ?
+		ORDER BY ultimo_uso_em DESC`,
+		usuarioID, time.Now(),
+	)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar as sessões do usuário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	sessoes := []model.Sessao{}
+	for rows.Next() {
+		sessao, err := scanSessao(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		sessoes = append(sessoes, *sessao)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os resultados de sessões",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return sessoes, nil
+}
+
+// Rotacionar grava o novo token de refresh da sessão se o token vigente ainda for o anterior
+// e a sessão não tiver sido encerrada. A condição no próprio UPDATE impede que dois refreshes
+// simultâneos com o mesmo token sejam aceitos.
+func (r *MySQLSessaoRepository) Rotacionar(ctx context.Context, s *model.Sessao, refreshJTIAnterior string) (bool, error) {
+	const metodo = "[MySQLSessaoRepository.Rotacionar]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE sessoes
+		SET refresh_jti = ?, dispositivo = ?, ip = ?, ultimo_uso_em = ?, expira_em = ?
+		WHERE id = ? AND refresh_jti = ? AND revogada_em IS NULL`,
+		s.RefreshJTI, s.Dispositivo, s.IP, s.UltimoUsoEm, s.ExpiraEm,
+		s.ID, refreshJTIAnterior,
+	)
+	if err != nil {
+		return false, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao substituir o token de refresh da sessão no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return false, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao substituir o token de refresh da sessão",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	return linhasAfetadas == 1, nil
+}
+
+// Revogar encerra a sessão, mantendo o instante de um encerramento anterior.
+func (r *MySQLSessaoRepository) Revogar(ctx context.Context, id string, revogadaEm time.Time) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE sessoes SET revogada_em = ? WHERE id = ? AND revogada_em IS NULL`,
+		revogadaEm, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLSessaoRepository.Revogar]",
+			utils.LevelError,
+			"erro ao encerrar a sessão no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+	return nil
+}
+
+// RevogarTodasDoUsuario encerra todas as sessões abertas do usuário.
+func (r *MySQLSessaoRepository) RevogarTodasDoUsuario(ctx context.Context, usuarioID string, revogadaEm time.Time) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE sessoes SET revogada_em = ? WHERE usuario_id = ? AND revogada_em IS NULL`,
+		revogadaEm, usuarioID,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLSessaoRepository.RevogarTodasDoUsuario]",
+			utils.LevelError,
+			"erro ao encerrar as sessões do usuário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+	return nil
+}
+
+// RemoverExpiradas exclui as sessões expiradas antes do limite e retorna quantas foram excluídas.
+func (r *MySQLSessaoRepository) RemoverExpiradas(ctx context.Context, limite time.Time) (int, error) {
+	const metodo = "[MySQLSessaoRepository.RemoverExpiradas]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(ctx, `DELETE FROM sessoes WHERE expira_em < ?`, limite)
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao remover as sessões expiradas do banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao remover as sessões expiradas",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	return int(linhasAfetadas), nil
+}
+
+// Metodos auxiliares
+
+// scanSessao mapeia os dados de um scanner (row ou rows) para uma struct Sessao.
+func scanSessao(scanner interface{ Scan(dest ...any) error }) (*model.Sessao, error) {
+	var sessao model.Sessao
+	err := scanner.Scan(
+		&sessao.ID,
+		&sessao.UsuarioID,
+		&sessao.Dispositivo,
+		&sessao.IP,
+		&sessao.RefreshJTI,
+		&sessao.CriadoEm,
+		&sessao.UltimoUsoEm,
+		&sessao.ExpiraEm,
+		&sessao.RevogadaEm,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLSessaoRepository.scanSessao]",
+			utils.LevelError,
+			"o scanner falhou ao scanear a sessão",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerSessao, err),
+		)
+	}
+
+	return &sessao, nil
+}
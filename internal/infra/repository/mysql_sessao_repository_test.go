@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+func TestMySQLSessaoRepositoryRotacionar(t *testing.T) {
+	casos := []struct {
+		nome           string
+		linhasAfetadas int64
+		esperado       bool
+	}{
+		{"token anterior ainda vigente", 1, true},
+		{"token anterior já substituído ou sessão encerrada", 0, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			banco := &bancoFake{executar: func(string, []any) (int64, error) {
+				return c.linhasAfetadas, nil
+			}}
+			r := NewMySQLSessaoRepository(banco.abrir())
+
+			sessao := &model.Sessao{ID: "ses-1", RefreshJTI: "jti-2", UltimoUsoEm: time.Now(), ExpiraEm: time.Now().Add(time.Hour)}
+			rotacionada, err := r.Rotacionar(context.Background(), sessao, "jti-1")
+			if err != nil {
+				t.Fatalf("Rotacionar = %v, esperado nil", err)
+			}
+			if rotacionada != c.esperado {
+				t.Errorf("Rotacionar = %t, esperado %t", rotacionada, c.esperado)
+			}
+
+			// a substituição é condicionada ao token anterior
+			args := banco.comandos[0].args
+			if args[0] != "jti-2" || args[len(args)-1] != "jti-1" {
+				t.Errorf("argumentos = %v, esperado o novo token jti-2 e a condição pelo token jti-1", args)
+			}
+		})
+	}
+}
@@ -94,7 +94,7 @@ func (r *MySQLSubcategoriaRepository) Salvar(ctx context.Context, s *model.Subca
 		)
 	}
 
-	resultado, err := r.db.ExecContext(
+	resultado, err := conexao(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO subcategorias (
 		id, categoria_id, nome, status, criado_em, atualizado_em
@@ -158,7 +158,7 @@ func (r *MySQLSubcategoriaRepository) Atualizar(ctx context.Context, id string,
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE subcategorias 
 		SET categoria_id=?, nome=?, status=?, atualizado_em=NOW() 
@@ -207,7 +207,7 @@ func (r *MySQLSubcategoriaRepository) Ativar(ctx context.Context, id string) err
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE subcategorias 
 		SET status=true, atualizado_em=NOW() 
@@ -241,7 +241,7 @@ func (r *MySQLSubcategoriaRepository) Desativar(ctx context.Context, id string)
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE subcategorias 
 		SET status=false, atualizado_em=NOW() 
@@ -286,7 +286,7 @@ func (r *MySQLSubcategoriaRepository) Listar(ctx context.Context, filtro model.S
 	query.WriteString(" ORDER BY nome ASC LIMIT ? OFFSET ?")
 	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
 
-	rows, err := r.db.QueryContext(ctx, query.String(), args...)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLSubcategoriaRepository.Listar]",
@@ -307,7 +307,7 @@ func (r *MySQLSubcategoriaRepository) Listar(ctx context.Context, filtro model.S
 	}
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLSubcategoriaRepository.Listar]",
 			utils.LevelError,
@@ -323,7 +323,7 @@ func (r *MySQLSubcategoriaRepository) Listar(ctx context.Context, filtro model.S
 
 // buscar executa uma consulta que retorna uma única subcategoria.
 func (r *MySQLSubcategoriaRepository) buscar(ctx context.Context, query string, args ...any) (*model.Subcategoria, error) {
-	row := r.db.QueryRowContext(ctx, query, args...)
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
 
 	subcategoria, err := scanSubcategoria(row)
 	if err != nil {
@@ -336,7 +336,7 @@ func (r *MySQLSubcategoriaRepository) buscar(ctx context.Context, query string,
 // ExisteSubcategoriaPorID verifica se uma subcategoria existe pelo seu ID.
 func ExisteSubcategoriaPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
 	var existe bool
-	err := db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM subcategorias WHERE id=?)`, id).Scan(&existe)
+	err := conexao(ctx, db).QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM subcategorias WHERE id=?)`, id).Scan(&existe)
 	if err != nil {
 		return false, utils.NewAppError(
 			"[MySQLSubcategoriaRepository.ExisteSubcategoriaPorID]",
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerTokenRevogado = errors.New("erro ao scanear token revogado do banco de dados MySQL")
+)
+
+// MySQLTokenRevogadoRepository é a implementação das revogações de tokens para o MySQL.
+type MySQLTokenRevogadoRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLTokenRevogadoRepository cria uma nova instância de MySQLTokenRevogadoRepository.
+func NewMySQLTokenRevogadoRepository(db *sql.DB) *MySQLTokenRevogadoRepository {
+	return &MySQLTokenRevogadoRepository{db: db}
+}
+
+// Salvar registra a revogação de um token. Revogar de novo o mesmo token não tem efeito.
+func (r *MySQLTokenRevogadoRepository) Salvar(ctx context.Context, t *model.TokenRevogado) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO tokens_revogados (jti, usuario_id, expira_em, revogado_em)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE jti = jti`,
+		t.JTI, t.UsuarioID, t.ExpiraEm, t.RevogadoEm,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLTokenRevogadoRepository.Salvar]",
+			utils.LevelError,
+			"erro ao salvar a revogação do token no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+	return nil
+}
+
+// RevogarTodosDoUsuario revoga todos os tokens do usuário emitidos até o instante informado,
+// sem recuar uma revogação posterior já registrada.
+func (r *MySQLTokenRevogadoRepository) RevogarTodosDoUsuario(ctx context.Context, usuarioID string, revogadoEm time.Time) error {
+	_, err := conexao(ctx, r.db).ExecContext(
+		ctx,
+		`INSERT INTO revogacoes_usuarios (usuario_id, revogado_em)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE revogado_em = GREATEST(revogado_em, VALUES(revogado_em))`,
+		usuarioID, revogadoEm,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			"[MySQLTokenRevogadoRepository.RevogarTodosDoUsuario]",
+			utils.LevelError,
+			"erro ao revogar os tokens do usuário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+	return nil
+}
+
+// BuscarRevogacoesUsuario retorna as revogações vigentes dos tokens do usuário: os tokens
+// revogados e as sessões encerradas ainda não expirados e o instante da revogação de todos
+// os tokens, quando houver.
+func (r *MySQLTokenRevogadoRepository) BuscarRevogacoesUsuario(ctx context.Context, usuarioID string) (*model.RevogacoesUsuario, error) {
+	const metodo = "[MySQLTokenRevogadoRepository.BuscarRevogacoesUsuario]"
+
+	revogacoes := &model.RevogacoesUsuario{
+		Tokens:  map[string]struct{}{},
+		Sessoes: map[string]struct{}{},
+	}
+
+	var revogadoEm time.Time
+	err := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT revogado_em FROM revogacoes_usuarios WHERE usuario_id = ?`,
+		usuarioID,
+	).Scan(&revogadoEm)
+	switch {
+	case err == nil:
+		revogacoes.RevogadoEm = &revogadoEm
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao buscar a revogação dos tokens do usuário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	agora := time.Now()
+
+	err = r.listarIdentificadores(
+		ctx,
+		revogacoes.Tokens,
+		`SELECT jti FROM tokens_revogados WHERE usuario_id = ? AND expira_em > ?`,
+		usuarioID, agora,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	err = r.listarIdentificadores(
+		ctx,
+		revogacoes.Sessoes,
+		`SELECT id FROM sessoes WHERE usuario_id = ? AND revogada_em IS NOT NULL AND expira_em > ?`,
+		usuarioID, agora,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	return revogacoes, nil
+}
+
+// RemoverExpirados exclui as revogações dos tokens expirados antes do limite e retorna quantas
+// foram excluídas.
+func (r *MySQLTokenRevogadoRepository) RemoverExpirados(ctx context.Context, limite time.Time) (int, error) {
+	const metodo = "[MySQLTokenRevogadoRepository.RemoverExpirados]"
+
+	resultado, err := conexao(ctx, r.db).ExecContext(ctx, `DELETE FROM tokens_revogados WHERE expira_em < ?`, limite)
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao remover os tokens revogados expirados do banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return 0, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao obter o número de linhas afetadas ao remover os tokens revogados expirados",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	return int(linhasAfetadas), nil
+}
+
+// Metodos auxiliares
+
+// listarIdentificadores executa a consulta e acrescenta ao conjunto os identificadores
+// retornados na primeira coluna.
+func (r *MySQLTokenRevogadoRepository) listarIdentificadores(ctx context.Context, conjunto map[string]struct{}, query string, args ...any) error {
+	const metodo = "[MySQLTokenRevogadoRepository.listarIdentificadores]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar os tokens revogados do usuário no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return utils.NewAppError(
+				metodo,
+				utils.LevelError,
+				"o scanner falhou ao scanear o token revogado",
+				fmt.Errorf(utils.FmtErroWrap, ErrScannerTokenRevogado, err),
+			)
+		}
+		conjunto[id] = struct{}{}
+	}
+
+	if err := rows.Err(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os tokens revogados do usuário",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return nil
+}
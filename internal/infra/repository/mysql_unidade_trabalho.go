@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// chaveTransacao identifica a transação da unidade de trabalho no contexto.
+type chaveTransacao struct{}
+
+// chaveAcoesTransacao identifica no contexto as ações registradas para o fim da transação.
+type chaveAcoesTransacao struct{}
+
+// acoesTransacao guarda as ações executadas após a confirmação ou o desfazimento da transação.
+type acoesTransacao struct {
+	mu            sync.Mutex
+	aposConfirmar []func()
+	aposDesfazer  []func()
+}
+
+// executar executa, na ordem em que foram registradas, as ações da confirmação ou as do
+// desfazimento da transação.
+func (a *acoesTransacao) executar(confirmada bool) {
+	a.mu.Lock()
+	acoes := a.aposDesfazer
+	if confirmada {
+		acoes = a.aposConfirmar
+	}
+	a.mu.Unlock()
+
+	for _, acao := range acoes {
+		acao()
+	}
+}
+
+// executorSQL reúne os métodos comuns a *sql.DB e *sql.Tx usados pelos repositórios.
+type executorSQL interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// MySQLUnidadeDeTrabalho é a implementação da unidade de trabalho para o MySQL. A transação
+// é guardada no contexto, e os repositórios a usam por meio de conexao e iniciarTransacao.
+type MySQLUnidadeDeTrabalho struct {
+	db *sql.DB
+}
+
+// NewMySQLUnidadeDeTrabalho cria uma nova instância de MySQLUnidadeDeTrabalho.
+func NewMySQLUnidadeDeTrabalho(db *sql.DB) *MySQLUnidadeDeTrabalho {
+	return &MySQLUnidadeDeTrabalho{db: db}
+}
+
+// Executar executa a operação em uma transação, confirmada apenas se a operação não retornar
+// erro. Dentro de outra unidade de trabalho, a operação participa da transação em andamento.
+// As ações registradas durante a operação são executadas após a confirmação ou o desfazimento.
+func (u *MySQLUnidadeDeTrabalho) Executar(ctx context.Context, operacao func(ctx context.Context) error) error {
+	const metodo = "[MySQLUnidadeDeTrabalho.Executar]"
+
+	if _, ok := ctx.Value(chaveTransacao{}).(*sql.Tx); ok {
+		return operacao(ctx)
+	}
+
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação da unidade de trabalho",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	acoes := &acoesTransacao{}
+	ctxTransacao := context.WithValue(context.WithValue(ctx, chaveTransacao{}, tx), chaveAcoesTransacao{}, acoes)
+
+	if err := operacao(ctxTransacao); err != nil {
+		tx.Rollback()
+		acoes.executar(false)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		acoes.executar(false)
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação da unidade de trabalho",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	acoes.executar(true)
+	return nil
+}
+
+// AposConfirmar registra uma ação executada após a confirmação da transação em andamento no
+// contexto. Fora de uma unidade de trabalho, a ação é executada de imediato.
+func (u *MySQLUnidadeDeTrabalho) AposConfirmar(ctx context.Context, acao func()) {
+	acoes, ok := ctx.Value(chaveAcoesTransacao{}).(*acoesTransacao)
+	if !ok {
+		acao()
+		return
+	}
+
+	acoes.mu.Lock()
+	defer acoes.mu.Unlock()
+	acoes.aposConfirmar = append(acoes.aposConfirmar, acao)
+}
+
+// AposDesfazer registra uma ação executada após o desfazimento da transação em andamento no
+// contexto. Fora de uma unidade de trabalho, a ação é ignorada.
+func (u *MySQLUnidadeDeTrabalho) AposDesfazer(ctx context.Context, acao func()) {
+	acoes, ok := ctx.Value(chaveAcoesTransacao{}).(*acoesTransacao)
+	if !ok {
+		return
+	}
+
+	acoes.mu.Lock()
+	defer acoes.mu.Unlock()
+	acoes.aposDesfazer = append(acoes.aposDesfazer, acao)
+}
+
+// Metodos auxiliares
+
+// conexao retorna a transação da unidade de trabalho em andamento no contexto ou, fora dela,
+// o pool de conexões.
+func conexao(ctx context.Context, db *sql.DB) executorSQL {
+	if tx, ok := ctx.Value(chaveTransacao{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// transacaoMySQL é uma transação usada por um único método do repositório. Dentro de uma
+// unidade de trabalho, ela reaproveita a transação em andamento, e a confirmação e o
+// desfazimento ficam a cargo da unidade de trabalho.
+type transacaoMySQL struct {
+	*sql.Tx
+	propria bool
+}
+
+// iniciarTransacao inicia uma transação própria ou, dentro de uma unidade de trabalho,
+// reaproveita a transação em andamento.
+func iniciarTransacao(ctx context.Context, db *sql.DB) (*transacaoMySQL, error) {
+	if tx, ok := ctx.Value(chaveTransacao{}).(*sql.Tx); ok {
+		return &transacaoMySQL{Tx: tx}, nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &transacaoMySQL{Tx: tx, propria: true}, nil
+}
+
+// Commit confirma a transação quando ela é própria do método.
+func (t *transacaoMySQL) Commit() error {
+	if !t.propria {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+// Rollback desfaz a transação quando ela é própria do método.
+func (t *transacaoMySQL) Rollback() error {
+	if !t.propria {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
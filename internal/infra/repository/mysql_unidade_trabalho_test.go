@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMySQLUnidadeDeTrabalhoExecutar(t *testing.T) {
+	falha := errors.New("falha na operação")
+
+	casos := []struct {
+		nome        string
+		erro        error
+		confirmadas int
+		desfeitas   int
+		acoes       []string
+	}{
+		{"operação confirmada", nil, 1, 0, []string{"confirmada"}},
+		{"operação desfeita", falha, 0, 1, []string{"desfeita"}},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			banco := &bancoFake{}
+			u := NewMySQLUnidadeDeTrabalho(banco.abrir())
+
+			var acoes []string
+			err := u.Executar(context.Background(), func(ctx context.Context) error {
+				u.AposConfirmar(ctx, func() { acoes = append(acoes, "confirmada") })
+				u.AposDesfazer(ctx, func() { acoes = append(acoes, "desfeita") })
+
+				// a unidade de trabalho aninhada participa da transação em andamento
+				err := u.Executar(ctx, func(ctx context.Context) error {
+					_, err := conexao(ctx, banco.abrir()).ExecContext(ctx, "UPDATE chamados SET status=?", "ATRIBUIDO")
+					return err
+				})
+				if err != nil {
+					return err
+				}
+
+				// as ações aguardam o fim da transação
+				if len(acoes) != 0 {
+					t.Errorf("ações = %v antes do fim da transação, esperado nenhuma", acoes)
+				}
+				return c.erro
+			})
+
+			if !errors.Is(err, c.erro) || (c.erro == nil && err != nil) {
+				t.Fatalf("Executar = %v, esperado %v", err, c.erro)
+			}
+			if banco.confirmadas != c.confirmadas || banco.desfeitas != c.desfeitas {
+				t.Errorf("confirmadas/desfeitas = %d/%d, esperado %d/%d", banco.confirmadas, banco.desfeitas, c.confirmadas, c.desfeitas)
+			}
+			if len(acoes) != len(c.acoes) || acoes[0] != c.acoes[0] {
+				t.Errorf("ações = %v, esperado %v", acoes, c.acoes)
+			}
+			if len(banco.comandos) != 1 {
+				t.Errorf("comandos = %d, esperado 1 na transação", len(banco.comandos))
+			}
+		})
+	}
+}
+
+func TestMySQLUnidadeDeTrabalhoAposConfirmarForaDaTransacao(t *testing.T) {
+	u := NewMySQLUnidadeDeTrabalho((&bancoFake{}).abrir())
+
+	executada := false
+	u.AposConfirmar(context.Background(), func() { executada = true })
+	if !executada {
+		t.Error("ação não executada fora da unidade de trabalho, esperado a execução imediata")
+	}
+}
@@ -36,7 +36,7 @@ func NewMySQLUsuarioRepository(db *sql.DB) *MySQLUsuarioRepository {
 func (r *MySQLUsuarioRepository) BuscarPorID(ctx context.Context, id string) (*model.Usuario, error) {
 	usuario, err := r.buscar(
 		ctx,
-		`SELECT id, nome, login, email, permissao, status, 
+		`SELECT id, nome, login, email, permissao, papel, status, 
 		 avatar, ultimo_login, criado_em, atualizado_em
      FROM usuarios 
 		 WHERE id=?`,
@@ -62,7 +62,7 @@ func (r *MySQLUsuarioRepository) BuscarPorID(ctx context.Context, id string) (*m
 func (r *MySQLUsuarioRepository) BuscarPorLogin(ctx context.Context, login string) (*model.Usuario, error) {
 	usuario, err := r.buscar(
 		ctx,
-		`SELECT id, nome, login, email, permissao, status,
+		`SELECT id, nome, login, email, permissao, papel, status,
 		 avatar, ultimo_login, criado_em, atualizado_em
      FROM usuarios 
 		 WHERE login=?`,
@@ -84,11 +84,38 @@ func (r *MySQLUsuarioRepository) BuscarPorLogin(ctx context.Context, login strin
 	return usuario, nil
 }
 
+// BuscarPorEmail busca um usuário pelo e-mail; a collation da tabela não diferencia
+// maiúsculas de minúsculas.
+func (r *MySQLUsuarioRepository) BuscarPorEmail(ctx context.Context, email string) (*model.Usuario, error) {
+	usuario, err := r.buscar(
+		ctx,
+		`SELECT id, nome, login, email, permissao, papel, status,
+		 avatar, ultimo_login, criado_em, atualizado_em
+		 FROM usuarios
+		 WHERE email=?`,
+		email,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLUsuarioRepository.BuscarPorEmail]: %w", err)
+	}
+
+	if usuario == nil {
+		return nil, utils.NewAppError(
+			"[MySQLUsuarioRepository.BuscarPorEmail]",
+			utils.LevelInfo,
+			"a busca por e-mail não retornou resultados",
+			ErrUsuarioNaoEncontrado,
+		)
+	}
+
+	return usuario, nil
+}
+
 // Salvar insere um novo usuário no banco de dados.
 func (r *MySQLUsuarioRepository) Salvar(ctx context.Context, u *model.Usuario) error {
 	const metodo = "[MySQLUsuarioRepository.Salvar]"
 
-	resultado, err := r.db.ExecContext(
+	resultado, err := conexao(ctx, r.db).ExecContext(
 		ctx,
 		`INSERT INTO usuarios(
      id, nome, login, email, permissao, status, 
@@ -164,13 +191,12 @@ func (r *MySQLUsuarioRepository) Atualizar(ctx context.Context, id string, u *mo
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE usuarios
-     SET nome=?, email=?, permissao=?, 
-		 status=?, avatar=?, atualizado_em=NOW()
+     SET nome=?, email=?, avatar=?, atualizado_em=NOW()
      WHERE id=?`,
-		u.Nome, u.Email, u.Permissao, u.Status, u.Avatar, id,
+		u.Nome, u.Email, u.Avatar, id,
 	)
 	if err != nil {
 		if strings.Contains(err.Error(), "Duplicate entry") {
@@ -196,7 +222,8 @@ func (r *MySQLUsuarioRepository) Atualizar(ctx context.Context, id string, u *mo
 	return nil
 }
 
-// AtualizarPermissao atualiza a permissão de um usuário.
+// AtualizarPermissao atualiza a permissão de um usuário, que passa a ter o papel do sistema
+// correspondente no lugar de um papel personalizado.
 func (r *MySQLUsuarioRepository) AtualizarPermissao(ctx context.Context, id string, permissao string) error {
 	existe, err := ExisteUsuarioPorID(ctx, r.db, id)
 	if err != nil {
@@ -211,10 +238,10 @@ func (r *MySQLUsuarioRepository) AtualizarPermissao(ctx context.Context, id stri
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE usuarios 
-		 SET permissao=?, atualizado_em=NOW()
+		 SET permissao=?, papel=NULL, atualizado_em=NOW()
      WHERE id=?`,
 		permissao, id,
 	)
@@ -245,7 +272,7 @@ func (r *MySQLUsuarioRepository) Desativar(ctx context.Context, id string) error
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE usuarios 
 		 SET status=false, atualizado_em=NOW() 
@@ -279,7 +306,7 @@ func (r *MySQLUsuarioRepository) Ativar(ctx context.Context, id string) error {
 		)
 	}
 
-	_, err = r.db.ExecContext(
+	_, err = conexao(ctx, r.db).ExecContext(
 		ctx,
 		`UPDATE usuarios 
 		 SET status=true 
@@ -313,7 +340,7 @@ func (r *MySQLUsuarioRepository) AtualizarUltimoLogin(ctx context.Context, id st
 		)
 	}
 
-	_, err = r.db.ExecContext(ctx,
+	_, err = conexao(ctx, r.db).ExecContext(ctx,
 		`UPDATE usuarios 
 		 SET ultimo_login=NOW()
 		 WHERE id=?`,
@@ -339,7 +366,7 @@ func (r *MySQLUsuarioRepository) Listar(ctx context.Context, filtro model.Usuari
 	// TODO nao trazer os arquivados, incluir flag para exibir ou nao status false
 	query.WriteString(
 		`SELECT SQL_CALC_FOUND_ROWS 
-     id, nome, login, email, permissao, status, 
+     id, nome, login, email, permissao, papel, status, 
 		 avatar, ultimo_login, criado_em, atualizado_em
      FROM usuarios 
 		 WHERE 1=1`,
@@ -364,7 +391,7 @@ func (r *MySQLUsuarioRepository) Listar(ctx context.Context, filtro model.Usuari
 	query.WriteString(" ORDER BY nome ASC LIMIT ? OFFSET ?")
 	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
 
-	rows, err := r.db.QueryContext(ctx, query.String(), args...)
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query.String(), args...)
 	if err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLUsuarioRepository.Listar]",
@@ -385,7 +412,7 @@ func (r *MySQLUsuarioRepository) Listar(ctx context.Context, filtro model.Usuari
 	}
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
 		return nil, 0, utils.NewAppError(
 			"[MySQLUsuarioRepository.Listar]",
 			utils.LevelError,
@@ -401,7 +428,7 @@ func (r *MySQLUsuarioRepository) Listar(ctx context.Context, filtro model.Usuari
 
 // buscar executa uma consulta que retorna um único usuário.
 func (r *MySQLUsuarioRepository) buscar(ctx context.Context, query string, args ...any) (*model.Usuario, error) {
-	row := r.db.QueryRowContext(ctx, query, args...)
+	row := conexao(ctx, r.db).QueryRowContext(ctx, query, args...)
 	usuario, err := scanUsuario(row)
 	if err != nil {
 		return nil, fmt.Errorf("[MySQLUsuarioRepository.buscar]: %w", err)
@@ -412,7 +439,7 @@ func (r *MySQLUsuarioRepository) buscar(ctx context.Context, query string, args
 // ExisteUsuarioPorID verifica se um usuário existe com base no ID.
 func ExisteUsuarioPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
 	var existe bool
-	err := db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM usuarios WHERE id=?)`, id).Scan(&existe)
+	err := conexao(ctx, db).QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM usuarios WHERE id=?)`, id).Scan(&existe)
 	if err != nil {
 		return false, utils.NewAppError(
 			"[MySQLUsuarioRepository.ExisteUsuarioPorID]",
@@ -431,7 +458,7 @@ func ExisteUsuarioPorID(ctx context.Context, db *sql.DB, id string) (bool, error
 // ExistePorLogin verifica se um usuário existe com base no login.
 func (r *MySQLUsuarioRepository) ExistePorLogin(ctx context.Context, login string) (bool, error) {
 	var existe bool
-	err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM usuarios WHERE login=?)`, login).Scan(&existe)
+	err := conexao(ctx, r.db).QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM usuarios WHERE login=?)`, login).Scan(&existe)
 	if err != nil {
 		return false, utils.NewAppError(
 			"[MySQLUsuarioRepository.ExistePorLogin]",
@@ -456,6 +483,7 @@ func scanUsuario(scanner interface{ Scan(dest ...any) error }) (*model.Usuario,
 		&usuario.Login,
 		&usuario.Email,
 		&usuario.Permissao,
+		&usuario.Papel,
 		&usuario.Status,
 		&usuario.Avatar,
 		&usuario.UltimoLogin,
@@ -0,0 +1,404 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrScannerWebhook       = errors.New("erro ao scanear webhook do banco de dados MySQL")
+	ErrWebhookNaoEncontrado = errors.New("webhook não encontrado no banco de dados MySQL")
+)
+
+// colunasWebhook lista as colunas lidas por scanWebhook, na mesma ordem. Os tipos de evento
+// assinados são agregados em uma única coluna separada por vírgulas.
+const colunasWebhook = `w.id, w.url, w.segredo, w.status, w.criado_em, w.atualizado_em,
+	(SELECT GROUP_CONCAT(e.tipo ORDER BY e.tipo) FROM webhook_eventos e WHERE e.webhook_id = w.id)`
+
+// MySQLWebhookRepository é a implementação do repositório de webhooks para o MySQL.
+type MySQLWebhookRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLWebhookRepository cria uma nova instância de MySQLWebhookRepository.
+func NewMySQLWebhookRepository(db *sql.DB) *MySQLWebhookRepository {
+	return &MySQLWebhookRepository{db: db}
+}
+
+// BuscarPorID busca um webhook pelo seu ID.
+func (r *MySQLWebhookRepository) BuscarPorID(ctx context.Context, id string) (*model.Webhook, error) {
+	row := conexao(ctx, r.db).QueryRowContext(
+		ctx,
+		`SELECT `+colunasWebhook+`
+		FROM webhooks w
+		WHERE w.id = ?`,
+		id,
+	)
+	webhook, err := scanWebhook(row)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLWebhookRepository.BuscarPorID]: %w", err)
+	}
+
+	if webhook == nil {
+		return nil, utils.NewAppError(
+			"[MySQLWebhookRepository.BuscarPorID]",
+			utils.LevelInfo,
+			"a busca por ID não retornou resultados",
+			ErrWebhookNaoEncontrado,
+		)
+	}
+
+	return webhook, nil
+}
+
+// ListarAtivosPorTipoEvento retorna os webhooks ativos que assinam o tipo de evento.
+func (r *MySQLWebhookRepository) ListarAtivosPorTipoEvento(ctx context.Context, tipo model.TipoEvento) ([]model.Webhook, error) {
+	webhooks, err := r.listar(
+		ctx,
+		`SELECT `+colunasWebhook+`
+		FROM webhooks w
+		WHERE w.status = TRUE
+		AND EXISTS(SELECT 1 FROM webhook_eventos e WHERE e.webhook_id = w.id AND e.tipo = ?)
+		ORDER BY w.criado_em ASC`,
+		tipo,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[MySQLWebhookRepository.ListarAtivosPorTipoEvento]: %w", err)
+	}
+	return webhooks, nil
+}
+
+// Salvar cria um novo webhook com os tipos de evento assinados em uma única transação.
+func (r *MySQLWebhookRepository) Salvar(ctx context.Context, w *model.Webhook) error {
+	const metodo = "[MySQLWebhookRepository.Salvar]"
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao salvar webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	resultado, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO webhooks (id, url, segredo, status, criado_em, atualizado_em)
+		VALUES (?, ?, ?, ?, NOW(), NOW())`,
+		w.ID, w.URL, w.Segredo, w.Status,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao salvar o webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	linhasAfetadas, err := resultado.RowsAffected()
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao obter o número de linhas afetadas ao salvar webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrRowsAffected, err),
+		)
+	}
+
+	if linhasAfetadas == 0 {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"nenhuma linha foi afetada ao salvar o webhook no banco de dados",
+			ErrExecContext,
+		)
+	}
+
+	if err := salvarTiposEventoWebhook(ctx, tx, w.ID, w.TiposEvento); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao salvar webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// Atualizar atualiza a URL e os tipos de evento do webhook em uma única transação; o segredo
+// é substituído apenas quando informado.
+func (r *MySQLWebhookRepository) Atualizar(ctx context.Context, id string, w *model.Webhook) error {
+	const metodo = "[MySQLWebhookRepository.Atualizar]"
+
+	existe, err := ExisteWebhookPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível atualizar o webhook",
+			ErrWebhookNaoEncontrado,
+		)
+	}
+
+	tx, err := iniciarTransacao(ctx, r.db)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao iniciar transação ao atualizar webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE webhooks
+		SET url = ?, segredo = COALESCE(NULLIF(?, ''), segredo), atualizado_em = NOW()
+		WHERE id = ?`,
+		w.URL, w.Segredo, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao atualizar o webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM webhook_eventos WHERE webhook_id = ?`, id)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao remover os tipos de evento do webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	if err := salvarTiposEventoWebhook(ctx, tx, id, w.TiposEvento); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao confirmar transação ao atualizar webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrTransacao, err),
+		)
+	}
+
+	return nil
+}
+
+// Ativar ativa um webhook pelo seu ID.
+func (r *MySQLWebhookRepository) Ativar(ctx context.Context, id string) error {
+	return r.alterarStatus(ctx, "[MySQLWebhookRepository.Ativar]", id, true)
+}
+
+// Desativar desativa um webhook pelo seu ID.
+func (r *MySQLWebhookRepository) Desativar(ctx context.Context, id string) error {
+	return r.alterarStatus(ctx, "[MySQLWebhookRepository.Desativar]", id, false)
+}
+
+// Listar lista webhooks com paginação e filtros opcionais.
+func (r *MySQLWebhookRepository) Listar(ctx context.Context, filtro model.WebhookFiltro) ([]model.Webhook, int, error) {
+	var query strings.Builder
+	args := []any{}
+
+	query.WriteString(
+		`SELECT SQL_CALC_FOUND_ROWS ` + colunasWebhook + `
+		FROM webhooks w
+		WHERE 1=1`,
+	)
+
+	if filtro.TipoEvento != nil && *filtro.TipoEvento != "" {
+		query.WriteString(" AND EXISTS(SELECT 1 FROM webhook_eventos e WHERE e.webhook_id = w.id AND e.tipo = ?)")
+		args = append(args, *filtro.TipoEvento)
+	}
+
+	if filtro.Status != nil {
+		query.WriteString(" AND w.status = ?")
+		args = append(args, *filtro.Status)
+	}
+
+	query.WriteString(" ORDER BY w.criado_em DESC LIMIT ? OFFSET ?")
+	args = append(args, filtro.Limite, (filtro.Pagina-1)*filtro.Limite)
+
+	webhooks, err := r.listar(ctx, query.String(), args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("[MySQLWebhookRepository.Listar]: %w", err)
+	}
+
+	var total int
+	if err := conexao(ctx, r.db).QueryRowContext(ctx, "SELECT FOUND_ROWS()").Scan(&total); err != nil {
+		return nil, 0, utils.NewAppError(
+			"[MySQLWebhookRepository.Listar]",
+			utils.LevelError,
+			"erro ao obter total de webhooks",
+			fmt.Errorf(utils.FmtErroWrap, ErrScan, err),
+		)
+	}
+
+	return webhooks, total, nil
+}
+
+// Metodos auxiliares
+
+// alterarStatus ativa ou desativa um webhook.
+func (r *MySQLWebhookRepository) alterarStatus(ctx context.Context, metodo, id string, status bool) error {
+	existe, err := ExisteWebhookPorID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !existe {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"não foi possível alterar o status do webhook",
+			ErrWebhookNaoEncontrado,
+		)
+	}
+
+	_, err = conexao(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE webhooks
+		SET status=?, atualizado_em=NOW()
+		WHERE id=?`,
+		status, id,
+	)
+	if err != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao alterar o status do webhook no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+		)
+	}
+
+	return nil
+}
+
+// listar executa uma consulta que retorna uma lista de webhooks.
+func (r *MySQLWebhookRepository) listar(ctx context.Context, query string, args ...any) ([]model.Webhook, error) {
+	const metodo = "[MySQLWebhookRepository.listar]"
+
+	rows, err := conexao(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"falha ao listar webhooks no banco de dados",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	defer rows.Close()
+
+	webhooks := []model.Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelError,
+			"erro ao iterar sobre os resultados de webhooks",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+
+	return webhooks, nil
+}
+
+// salvarTiposEventoWebhook insere os tipos de evento assinados pelo webhook dentro da transação.
+func salvarTiposEventoWebhook(ctx context.Context, tx executorSQL, webhookID string, tipos []model.TipoEvento) error {
+	for _, tipo := range tipos {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT IGNORE INTO webhook_eventos (webhook_id, tipo) VALUES (?, ?)`,
+			webhookID, tipo,
+		)
+		if err != nil {
+			return utils.NewAppError(
+				"[MySQLWebhookRepository.salvarTiposEventoWebhook]",
+				utils.LevelError,
+				"erro ao salvar os tipos de evento do webhook no banco de dados",
+				fmt.Errorf(utils.FmtErroWrap, ErrExecContext, err),
+			)
+		}
+	}
+	return nil
+}
+
+// ExisteWebhookPorID verifica se um webhook existe pelo seu ID.
+func ExisteWebhookPorID(ctx context.Context, db *sql.DB, id string) (bool, error) {
+	var existe bool
+	err := conexao(ctx, db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM webhooks WHERE id = ?)", id).Scan(&existe)
+	if err != nil {
+		return false, utils.NewAppError(
+			"[MySQLWebhookRepository.ExisteWebhookPorID]",
+			utils.LevelError,
+			"falha ao verificar existência do webhook por ID",
+			fmt.Errorf(utils.FmtErroWrap, ErrQueryContext, err),
+		)
+	}
+	return existe, nil
+}
+
+// scanWebhook mapeia os dados de um scanner (row ou rows) para uma struct Webhook.
+func scanWebhook(scanner interface{ Scan(dest ...any) error }) (*model.Webhook, error) {
+	var webhook model.Webhook
+	var tipos sql.NullString
+	err := scanner.Scan(
+		&webhook.ID,
+		&webhook.URL,
+		&webhook.Segredo,
+		&webhook.Status,
+		&webhook.CriadoEm,
+		&webhook.AtualizadoEm,
+		&tipos,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, utils.NewAppError(
+			"[MySQLWebhookRepository.scanWebhook]",
+			utils.LevelError,
+			"o scanner falhou ao scanear o webhook",
+			fmt.Errorf(utils.FmtErroWrap, ErrScannerWebhook, err),
+		)
+	}
+
+	webhook.TiposEvento = []model.TipoEvento{}
+	if tipos.Valid && tipos.String != "" {
+		for _, tipo := range strings.Split(tipos.String, ",") {
+			webhook.TiposEvento = append(webhook.TiposEvento, model.TipoEvento(tipo))
+		}
+	}
+	return &webhook, nil
+}
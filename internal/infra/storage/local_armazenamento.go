@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+var (
+	ErrChaveArquivoInvalida = errors.New("a chave do arquivo é inválida")
+	ErrArquivoNaoEncontrado = errors.New("arquivo não encontrado no armazenamento")
+	ErrArmazenamento        = errors.New("erro ao acessar o armazenamento de arquivos")
+)
+
+// ArmazenamentoLocal guarda o conteúdo dos arquivos em um diretório do sistema de arquivos local.
+type ArmazenamentoLocal struct {
+	diretorio string
+}
+
+// NewArmazenamentoLocal cria uma nova instância de ArmazenamentoLocal, criando o diretório se necessário.
+func NewArmazenamentoLocal(diretorio string) (*ArmazenamentoLocal, error) {
+	if err := os.MkdirAll(diretorio, 0o750); err != nil {
+		return nil, utils.NewAppError(
+			"[storage.NewArmazenamentoLocal]",
+			utils.LevelError,
+			"falha ao criar o diretório do armazenamento",
+			fmt.Errorf(utils.FmtErroWrap, ErrArmazenamento, err),
+		)
+	}
+	return &ArmazenamentoLocal{diretorio: diretorio}, nil
+}
+
+// Salvar grava o conteúdo sob a chave informada. O conteúdo é escrito em um arquivo
+// temporário e renomeado ao final, para que leituras nunca vejam arquivos incompletos.
+func (a *ArmazenamentoLocal) Salvar(ctx context.Context, chave string, r io.Reader) error {
+	const metodo = "[ArmazenamentoLocal.Salvar]"
+
+	caminho, err := a.caminho(chave)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(caminho), 0o750); err != nil {
+		return a.erro(metodo, "falha ao criar o diretório do arquivo", err)
+	}
+
+	temporario, err := os.CreateTemp(filepath.Dir(caminho), ".envio-*")
+	if err != nil {
+		return a.erro(metodo, "falha ao criar o arquivo temporário", err)
+	}
+	defer os.Remove(temporario.Name())
+
+	if _, err := io.Copy(temporario, r); err != nil {
+		temporario.Close()
+		return a.erro(metodo, "falha ao gravar o conteúdo do arquivo", err)
+	}
+	if err := temporario.Close(); err != nil {
+		return a.erro(metodo, "falha ao fechar o arquivo temporário", err)
+	}
+
+	if err := os.Rename(temporario.Name(), caminho); err != nil {
+		return a.erro(metodo, "falha ao mover o arquivo para o destino", err)
+	}
+	return nil
+}
+
+// Abrir retorna o conteúdo armazenado sob a chave.
+func (a *ArmazenamentoLocal) Abrir(ctx context.Context, chave string) (io.ReadCloser, error) {
+	const metodo = "[ArmazenamentoLocal.Abrir]"
+
+	caminho, err := a.caminho(chave)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	arquivo, err := os.Open(caminho)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, utils.NewAppError(metodo, utils.LevelInfo, "o arquivo não existe no armazenamento", ErrArquivoNaoEncontrado)
+	}
+	if err != nil {
+		return nil, a.erro(metodo, "falha ao abrir o arquivo", err)
+	}
+	return arquivo, nil
+}
+
+// Remover apaga o conteúdo armazenado sob a chave; chaves inexistentes não geram erro.
+func (a *ArmazenamentoLocal) Remover(ctx context.Context, chave string) error {
+	const metodo = "[ArmazenamentoLocal.Remover]"
+
+	caminho, err := a.caminho(chave)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := os.Remove(caminho); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return a.erro(metodo, "falha ao remover o arquivo", err)
+	}
+	return nil
+}
+
+// Métodos auxiliares
+
+// caminho converte a chave em um caminho dentro do diretório do armazenamento,
+// recusando chaves absolutas ou que apontem para fora dele.
+func (a *ArmazenamentoLocal) caminho(chave string) (string, error) {
+	relativo := filepath.FromSlash(chave)
+	if chave == "" || !filepath.IsLocal(relativo) {
+		return "", utils.NewAppError(
+			"[ArmazenamentoLocal.caminho]",
+			utils.LevelInfo,
+			fmt.Sprintf("a chave %q não é um caminho relativo válido", chave),
+			ErrChaveArquivoInvalida,
+		)
+	}
+	return filepath.Join(a.diretorio, relativo), nil
+}
+
+// erro padroniza os erros de acesso ao sistema de arquivos.
+func (a *ArmazenamentoLocal) erro(metodo, mensagem string, err error) error {
+	return utils.NewAppError(metodo, utils.LevelError, mensagem, fmt.Errorf(utils.FmtErroWrap, ErrArmazenamento, err))
+}
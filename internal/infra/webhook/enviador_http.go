@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+var (
+	ErrRespostaWebhook = errors.New("o webhook respondeu com status fora da faixa 2xx")
+)
+
+// Cabeçalhos enviados em cada entrega. A assinatura é o HMAC-SHA256, em hexadecimal, de
+// "<timestamp>.<corpo>" com o segredo do webhook; o timestamp permite ao destino recusar
+// entregas antigas reenviadas por terceiros.
+const (
+	CabecalhoAssinatura = "X-Webhook-Assinatura"
+	CabecalhoTimestamp  = "X-Webhook-Timestamp"
+	CabecalhoEvento     = "X-Webhook-Evento"
+	CabecalhoEntrega    = "X-Webhook-Entrega"
+)
+
+// tamanhoMaximoRespostaLida limita o trecho da resposta guardado no registro da tentativa.
+const tamanhoMaximoRespostaLida = 512
+
+// EnviadorHTTP envia as entregas por HTTP POST, sem seguir redirecionamentos, para que o
+// conteúdo assinado chegue apenas ao endereço cadastrado.
+type EnviadorHTTP struct {
+	cliente *http.Client
+}
+
+// NewEnviadorHTTP cria uma nova instância de EnviadorHTTP com o tempo limite de cada envio.
+func NewEnviadorHTTP(timeout time.Duration) *EnviadorHTTP {
+	return &EnviadorHTTP{
+		cliente: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Enviar envia o payload da entrega assinado com o segredo do webhook e retorna o status
+// HTTP da resposta. Respostas fora da faixa 2xx são retornadas como erro, com o início do corpo.
+func (e *EnviadorHTTP) Enviar(ctx context.Context, w *model.Webhook, entrega *model.EntregaWebhook) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(entrega.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("[webhook.Enviar]: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", "GestorDeChamados-Webhook/1.0")
+	req.Header.Set(CabecalhoTimestamp, timestamp)
+	req.Header.Set(CabecalhoAssinatura, "sha256="+Assinar(w.Segredo, timestamp, entrega.Payload))
+	req.Header.Set(CabecalhoEvento, string(entrega.TipoEvento))
+	req.Header.Set(CabecalhoEntrega, entrega.ID)
+
+	resp, err := e.cliente.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("[webhook.Enviar]: %w", err)
+	}
+	defer resp.Body.Close()
+
+	trecho, _ := io.ReadAll(io.LimitReader(resp.Body, tamanhoMaximoRespostaLida))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("[webhook.Enviar]: %w: %d %s", ErrRespostaWebhook, resp.StatusCode, strings.TrimSpace(string(trecho)))
+	}
+	return resp.StatusCode, nil
+}
+
+// Assinar calcula a assinatura HMAC-SHA256, em hexadecimal, de "<timestamp>.<corpo>".
+func Assinar(segredo, timestamp string, corpo []byte) string {
+	mac := hmac.New(sha256.New, []byte(segredo))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(corpo)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -8,9 +8,11 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/storage"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
 )
@@ -34,7 +36,7 @@ func NewAcompanhamentoHandler(usecase usecase.AcompanhamentoUsecase, usecaseLog
 
 // Criar godoc
 // @Summary      Cria um novo acompanhamento
-// @Description	Cria um novo acompanhamento com os dados fornecidos no corpo da requisição.
+// @Description	Cria um novo acompanhamento com os dados fornecidos no corpo da requisição. Use a visibilidade INTERNO para notas visíveis apenas à equipe técnica (ADM, TEC e DEV); sem visibilidade, o acompanhamento é público.
 // @Tags         Acompanhamentos
 // @Accept       json
 // @Produce      json
@@ -67,6 +69,18 @@ func (h *AcompanhamentoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar acompanhamento", err.Error())
 			return
 
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida),
+			errors.Is(err, model.ErrNotaInternaNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para criar o acompanhamento", err.Error())
+			return
+
 		// erros do servidor - 500
 		case errors.Is(err, utils.ErrUUIDv7Generation),
 			errors.Is(err, repository.ErrRowsAffected),
@@ -91,10 +105,11 @@ func (h *AcompanhamentoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoCriar,
 		entidadeAcompanhamento,
+		acompanhamento.ChamadoID,
 		fmt.Sprintf("Acompanhamento criado via API: %s", acompanhamento.String()),
 	)
 	if err != nil {
@@ -150,6 +165,11 @@ func (h *AcompanhamentoHandler) BuscarTudo(w http.ResponseWriter, r *http.Reques
 	items, total, filtroCorrigido, err := h.Usecase.ListarAcompanhamentos(ctx, filtro)
 	if err != nil {
 		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, repository.ErrQueryContext),
 			errors.Is(err, repository.ErrScannerAcompanhamento),
@@ -213,6 +233,17 @@ func (h *AcompanhamentoHandler) BuscarPorID(w http.ResponseWriter, r *http.Reque
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao buscar acompanhamento", err.Error())
 			return
 
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrNotaInternaNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para buscar o acompanhamento", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, repository.ErrQueryContext),
 			errors.Is(err, repository.ErrScannerAcompanhamento),
@@ -241,7 +272,7 @@ func (h *AcompanhamentoHandler) BuscarPorID(w http.ResponseWriter, r *http.Reque
 
 // Atualizar godoc
 // @Summary      Atualiza um acompanhamento
-// @Description	Atualiza dados do acompanhamento
+// @Description	Edita o conteúdo e a visibilidade do acompanhamento. Apenas o autor pode editar, dentro do prazo de edição, e o conteúdo anterior é mantido no histórico de revisões
 // @Tags         Acompanhamentos
 // @Accept       json
 // @Produce      json
@@ -249,9 +280,12 @@ func (h *AcompanhamentoHandler) BuscarPorID(w http.ResponseWriter, r *http.Reque
 // @Param        acompanhamento body model.Acompanhamento true "Dados do acompanhamento"
 // @Success      200  {object}  model.Acompanhamento
 // @Failure			400  {object}  any
+// @Failure			401  {object}  any
+// @Failure			403  {object}  any
 // @Failure			404  {object}  any
 // @Failure			405  {object}  any
 // @Failure			408  {object}  any
+// @Failure			409  {object}  any
 // @Failure			500  {object}  any
 // @Router			/acompanhamentos/atualizar/{id} [put]
 // Atualizar acompanhamento
@@ -284,9 +318,29 @@ func (h *AcompanhamentoHandler) Atualizar(w http.ResponseWriter, r *http.Request
 			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar acompanhamento", err.Error())
 			return
 
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrNotaInternaNaoPermitida),
+			errors.Is(err, model.ErrEdicaoDeOutroUsuario):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para atualizar o acompanhamento", err.Error())
+			return
+
+		// conflitos de estado - 409
+		case errors.Is(err, model.ErrPrazoEdicaoExpirado),
+			errors.Is(err, model.ErrAcompanhamentoRemovido):
+			response.ErrorJSON(w, http.StatusConflict, "o acompanhamento não pode mais ser editado", err.Error())
+			return
+
 		// erros do servidor - 500
-		case errors.Is(err, repository.ErrQueryContext),
-			errors.Is(err, repository.ErrExecContext):
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrTransacao):
 			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao atualizar acompanhamento", err.Error())
 			return
 
@@ -307,10 +361,11 @@ func (h *AcompanhamentoHandler) Atualizar(w http.ResponseWriter, r *http.Request
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoAtualizar,
 		entidadeAcompanhamento,
+		acompanhamento.ChamadoID,
 		fmt.Sprintf("Acompanhamento atualizado via API: %s", acompanhamento.String()),
 	)
 	if err != nil {
@@ -323,15 +378,18 @@ func (h *AcompanhamentoHandler) Atualizar(w http.ResponseWriter, r *http.Request
 
 // Deletar godoc
 // @Summary      Deleta um acompanhamento
-// @Description	Deleta um acompanhamento pelo ID
+// @Description	Substitui o conteúdo do acompanhamento pela mensagem de remoção. Apenas o autor ou um administrador pode remover, e o conteúdo anterior é mantido no histórico de revisões
 // @Tags         Acompanhamentos
 // @Accept       json
 // @Produce      json
 // @Param        id path string true "ID do acompanhamento"
 // @Success      200  {object}  map[string]any
+// @Failure			401  {object}  any
+// @Failure			403  {object}  any
 // @Failure			404  {object}  any
 // @Failure			405  {object}  any
 // @Failure			408  {object}  any
+// @Failure			409  {object}  any
 // @Failure			500  {object}  any
 // @Router			/acompanhamentos/deletar/{id} [delete]
 // Deletar acompanhamento
@@ -353,8 +411,29 @@ func (h *AcompanhamentoHandler) Deletar(w http.ResponseWriter, r *http.Request)
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao deletar acompanhamento", err.Error())
 			return
 
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrNotaInternaNaoPermitida),
+			errors.Is(err, model.ErrRemocaoDeOutroUsuario):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para deletar o acompanhamento", err.Error())
+			return
+
+		// conflitos de estado - 409
+		case errors.Is(err, model.ErrAcompanhamentoRemovido):
+			response.ErrorJSON(w, http.StatusConflict, "o acompanhamento já foi removido", err.Error())
+			return
+
 		// erros do servidor - 500
-		case errors.Is(err, repository.ErrExecContext):
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrTransacao),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, storage.ErrArmazenamento):
 			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao deletar acompanhamento", err.Error())
 			return
 
@@ -377,16 +456,16 @@ func (h *AcompanhamentoHandler) Deletar(w http.ResponseWriter, r *http.Request)
 
 	err := h.UsecaseLog.CriarLog(
 		ctx,
-		model.AcaoDesativar,
+		model.AcaoDeletar,
 		entidadeAcompanhamento,
-		fmt.Sprintf("Acompanhamento deletado via API: ID %s", id),
+		fmt.Sprintf("Acompanhamento removido via API: ID %s", id),
 	)
 	if err != nil {
 		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
 		return
 	}
 
-	response.JSON(w, http.StatusOK, map[string]string{"message": "acompanhamento deletado com sucesso"})
+	response.JSON(w, http.StatusOK, map[string]string{"message": "acompanhamento removido com sucesso"})
 }
 
 // BuscarPorChamadoID godoc
@@ -397,6 +476,7 @@ func (h *AcompanhamentoHandler) Deletar(w http.ResponseWriter, r *http.Request)
 // @Produce      json
 // @Param        id   path      string  true  "ID do chamado"
 // @Success      200  {object}  []model.AcompanhamentoResponse
+// @Failure			403  {object}  any
 // @Failure			404  {object}  any
 // @Failure			405  {object}  any
 // @Failure			408  {object}  any
@@ -417,10 +497,21 @@ func (h *AcompanhamentoHandler) BuscarPorChamadoID(w http.ResponseWriter, r *htt
 		switch {
 		// recursos não encontrados - 404
 		case errors.Is(err, model.ErrIDInvalido),
-			errors.Is(err, repository.ErrAcompanhamentoNaoEncontrado):
+			errors.Is(err, repository.ErrAcompanhamentoNaoEncontrado),
+			errors.Is(err, repository.ErrChamadoNaoEncontrado):
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao buscar acompanhamento", err.Error())
 			return
 
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para ver os acompanhamentos do chamado", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, repository.ErrQueryContext),
 			errors.Is(err, repository.ErrScannerAcompanhamento),
@@ -445,4 +536,66 @@ func (h *AcompanhamentoHandler) BuscarPorChamadoID(w http.ResponseWriter, r *htt
 		}
 	}
 	response.JSON(w, http.StatusOK, acompanhamentos)
-}
\ No newline at end of file
+}
+// Revisoes godoc
+// @Summary      Lista as revisões de um acompanhamento
+// @Description	Lista as edições e a remoção do acompanhamento, com o autor, a data e o conteúdo anterior de cada alteração
+// @Tags         Acompanhamentos
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "ID do acompanhamento"
+// @Success      200  {object}  []response.RevisaoAcompanhamentoResponse
+// @Failure			404  {object}  any
+// @Failure			405  {object}  any
+// @Failure			408  {object}  any
+// @Failure			500  {object}  any
+// @Router			/acompanhamentos/revisoes/{id} [get]
+// Revisoes lista as revisões de um acompanhamento
+func (h *AcompanhamentoHandler) Revisoes(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	revisoes, err := h.Usecase.ListarRevisoesAcompanhamento(ctx, id)
+	if err != nil {
+		switch {
+		// recursos não encontrados - 404
+		case errors.Is(err, model.ErrAcompanhamentoIDInvalido),
+			errors.Is(err, repository.ErrAcompanhamentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao listar revisões do acompanhamento", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerAcompanhamento),
+			errors.Is(err, repository.ErrScan):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao listar revisões do acompanhamento", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar revisões do acompanhamento", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar revisões do acompanhamento", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar revisões do acompanhamento", err.Error())
+			return
+		}
+	}
+
+	resp := make([]*response.RevisaoAcompanhamentoResponse, 0, len(revisoes))
+	for i := range revisoes {
+		resp = append(resp, response.ToRevisaoAcompanhamentoResponse(&revisoes[i]))
+	}
+	response.JSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,441 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/storage"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+const (
+	entidadeAnexo = "ANEXO"
+
+	// timeoutTransferenciaAnexo limita o envio e o download de arquivos, mais demorados que as demais requisições
+	timeoutTransferenciaAnexo = 2 * time.Minute
+
+	// margemMultipartAnexo cobre os cabeçalhos e campos do formulário além do próprio arquivo (1 MB)
+	margemMultipartAnexo = 1 << 20
+
+	// memoriaMultipartAnexo é o quanto do formulário fica em memória antes de ir para arquivos temporários (8 MB)
+	memoriaMultipartAnexo = 8 << 20
+)
+
+// AnexoHandler gerencia as requisições HTTP relacionadas aos anexos.
+type AnexoHandler struct {
+	Usecase       usecase.AnexoUsecase
+	UsecaseLog    usecase.LogUsecase
+	tamanhoMaximo int64
+}
+
+// NewAnexoHandler cria uma nova instância de AnexoHandler.
+func NewAnexoHandler(usecase usecase.AnexoUsecase, usecaseLog usecase.LogUsecase, tamanhoMaximo int64) *AnexoHandler {
+	return &AnexoHandler{
+		Usecase:       usecase,
+		UsecaseLog:    usecaseLog,
+		tamanhoMaximo: tamanhoMaximo,
+	}
+}
+
+// Enviar godoc
+// @Summary Envia um anexo
+// @Description Envia um arquivo (campo "arquivo") para o chamado. Informe "acompanhamentoId" para anexá-lo a um acompanhamento do chamado. O tipo do arquivo é identificado pelo conteúdo e deve estar entre os tipos permitidos.
+// @Tags Anexos
+// @Accept multipart/form-data
+// @Produce json
+// @Param chamadoId path string true "ID do chamado"
+// @Param arquivo formData file true "Arquivo a ser anexado"
+// @Param acompanhamentoId formData string false "ID do acompanhamento"
+// @Success 201 {object} response.AnexoResponse
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 413 {object} any
+// @Failure 415 {object} any
+// @Failure 500 {object} any
+// @Router /anexos/enviar/{chamadoId} [post]
+// Enviar envia um anexo para o chamado ou um de seus acompanhamentos
+func (h *AnexoHandler) Enviar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutTransferenciaAnexo)
+	defer cancel()
+	estenderPrazoTransferencia(w)
+
+	chamadoID := lastSegment(r.URL.Path)
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.tamanhoMaximo+margemMultipartAnexo)
+	if err := r.ParseMultipartForm(memoriaMultipartAnexo); err != nil {
+		var erroTamanho *http.MaxBytesError
+		if errors.As(err, &erroTamanho) {
+			response.ErrorJSON(w, http.StatusRequestEntityTooLarge, "o arquivo excede o tamanho máximo permitido", err.Error())
+			return
+		}
+		response.ErrorJSON(w, http.StatusBadRequest, "formulário de envio inválido", err.Error())
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	arquivo, cabecalho, err := r.FormFile("arquivo")
+	if err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "arquivo inválido ou ausente", err.Error())
+		return
+	}
+	defer arquivo.Close()
+
+	envio := &model.EnvioAnexo{
+		ChamadoID:   chamadoID,
+		NomeArquivo: cabecalho.Filename,
+	}
+	if acompanhamentoID := r.FormValue("acompanhamentoId"); acompanhamentoID != "" {
+		envio.AcompanhamentoID = &acompanhamentoID
+	}
+
+	anexo, err := h.Usecase.EnviarAnexo(ctx, envio, arquivo)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrChamadoIDInvalido),
+			errors.Is(err, model.ErrNomeAnexoInvalido),
+			errors.Is(err, model.ErrAnexoVazio),
+			errors.Is(err, model.ErrAnexoDeOutroChamado):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao enviar anexo", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrNotaInternaNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para anexar arquivos ao chamado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrChamadoNaoEncontrado),
+			errors.Is(err, repository.ErrAcompanhamentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "chamado ou acompanhamento não encontrado ao enviar anexo", err.Error())
+			return
+
+		// arquivo grande demais - 413
+		case errors.Is(err, model.ErrTamanhoAnexoExcedido):
+			response.ErrorJSON(w, http.StatusRequestEntityTooLarge, "o arquivo excede o tamanho máximo permitido", err.Error())
+			return
+
+		// tipo de arquivo não permitido - 415
+		case errors.Is(err, model.ErrTipoAnexoNaoPermitido):
+			response.ErrorJSON(w, http.StatusUnsupportedMediaType, "tipo de arquivo não permitido", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, storage.ErrArmazenamento),
+			errors.Is(err, repository.ErrRowsAffected),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao enviar anexo", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao enviar anexo", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao enviar anexo", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao enviar anexo", err.Error())
+			return
+		}
+	}
+
+	err = h.UsecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoCriar,
+		entidadeAnexo,
+		anexo.ChamadoID,
+		fmt.Sprintf("Anexo enviado via API: %s", anexo.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToAnexoResponse(anexo))
+}
+
+// Baixar godoc
+// @Summary Baixa um anexo
+// @Description Retorna o conteúdo do anexo, desde que o chamado seja visível ao usuário autenticado
+// @Tags Anexos
+// @Produce octet-stream
+// @Param id path string true "ID do anexo"
+// @Success 200 {file} file
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /anexos/baixar/{id} [get]
+// Baixar baixa o conteúdo de um anexo
+func (h *AnexoHandler) Baixar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutTransferenciaAnexo)
+	defer cancel()
+	estenderPrazoTransferencia(w)
+
+	id := lastSegment(r.URL.Path)
+	anexo, conteudo, err := h.Usecase.AbrirAnexo(ctx, id)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrNotaInternaNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para baixar o anexo", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrIDInvalido),
+			errors.Is(err, repository.ErrAnexoNaoEncontrado),
+			errors.Is(err, repository.ErrChamadoNaoEncontrado),
+			errors.Is(err, storage.ErrArquivoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "anexo não encontrado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, storage.ErrArmazenamento),
+			errors.Is(err, repository.ErrScannerAnexo):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao baixar anexo", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao baixar anexo", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao baixar anexo", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao baixar anexo", err.Error())
+			return
+		}
+	}
+	defer conteudo.Close()
+
+	w.Header().Set("Content-Type", anexo.TipoMIME)
+	w.Header().Set("Content-Length", strconv.FormatInt(anexo.Tamanho, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": anexo.NomeArquivo}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	// o status já foi enviado; uma falha aqui só interrompe a transferência
+	_, _ = io.Copy(w, conteudo)
+}
+
+// BuscarPorChamado godoc
+// @Summary Busca os anexos de um chamado
+// @Description Retorna os metadados dos anexos do chamado e de seus acompanhamentos, do mais antigo ao mais recente
+// @Tags Anexos
+// @Accept json
+// @Produce json
+// @Param chamadoId path string true "ID do chamado"
+// @Success 200 {array} response.AnexoResponse
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /anexos/buscar-por-chamado/{chamadoId} [get]
+// BuscarPorChamado busca os anexos de um chamado
+func (h *AnexoHandler) BuscarPorChamado(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	chamadoID := lastSegment(r.URL.Path)
+	anexos, err := h.Usecase.BuscarAnexosPorChamado(ctx, chamadoID)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrChamadoIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "ID do chamado inválido", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para ver os anexos do chamado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrChamadoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "chamado não encontrado ao buscar anexos", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerAnexo):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao buscar anexos", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar anexos", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar anexos", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar anexos", err.Error())
+			return
+		}
+	}
+
+	resposta := make([]response.AnexoResponse, 0, len(anexos))
+	for i := range anexos {
+		resposta = append(resposta, *response.ToAnexoResponse(&anexos[i]))
+	}
+	response.JSON(w, http.StatusOK, resposta)
+}
+
+// Deletar godoc
+// @Summary Deleta um anexo
+// @Description Remove os metadados e o conteúdo do anexo. Apenas quem enviou o anexo ou um administrador pode removê-lo.
+// @Tags Anexos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do anexo"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /anexos/deletar/{id} [delete]
+// Deletar deleta um anexo
+func (h *AnexoHandler) Deletar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodDelete) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	if err := h.Usecase.DeletarAnexo(ctx, id); err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrNotaInternaNaoPermitida),
+			errors.Is(err, model.ErrAnexoDeOutroUsuario):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para deletar o anexo", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrIDInvalido),
+			errors.Is(err, repository.ErrAnexoNaoEncontrado),
+			errors.Is(err, repository.ErrChamadoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "anexo não encontrado ao deletar", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, storage.ErrArmazenamento),
+			errors.Is(err, repository.ErrRowsAffected),
+			errors.Is(err, repository.ErrExecContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao deletar anexo", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao deletar anexo", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao deletar anexo", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao deletar anexo", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoDesativar,
+		entidadeAnexo,
+		fmt.Sprintf("Anexo deletado via API: ID %s", id),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "anexo deletado com sucesso"})
+}
+
+// estenderPrazoTransferencia amplia os prazos de leitura e escrita da conexão, que
+// o servidor limita para as requisições comuns, até o timeout de transferência.
+func estenderPrazoTransferencia(w http.ResponseWriter) {
+	prazo := time.Now().Add(timeoutTransferenciaAnexo)
+	controlador := http.NewResponseController(w)
+	_ = controlador.SetReadDeadline(prazo)
+	_ = controlador.SetWriteDeadline(prazo)
+}
@@ -0,0 +1,806 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+const (
+	entidadeApontamento = "APONTAMENTO"
+)
+
+type ApontamentoHandler struct {
+	Usecase    usecase.ApontamentoUsecase
+	UsecaseLog usecase.LogUsecase
+}
+
+func NewApontamentoHandler(usecase usecase.ApontamentoUsecase, usecaseLog usecase.LogUsecase) *ApontamentoHandler {
+	return &ApontamentoHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// Criar godoc
+// @Summary Registra um apontamento de horas
+// @Description Registra um apontamento concluído para o usuário autenticado. Informe iniciadoEm e finalizadoEm ou apenas a duração em minutos.
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Param apontamento body model.Apontamento true "Dados do apontamento"
+// @Success 201 {object} response.ApontamentoResponse
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/criar [post]
+// Criar registra um apontamento de horas
+func (h *ApontamentoHandler) Criar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	var apontamento model.Apontamento
+	if err := json.NewDecoder(r.Body).Decode(&apontamento); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.CriarApontamento(ctx, &apontamento); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrAtendimentoApontamentoVazio),
+			errors.Is(err, model.ErrDescricaoApontamentoVazia),
+			errors.Is(err, model.ErrPeriodoApontamentoInvalido),
+			errors.Is(err, model.ErrFimApontamentoInvalido),
+			errors.Is(err, model.ErrIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar apontamento", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrAtendimentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "atendimento não encontrado ao criar apontamento", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrRowsAffected),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrScannerAtendimento):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao criar apontamento", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao criar apontamento", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao criar apontamento", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao criar apontamento", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoCriar,
+		entidadeApontamento,
+		fmt.Sprintf("Apontamento criado via API: %s", apontamento.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToApontamentoResponse(&apontamento))
+}
+
+// BuscarPorID godoc
+// @Summary Busca um apontamento pelo ID
+// @Description Busca um apontamento pelo ID fornecido na URL
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do apontamento"
+// @Success 200 {object} response.ApontamentoResponse
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/buscar-por-id/{id} [get]
+// BuscarPorID busca um apontamento pelo ID
+func (h *ApontamentoHandler) BuscarPorID(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	apontamento, err := h.Usecase.BuscarApontamentoPorID(ctx, id)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrApontamentoIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "ID do apontamento inválido", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrApontamentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "apontamento não encontrado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrScannerApontamento):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao buscar apontamento", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar apontamento", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar apontamento", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar apontamento", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.ToApontamentoResponse(apontamento))
+}
+
+// BuscarTudo godoc
+// @Summary Lista apontamentos com filtros opcionais
+// @Description Lista apontamentos com paginação e filtros opcionais por atendimento, chamado, técnico, faturamento e período de início (RFC 3339)
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Param pagina query int false "Número da página" default(1)
+// @Param limite query int false "Número de itens por página" default(10) maximum(100)
+// @Param atendimentoId query string false "ID do atendimento"
+// @Param chamadoId query string false "ID do chamado"
+// @Param usuarioId query string false "ID do técnico"
+// @Param faturavel query bool false "Apenas apontamentos faturáveis ou não faturáveis"
+// @Param inicio query string false "Apontamentos iniciados a partir de (RFC 3339)"
+// @Param fim query string false "Apontamentos iniciados antes de (RFC 3339)"
+// @Success 200 {object} response.PageResponse[model.Apontamento]
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/buscar-tudo [get]
+// BuscarTudo lista apontamentos com filtros opcionais
+func (h *ApontamentoHandler) BuscarTudo(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.ApontamentoFiltro{}
+
+	if pagina, err := strconv.Atoi(query.Get("pagina")); err == nil {
+		filtro.Pagina = pagina
+	}
+
+	if limite, err := strconv.Atoi(query.Get("limite")); err == nil {
+		filtro.Limite = limite
+	}
+
+	if atendimentoID := query.Get("atendimentoId"); atendimentoID != "" {
+		filtro.AtendimentoID = &atendimentoID
+	}
+
+	if chamadoID := query.Get("chamadoId"); chamadoID != "" {
+		filtro.ChamadoID = &chamadoID
+	}
+
+	if usuarioID := query.Get("usuarioId"); usuarioID != "" {
+		filtro.UsuarioID = &usuarioID
+	}
+
+	if faturavelStr := query.Get("faturavel"); faturavelStr != "" {
+		if faturavel, err := strconv.ParseBool(faturavelStr); err == nil {
+			filtro.Faturavel = &faturavel
+		}
+	}
+
+	var err error
+	if filtro.Inicio, filtro.Fim, err = periodoDaQuery(query.Get("inicio"), query.Get("fim")); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "período inválido ao listar apontamentos", err.Error())
+		return
+	}
+
+	items, total, filtroCorrigido, err := h.Usecase.ListarApontamentos(ctx, filtro)
+	if err != nil {
+		switch {
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerApontamento),
+			errors.Is(err, repository.ErrScan):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao listar apontamentos", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar apontamentos", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar apontamentos", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar apontamentos", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.PageResponse[model.Apontamento]{
+		Total:  total,
+		Pagina: filtroCorrigido.Pagina,
+		Limite: filtroCorrigido.Limite,
+		Items:  items,
+	})
+}
+
+// Atualizar godoc
+// @Summary Atualiza um apontamento
+// @Description Atualiza o período, a descrição e o faturamento de um apontamento. Apenas o autor, ADM e DEV podem alterá-lo; no cronômetro em andamento, o período é mantido.
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do apontamento"
+// @Param apontamento body model.Apontamento true "Dados do apontamento"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/atualizar/{id} [put]
+// Atualizar atualiza um apontamento
+func (h *ApontamentoHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPut) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	var apontamento model.Apontamento
+	if err := json.NewDecoder(r.Body).Decode(&apontamento); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtualizarApontamento(ctx, id, &apontamento); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrApontamentoIDInvalido),
+			errors.Is(err, model.ErrDescricaoApontamentoVazia),
+			errors.Is(err, model.ErrPeriodoApontamentoInvalido),
+			errors.Is(err, model.ErrFimApontamentoInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar apontamento", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrApontamentoDeOutroUsuario):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para atualizar o apontamento", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrApontamentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "apontamento não encontrado ao atualizar", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrScannerApontamento):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao atualizar apontamento", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar apontamento", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar apontamento", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar apontamento", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeApontamento,
+		fmt.Sprintf("Apontamento atualizado via API: ID(%s) | Descricao=%s | Faturavel=%t", id, apontamento.Descricao, apontamento.Faturavel),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "apontamento atualizado com sucesso"})
+}
+
+// Deletar godoc
+// @Summary Deleta um apontamento
+// @Description Deleta um apontamento pelo ID. Apenas o autor, ADM e DEV podem deletá-lo.
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do apontamento"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/deletar/{id} [delete]
+// Deletar deleta um apontamento
+func (h *ApontamentoHandler) Deletar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodDelete) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	if err := h.Usecase.DeletarApontamento(ctx, id); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrApontamentoIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "ID do apontamento inválido", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrApontamentoDeOutroUsuario):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para deletar o apontamento", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrApontamentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "apontamento não encontrado ao deletar", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrScannerApontamento):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao deletar apontamento", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao deletar apontamento", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao deletar apontamento", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao deletar apontamento", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoDeletar,
+		entidadeApontamento,
+		fmt.Sprintf("Apontamento deletado via API: ID(%s)", id),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "apontamento deletado com sucesso"})
+}
+
+// Iniciar godoc
+// @Summary Inicia o cronômetro de um apontamento
+// @Description Inicia o cronômetro do usuário autenticado em um atendimento vigente. Cada técnico possui no máximo um cronômetro em andamento.
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Param apontamento body model.Apontamento true "Atendimento, descrição e faturamento"
+// @Success 201 {object} response.ApontamentoResponse
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/iniciar [post]
+// Iniciar inicia o cronômetro de um apontamento
+func (h *ApontamentoHandler) Iniciar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	var apontamento model.Apontamento
+	if err := json.NewDecoder(r.Body).Decode(&apontamento); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.IniciarApontamento(ctx, &apontamento); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrAtendimentoApontamentoVazio),
+			errors.Is(err, model.ErrDescricaoApontamentoVazia),
+			errors.Is(err, model.ErrIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao iniciar apontamento", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrAtendimentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "atendimento não encontrado ao iniciar apontamento", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrApontamentoEmAndamento),
+			errors.Is(err, model.ErrChamadoSemAtendimentoAtivo):
+			response.ErrorJSON(w, http.StatusConflict, "não foi possível iniciar o apontamento", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrRowsAffected),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrScannerApontamento),
+			errors.Is(err, repository.ErrScannerAtendimento):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao iniciar apontamento", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao iniciar apontamento", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao iniciar apontamento", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao iniciar apontamento", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoCriar,
+		entidadeApontamento,
+		fmt.Sprintf("Cronômetro de apontamento iniciado via API: %s", apontamento.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToApontamentoResponse(&apontamento))
+}
+
+// Parar godoc
+// @Summary Para o cronômetro em andamento
+// @Description Encerra o cronômetro em andamento do usuário autenticado e registra a duração do apontamento
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.ApontamentoResponse
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/parar [post]
+// Parar para o cronômetro em andamento
+func (h *ApontamentoHandler) Parar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	apontamento, err := h.Usecase.PararApontamento(ctx)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrSemApontamentoEmAndamento),
+			errors.Is(err, repository.ErrApontamentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusConflict, "não há cronômetro em andamento para parar", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrScannerApontamento):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao parar apontamento", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao parar apontamento", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao parar apontamento", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao parar apontamento", err.Error())
+			return
+		}
+	}
+
+	err = h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeApontamento,
+		fmt.Sprintf("Cronômetro de apontamento parado via API: %s", apontamento.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.ToApontamentoResponse(apontamento))
+}
+
+// EmAndamento godoc
+// @Summary Busca o cronômetro em andamento
+// @Description Retorna o cronômetro em andamento do usuário autenticado
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.ApontamentoResponse
+// @Failure 401 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/em-andamento [get]
+// EmAndamento busca o cronômetro em andamento
+func (h *ApontamentoHandler) EmAndamento(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	apontamento, err := h.Usecase.BuscarApontamentoEmAndamento(ctx)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrSemApontamentoEmAndamento):
+			response.ErrorJSON(w, http.StatusNotFound, "nenhum cronômetro em andamento", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrScannerApontamento):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao buscar cronômetro em andamento", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar cronômetro em andamento", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar cronômetro em andamento", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar cronômetro em andamento", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.ToApontamentoResponse(apontamento))
+}
+
+// Totais godoc
+// @Summary Totaliza as horas apontadas
+// @Description Soma os minutos dos apontamentos concluídos agrupados por chamado, categoria ou técnico, com filtros opcionais de faturamento e período de início (RFC 3339)
+// @Tags Apontamento
+// @Accept json
+// @Produce json
+// @Param agruparPor query string false "Agrupamento: CHAMADO, CATEGORIA ou TECNICO" default(CHAMADO)
+// @Param faturavel query bool false "Apenas apontamentos faturáveis ou não faturáveis"
+// @Param inicio query string false "Apontamentos iniciados a partir de (RFC 3339)"
+// @Param fim query string false "Apontamentos iniciados antes de (RFC 3339)"
+// @Success 200 {object} response.TotaisApontamentoResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /apontamentos/totais [get]
+// Totais totaliza as horas apontadas
+func (h *ApontamentoHandler) Totais(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.TotaisApontamentoFiltro{
+		AgruparPor: model.AgrupamentoApontamento(query.Get("agruparPor")),
+	}
+
+	if faturavelStr := query.Get("faturavel"); faturavelStr != "" {
+		if faturavel, err := strconv.ParseBool(faturavelStr); err == nil {
+			filtro.Faturavel = &faturavel
+		}
+	}
+
+	var err error
+	if filtro.Inicio, filtro.Fim, err = periodoDaQuery(query.Get("inicio"), query.Get("fim")); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "período inválido ao totalizar apontamentos", err.Error())
+		return
+	}
+
+	totais, err := h.Usecase.TotalizarApontamentos(ctx, filtro)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrAgrupamentoApontamentoInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "agrupamento inválido ao totalizar apontamentos", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerApontamento):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao totalizar apontamentos", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao totalizar apontamentos", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao totalizar apontamentos", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao totalizar apontamentos", err.Error())
+			return
+		}
+	}
+
+	if filtro.AgruparPor == "" {
+		filtro.AgruparPor = model.AgruparPorChamado
+	}
+
+	response.JSON(w, http.StatusOK, response.TotaisApontamentoResponse{
+		AgruparPor: filtro.AgruparPor,
+		Inicio:     filtro.Inicio,
+		Fim:        filtro.Fim,
+		Totais:     totais,
+	})
+}
+
+// periodoDaQuery interpreta os instantes opcionais de início e fim (RFC 3339) de uma consulta.
+func periodoDaQuery(inicioStr, fimStr string) (*time.Time, *time.Time, error) {
+	var inicio, fim *time.Time
+
+	if inicioStr != "" {
+		t, err := time.Parse(time.RFC3339, inicioStr)
+		if err != nil {
+			return nil, nil, err
+		}
+		inicio = &t
+	}
+
+	if fimStr != "" {
+		t, err := time.Parse(time.RFC3339, fimStr)
+		if err != nil {
+			return nil, nil, err
+		}
+		fim = &t
+	}
+
+	return inicio, fim, nil
+}
@@ -66,6 +66,11 @@ func (h *AtendimentoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar atendimento", err.Error())
 			return
 
+		// conflitos - 409
+		case errors.Is(err, model.ErrAtendimentoAtivoExistente):
+			response.ErrorJSON(w, http.StatusConflict, "chamado já possui técnico atribuído", err.Error())
+			return
+
 		// erros do servidor - 500
 		case errors.Is(err, utils.ErrUUIDv7Generation),
 			errors.Is(err, repository.ErrRowsAffected),
@@ -73,11 +78,17 @@ func (h *AtendimentoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao criar atendimento", err.Error())
 			return
 
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para criar o atendimento", err.Error())
+			return
+
 		// erros de contexto - 408
 		case errors.Is(err, context.DeadlineExceeded):
 			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao criar atendimento", err.Error())
 			return
-		
+
 			// erros de contexto - 400
 		case errors.Is(err, context.Canceled):
 			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao criar atendimento", err.Error())
@@ -90,10 +101,11 @@ func (h *AtendimentoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoCriar,
 		entidadeAtendimento,
+		atendimento.ChamadoID,
 		fmt.Sprintf("Atendimento criado via API: %s", atendimento.String()),
 	)
 	if err != nil {
@@ -143,6 +155,11 @@ func (h *AtendimentoHandler) BuscarPorID(w http.ResponseWriter, r *http.Request)
 			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao buscar atendimento", err.Error())
 			return
 
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para buscar o atendimento", err.Error())
+			return
+
 		// erros de contexto - 408
 		case errors.Is(err, context.DeadlineExceeded):
 			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar atendimento", err.Error())
@@ -163,86 +180,205 @@ func (h *AtendimentoHandler) BuscarPorID(w http.ResponseWriter, r *http.Request)
 	response.JSON(w, http.StatusOK, response.ToAtendimentoResponse(atendimento))
 }
 
-// Atualizar godoc
-// @Sumary Atualiza um atendimento existente
-// @Description Atualiza um atendimento existente com os dados fornecidos no corpo da requisição
+// Transferir godoc
+// @Sumary Transfere um chamado para outro técnico
+// @Description Finaliza o atendimento vigente do chamado e inicia um novo atendimento com o técnico informado. Um chamado ainda na fila é atribuído.
 // @Tags Atendimento
 // @Accept json
 // @Produce json
-// @Param id path string true "ID do atendimento"
-// @Param atendimento body model.Atendimento true "Dados do atendimento"
-// @Success 204 {object} any
+// @Param chamadoId path string true "ID do chamado"
+// @Param transferencia body model.TransferenciaAtendimento true "Técnico de destino e motivo"
+// @Success 201 {object} response.AtendimentoResponse
 // @Failure 400 {object} any
 // @Failure 404 {object} any
 // @Failure 405 {object} any
 // @Failure 408 {object} any
+// @Failure 409 {object} any
 // @Failure 500 {object} any
-// @Router /atendimentos/atualizar/{id} [put]
-// Atualizar atualiza um atendimento existente
-func (h *AtendimentoHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
-	if !metodoHttpValido(w, r, http.MethodPut) {
+// @Router /atendimentos/transferir/{chamadoId} [post]
+// Transferir transfere um chamado para outro técnico
+func (h *AtendimentoHandler) Transferir(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
 		return
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
 	defer cancel()
 
-	id := lastSegment(r.URL.Path)
+	chamadoID := lastSegment(r.URL.Path)
 
-	var atendimento model.Atendimento
-	if err := json.NewDecoder(r.Body).Decode(&atendimento); err != nil {
+	var transferencia model.TransferenciaAtendimento
+	if err := json.NewDecoder(r.Body).Decode(&transferencia); err != nil {
 		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
 		return
 	}
 
-	if err := h.Usecase.AtualizarAtendimento(ctx, id, &atendimento); err != nil {
+	atendimento, err := h.Usecase.TransferirChamado(ctx, chamadoID, &transferencia)
+	if err != nil {
 		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrChamadoIDInvalido),
+			errors.Is(err, model.ErrAtribuidoIDInvalido),
+			errors.Is(err, model.ErrMotivoAtendimentoObrigatorio):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao transferir chamado", err.Error())
+			return
+
 		// recursos não encontrados - 404
-		case errors.Is(err, model.ErrAtendimentoIDInvalido),
+		case errors.Is(err, repository.ErrChamadoNaoEncontrado),
+			errors.Is(err, repository.ErrUsuarioNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "chamado ou técnico não encontrado ao transferir chamado", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrTransferenciaMesmoTecnico),
+			errors.Is(err, model.ErrTransferenciaNaoPermitida),
 			errors.Is(err, repository.ErrAtendimentoNaoEncontrado):
-			response.ErrorJSON(w, http.StatusNotFound, "ID do atendimento inválido ao atualizar", err.Error())
+			response.ErrorJSON(w, http.StatusConflict, "não foi possível transferir o chamado", err.Error())
+			return
+
+		// erros do servidor - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao transferir chamado", err.Error())
 			return
 
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para transferir o chamado", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao transferir chamado", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao transferir chamado", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao transferir chamado", err.Error())
+			return
+		}
+	}
+
+	err = h.UsecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoCriar,
+		entidadeAtendimento,
+		atendimento.ChamadoID,
+		fmt.Sprintf("Chamado transferido via API: %s | Motivo=%s", atendimento.String(), transferencia.Motivo),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToAtendimentoResponse(atendimento))
+}
+
+// Devolver godoc
+// @Sumary Devolve um chamado para a fila
+// @Description Finaliza o atendimento vigente do chamado e o devolve para a fila com o status ABERTO
+// @Tags Atendimento
+// @Accept json
+// @Produce json
+// @Param chamadoId path string true "ID do chamado"
+// @Param devolucao body model.DevolucaoAtendimento true "Motivo da devolução"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /atendimentos/devolver/{chamadoId} [post]
+// Devolver devolve um chamado para a fila
+func (h *AtendimentoHandler) Devolver(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	chamadoID := lastSegment(r.URL.Path)
+
+	var devolucao model.DevolucaoAtendimento
+	if err := json.NewDecoder(r.Body).Decode(&devolucao); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.DevolverChamado(ctx, chamadoID, &devolucao); err != nil {
+		switch {
 		// requisições inválidas - 400
-		case errors.As(err, &utils.ValidacaoErrors{}):
-			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar atendimento", err.Error())
+		case errors.Is(err, model.ErrChamadoIDInvalido),
+			errors.Is(err, model.ErrMotivoAtendimentoObrigatorio):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao devolver chamado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida),
+			errors.Is(err, model.ErrTransicaoStatusNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para devolver o chamado", err.Error())
+			return
+
+		// recursos não encontrados - 404
+		case errors.Is(err, repository.ErrChamadoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "chamado não encontrado ao devolver para a fila", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrTransicaoStatusInvalida),
+			errors.Is(err, model.ErrChamadoSemAtendimentoAtivo),
+			errors.Is(err, repository.ErrAtendimentoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusConflict, "não foi possível devolver o chamado para a fila", err.Error())
 			return
 
 		// erros do servidor - 500
 		case errors.Is(err, repository.ErrExecContext),
 			errors.Is(err, repository.ErrQueryContext):
-			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao atualizar atendimento", err.Error())
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao devolver chamado", err.Error())
 			return
 
 		// erros de contexto - 408
 		case errors.Is(err, context.DeadlineExceeded):
-			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar atendimento", err.Error())
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao devolver chamado", err.Error())
 			return
 
 		// erros de contexto - 400
 		case errors.Is(err, context.Canceled):
-			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar atendimento", err.Error())
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao devolver chamado", err.Error())
 			return
 
 		// fallback de segurança - 500
 		default:
-			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar atendimento", err.Error())
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao devolver chamado", err.Error())
 			return
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoAtualizar,
 		entidadeAtendimento,
-		fmt.Sprintf("Atendimento atualizado via API: %s", atendimento.String()),
+		chamadoID,
+		fmt.Sprintf("Chamado devolvido para a fila via API: chamado ID(%s) | Motivo=%s", chamadoID, devolucao.Motivo),
 	)
 	if err != nil {
 		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
 		return
 	}
 
-	response.JSON(w, http.StatusOK, response.ToAtendimentoResponse(&atendimento))
+	response.JSON(w, http.StatusOK, map[string]string{"message": "chamado devolvido para a fila com sucesso"})
 }
 
 // Listar godoc
@@ -291,7 +427,7 @@ func (h *AtendimentoHandler) BuscarTudo(w http.ResponseWriter, r *http.Request)
 	}
 
 	items, total, filtroCorrigido, err := h.Usecase.ListarAtendimentos(ctx, filtro)
-		if err != nil {
+	if err != nil {
 		switch {
 		// erros internos - 500
 		case errors.Is(err, repository.ErrQueryContext),
@@ -317,9 +453,9 @@ func (h *AtendimentoHandler) BuscarTudo(w http.ResponseWriter, r *http.Request)
 		}
 	}
 	response.JSON(w, http.StatusOK, response.PageResponse[model.Atendimento]{
-		Total: total,
+		Total:  total,
 		Pagina: filtroCorrigido.Pagina,
 		Limite: filtroCorrigido.Limite,
-		Items: items,
+		Items:  items,
 	})
-}
\ No newline at end of file
+}
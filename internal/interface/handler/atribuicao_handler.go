@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+// AtribuicaoHandler gerencia as requisições HTTP relacionadas à atribuição automática de chamados.
+type AtribuicaoHandler struct {
+	Usecase usecase.AtribuicaoUsecase
+}
+
+// NewAtribuicaoHandler cria uma nova instância de AtribuicaoHandler.
+func NewAtribuicaoHandler(usecase usecase.AtribuicaoUsecase) *AtribuicaoHandler {
+	return &AtribuicaoHandler{Usecase: usecase}
+}
+
+// Previa godoc
+// @Summary Prévia da atribuição automática
+// @Description Retorna o técnico que seria escolhido para um novo chamado da categoria, o motivo da escolha e os candidatos avaliados. Nenhum chamado é alterado.
+// @Tags Atribuições
+// @Accept json
+// @Produce json
+// @Param categoriaId path string true "ID da categoria"
+// @Success 200 {object} model.SugestaoAtribuicao
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /atribuicoes/previa/{categoriaId} [get]
+// Previa retorna a prévia da atribuição automática para a categoria.
+func (h *AtribuicaoHandler) Previa(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	categoriaID := lastSegment(r.URL.Path)
+
+	sugestao, err := h.Usecase.SugerirTecnico(ctx, categoriaID)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrCategoriaIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "ID inválido ao gerar prévia de atribuição", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrCategoriaNaoEncontrada):
+			response.ErrorJSON(w, http.StatusNotFound, "categoria não encontrada ao gerar prévia de atribuição", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerCategoria),
+			errors.Is(err, repository.ErrScannerCandidatoAtribuicao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao gerar prévia de atribuição", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao gerar prévia de atribuição", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao gerar prévia de atribuição", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao gerar prévia de atribuição", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, sugestao)
+}
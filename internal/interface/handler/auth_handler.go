@@ -4,10 +4,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"strings"
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/jwt"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
 )
@@ -27,6 +31,11 @@ type RefreshRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// LogoutRequest representa o payload opcional para a requisição de logout.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
 // LoginDTO representa o payload para a requisição de login.
 type LoginDto struct {
 	Login string `json:"login"`
@@ -46,6 +55,19 @@ func parseLoginRequest(r *http.Request) (*LoginDto, error) {
 	return &req, nil
 }
 
+// origemRequisicao identifica o dispositivo (User-Agent) e o IP de onde partiu a requisição,
+// registrados na sessão. Atrás de um proxy, vale o primeiro IP de X-Forwarded-For.
+func origemRequisicao(r *http.Request) model.OrigemSessao {
+	ip := strings.TrimSpace(strings.Split(r.Header.Get("X-Forwarded-For"), ",")[0])
+	if ip == "" {
+		ip = r.RemoteAddr
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			ip = host
+		}
+	}
+	return model.OrigemSessao{Dispositivo: r.UserAgent(), IP: ip}
+}
+
 // jwtClaimsFromRequest extrai as claims JWT do contexto da requisição.
 func jwtClaimsFromRequest(r *http.Request) *jwt.Claims {
 	if valor := r.Context().Value(middleware.ChaveUsuario); valor != nil {
@@ -60,7 +82,7 @@ func jwtClaimsFromRequest(r *http.Request) *jwt.Claims {
 
 // Login godoc
 // @Summary      Login
-// @Description  Autentica um usuário e retorna tokens JWT.
+// @Description  Autentica um usuário, abre uma nova sessão e retorna tokens JWT.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -77,7 +99,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tokens, err := h.Usecase.Login(r.Context(), req.Login, req.Senha)
+	tokens, err := h.Usecase.Login(r.Context(), req.Login, req.Senha, origemRequisicao(r))
 	if err != nil {
 		response.ErrorJSON(w, http.StatusUnauthorized, "falha no login", err.Error())
 		return
@@ -88,7 +110,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 // Refresh godoc
 // @Summary      Refresh
-// @Description  Atualiza os tokens JWT usando um token de refresh.
+// @Description  Atualiza os tokens JWT usando um token de refresh. O token de refresh usado deixa de valer; reapresentá-lo encerra a sessão.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -105,8 +127,12 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tokens, err := h.Usecase.Refresh(r.Context(), body.RefreshToken)
+	tokens, err := h.Usecase.Refresh(r.Context(), body.RefreshToken, origemRequisicao(r))
 	if err != nil {
+		if errors.Is(err, model.ErrReusoRefreshToken) {
+			response.ErrorJSON(w, http.StatusUnauthorized, "refresh reutilizado, sessão encerrada", err.Error())
+			return
+		}
 		response.ErrorJSON(w, http.StatusUnauthorized, "refresh inválido", err.Error())
 		return
 	}
@@ -139,3 +165,84 @@ func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
 
 	response.JSON(w, http.StatusOK, usuario)
 }
+
+// Logout godoc
+// @Summary      Logout
+// @Description  Encerra a sessão do token de acesso usado na requisição, revogando os seus tokens de acesso e de refresh e, quando informado, o token de refresh.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        logoutRequest  body      LogoutRequest  false  "Token de refresh da sessão"
+// @Success      200            {object}  map[string]string
+// @Failure      400            {object}  map[string]string
+// @Failure      401            {object}  map[string]string
+// @Failure      405            {object}  map[string]string
+// @Failure      500            {object}  map[string]string
+// @Router       /logout [post]
+// Logout encerra a sessão do usuário autenticado.
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	claims := jwtClaimsFromRequest(r)
+	if claims == nil {
+		response.ErrorJSON(w, http.StatusUnauthorized, "não autenticado", nil)
+		return
+	}
+
+	// O corpo é opcional: o token de refresh só é necessário para tokens emitidos antes das sessões
+	var body LogoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	err := h.Usecase.Logout(r.Context(), claims.ID, claims.Sessao, claims.JTI(), claims.ExpiresAt.Time, body.RefreshToken)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrTokenOutroUsuario),
+			errors.Is(err, model.ErrRefreshInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "refresh inválido", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao encerrar a sessão", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "sessão encerrada com sucesso"})
+}
+
+// LogoutTodasSessoes godoc
+// @Summary      Logout de todas as sessões
+// @Description  Encerra todas as sessões do usuário autenticado, revogando os tokens de acesso e de refresh já emitidos, inclusive o usado na requisição.
+// @Tags         auth
+// @Produce      json
+// @Success      200  {object}  map[string]string
+// @Failure      401  {object}  map[string]string
+// @Failure      405  {object}  map[string]string
+// @Failure      500  {object}  map[string]string
+// @Router       /logout/todas-sessoes [post]
+// LogoutTodasSessoes encerra todas as sessões do usuário autenticado.
+func (h *AuthHandler) LogoutTodasSessoes(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	claims := jwtClaimsFromRequest(r)
+	if claims == nil {
+		response.ErrorJSON(w, http.StatusUnauthorized, "não autenticado", nil)
+		return
+	}
+
+	if err := h.Usecase.LogoutTodasSessoes(r.Context(), claims.ID); err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, "erro ao encerrar as sessões", err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "sessões encerradas com sucesso"})
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+// AutorizacaoHandler gerencia as requisições HTTP relacionadas às permissões efetivas do usuário.
+type AutorizacaoHandler struct {
+	Usecase usecase.AutorizacaoUsecase
+}
+
+// NewAutorizacaoHandler cria uma nova instância de AutorizacaoHandler.
+func NewAutorizacaoHandler(usecase usecase.AutorizacaoUsecase) *AutorizacaoHandler {
+	return &AutorizacaoHandler{Usecase: usecase}
+}
+
+// MinhasPermissoes godoc
+// @Summary Permissões efetivas do usuário autenticado
+// @Description Retorna, para cada categoria ativa, a permissão efetiva do usuário autenticado, combinando a permissão global com as permissões concedidas na categoria, e as operações que ela permite sobre os chamados da categoria.
+// @Tags Autorização
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.PermissoesEfetivas
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /autorizacao/minhas-permissoes [get]
+// MinhasPermissoes retorna as permissões efetivas do usuário autenticado por categoria.
+func (h *AutorizacaoHandler) MinhasPermissoes(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	permissoes, err := h.Usecase.ListarPermissoesEfetivas(ctx)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerCategoria),
+			errors.Is(err, repository.ErrScannerCategoriaPermissao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao buscar as permissões efetivas", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar as permissões efetivas", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar as permissões efetivas", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar as permissões efetivas", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, permissoes)
+}
@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+const (
+	entidadeAvaliacao = "AVALIACAO"
+)
+
+type AvaliacaoHandler struct {
+	Usecase    usecase.AvaliacaoUsecase
+	UsecaseLog usecase.LogUsecase
+}
+
+func NewAvaliacaoHandler(usecase usecase.AvaliacaoUsecase, usecaseLog usecase.LogUsecase) *AvaliacaoHandler {
+	return &AvaliacaoHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// Responder godoc
+// @Summary Responde a pesquisa de satisfação de um chamado resolvido
+// @Description O criador do chamado avalia o atendimento (nota de 1 a 5 e comentário) e aceita ou rejeita a solução. Aceitar fecha o chamado; rejeitar reabre o chamado e registra o comentário como acompanhamento.
+// @Tags Avaliacao
+// @Accept json
+// @Produce json
+// @Param chamadoId path string true "ID do chamado"
+// @Param resposta body model.RespostaAvaliacao true "Nota, comentário e aceite da solução"
+// @Success 201 {object} response.AvaliacaoResponse
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /avaliacoes/responder/{chamadoId} [post]
+// Responder responde a pesquisa de satisfação de um chamado resolvido
+func (h *AvaliacaoHandler) Responder(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	chamadoID := lastSegment(r.URL.Path)
+
+	var resposta model.RespostaAvaliacao
+	if err := json.NewDecoder(r.Body).Decode(&resposta); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	avaliacao, err := h.Usecase.ResponderAvaliacao(ctx, chamadoID, &resposta)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrChamadoIDInvalido),
+			errors.Is(err, model.ErrNotaAvaliacaoInvalida),
+			errors.Is(err, model.ErrComentarioRejeicaoObrigatorio):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao responder avaliação", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrAvaliacaoDeOutroUsuario),
+			errors.Is(err, model.ErrTransicaoStatusNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para responder a avaliação", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrChamadoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "chamado não encontrado ao responder avaliação", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrChamadoNaoResolvido),
+			errors.Is(err, model.ErrChamadoNaoReabrivel),
+			errors.Is(err, model.ErrPrazoReaberturaExpirado),
+			errors.Is(err, model.ErrTransicaoStatusInvalida):
+			response.ErrorJSON(w, http.StatusConflict, "não foi possível responder a avaliação", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrRowsAffected),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao responder avaliação", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao responder avaliação", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao responder avaliação", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao responder avaliação", err.Error())
+			return
+		}
+	}
+
+	err = h.UsecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoCriar,
+		entidadeAvaliacao,
+		avaliacao.ChamadoID,
+		fmt.Sprintf("Avaliação respondida via API: %s", avaliacao.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToAvaliacaoResponse(avaliacao))
+}
+
+// BuscarPorChamado godoc
+// @Summary Busca as avaliações de um chamado
+// @Description Retorna as respostas à pesquisa de satisfação do chamado, da mais antiga à mais recente
+// @Tags Avaliacao
+// @Accept json
+// @Produce json
+// @Param chamadoId path string true "ID do chamado"
+// @Success 200 {array} response.AvaliacaoResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /avaliacoes/buscar-por-chamado/{chamadoId} [get]
+// BuscarPorChamado busca as avaliações de um chamado
+func (h *AvaliacaoHandler) BuscarPorChamado(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	chamadoID := lastSegment(r.URL.Path)
+	avaliacoes, err := h.Usecase.BuscarAvaliacoesPorChamado(ctx, chamadoID)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrChamadoIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "ID do chamado inválido", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para buscar as avaliações do chamado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerAvaliacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao buscar avaliações", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar avaliações", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar avaliações", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar avaliações", err.Error())
+			return
+		}
+	}
+
+	resposta := make([]response.AvaliacaoResponse, 0, len(avaliacoes))
+	for i := range avaliacoes {
+		resposta = append(resposta, *response.ToAvaliacaoResponse(&avaliacoes[i]))
+	}
+	response.JSON(w, http.StatusOK, resposta)
+}
+
+// CSAT godoc
+// @Summary Agrega a satisfação dos usuários
+// @Description Agrega as avaliações por técnico, categoria ou mês, com nota média, percentual de satisfação (notas 4 e 5) e soluções rejeitadas. O período filtra a data da avaliação (RFC 3339).
+// @Tags Avaliacao
+// @Accept json
+// @Produce json
+// @Param agruparPor query string false "Agrupamento: TECNICO, CATEGORIA ou MES" default(TECNICO)
+// @Param inicio query string false "Avaliações respondidas a partir de (RFC 3339)"
+// @Param fim query string false "Avaliações respondidas antes de (RFC 3339)"
+// @Success 200 {object} response.CSATResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /avaliacoes/csat [get]
+// CSAT agrega a satisfação dos usuários
+func (h *AvaliacaoHandler) CSAT(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.CSATFiltro{
+		AgruparPor: model.AgrupamentoCSAT(query.Get("agruparPor")),
+	}
+
+	var err error
+	if filtro.Inicio, filtro.Fim, err = periodoDaQuery(query.Get("inicio"), query.Get("fim")); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "período inválido ao agregar avaliações", err.Error())
+		return
+	}
+
+	totais, err := h.Usecase.AgregarCSAT(ctx, filtro)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrAgrupamentoCSATInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "agrupamento inválido ao agregar avaliações", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerAvaliacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao agregar avaliações", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao agregar avaliações", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao agregar avaliações", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao agregar avaliações", err.Error())
+			return
+		}
+	}
+
+	if filtro.AgruparPor == "" {
+		filtro.AgruparPor = model.CSATPorTecnico
+	}
+
+	response.JSON(w, http.StatusOK, response.CSATResponse{
+		AgruparPor: filtro.AgruparPor,
+		Inicio:     filtro.Inicio,
+		Fim:        filtro.Fim,
+		Totais:     totais,
+	})
+}
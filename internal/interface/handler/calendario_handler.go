@@ -0,0 +1,746 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+const (
+	entidadeCalendario = "CALENDARIO"
+
+	// tamanhoMaximoArquivoFeriados limita o arquivo de importação de feriados (1 MB)
+	tamanhoMaximoArquivoFeriados = 1 << 20
+)
+
+// CalendarioHandler gerencia as requisições HTTP relacionadas a calendários de expediente.
+type CalendarioHandler struct {
+	Usecase    usecase.CalendarioUsecase
+	UsecaseLog usecase.LogUsecase
+}
+
+// NewCalendarioHandler cria uma nova instância de CalendarioHandler.
+func NewCalendarioHandler(usecase usecase.CalendarioUsecase, usecaseLog usecase.LogUsecase) *CalendarioHandler {
+	return &CalendarioHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// Criar godoc
+// @Summary Criar um novo calendário
+// @Description Cria um calendário de expediente. Sem categoria, o calendário é o padrão.
+// @Tags Calendarios
+// @Accept json
+// @Produce json
+// @Param calendario body model.Calendario true "Calendário"
+// @Success 201 {object} response.CalendarioResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/criar [post]
+// Criar calendário
+func (h *CalendarioHandler) Criar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	var calendario model.Calendario
+	if err := json.NewDecoder(r.Body).Decode(&calendario); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.CriarCalendario(ctx, &calendario); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrNomeInvalido),
+			errors.Is(err, model.ErrCalendarioSemExpediente),
+			errors.Is(err, model.ErrDiaSemanaInvalido),
+			errors.Is(err, model.ErrDiaSemanaDuplicado),
+			errors.Is(err, model.ErrHorarioExpedienteInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar calendário", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, repository.ErrCalendarioJaExiste):
+			response.ErrorJSON(w, http.StatusConflict, "calendário já cadastrado para a categoria", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao criar calendário", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao criar calendário", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao criar calendário", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao criar calendário", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoCriar,
+		entidadeCalendario,
+		fmt.Sprintf("Calendário criado via API: %s", calendario.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToCalendarioResponse(&calendario))
+}
+
+// BuscarTudo godoc
+// @Summary Listar calendários
+// @Description Retorna lista paginada de calendários (sem os feriados)
+// @Tags Calendarios
+// @Accept json
+// @Produce json
+// @Param pagina query int false "Página"
+// @Param limite query int false "Limite"
+// @Param busca query string false "Busca por nome"
+// @Param categoriaId query string false "ID da categoria"
+// @Param status query bool false "Status"
+// @Success 200 {object} []model.Calendario
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/buscar-tudo [get]
+// BuscarTudo lista calendários com paginação e filtros.
+func (h *CalendarioHandler) BuscarTudo(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.CalendarioFiltro{}
+
+	if pagina, err := strconv.Atoi(query.Get("pagina")); err == nil {
+		filtro.Pagina = pagina
+	}
+	if limite, err := strconv.Atoi(query.Get("limite")); err == nil {
+		filtro.Limite = limite
+	}
+	if busca := query.Get("busca"); busca != "" {
+		filtro.Busca = &busca
+	}
+	if categoriaID := query.Get("categoriaId"); categoriaID != "" {
+		filtro.CategoriaID = &categoriaID
+	}
+	if statusStr := query.Get("status"); statusStr != "" {
+		if status, err := strconv.ParseBool(statusStr); err == nil {
+			filtro.Status = &status
+		}
+	}
+
+	items, total, filtroCorrigido, err := h.Usecase.ListarCalendarios(ctx, filtro)
+	if err != nil {
+		switch {
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerCalendario),
+			errors.Is(err, repository.ErrScan):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao listar calendários", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar calendários", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar calendários", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar calendários", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.PageResponse[model.Calendario]{
+		Total:  total,
+		Pagina: filtroCorrigido.Pagina,
+		Limite: filtroCorrigido.Limite,
+		Items:  items,
+	})
+}
+
+// BuscarPorID godoc
+// @Summary Buscar calendário por ID
+// @Description Retorna um calendário pelo ID, com expedientes e feriados
+// @Tags Calendarios
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do calendário"
+// @Success 200 {object} response.CalendarioResponse
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/buscar-por-id/{id} [get]
+// BuscarPorID busca um calendário pelo ID.
+func (h *CalendarioHandler) BuscarPorID(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	calendario, err := h.Usecase.BuscarCalendarioPorID(ctx, id)
+	if err != nil {
+		switch {
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrCalendarioIDInvalido),
+			errors.Is(err, repository.ErrCalendarioNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao buscar calendário", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerCalendario):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao buscar calendário", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar calendário", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar calendário", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar calendário", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.ToCalendarioResponse(calendario))
+}
+
+// Atualizar godoc
+// @Summary Atualizar calendário
+// @Description Atualiza o nome, a categoria, o status e os expedientes de um calendário. Os feriados não são alterados.
+// @Tags Calendarios
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do calendário"
+// @Param calendario body model.Calendario true "Calendário"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/atualizar/{id} [put]
+// Atualizar atualiza um calendário existente.
+func (h *CalendarioHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPut) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	var calendario model.Calendario
+	if err := json.NewDecoder(r.Body).Decode(&calendario); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtualizarCalendario(ctx, id, &calendario); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrCalendarioIDInvalido),
+			errors.Is(err, model.ErrNomeInvalido),
+			errors.Is(err, model.ErrCalendarioSemExpediente),
+			errors.Is(err, model.ErrDiaSemanaInvalido),
+			errors.Is(err, model.ErrDiaSemanaDuplicado),
+			errors.Is(err, model.ErrHorarioExpedienteInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar calendário", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrCalendarioNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao atualizar calendário", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, repository.ErrCalendarioJaExiste):
+			response.ErrorJSON(w, http.StatusConflict, "calendário já cadastrado para a categoria", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao atualizar calendário", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar calendário", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar calendário", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar calendário", err.Error())
+			return
+		}
+	}
+
+	calendario.ID = id
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeCalendario,
+		fmt.Sprintf("Calendário atualizado via API: %s", calendario.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "calendário atualizado com sucesso"})
+}
+
+// Desativar godoc
+// @Summary Desativar calendário
+// @Description Desativa um calendário pelo ID. Chamados da categoria passam a usar o calendário padrão.
+// @Tags Calendarios
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do calendário"
+// @Success 200 {object} response.StatusCalendario
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/desativar/{id} [delete]
+// Desativar desativa um calendário.
+func (h *CalendarioHandler) Desativar(w http.ResponseWriter, r *http.Request) {
+	h.alterarStatus(w, r, http.MethodDelete, false)
+}
+
+// Ativar godoc
+// @Summary Ativar calendário
+// @Description Ativa um calendário pelo ID.
+// @Tags Calendarios
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do calendário"
+// @Success 200 {object} response.StatusCalendario
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/ativar/{id} [patch]
+// Ativar ativa um calendário.
+func (h *CalendarioHandler) Ativar(w http.ResponseWriter, r *http.Request) {
+	h.alterarStatus(w, r, http.MethodPatch, true)
+}
+
+// ImportarFeriados godoc
+// @Summary Importar feriados
+// @Description Importa feriados para o calendário a partir de um arquivo CSV (campo "arquivo") com as colunas data e descrição, separadas por vírgula ou ponto e vírgula. As datas podem estar nos formatos AAAA-MM-DD ou DD/MM/AAAA e a linha de cabeçalho é opcional. Datas já cadastradas têm a descrição atualizada.
+// @Tags Calendarios
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID do calendário"
+// @Param arquivo formData file true "Arquivo CSV de feriados"
+// @Success 200 {object} response.FeriadosImportados
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/importar-feriados/{id} [post]
+// ImportarFeriados importa feriados de um arquivo CSV para o calendário.
+func (h *CalendarioHandler) ImportarFeriados(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	r.Body = http.MaxBytesReader(w, r.Body, tamanhoMaximoArquivoFeriados)
+	arquivo, _, err := r.FormFile("arquivo")
+	if err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "arquivo de feriados inválido ou ausente", err.Error())
+		return
+	}
+	defer arquivo.Close()
+
+	feriados, err := lerFeriadosCSV(arquivo)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "arquivo de feriados inválido", err.Error())
+		return
+	}
+
+	total, err := h.Usecase.ImportarFeriados(ctx, id, feriados)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrDataFeriadoInvalida):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao importar feriados", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrCalendarioIDInvalido),
+			errors.Is(err, repository.ErrCalendarioNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao importar feriados", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao importar feriados", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao importar feriados", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao importar feriados", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao importar feriados", err.Error())
+			return
+		}
+	}
+
+	err = h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeCalendario,
+		fmt.Sprintf("Feriados importados via API: calendário ID(%s) feriados(%d)", id, total),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.FeriadosImportados{Importados: total})
+}
+
+// RemoverFeriado godoc
+// @Summary Remover feriado
+// @Description Remove um feriado do calendário pela data.
+// @Tags Calendarios
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do calendário"
+// @Param data query string true "Data do feriado (AAAA-MM-DD)"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/remover-feriado/{id} [delete]
+// RemoverFeriado remove um feriado do calendário.
+func (h *CalendarioHandler) RemoverFeriado(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodDelete) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	data := r.URL.Query().Get("data")
+
+	if err := h.Usecase.RemoverFeriado(ctx, id, data); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrDataFeriadoInvalida):
+			response.ErrorJSON(w, http.StatusBadRequest, "data inválida ao remover feriado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrCalendarioIDInvalido),
+			errors.Is(err, repository.ErrFeriadoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "feriado não encontrado no calendário", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrRowsAffected):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao remover feriado", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao remover feriado", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao remover feriado", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao remover feriado", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeCalendario,
+		fmt.Sprintf("Feriado removido via API: calendário ID(%s) data(%s)", id, data),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "feriado removido com sucesso"})
+}
+
+// TempoUtil godoc
+// @Summary Calcular tempo útil
+// @Description Calcula o tempo de expediente entre dois instantes (RFC 3339) usando o calendário vigente da categoria, ou o calendário padrão quando a categoria não for informada.
+// @Tags Calendarios
+// @Accept json
+// @Produce json
+// @Param inicio query string true "Instante inicial (RFC 3339)"
+// @Param fim query string true "Instante final (RFC 3339)"
+// @Param categoriaId query string false "ID da categoria"
+// @Success 200 {object} response.TempoUtilResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /calendarios/tempo-util [get]
+// TempoUtil calcula o tempo de expediente entre dois instantes.
+func (h *CalendarioHandler) TempoUtil(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	inicio, err := time.Parse(time.RFC3339, query.Get("inicio"))
+	if err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "instante inicial inválido", err.Error())
+		return
+	}
+	fim, err := time.Parse(time.RFC3339, query.Get("fim"))
+	if err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "instante final inválido", err.Error())
+		return
+	}
+
+	duracao, err := h.Usecase.CalcularTempoUtil(ctx, query.Get("categoriaId"), inicio, fim)
+	if err != nil {
+		switch {
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerCalendario):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao calcular tempo útil", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao calcular tempo útil", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao calcular tempo útil", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao calcular tempo útil", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.TempoUtilResponse{
+		Inicio:           inicio,
+		Fim:              fim,
+		TempoUtilMinutos: int64(duracao / time.Minute),
+	})
+}
+
+// alterarStatus trata as requisições de ativação e desativação de calendários.
+func (h *CalendarioHandler) alterarStatus(w http.ResponseWriter, r *http.Request, metodo string, ativo bool) {
+	if !metodoHttpValido(w, r, metodo) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	acao, operacao := model.AcaoAtivar, "ativar"
+	alterar := h.Usecase.AtivarCalendario
+	if !ativo {
+		acao, operacao = model.AcaoDesativar, "desativar"
+		alterar = h.Usecase.DesativarCalendario
+	}
+
+	if err := alterar(ctx, id); err != nil {
+		switch {
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrCalendarioIDInvalido),
+			errors.Is(err, repository.ErrCalendarioNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao "+operacao+" calendário", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao "+operacao+" calendário", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao "+operacao+" calendário", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao "+operacao+" calendário", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao "+operacao+" calendário", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		acao,
+		entidadeCalendario,
+		fmt.Sprintf("Calendário alterado via API: calendário ID(%s) ativo(%t)", id, ativo),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.StatusCalendario{Ativo: ativo})
+}
+
+// lerFeriadosCSV lê os feriados de um arquivo CSV com as colunas data e descrição.
+// O separador (vírgula ou ponto e vírgula) é detectado pela primeira linha, que é
+// ignorada quando não contém uma data válida (cabeçalho).
+func lerFeriadosCSV(arquivo io.Reader) ([]model.Feriado, error) {
+	conteudo, err := io.ReadAll(arquivo)
+	if err != nil {
+		return nil, err
+	}
+	texto := strings.TrimPrefix(string(conteudo), "\ufeff")
+
+	primeiraLinha, _, _ := strings.Cut(texto, "\n")
+
+	leitor := csv.NewReader(strings.NewReader(texto))
+	leitor.FieldsPerRecord = -1
+	leitor.TrimLeadingSpace = true
+	if strings.Contains(primeiraLinha, ";") {
+		leitor.Comma = ';'
+	}
+
+	registros, err := leitor.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	feriados := make([]model.Feriado, 0, len(registros))
+	for i, registro := range registros {
+		if len(registro) == 0 || strings.TrimSpace(registro[0]) == "" {
+			continue
+		}
+
+		descricao := ""
+		if len(registro) > 1 {
+			descricao = strings.TrimSpace(registro[1])
+		}
+
+		feriado, err := model.NewFeriado(strings.TrimSpace(registro[0]), descricao)
+		if err != nil {
+			if i == 0 {
+				// primeira linha sem data válida: cabeçalho
+				continue
+			}
+			return nil, fmt.Errorf("linha %d: %w", i+1, err)
+		}
+		feriados = append(feriados, *feriado)
+	}
+
+	return feriados, nil
+}
@@ -64,7 +64,9 @@ func (h *CategoriaHandler) Criar(w http.ResponseWriter, r *http.Request) {
 	if err := h.Usecase.CriarCategoria(ctx, &categoria); err != nil {
 		switch {
 		// requisições inválidas - 400
-		case errors.As(err, &utils.ValidacaoErrors{}):
+		case errors.As(err, &utils.ValidacaoErrors{}),
+			errors.Is(err, model.ErrNomeInvalido),
+			errors.Is(err, model.ErrEstrategiaAtribuicaoInvalida):
 			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar categoria", err.Error())
 			return
 
@@ -343,7 +345,8 @@ func (h *CategoriaHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
 		switch {
 		// requisições inválidas - 400
 		case errors.Is(err, model.ErrCategoriaIDInvalido),
-			errors.Is(err, model.ErrNomeInvalido):
+			errors.Is(err, model.ErrNomeInvalido),
+			errors.Is(err, model.ErrEstrategiaAtribuicaoInvalida):
 			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar categoria", err.Error())
 
 		// recurso não encontrado - 404
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
@@ -68,6 +69,11 @@ func (h *ChamadoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar chamado", err.Error())
 			return
 
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, utils.ErrUUIDv7Generation),
 			errors.Is(err, repository.ErrRowsAffected),
@@ -92,10 +98,11 @@ func (h *ChamadoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoCriar,
 		entidadeChamado,
+		chamado.ID,
 		fmt.Sprintf("Chamado criado via API: %s", chamado.String()),
 	)
 	if err != nil {
@@ -103,7 +110,7 @@ func (h *ChamadoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response.JSON(w, http.StatusCreated, response.ToChamadoResponse(&chamado))
+	response.JSON(w, http.StatusCreated, response.ToChamadoResponse(&chamado, permissaoDaRequisicao(r)))
 }
 
 // BuscarTudo godoc
@@ -120,6 +127,14 @@ func (h *ChamadoHandler) Criar(w http.ResponseWriter, r *http.Request) {
 // @Param subcategoriaId query string false "ID da subcategoria"
 // @Param criadorId query string false "ID do criador"
 // @Param atribuidoId query string false "ID do atribuído"
+// @Param slaPrimeiraRespostaViolado query bool false "Prazo de primeira resposta violado"
+// @Param slaSolucaoViolado query bool false "Prazo de solução violado"
+// @Param impacto query string false "Impacto (BAIXO, MEDIO, ALTO)"
+// @Param urgencia query string false "Urgência (BAIXA, MEDIA, ALTA)"
+// @Param prioridade query string false "Prioridade (P1, P2, P3, P4)"
+// @Param reaberturasMin query int false "Quantidade mínima de reaberturas"
+// @Param ordenarPor query string false "Campo de ordenação (criadoEm, atualizadoEm, prioridade, prazoSolucao)"
+// @Param ordem query string false "Direção da ordenação (ASC, DESC)"
 // @Success 200 {object} []model.Chamado
 // @Failure 400 {object} any
 // @Failure 405 {object} any
@@ -161,6 +176,32 @@ func (h *ChamadoHandler) BuscarTudo(w http.ResponseWriter, r *http.Request) {
 	if criadorID := query.Get("criadorId"); criadorID != "" {
 		filtro.CriadorID = &criadorID
 	}
+	if violadoStr := query.Get("slaPrimeiraRespostaViolado"); violadoStr != "" {
+		if violado, err := strconv.ParseBool(violadoStr); err == nil {
+			filtro.SLAPrimeiraRespostaViolado = &violado
+		}
+	}
+	if violadoStr := query.Get("slaSolucaoViolado"); violadoStr != "" {
+		if violado, err := strconv.ParseBool(violadoStr); err == nil {
+			filtro.SLASolucaoViolado = &violado
+		}
+	}
+	if impacto := query.Get("impacto"); impacto != "" {
+		filtro.Impacto = &impacto
+	}
+	if urgencia := query.Get("urgencia"); urgencia != "" {
+		filtro.Urgencia = &urgencia
+	}
+	if prioridade := query.Get("prioridade"); prioridade != "" {
+		filtro.Prioridade = &prioridade
+	}
+	if reaberturasStr := query.Get("reaberturasMin"); reaberturasStr != "" {
+		if reaberturas, err := strconv.Atoi(reaberturasStr); err == nil {
+			filtro.ReaberturasMin = &reaberturas
+		}
+	}
+	filtro.OrdenarPor = model.CampoOrdenacaoChamado(query.Get("ordenarPor"))
+	filtro.Ordem = model.Ordem(query.Get("ordem"))
 
 	items, total, filtroCorrigido, err := h.Usecase.ListarChamados(ctx, filtro)
 	if err != nil {
@@ -229,6 +270,11 @@ func (h *ChamadoHandler) BuscarPorID(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao buscar chamado", err.Error())
 			return
 
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para buscar o chamado", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, repository.ErrExecContext),
 			errors.Is(err, repository.ErrScannerChamado):
@@ -252,7 +298,7 @@ func (h *ChamadoHandler) BuscarPorID(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	response.JSON(w, http.StatusOK, response.ToChamadoResponse(chamado))
+	response.JSON(w, http.StatusOK, response.ToChamadoResponse(chamado, permissaoDaRequisicao(r)))
 }
 
 // Atualizar godoc
@@ -298,6 +344,12 @@ func (h *ChamadoHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao atualizar chamado", err.Error())
 			return
 
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para atualizar o chamado", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, repository.ErrExecContext),
 			errors.Is(err, repository.ErrQueryContext):
@@ -321,10 +373,11 @@ func (h *ChamadoHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoAtualizar,
 		entidadeChamado,
+		id,
 		fmt.Sprintf("Chamado atualizado via API: %s", chamado.String()),
 	)
 	if err != nil {
@@ -332,7 +385,7 @@ func (h *ChamadoHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response.JSON(w, http.StatusOK, response.ToChamadoResponse(&chamado))
+	response.JSON(w, http.StatusOK, response.ToChamadoResponse(&chamado, permissaoDaRequisicao(r)))
 }
 
 // Arquivar godoc
@@ -366,6 +419,12 @@ func (h *ChamadoHandler) Arquivar(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao arquivar chamado", err.Error())
 			return
 
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para arquivar o chamado", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, repository.ErrExecContext),
 			errors.Is(err, repository.ErrQueryContext):
@@ -389,10 +448,11 @@ func (h *ChamadoHandler) Arquivar(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoArquivar,
 		entidadeChamado,
+		id,
 		fmt.Sprintf("Chamado arquivado via API: chamado ID(%s)", id),
 	)
 	if err != nil {
@@ -434,6 +494,12 @@ func (h *ChamadoHandler) Desarquivar(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao desarquivar chamado", err.Error())
 			return
 
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para desarquivar o chamado", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, repository.ErrExecContext),
 			errors.Is(err, repository.ErrQueryContext):
@@ -457,10 +523,11 @@ func (h *ChamadoHandler) Desarquivar(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoDesarquivar,
 		entidadeChamado,
+		id,
 		fmt.Sprintf("Chamado desarquivado via API: chamado ID(%s)", id),
 	)
 	if err != nil {
@@ -481,9 +548,12 @@ func (h *ChamadoHandler) Desarquivar(w http.ResponseWriter, r *http.Request) {
 // @Param chamado body object true "Status e solução do chamado" { "status": "string", "solucao": "string (opcional)" }
 // @Success 200 {object} map[string]any
 // @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
 // @Failure 404 {object} any
 // @Failure 405 {object} any
 // @Failure 408 {object} any
+// @Failure 409 {object} any
 // @Failure 500 {object} any
 // @Router /chamados/atualizar-status/{id} [patch]
 // AtualizarStatus atualiza o status do chamado por ID
@@ -514,14 +584,37 @@ func (h *ChamadoHandler) AtualizarStatus(w http.ResponseWriter, r *http.Request)
 			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar status do chamado", err.Error())
 			return
 
+		case errors.Is(err, model.ErrStatusChamadoInvalido),
+			errors.Is(err, model.ErrSolucaoObrigatoria):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar status do chamado", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado ao atualizar status do chamado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida),
+			errors.Is(err, model.ErrTransicaoStatusNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "usuário sem permissão para a transição de status do chamado", err.Error())
+			return
+
 		// recurso não encontrado - 404
 		case errors.Is(err, repository.ErrChamadoNaoEncontrado):
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao atualizar status do chamado", err.Error())
 			return
 
+		// conflito com o status atual - 409
+		case errors.Is(err, model.ErrTransicaoStatusInvalida):
+			response.ErrorJSON(w, http.StatusConflict, "transição de status inválida para o chamado", err.Error())
+			return
+
 		// erros internos - 500
 		case errors.Is(err, repository.ErrExecContext),
-			errors.Is(err, repository.ErrQueryContext):
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrRowsAffected):
 			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao atualizar status do chamado", err.Error())
 			return
 
@@ -542,10 +635,11 @@ func (h *ChamadoHandler) AtualizarStatus(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	err := h.UsecaseLog.CriarLog(
+	err := h.UsecaseLog.CriarLogChamado(
 		ctx,
 		model.AcaoAtualizar,
 		entidadeChamado,
+		id,
 		fmt.Sprintf("Chamado atualizado via API: chamado ID(%s)", id),
 	)
 	if err != nil {
@@ -610,3 +704,288 @@ func (h *ChamadoHandler) ListaCompleta(w http.ResponseWriter, r *http.Request) {
 
 	response.JSON(w, http.StatusOK, items)
 }
+
+// AtualizarPrioridade godoc
+// @Summary Atualiza a prioridade de um chamado
+// @Description Altera o impacto e a urgência de um chamado, derivando a prioridade pela matriz de prioridade. O motivo é obrigatório e fica registrado nos logs.
+// @Tags chamados
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do chamado"
+// @Param prioridade body model.AlteracaoPrioridade true "Impacto, urgência e motivo"
+// @Success 200 {object} model.AlteracaoPrioridade
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /chamados/atualizar-prioridade/{id} [patch]
+// AtualizarPrioridade atualiza a prioridade do chamado por ID
+func (h *ChamadoHandler) AtualizarPrioridade(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPatch) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	var alteracao model.AlteracaoPrioridade
+	if err := json.NewDecoder(r.Body).Decode(&alteracao); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtualizarPrioridadeChamado(ctx, id, &alteracao); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrIDInvalido),
+			errors.Is(err, model.ErrImpactoInvalido),
+			errors.Is(err, model.ErrUrgenciaInvalida),
+			errors.Is(err, model.ErrMotivoPrioridadeObrigatorio):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar prioridade do chamado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrChamadoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao atualizar prioridade do chamado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para atualizar a prioridade do chamado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, model.ErrMatrizPrioridadeIncompleta),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao atualizar prioridade do chamado", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar prioridade do chamado", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar prioridade do chamado", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar prioridade do chamado", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeChamado,
+		id,
+		fmt.Sprintf("Prioridade do chamado alterada via API: %s", alteracao.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, alteracao)
+}
+
+// Reabrir godoc
+// @Summary Reabre um chamado resolvido ou fechado
+// @Description Reabre um chamado resolvido ou fechado dentro do prazo de reabertura. O motivo é registrado como acompanhamento, a solução é descartada e o chamado volta ATRIBUIDO ao último técnico que o atendeu (ou para a fila, quando não houve atendimento).
+// @Tags chamados
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do chamado"
+// @Param reabertura body object true "Motivo da reabertura" { "motivo": "string" }
+// @Success 200 {object} model.Reabertura
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /chamados/reabrir/{id} [post]
+// Reabrir reabre um chamado resolvido ou fechado
+func (h *ChamadoHandler) Reabrir(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	var reabertura model.Reabertura
+	if err := json.NewDecoder(r.Body).Decode(&reabertura); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.ReabrirChamado(ctx, id, &reabertura); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrIDInvalido),
+			errors.Is(err, model.ErrMotivoReaberturaObrigatorio):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao reabrir chamado", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado ao reabrir chamado", err.Error())
+			return
+
+		// permissão insuficiente - 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel),
+			errors.Is(err, model.ErrOperacaoNaoPermitida),
+			errors.Is(err, model.ErrReaberturaDeOutroUsuario):
+			response.ErrorJSON(w, http.StatusForbidden, "usuário sem permissão para reabrir o chamado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrChamadoNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao reabrir chamado", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrChamadoNaoReabrivel),
+			errors.Is(err, model.ErrPrazoReaberturaExpirado):
+			response.ErrorJSON(w, http.StatusConflict, "não foi possível reabrir o chamado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrRowsAffected),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao reabrir chamado", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao reabrir chamado", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao reabrir chamado", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao reabrir chamado", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeChamado,
+		id,
+		fmt.Sprintf("Chamado reaberto via API: %s", reabertura.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, reabertura)
+}
+
+// Reaberturas godoc
+// @Summary Agrega as reaberturas de chamados
+// @Description Agrega os chamados solucionados por técnico (do atendimento mais recente), categoria ou mês de abertura, com a quantidade de chamados reabertos, o total de reaberturas e a taxa de reabertura. O período filtra a data de abertura do chamado (RFC 3339).
+// @Tags chamados
+// @Accept json
+// @Produce json
+// @Param agruparPor query string false "Agrupamento: TECNICO, CATEGORIA ou MES" default(TECNICO)
+// @Param inicio query string false "Chamados abertos a partir de (RFC 3339)"
+// @Param fim query string false "Chamados abertos antes de (RFC 3339)"
+// @Success 200 {object} response.ReaberturasResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /chamados/reaberturas [get]
+// Reaberturas agrega as reaberturas de chamados
+func (h *ChamadoHandler) Reaberturas(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.ReaberturaFiltro{
+		AgruparPor: model.AgrupamentoReabertura(query.Get("agruparPor")),
+	}
+
+	var err error
+	if filtro.Inicio, filtro.Fim, err = periodoDaQuery(query.Get("inicio"), query.Get("fim")); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, "período inválido ao agregar reaberturas", err.Error())
+		return
+	}
+
+	totais, err := h.Usecase.AgregarReaberturas(ctx, filtro)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrAgrupamentoReaberturaInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "agrupamento inválido ao agregar reaberturas", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerChamado):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao agregar reaberturas", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao agregar reaberturas", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao agregar reaberturas", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao agregar reaberturas", err.Error())
+			return
+		}
+	}
+
+	if filtro.AgruparPor == "" {
+		filtro.AgruparPor = model.ReaberturaPorTecnico
+	}
+
+	response.JSON(w, http.StatusOK, response.ReaberturasResponse{
+		AgruparPor: filtro.AgruparPor,
+		Inicio:     filtro.Inicio,
+		Fim:        filtro.Fim,
+		Totais:     totais,
+	})
+}
+
+// permissaoDaRequisicao retorna a permissão do usuário autenticado na requisição.
+func permissaoDaRequisicao(r *http.Request) model.Permissao {
+	if claims := jwtClaimsFromRequest(r); claims != nil {
+		return model.Permissao(claims.Permissao)
+	}
+	return ""
+}
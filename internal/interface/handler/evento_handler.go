@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+const (
+	// intervaloHeartbeatEventos é o intervalo entre os comentários enviados para manter a conexão aberta
+	intervaloHeartbeatEventos = 25 * time.Second
+
+	// prazoEscritaEvento limita cada escrita no fluxo; o prazo é renovado a cada evento
+	prazoEscritaEvento = 10 * time.Second
+
+	// intervaloReconexaoEventos é o intervalo sugerido ao cliente para reconectar, em milissegundos
+	intervaloReconexaoEventos = 3000
+)
+
+// EventoHandler gerencia as requisições HTTP relacionadas aos eventos em tempo real.
+type EventoHandler struct {
+	Usecase usecase.AssinarEventos
+}
+
+// NewEventoHandler cria uma nova instância de EventoHandler.
+func NewEventoHandler(usecase usecase.AssinarEventos) *EventoHandler {
+	return &EventoHandler{Usecase: usecase}
+}
+
+// Assinar godoc
+// @Summary Transmite eventos em tempo real
+// @Description Abre um fluxo Server-Sent Events com os eventos dos chamados visíveis ao usuário: CHAMADO_CRIADO, ACOMPANHAMENTO_CRIADO, STATUS_ALTERADO, CHAMADO_ATRIBUIDO, SLA_EM_RISCO e SLA_VIOLADO. Ao reconectar, envie o cabeçalho Last-Event-ID (ou o parâmetro ultimoEventoId) para receber os eventos perdidos; se eles já tiverem sido descartados, o evento RESSINCRONIZAR indica que o estado deve ser recarregado.
+// @Tags Eventos
+// @Produce text/event-stream
+// @Param Last-Event-ID header string false "ID do último evento recebido"
+// @Param ultimoEventoId query string false "ID do último evento recebido, para clientes que não enviam o cabeçalho"
+// @Success 200 {object} model.Evento
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 500 {object} any
+// @Router /eventos/assinar [get]
+// Assinar transmite os eventos em tempo real
+func (h *EventoHandler) Assinar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ultimoID := r.Header.Get("Last-Event-ID")
+	if ultimoID == "" {
+		ultimoID = r.URL.Query().Get("ultimoEventoId")
+	}
+
+	var ultimoEventoID uint64
+	if ultimoID != "" {
+		var err error
+		ultimoEventoID, err = strconv.ParseUint(ultimoID, 10, 64)
+		if err != nil {
+			response.ErrorJSON(w, http.StatusBadRequest, "ID do último evento inválido", err.Error())
+			return
+		}
+	}
+
+	assinatura, err := h.Usecase.AssinarEventos(r.Context(), ultimoEventoID)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao assinar eventos", err.Error())
+			return
+		}
+	}
+	defer assinatura.Cancelar()
+
+	controlador := http.NewResponseController(w)
+
+	// o prazo de leitura do servidor encerraria o fluxo; a desconexão do cliente
+	// continua sendo detectada pelo contexto da requisição
+	_ = controlador.SetReadDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// escrever envia um trecho do fluxo, renovando o prazo de escrita do servidor
+	escrever := func(trecho func(io.Writer) error) error {
+		if err := controlador.SetWriteDeadline(time.Now().Add(prazoEscritaEvento)); err != nil {
+			return err
+		}
+		if err := trecho(w); err != nil {
+			return err
+		}
+		return controlador.Flush()
+	}
+
+	err = escrever(func(w io.Writer) error {
+		_, err := fmt.Fprintf(w, "retry: %d\n\n", intervaloReconexaoEventos)
+		return err
+	})
+	if err != nil {
+		return
+	}
+
+	if !assinatura.Completa {
+		ressincronizar := model.Evento{Tipo: model.EventoRessincronizar, CriadoEm: time.Now()}
+		if err := escrever(func(w io.Writer) error { return escreverEvento(w, &ressincronizar) }); err != nil {
+			return
+		}
+	}
+
+	for i := range assinatura.Pendentes {
+		if err := escrever(func(w io.Writer) error { return escreverEvento(w, &assinatura.Pendentes[i]) }); err != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(intervaloHeartbeatEventos)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-heartbeat.C:
+			err := escrever(func(w io.Writer) error {
+				_, err := io.WriteString(w, ": heartbeat\n\n")
+				return err
+			})
+			if err != nil {
+				return
+			}
+
+		case evento, ok := <-assinatura.Eventos:
+			// o canal é fechado quando o cliente não acompanha os eventos;
+			// ao reconectar com o Last-Event-ID, os eventos perdidos são reenviados
+			if !ok {
+				return
+			}
+			if err := escrever(func(w io.Writer) error { return escreverEvento(w, &evento) }); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// escreverEvento escreve o evento no formato Server-Sent Events. O evento de ressincronização
+// não possui ID, para não substituir o último evento recebido pelo cliente.
+func escreverEvento(w io.Writer, e *model.Evento) error {
+	dados, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	if e.ID != 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", e.ID); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Tipo, dados)
+	return err
+}
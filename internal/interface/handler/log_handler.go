@@ -168,6 +168,11 @@ func (h *LogHandler) BuscarPorID(w http.ResponseWriter, r *http.Request) {
 			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao buscar log", err.Error())
 			return
 
+		// permissão insuficiente - status 403
+		case errors.Is(err, model.ErrChamadoNaoVisivel):
+			response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para buscar o log", err.Error())
+			return
+
 			// erros do repositório - status 500
 			case errors.Is(err, repository.ErrQueryContext),
 			errors.Is(err, repository.ErrScannerLog):
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+const (
+	entidadeMatrizPrioridade = "MATRIZ_PRIORIDADE"
+)
+
+// MatrizPrioridadeHandler gerencia as requisições HTTP relacionadas à matriz de prioridade.
+type MatrizPrioridadeHandler struct {
+	Usecase    usecase.MatrizPrioridadeUsecase
+	UsecaseLog usecase.LogUsecase
+}
+
+// NewMatrizPrioridadeHandler cria uma nova instância de MatrizPrioridadeHandler.
+func NewMatrizPrioridadeHandler(usecase usecase.MatrizPrioridadeUsecase, usecaseLog usecase.LogUsecase) *MatrizPrioridadeHandler {
+	return &MatrizPrioridadeHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// BuscarMatriz godoc
+// @Summary Buscar a matriz de prioridade
+// @Description Retorna as combinações de impacto e urgência e a prioridade derivada de cada uma.
+// @Tags Prioridades
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.CelulaMatrizPrioridade
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /prioridades/matriz [get]
+// BuscarMatriz retorna a matriz de prioridade.
+func (h *MatrizPrioridadeHandler) BuscarMatriz(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	matriz, err := h.Usecase.BuscarMatrizPrioridade(ctx)
+	if err != nil {
+		switch {
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerMatrizPrioridade):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao buscar matriz de prioridade", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar matriz de prioridade", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar matriz de prioridade", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar matriz de prioridade", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, matriz)
+}
+
+// AtualizarMatriz godoc
+// @Summary Atualizar a matriz de prioridade
+// @Description Inclui ou atualiza combinações de impacto e urgência da matriz. Chamados existentes mantêm a prioridade já derivada.
+// @Tags Prioridades
+// @Accept json
+// @Produce json
+// @Param matriz body []model.CelulaMatrizPrioridade true "Combinações da matriz"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /prioridades/matriz/atualizar [put]
+// AtualizarMatriz atualiza a matriz de prioridade.
+func (h *MatrizPrioridadeHandler) AtualizarMatriz(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPut) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	var matriz model.MatrizPrioridade
+	if err := json.NewDecoder(r.Body).Decode(&matriz); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtualizarMatrizPrioridade(ctx, matriz); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrMatrizPrioridadeVazia),
+			errors.Is(err, model.ErrImpactoInvalido),
+			errors.Is(err, model.ErrUrgenciaInvalida),
+			errors.Is(err, model.ErrPrioridadeInvalida):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar matriz de prioridade", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao atualizar matriz de prioridade", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar matriz de prioridade", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar matriz de prioridade", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar matriz de prioridade", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeMatrizPrioridade,
+		fmt.Sprintf("Matriz de prioridade atualizada via API: %d combinações", len(matriz)),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "matriz de prioridade atualizada com sucesso"})
+}
@@ -0,0 +1,423 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+const (
+	entidadePreferenciaNotificacao = "PREFERENCIA_NOTIFICACAO"
+)
+
+// NotificacaoHandler gerencia as requisições HTTP relacionadas à central e às preferências de notificação.
+type NotificacaoHandler struct {
+	Usecase        usecase.PreferenciaNotificacaoUsecase
+	UsecaseCentral usecase.CentralNotificacoesUsecase
+	UsecaseLog     usecase.LogUsecase
+}
+
+// NewNotificacaoHandler cria uma nova instância de NotificacaoHandler.
+func NewNotificacaoHandler(usecase usecase.PreferenciaNotificacaoUsecase, usecaseCentral usecase.CentralNotificacoesUsecase, usecaseLog usecase.LogUsecase) *NotificacaoHandler {
+	return &NotificacaoHandler{
+		Usecase:        usecase,
+		UsecaseCentral: usecaseCentral,
+		UsecaseLog:     usecaseLog,
+	}
+}
+
+// BuscarPreferencias godoc
+// @Summary Buscar as preferências de notificação
+// @Description Retorna, para cada tipo de notificação, se o usuário autenticado a recebe por e-mail. Tipos nunca configurados são enviados por padrão.
+// @Tags Notificações
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.PreferenciaNotificacao
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /notificacoes/preferencias [get]
+// BuscarPreferencias retorna as preferências de notificação do usuário autenticado.
+func (h *NotificacaoHandler) BuscarPreferencias(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	preferencias, err := h.Usecase.BuscarPreferenciasNotificacao(ctx)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerPreferenciaNotificacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao buscar preferências de notificação", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar preferências de notificação", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar preferências de notificação", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar preferências de notificação", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, preferencias)
+}
+
+// AtualizarPreferencias godoc
+// @Summary Atualizar as preferências de notificação
+// @Description Define quais tipos de notificação o usuário autenticado recebe por e-mail. Tipos não informados mantêm a preferência atual.
+// @Tags Notificações
+// @Accept json
+// @Produce json
+// @Param preferencias body []model.PreferenciaNotificacao true "Preferências por tipo de notificação"
+// @Success 200 {object} []model.PreferenciaNotificacao
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /notificacoes/preferencias/atualizar [put]
+// AtualizarPreferencias atualiza as preferências de notificação do usuário autenticado.
+func (h *NotificacaoHandler) AtualizarPreferencias(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPut) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	var preferencias []model.PreferenciaNotificacao
+	if err := json.NewDecoder(r.Body).Decode(&preferencias); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	atualizadas, err := h.Usecase.AtualizarPreferenciasNotificacao(ctx, preferencias)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrTipoNotificacaoInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar preferências de notificação", err.Error())
+			return
+
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrTransacao),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerPreferenciaNotificacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao atualizar preferências de notificação", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar preferências de notificação", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar preferências de notificação", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar preferências de notificação", err.Error())
+			return
+		}
+	}
+
+	err = h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadePreferenciaNotificacao,
+		fmt.Sprintf("Preferências de notificação atualizadas via API: %d tipo(s)", len(preferencias)),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, atualizadas)
+}
+
+// BuscarTudo godoc
+// @Summary Listar notificações
+// @Description Retorna lista paginada das notificações do usuário autenticado, das mais recentes às mais antigas
+// @Tags Notificações
+// @Accept json
+// @Produce json
+// @Param pagina query int false "Página"
+// @Param limite query int false "Limite"
+// @Param lida query bool false "Filtra as notificações lidas (true) ou não lidas (false)"
+// @Success 200 {object} []model.NotificacaoUsuario
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /notificacoes/buscar-tudo [get]
+// BuscarTudo lista as notificações do usuário autenticado com paginação.
+func (h *NotificacaoHandler) BuscarTudo(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.NotificacaoUsuarioFiltro{}
+
+	if pagina, err := strconv.Atoi(query.Get("pagina")); err == nil {
+		filtro.Pagina = pagina
+	}
+	if limite, err := strconv.Atoi(query.Get("limite")); err == nil {
+		filtro.Limite = limite
+	}
+	if lida, err := strconv.ParseBool(query.Get("lida")); err == nil {
+		filtro.Lida = &lida
+	}
+
+	items, total, filtroCorrigido, err := h.UsecaseCentral.ListarNotificacoes(ctx, filtro)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerNotificacaoUsuario),
+			errors.Is(err, repository.ErrScan):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao listar notificações", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar notificações", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar notificações", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar notificações", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.PageResponse[model.NotificacaoUsuario]{
+		Total:  total,
+		Pagina: filtroCorrigido.Pagina,
+		Limite: filtroCorrigido.Limite,
+		Items:  items,
+	})
+}
+
+// ContarNaoLidas godoc
+// @Summary Contar notificações não lidas
+// @Description Retorna a quantidade de notificações não lidas do usuário autenticado
+// @Tags Notificações
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.ContagemNotificacoes
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /notificacoes/nao-lidas [get]
+// ContarNaoLidas retorna a quantidade de notificações não lidas do usuário autenticado.
+func (h *NotificacaoHandler) ContarNaoLidas(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	total, err := h.UsecaseCentral.ContarNotificacoesNaoLidas(ctx)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrScan):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao contar notificações não lidas", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao contar notificações não lidas", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao contar notificações não lidas", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao contar notificações não lidas", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.ContagemNotificacoes{NaoLidas: total})
+}
+
+// MarcarLida godoc
+// @Summary Marcar notificação como lida
+// @Description Marca como lida uma notificação do usuário autenticado. Marcar uma notificação já lida mantém a data da primeira leitura.
+// @Tags Notificações
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da notificação"
+// @Success 200 {object} model.NotificacaoUsuario
+// @Failure 401 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /notificacoes/marcar-lida/{id} [patch]
+// MarcarLida marca uma notificação do usuário autenticado como lida.
+func (h *NotificacaoHandler) MarcarLida(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPatch) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	notificacao, err := h.UsecaseCentral.MarcarNotificacaoLida(ctx, id)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrNotificacaoIDInvalido),
+			errors.Is(err, repository.ErrNotificacaoNaoEncontrada):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao marcar notificação como lida", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrScannerNotificacaoUsuario):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao marcar notificação como lida", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao marcar notificação como lida", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao marcar notificação como lida", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao marcar notificação como lida", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, notificacao)
+}
+
+// MarcarTodasLidas godoc
+// @Summary Marcar todas as notificações como lidas
+// @Description Marca como lidas todas as notificações não lidas do usuário autenticado
+// @Tags Notificações
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.NotificacoesMarcadas
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /notificacoes/marcar-todas-lidas [patch]
+// MarcarTodasLidas marca todas as notificações do usuário autenticado como lidas.
+func (h *NotificacaoHandler) MarcarTodasLidas(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPatch) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	marcadas, err := h.UsecaseCentral.MarcarTodasNotificacoesLidas(ctx)
+	if err != nil {
+		switch {
+		// usuário não autenticado - 401
+		case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+			response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrRowsAffected):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao marcar notificações como lidas", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao marcar notificações como lidas", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao marcar notificações como lidas", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao marcar notificações como lidas", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.NotificacoesMarcadas{Marcadas: marcadas})
+}
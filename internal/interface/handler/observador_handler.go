@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+const (
+	entidadeObservador = "OBSERVADOR"
+)
+
+// ObservadorHandler gerencia as requisições HTTP relacionadas aos observadores dos chamados.
+type ObservadorHandler struct {
+	Usecase    usecase.ObservadorUsecase
+	UsecaseLog usecase.LogUsecase
+}
+
+// NewObservadorHandler cria uma nova instância de ObservadorHandler.
+func NewObservadorHandler(usecase usecase.ObservadorUsecase, usecaseLog usecase.LogUsecase) *ObservadorHandler {
+	return &ObservadorHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// AdicionarObservadorRequest representa o payload opcional para incluir um observador.
+type AdicionarObservadorRequest struct {
+	UsuarioID string `json:"usuarioId,omitempty"`
+}
+
+// Adicionar godoc
+// @Summary Adiciona um observador ao chamado
+// @Description Inclui o usuário informado nos observadores do chamado; sem usuário, o usuário autenticado passa a observá-lo. Incluir outro usuário é permitido ao criador do chamado e a quem pode atualizá-lo na categoria.
+// @Tags Observadores
+// @Accept json
+// @Produce json
+// @Param chamadoId path string true "ID do chamado"
+// @Param observador body AdicionarObservadorRequest false "Usuário a incluir nos observadores"
+// @Success 201 {object} model.Observador
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /observadores/adicionar/{chamadoId} [post]
+// Adicionar adiciona um observador ao chamado
+func (h *ObservadorHandler) Adicionar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	chamadoID := lastSegment(r.URL.Path)
+
+	// O corpo é opcional: sem ele, o usuário autenticado passa a observar o chamado
+	var body AdicionarObservadorRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	observador, err := h.Usecase.AdicionarObservador(ctx, chamadoID, body.UsuarioID)
+	if err != nil {
+		h.responderErro(w, err, "adicionar observador")
+		return
+	}
+
+	err = h.UsecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoCriar,
+		entidadeObservador,
+		observador.ChamadoID,
+		fmt.Sprintf("Observador adicionado via API: %s", observador.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, observador)
+}
+
+// Remover godoc
+// @Summary Remove um observador do chamado
+// @Description Retira o usuário informado dos observadores do chamado; sem usuário, o usuário autenticado deixa de observá-lo. Retirar outro usuário é permitido ao criador do chamado e a quem pode atualizá-lo na categoria.
+// @Tags Observadores
+// @Accept json
+// @Produce json
+// @Param chamadoId path string true "ID do chamado"
+// @Param usuarioId query string false "ID do usuário a retirar dos observadores"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 403 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /observadores/remover/{chamadoId} [delete]
+// Remover remove um observador do chamado
+func (h *ObservadorHandler) Remover(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodDelete) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	chamadoID := lastSegment(r.URL.Path)
+	usuarioID := r.URL.Query().Get("usuarioId")
+
+	if err := h.Usecase.RemoverObservador(ctx, chamadoID, usuarioID); err != nil {
+		h.responderErro(w, err, "remover observador")
+		return
+	}
+
+	err := h.UsecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoDeletar,
+		entidadeObservador,
+		chamadoID,
+		fmt.Sprintf("Observador removido via API: chamado(%s) | usuario(%s)", chamadoID, usuarioID),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "observador removido com sucesso"})
+}
+
+// BuscarPorChamado godoc
+// @Summary Busca os observadores de um chamado
+// @Description Retorna os observadores do chamado, do mais antigo ao mais recente
+// @Tags Observadores
+// @Accept json
+// @Produce json
+// @Param chamadoId path string true "ID do chamado"
+// @Success 200 {array} model.Observador
+// @Failure 400 {object} any
+// @Failure 403 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /observadores/buscar-por-chamado/{chamadoId} [get]
+// BuscarPorChamado busca os observadores de um chamado
+func (h *ObservadorHandler) BuscarPorChamado(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	observadores, err := h.Usecase.ListarObservadores(ctx, lastSegment(r.URL.Path))
+	if err != nil {
+		h.responderErro(w, err, "buscar observadores")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, observadores)
+}
+
+// --- Helpers ---
+
+// responderErro responde com o status correspondente ao erro da operação sobre os observadores.
+func (h *ObservadorHandler) responderErro(w http.ResponseWriter, err error, operacao string) {
+	switch {
+	// requisições inválidas - 400
+	case errors.Is(err, model.ErrChamadoIDInvalido),
+		errors.Is(err, model.ErrObservadorUsuarioIDInvalido):
+		response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao "+operacao, err.Error())
+
+	// usuário não autenticado - 401
+	case errors.Is(err, middleware.ErrUsuarioNaoAutenticado):
+		response.ErrorJSON(w, http.StatusUnauthorized, "usuário não autenticado", err.Error())
+
+	// permissão insuficiente - 403
+	case errors.Is(err, model.ErrChamadoNaoVisivel),
+		errors.Is(err, model.ErrOperacaoNaoPermitida):
+		response.ErrorJSON(w, http.StatusForbidden, "permissão insuficiente para "+operacao, err.Error())
+
+	// recurso não encontrado - 404
+	case errors.Is(err, repository.ErrChamadoNaoEncontrado),
+		errors.Is(err, repository.ErrUsuarioNaoEncontrado),
+		errors.Is(err, repository.ErrObservadorNaoEncontrado):
+		response.ErrorJSON(w, http.StatusNotFound, "recurso não encontrado ao "+operacao, err.Error())
+
+	// erros internos - 500
+	case errors.Is(err, repository.ErrExecContext),
+		errors.Is(err, repository.ErrRowsAffected),
+		errors.Is(err, repository.ErrQueryContext),
+		errors.Is(err, repository.ErrScannerObservador):
+		response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao "+operacao, err.Error())
+
+	// erros de contexto - 408
+	case errors.Is(err, context.DeadlineExceeded):
+		response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao "+operacao, err.Error())
+
+	// erros de contexto - 400
+	case errors.Is(err, context.Canceled):
+		response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao "+operacao, err.Error())
+
+	// fallback de segurança - 500
+	default:
+		response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao "+operacao, err.Error())
+	}
+}
@@ -0,0 +1,594 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+const (
+	entidadePapel = "PAPEL"
+)
+
+// PapelHandler gerencia as requisições HTTP relacionadas aos papéis e às suas permissões.
+type PapelHandler struct {
+	Usecase    usecase.PapelUsecase
+	UsecaseLog usecase.LogUsecase
+}
+
+// NewPapelHandler cria uma nova instância de PapelHandler.
+func NewPapelHandler(usecase usecase.PapelUsecase, usecaseLog usecase.LogUsecase) *PapelHandler {
+	return &PapelHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// Criar godoc
+// @Summary Criar um novo papel
+// @Description Cadastra um papel personalizado com as permissões nomeadas concedidas. A permissão base define as regras de negócio aplicadas aos usuários do papel.
+// @Tags Papéis
+// @Accept json
+// @Produce json
+// @Param papel body model.Papel true "Papel"
+// @Success 201 {object} response.PapelResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /papeis/criar [post]
+// Criar papel
+func (h *PapelHandler) Criar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	var papel model.Papel
+	if err := json.NewDecoder(r.Body).Decode(&papel); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.CriarPapel(ctx, &papel); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrCodigoPapelInvalido),
+			errors.Is(err, model.ErrNomePapelInvalido),
+			errors.Is(err, model.ErrDescricaoPapelInvalida),
+			errors.Is(err, model.ErrPermissaoInvalida),
+			errors.Is(err, model.ErrPermissaoNomeadaInvalida):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar papel", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, repository.ErrPapelJaExiste):
+			response.ErrorJSON(w, http.StatusConflict, "papel já cadastrado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao criar papel", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao criar papel", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao criar papel", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao criar papel", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoCriar,
+		entidadePapel,
+		fmt.Sprintf("Papel criado via API: %s", papel.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToPapelResponse(&papel))
+}
+
+// BuscarTudo godoc
+// @Summary Listar papéis
+// @Description Retorna todos os papéis, do sistema e personalizados, com as permissões concedidas
+// @Tags Papéis
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.Papel
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /papeis/buscar-tudo [get]
+// BuscarTudo lista todos os papéis.
+func (h *PapelHandler) BuscarTudo(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	papeis, err := h.Usecase.ListarPapeis(ctx)
+	if err != nil {
+		switch {
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerPapel):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao listar papéis", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar papéis", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar papéis", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar papéis", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, papeis)
+}
+
+// BuscarPorCodigo godoc
+// @Summary Buscar papel por código
+// @Description Retorna um papel pelo código, com as permissões concedidas
+// @Tags Papéis
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do papel"
+// @Success 200 {object} response.PapelResponse
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /papeis/buscar-por-codigo/{codigo} [get]
+// BuscarPorCodigo busca um papel pelo código.
+func (h *PapelHandler) BuscarPorCodigo(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	codigo := lastSegment(r.URL.Path)
+	papel, err := h.Usecase.BuscarPapelPorCodigo(ctx, codigo)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrCodigoPapelInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "código inválido ao buscar papel", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrPapelNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "papel não encontrado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerPapel):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao buscar papel", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar papel", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar papel", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar papel", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.ToPapelResponse(papel))
+}
+
+// PermissoesDisponiveis godoc
+// @Summary Listar permissões disponíveis
+// @Description Retorna as permissões nomeadas que podem ser concedidas aos papéis
+// @Tags Papéis
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.DefinicaoPermissao
+// @Failure 405 {object} any
+// @Router /papeis/permissoes-disponiveis [get]
+// PermissoesDisponiveis lista as permissões nomeadas que podem ser concedidas aos papéis.
+func (h *PapelHandler) PermissoesDisponiveis(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	response.JSON(w, http.StatusOK, h.Usecase.ListarPermissoesDisponiveis())
+}
+
+// Atualizar godoc
+// @Summary Atualizar papel
+// @Description Atualiza o nome, a descrição e a permissão base de um papel pelo código. A permissão base dos papéis do sistema não pode ser alterada; a dos papéis personalizados é estendida aos seus usuários.
+// @Tags Papéis
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do papel"
+// @Param papel body model.Papel true "Papel"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /papeis/atualizar/{codigo} [put]
+// Atualizar atualiza um papel existente.
+func (h *PapelHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPut) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	codigo := lastSegment(r.URL.Path)
+
+	var papel model.Papel
+	if err := json.NewDecoder(r.Body).Decode(&papel); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtualizarPapel(ctx, codigo, &papel); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrCodigoPapelInvalido),
+			errors.Is(err, model.ErrNomePapelInvalido),
+			errors.Is(err, model.ErrDescricaoPapelInvalida),
+			errors.Is(err, model.ErrPermissaoInvalida):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar papel", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrPapelNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "papel não encontrado ao atualizar", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrPapelDoSistema):
+			response.ErrorJSON(w, http.StatusConflict, "não é possível alterar a permissão base do papel do sistema", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerPapel),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao atualizar papel", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar papel", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar papel", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar papel", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadePapel,
+		fmt.Sprintf("Papel atualizado via API: %s", papel.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "papel atualizado com sucesso"})
+}
+
+// AtualizarPermissoes godoc
+// @Summary Atualizar permissões do papel
+// @Description Substitui as permissões nomeadas concedidas a um papel pelo código. A alteração vale de imediato para os usuários do papel. O papel ADM deve manter a permissão papel.editar.
+// @Tags Papéis
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do papel"
+// @Param permissoes body object true "Permissões do papel" example({"permissoes": ["chamado.criar", "chamado.ler"]})
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /papeis/atualizar-permissoes/{codigo} [put]
+// AtualizarPermissoes substitui as permissões concedidas a um papel.
+func (h *PapelHandler) AtualizarPermissoes(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPut) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	codigo := lastSegment(r.URL.Path)
+
+	var requisicao struct {
+		Permissoes []model.PermissaoNomeada `json:"permissoes"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requisicao); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtualizarPermissoesPapel(ctx, codigo, requisicao.Permissoes); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrCodigoPapelInvalido),
+			errors.Is(err, model.ErrPermissaoNomeadaInvalida):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar as permissões do papel", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrPapelNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "papel não encontrado ao atualizar as permissões", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrPermissaoEssencialPapel):
+			response.ErrorJSON(w, http.StatusConflict, "não é possível remover a administração dos papéis do papel ADM", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao atualizar as permissões do papel", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar as permissões do papel", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar as permissões do papel", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar as permissões do papel", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadePapel,
+		fmt.Sprintf("Permissões do papel atualizadas via API: papel(%s), permissões(%v)", codigo, requisicao.Permissoes),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "permissões do papel atualizadas com sucesso"})
+}
+
+// Deletar godoc
+// @Summary Remover papel
+// @Description Remove um papel personalizado sem usuários. Os papéis do sistema não podem ser removidos.
+// @Tags Papéis
+// @Accept json
+// @Produce json
+// @Param codigo path string true "Código do papel"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /papeis/deletar/{codigo} [delete]
+// Deletar remove um papel personalizado.
+func (h *PapelHandler) Deletar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodDelete) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	codigo := lastSegment(r.URL.Path)
+
+	if err := h.Usecase.DeletarPapel(ctx, codigo); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrCodigoPapelInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "código inválido ao remover papel", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrPapelNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "papel não encontrado ao remover", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrPapelDoSistema),
+			errors.Is(err, repository.ErrPapelEmUso):
+			response.ErrorJSON(w, http.StatusConflict, "não é possível remover o papel", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao remover papel", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao remover papel", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao remover papel", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao remover papel", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoDeletar,
+		entidadePapel,
+		fmt.Sprintf("Papel removido via API: papel(%s)", codigo),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "papel removido com sucesso"})
+}
+
+// AtribuirUsuario godoc
+// @Summary Atribuir papel ao usuário
+// @Description Define o papel do usuário pelo ID; a permissão do usuário passa a ser a permissão base do papel. As sessões abertas do usuário são encerradas e a alteração vale a partir do próximo login.
+// @Tags Papéis
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do usuário"
+// @Param papel body object true "Código do papel" example({"papel": "SUPERVISOR"})
+// @Success 200 {object} response.PapelUsuario
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /papeis/atribuir/{id} [patch]
+// AtribuirUsuario define o papel de um usuário.
+func (h *PapelHandler) AtribuirUsuario(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPatch) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	usuarioID := lastSegment(r.URL.Path)
+
+	var requisicao struct {
+		Papel string `json:"papel"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requisicao); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtribuirPapelUsuario(ctx, usuarioID, requisicao.Papel); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrIDInvalido),
+			errors.Is(err, model.ErrCodigoPapelInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atribuir papel", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrUsuarioNaoEncontrado),
+			errors.Is(err, repository.ErrPapelNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "usuário ou papel não encontrado ao atribuir papel", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerPapel):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao atribuir papel", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atribuir papel", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atribuir papel", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atribuir papel", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeUsuario,
+		fmt.Sprintf("Papel do usuário atualizado via API: usuário ID(%s), novo papel(%s)", usuarioID, requisicao.Papel),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.PapelUsuario{UsuarioID: usuarioID, Papel: requisicao.Papel})
+}
@@ -0,0 +1,451 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+const (
+	entidadePoliticaSLA = "POLITICA_SLA"
+)
+
+// PoliticaSLAHandler gerencia as requisições HTTP relacionadas a políticas de SLA.
+type PoliticaSLAHandler struct {
+	Usecase    usecase.PoliticaSLAUsecase
+	UsecaseLog usecase.LogUsecase
+}
+
+// NewPoliticaSLAHandler cria uma nova instância de PoliticaSLAHandler.
+func NewPoliticaSLAHandler(usecase usecase.PoliticaSLAUsecase, usecaseLog usecase.LogUsecase) *PoliticaSLAHandler {
+	return &PoliticaSLAHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// Criar godoc
+// @Summary Criar uma nova política de SLA
+// @Description Cria uma política de SLA para uma categoria ou subcategoria.
+// @Tags Politicas SLA
+// @Accept json
+// @Produce json
+// @Param politica body model.PoliticaSLA true "Política de SLA"
+// @Success 201 {object} response.PoliticaSLAResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /politicas-sla/criar [post]
+// Criar política de SLA
+func (h *PoliticaSLAHandler) Criar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	var politica model.PoliticaSLA
+	if err := json.NewDecoder(r.Body).Decode(&politica); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.CriarPoliticaSLA(ctx, &politica); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrNomeInvalido),
+			errors.Is(err, model.ErrCategoriaIDInvalido),
+			errors.Is(err, model.ErrPrazoPrimeiraRespostaInvalido),
+			errors.Is(err, model.ErrPrazoSolucaoInvalido),
+			errors.Is(err, model.ErrPrazoSolucaoMenorPrimeiraResposta):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar política de SLA", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, repository.ErrPoliticaSLAJaExiste):
+			response.ErrorJSON(w, http.StatusConflict, "política de SLA já cadastrada para a categoria/subcategoria", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrRowsAffected):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao criar política de SLA", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao criar política de SLA", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao criar política de SLA", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao criar política de SLA", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoCriar,
+		entidadePoliticaSLA,
+		fmt.Sprintf("Política de SLA criada via API: %s", politica.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToPoliticaSLAResponse(&politica))
+}
+
+// BuscarTudo godoc
+// @Summary Listar políticas de SLA
+// @Description Retorna lista paginada de políticas de SLA
+// @Tags Politicas SLA
+// @Accept json
+// @Produce json
+// @Param pagina query int false "Página"
+// @Param limite query int false "Limite"
+// @Param categoriaId query string false "ID da categoria"
+// @Param subcategoriaId query string false "ID da subcategoria"
+// @Param status query bool false "Status"
+// @Success 200 {object} []model.PoliticaSLA
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /politicas-sla/buscar-tudo [get]
+// BuscarTudo lista políticas de SLA com paginação e filtros.
+func (h *PoliticaSLAHandler) BuscarTudo(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.PoliticaSLAFiltro{}
+
+	if pagina, err := strconv.Atoi(query.Get("pagina")); err == nil {
+		filtro.Pagina = pagina
+	}
+	if limite, err := strconv.Atoi(query.Get("limite")); err == nil {
+		filtro.Limite = limite
+	}
+	if categoriaID := query.Get("categoriaId"); categoriaID != "" {
+		filtro.CategoriaID = &categoriaID
+	}
+	if subcategoriaID := query.Get("subcategoriaId"); subcategoriaID != "" {
+		filtro.SubcategoriaID = &subcategoriaID
+	}
+	if statusStr := query.Get("status"); statusStr != "" {
+		if status, err := strconv.ParseBool(statusStr); err == nil {
+			filtro.Status = &status
+		}
+	}
+
+	items, total, filtroCorrigido, err := h.Usecase.ListarPoliticasSLA(ctx, filtro)
+	if err != nil {
+		switch {
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerPoliticaSLA),
+			errors.Is(err, repository.ErrScan):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao listar políticas de SLA", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar políticas de SLA", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar políticas de SLA", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar políticas de SLA", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.PageResponse[model.PoliticaSLA]{
+		Total:  total,
+		Pagina: filtroCorrigido.Pagina,
+		Limite: filtroCorrigido.Limite,
+		Items:  items,
+	})
+}
+
+// BuscarPorID godoc
+// @Summary Buscar política de SLA por ID
+// @Description Retorna uma política de SLA pelo ID
+// @Tags Politicas SLA
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da política de SLA"
+// @Success 200 {object} response.PoliticaSLAResponse
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /politicas-sla/buscar-por-id/{id} [get]
+// BuscarPorID busca uma política de SLA pelo ID.
+func (h *PoliticaSLAHandler) BuscarPorID(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	politica, err := h.Usecase.BuscarPoliticaSLAPorID(ctx, id)
+	if err != nil {
+		switch {
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrPoliticaSLAIDInvalido),
+			errors.Is(err, repository.ErrPoliticaSLANaoEncontrada):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao buscar política de SLA", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerPoliticaSLA):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao buscar política de SLA", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar política de SLA", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar política de SLA", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar política de SLA", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.ToPoliticaSLAResponse(politica))
+}
+
+// Atualizar godoc
+// @Summary Atualizar política de SLA
+// @Description Atualiza uma política de SLA existente pelo ID.
+// @Tags Politicas SLA
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da política de SLA"
+// @Param politica body model.PoliticaSLA true "Política de SLA"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /politicas-sla/atualizar/{id} [put]
+// Atualizar atualiza uma política de SLA existente.
+func (h *PoliticaSLAHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPut) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	var politica model.PoliticaSLA
+	if err := json.NewDecoder(r.Body).Decode(&politica); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtualizarPoliticaSLA(ctx, id, &politica); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrPoliticaSLAIDInvalido),
+			errors.Is(err, model.ErrNomeInvalido),
+			errors.Is(err, model.ErrCategoriaIDInvalido),
+			errors.Is(err, model.ErrPrazoPrimeiraRespostaInvalido),
+			errors.Is(err, model.ErrPrazoSolucaoInvalido),
+			errors.Is(err, model.ErrPrazoSolucaoMenorPrimeiraResposta):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar política de SLA", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrPoliticaSLANaoEncontrada):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao atualizar política de SLA", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, repository.ErrPoliticaSLAJaExiste):
+			response.ErrorJSON(w, http.StatusConflict, "política de SLA já cadastrada para a categoria/subcategoria", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao atualizar política de SLA", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar política de SLA", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar política de SLA", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar política de SLA", err.Error())
+			return
+		}
+	}
+
+	politica.ID = id
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadePoliticaSLA,
+		fmt.Sprintf("Política de SLA atualizada via API: %s", politica.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "política de SLA atualizada com sucesso"})
+}
+
+// Desativar godoc
+// @Summary Desativar política de SLA
+// @Description Desativa uma política de SLA pelo ID. Chamados já abertos mantêm seus prazos.
+// @Tags Politicas SLA
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da política de SLA"
+// @Success 200 {object} response.StatusPoliticaSLA
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /politicas-sla/desativar/{id} [delete]
+// Desativar desativa uma política de SLA.
+func (h *PoliticaSLAHandler) Desativar(w http.ResponseWriter, r *http.Request) {
+	h.alterarStatus(w, r, http.MethodDelete, false)
+}
+
+// Ativar godoc
+// @Summary Ativar política de SLA
+// @Description Ativa uma política de SLA pelo ID.
+// @Tags Politicas SLA
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da política de SLA"
+// @Success 200 {object} response.StatusPoliticaSLA
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /politicas-sla/ativar/{id} [patch]
+// Ativar ativa uma política de SLA.
+func (h *PoliticaSLAHandler) Ativar(w http.ResponseWriter, r *http.Request) {
+	h.alterarStatus(w, r, http.MethodPatch, true)
+}
+
+// alterarStatus trata as requisições de ativação e desativação de políticas de SLA.
+func (h *PoliticaSLAHandler) alterarStatus(w http.ResponseWriter, r *http.Request, metodo string, ativo bool) {
+	if !metodoHttpValido(w, r, metodo) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	acao, operacao := model.AcaoAtivar, "ativar"
+	alterar := h.Usecase.AtivarPoliticaSLA
+	if !ativo {
+		acao, operacao = model.AcaoDesativar, "desativar"
+		alterar = h.Usecase.DesativarPoliticaSLA
+	}
+
+	if err := alterar(ctx, id); err != nil {
+		switch {
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrPoliticaSLAIDInvalido),
+			errors.Is(err, repository.ErrPoliticaSLANaoEncontrada):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao "+operacao+" política de SLA", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao "+operacao+" política de SLA", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao "+operacao+" política de SLA", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao "+operacao+" política de SLA", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao "+operacao+" política de SLA", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		acao,
+		entidadePoliticaSLA,
+		fmt.Sprintf("Política de SLA alterada via API: política ID(%s) ativo(%t)", id, ativo),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.StatusPoliticaSLA{Ativo: ativo})
+}
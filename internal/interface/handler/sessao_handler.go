@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+const (
+	entidadeSessao = "SESSAO"
+)
+
+// SessaoHandler gerencia as requisições HTTP relacionadas às sessões dos usuários.
+type SessaoHandler struct {
+	Usecase    usecase.SessoesUsuario
+	UsecaseLog usecase.LogUsecase
+}
+
+// NewSessaoHandler cria uma nova instância de SessaoHandler.
+func NewSessaoHandler(usecase usecase.SessoesUsuario, usecaseLog usecase.LogUsecase) *SessaoHandler {
+	return &SessaoHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// MinhasSessoes godoc
+// @Summary Listar as minhas sessões
+// @Description Retorna as sessões ativas do usuário autenticado, com o dispositivo, o IP e o último uso. A sessão da requisição vem marcada como atual.
+// @Tags Sessões
+// @Accept json
+// @Produce json
+// @Success 200 {object} []model.Sessao
+// @Failure 401 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /sessoes/minhas [get]
+// Listar as sessões do usuário autenticado
+func (h *SessaoHandler) MinhasSessoes(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	claims := jwtClaimsFromRequest(r)
+	if claims == nil {
+		response.ErrorJSON(w, http.StatusUnauthorized, "não autenticado", nil)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	h.listarSessoes(ctx, w, claims.ID, claims.Sessao)
+}
+
+// BuscarPorUsuario godoc
+// @Summary Listar as sessões de um usuário
+// @Description Retorna as sessões ativas do usuário pelo ID, com o dispositivo, o IP e o último uso
+// @Tags Sessões
+// @Accept json
+// @Produce json
+// @Param usuarioID path string true "ID do usuário"
+// @Success 200 {object} []model.Sessao
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /sessoes/buscar-por-usuario/{usuarioID} [get]
+// Listar as sessões de um usuário
+func (h *SessaoHandler) BuscarPorUsuario(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	sessaoAtual := ""
+	if claims := jwtClaimsFromRequest(r); claims != nil {
+		sessaoAtual = claims.Sessao
+	}
+
+	h.listarSessoes(ctx, w, lastSegment(r.URL.Path), sessaoAtual)
+}
+
+// EncerrarMinhaSessao godoc
+// @Summary Encerrar uma das minhas sessões
+// @Description Encerra uma sessão do usuário autenticado pelo ID; os tokens de acesso e de refresh da sessão deixam de valer
+// @Tags Sessões
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 401 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /sessoes/encerrar-minha/{id} [delete]
+// Encerrar uma sessão do usuário autenticado
+func (h *SessaoHandler) EncerrarMinhaSessao(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodDelete) {
+		return
+	}
+
+	claims := jwtClaimsFromRequest(r)
+	if claims == nil {
+		response.ErrorJSON(w, http.StatusUnauthorized, "não autenticado", nil)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	h.encerrarSessao(ctx, w, id, func() error {
+		return h.Usecase.EncerrarSessaoPropria(ctx, claims.ID, id)
+	})
+}
+
+// Encerrar godoc
+// @Summary Encerrar sessão
+// @Description Encerra a sessão de qualquer usuário pelo ID; os tokens de acesso e de refresh da sessão deixam de valer
+// @Tags Sessões
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da sessão"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /sessoes/encerrar/{id} [delete]
+// Encerrar sessão
+func (h *SessaoHandler) Encerrar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodDelete) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	h.encerrarSessao(ctx, w, id, func() error {
+		return h.Usecase.EncerrarSessao(ctx, id)
+	})
+}
+
+// --- Helpers ---
+
+// listarSessoes responde com as sessões ativas do usuário.
+func (h *SessaoHandler) listarSessoes(ctx context.Context, w http.ResponseWriter, usuarioID, sessaoAtual string) {
+	sessoes, err := h.Usecase.ListarSessoesUsuario(ctx, usuarioID, sessaoAtual)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "ID inválido ao listar sessões", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerSessao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao listar sessões", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar sessões", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar sessões", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar sessões", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, sessoes)
+}
+
+// encerrarSessao executa o encerramento da sessão, registra o log e responde à requisição.
+func (h *SessaoHandler) encerrarSessao(ctx context.Context, w http.ResponseWriter, id string, encerrar func() error) {
+	if err := encerrar(); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrSessaoIDInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "ID inválido ao encerrar sessão", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrSessaoNaoEncontrada):
+			response.ErrorJSON(w, http.StatusNotFound, "sessão não encontrada ao encerrar", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerSessao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao encerrar sessão", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao encerrar sessão", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao encerrar sessão", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao encerrar sessão", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoDesativar,
+		entidadeSessao,
+		fmt.Sprintf("Sessão encerrada via API: sessao(%s)", id),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "sessão encerrada com sucesso"})
+}
@@ -46,6 +46,15 @@ func NewUsuarioHandler(usecaseUsr usecase.UsuarioUsecase,
 	}
 }
 
+// AtualizarUsuarioRequest representa o payload para a atualização dos dados de um usuário.
+// A permissão e o status são alterados apenas pelas rotas de atualização de permissão, de
+// ativação e de desativação.
+type AtualizarUsuarioRequest struct {
+	Nome   string  `json:"nome"`
+	Email  string  `json:"email"`
+	Avatar *string `json:"avatar,omitempty"`
+}
+
 // Helpers de path
 
 // lastSegment extrai o último segmento de um path
@@ -300,7 +309,7 @@ func (h *UsuarioHandler) BuscarPorID(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path string true "ID do usuário"
-// @Param usuario body model.Usuario true "Dados do usuário"
+// @Param usuario body AtualizarUsuarioRequest true "Dados do usuário"
 // @Success 200 {object} model.Usuario
 // @Failure 400 {object} any
 // @Failure 404 {object} any
@@ -318,12 +327,17 @@ func (h *UsuarioHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 
 	id := lastSegment(r.URL.Path)
-	var usuario model.Usuario
-	if err := json.NewDecoder(r.Body).Decode(&usuario); err != nil {
+	var requisicao AtualizarUsuarioRequest
+	if err := json.NewDecoder(r.Body).Decode(&requisicao); err != nil {
 		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
 		return
 	}
 
+	usuario := model.Usuario{
+		Nome:   requisicao.Nome,
+		Email:  requisicao.Email,
+		Avatar: requisicao.Avatar,
+	}
 	if err := h.UsecaseUsr.AtualizarUsuario(ctx, id, &usuario); err != nil {
 		switch {
 		// requisições inválidas - 400
@@ -493,7 +507,7 @@ func (h *UsuarioHandler) BuscarTecnicos(w http.ResponseWriter, r *http.Request)
 
 // Desativar godoc
 // @Summary Desativa usuário
-// @Description Desativa (soft delete) usuário pelo ID (apenas ADM)
+// @Description Desativa (soft delete) usuário pelo ID e encerra as sessões abertas (apenas ADM)
 // @Tags usuarios
 // @Accept json
 // @Produce json
@@ -644,7 +658,7 @@ func (h *UsuarioHandler) Autorizar(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 
 	id := lastSegment(r.URL.Path)
-	if err := h.UsecaseUsr.AtualizarUsuario(ctx, id, &model.Usuario{Status: true}); err != nil {
+	if err := h.UsecaseUsr.AtivarUsuario(ctx, id); err != nil {
 		switch {
 		// requisições inválidas - 400
 		case errors.As(err, &utils.ValidacaoErrors{}):
@@ -694,7 +708,7 @@ func (h *UsuarioHandler) Autorizar(w http.ResponseWriter, r *http.Request) {
 
 // AtualizarPermissao godoc
 // @Summary Atualiza permissão do usuário
-// @Description Atualiza permissão do usuário pelo ID (apenas ADM)
+// @Description Atualiza permissão do usuário pelo ID e encerra as sessões abertas (apenas ADM)
 // @Tags usuarios
 // @Accept json
 // @Produce json
@@ -818,7 +832,7 @@ func (h *UsuarioHandler) BuscarNovo(w http.ResponseWriter, r *http.Request) {
 
 	// 2) existe inativo? reativar e retornar
 	if usuario, _ := h.UsecaseUsr.BuscarUsuarioPorLogin(r.Context(), login); usuario != nil && !usuario.Status {
-		_ = h.UsecaseUsr.AtualizarUsuario(r.Context(), usuario.ID, &model.Usuario{Status: true})
+		_ = h.UsecaseUsr.AtivarUsuario(r.Context(), usuario.ID)
 		response.JSON(w, http.StatusOK, response.BuscarNovo{
 			Login: usuario.Login,
 			Nome:  usuario.Nome,
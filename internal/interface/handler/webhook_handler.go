@@ -0,0 +1,670 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+const (
+	entidadeWebhook        = "WEBHOOK"
+	entidadeEntregaWebhook = "ENTREGA_WEBHOOK"
+)
+
+// WebhookHandler gerencia as requisições HTTP relacionadas a webhooks e às suas entregas.
+type WebhookHandler struct {
+	Usecase    usecase.WebhookUsecase
+	UsecaseLog usecase.LogUsecase
+}
+
+// NewWebhookHandler cria uma nova instância de WebhookHandler.
+func NewWebhookHandler(usecase usecase.WebhookUsecase, usecaseLog usecase.LogUsecase) *WebhookHandler {
+	return &WebhookHandler{
+		Usecase:    usecase,
+		UsecaseLog: usecaseLog,
+	}
+}
+
+// Criar godoc
+// @Summary Criar um novo webhook
+// @Description Cadastra um endereço para receber os eventos dos chamados. Quando o segredo não é informado, ele é gerado e retornado apenas nesta resposta.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body model.Webhook true "Webhook"
+// @Success 201 {object} response.WebhookResponse
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/criar [post]
+// Criar webhook
+func (h *WebhookHandler) Criar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	var webhook model.Webhook
+	if err := json.NewDecoder(r.Body).Decode(&webhook); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.CriarWebhook(ctx, &webhook); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrURLWebhookInvalida),
+			errors.Is(err, model.ErrSegredoWebhookInvalido),
+			errors.Is(err, model.ErrTiposEventoWebhookVazio),
+			errors.Is(err, model.ErrTipoEventoWebhookInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao criar webhook", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, utils.ErrTokenAleatorioGeneration),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro ao criar webhook", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao criar webhook", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao criar webhook", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao criar webhook", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoCriar,
+		entidadeWebhook,
+		fmt.Sprintf("Webhook criado via API: %s", webhook.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, response.ToWebhookResponse(&webhook))
+}
+
+// BuscarTudo godoc
+// @Summary Listar webhooks
+// @Description Retorna lista paginada de webhooks, sem os segredos
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param pagina query int false "Página"
+// @Param limite query int false "Limite"
+// @Param tipoEvento query string false "Tipo de evento assinado"
+// @Param status query bool false "Status"
+// @Success 200 {object} []model.Webhook
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/buscar-tudo [get]
+// BuscarTudo lista webhooks com paginação e filtros.
+func (h *WebhookHandler) BuscarTudo(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.WebhookFiltro{}
+
+	if pagina, err := strconv.Atoi(query.Get("pagina")); err == nil {
+		filtro.Pagina = pagina
+	}
+	if limite, err := strconv.Atoi(query.Get("limite")); err == nil {
+		filtro.Limite = limite
+	}
+	if tipoEvento := query.Get("tipoEvento"); tipoEvento != "" {
+		tipo := model.TipoEvento(tipoEvento)
+		filtro.TipoEvento = &tipo
+	}
+	if statusStr := query.Get("status"); statusStr != "" {
+		if status, err := strconv.ParseBool(statusStr); err == nil {
+			filtro.Status = &status
+		}
+	}
+
+	items, total, filtroCorrigido, err := h.Usecase.ListarWebhooks(ctx, filtro)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrTipoEventoWebhookInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "filtro inválido ao listar webhooks", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerWebhook),
+			errors.Is(err, repository.ErrScan):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao listar webhooks", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar webhooks", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar webhooks", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar webhooks", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.PageResponse[model.Webhook]{
+		Total:  total,
+		Pagina: filtroCorrigido.Pagina,
+		Limite: filtroCorrigido.Limite,
+		Items:  items,
+	})
+}
+
+// BuscarPorID godoc
+// @Summary Buscar webhook por ID
+// @Description Retorna um webhook pelo ID, sem o segredo
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do webhook"
+// @Success 200 {object} response.WebhookResponse
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/buscar-por-id/{id} [get]
+// BuscarPorID busca um webhook pelo ID.
+func (h *WebhookHandler) BuscarPorID(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	webhook, err := h.Usecase.BuscarWebhookPorID(ctx, id)
+	if err != nil {
+		switch {
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrWebhookIDInvalido),
+			errors.Is(err, repository.ErrWebhookNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao buscar webhook", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerWebhook):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao buscar webhook", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar webhook", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar webhook", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar webhook", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.ToWebhookResponse(webhook))
+}
+
+// Atualizar godoc
+// @Summary Atualizar webhook
+// @Description Atualiza a URL e os tipos de evento de um webhook pelo ID. O segredo é substituído apenas quando informado.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do webhook"
+// @Param webhook body model.Webhook true "Webhook"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} any
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/atualizar/{id} [put]
+// Atualizar atualiza um webhook existente.
+func (h *WebhookHandler) Atualizar(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPut) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	var webhook model.Webhook
+	if err := json.NewDecoder(r.Body).Decode(&webhook); err != nil {
+		response.ErrorJSON(w, http.StatusBadRequest, payloadInvalidoMsg, err.Error())
+		return
+	}
+
+	if err := h.Usecase.AtualizarWebhook(ctx, id, &webhook); err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrWebhookIDInvalido),
+			errors.Is(err, model.ErrURLWebhookInvalida),
+			errors.Is(err, model.ErrSegredoWebhookInvalido),
+			errors.Is(err, model.ErrTiposEventoWebhookVazio),
+			errors.Is(err, model.ErrTipoEventoWebhookInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "dados inválidos ao atualizar webhook", err.Error())
+			return
+
+		// recurso não encontrado - 404
+		case errors.Is(err, repository.ErrWebhookNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao atualizar webhook", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao atualizar webhook", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao atualizar webhook", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao atualizar webhook", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao atualizar webhook", err.Error())
+			return
+		}
+	}
+
+	webhook.ID = id
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoAtualizar,
+		entidadeWebhook,
+		fmt.Sprintf("Webhook atualizado via API: %s", webhook.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, map[string]string{"message": "webhook atualizado com sucesso"})
+}
+
+// Desativar godoc
+// @Summary Desativar webhook
+// @Description Desativa um webhook pelo ID. As entregas pendentes são encerradas como falha e podem ser reenviadas após a reativação.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do webhook"
+// @Success 200 {object} response.StatusWebhook
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/desativar/{id} [delete]
+// Desativar desativa um webhook.
+func (h *WebhookHandler) Desativar(w http.ResponseWriter, r *http.Request) {
+	h.alterarStatus(w, r, http.MethodDelete, false)
+}
+
+// Ativar godoc
+// @Summary Ativar webhook
+// @Description Ativa um webhook pelo ID.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do webhook"
+// @Success 200 {object} response.StatusWebhook
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/ativar/{id} [patch]
+// Ativar ativa um webhook.
+func (h *WebhookHandler) Ativar(w http.ResponseWriter, r *http.Request) {
+	h.alterarStatus(w, r, http.MethodPatch, true)
+}
+
+// BuscarEntregas godoc
+// @Summary Listar entregas de webhooks
+// @Description Retorna lista paginada das entregas dos webhooks, das mais recentes às mais antigas, sem o payload
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param pagina query int false "Página"
+// @Param limite query int false "Limite"
+// @Param webhookId query string false "ID do webhook"
+// @Param chamadoId query string false "ID do chamado"
+// @Param tipoEvento query string false "Tipo de evento"
+// @Param status query string false "Status da entrega (PENDENTE, ENTREGUE, FALHOU)"
+// @Success 200 {object} []model.EntregaWebhook
+// @Failure 400 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/entregas/buscar-tudo [get]
+// BuscarEntregas lista as entregas dos webhooks com paginação e filtros.
+func (h *WebhookHandler) BuscarEntregas(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	query := r.URL.Query()
+
+	filtro := model.EntregaWebhookFiltro{}
+
+	if pagina, err := strconv.Atoi(query.Get("pagina")); err == nil {
+		filtro.Pagina = pagina
+	}
+	if limite, err := strconv.Atoi(query.Get("limite")); err == nil {
+		filtro.Limite = limite
+	}
+	if webhookID := query.Get("webhookId"); webhookID != "" {
+		filtro.WebhookID = &webhookID
+	}
+	if chamadoID := query.Get("chamadoId"); chamadoID != "" {
+		filtro.ChamadoID = &chamadoID
+	}
+	if tipoEvento := query.Get("tipoEvento"); tipoEvento != "" {
+		tipo := model.TipoEvento(tipoEvento)
+		filtro.TipoEvento = &tipo
+	}
+	if statusStr := query.Get("status"); statusStr != "" {
+		status := model.StatusEntregaWebhook(statusStr)
+		filtro.Status = &status
+	}
+
+	items, total, filtroCorrigido, err := h.Usecase.ListarEntregasWebhook(ctx, filtro)
+	if err != nil {
+		switch {
+		// requisições inválidas - 400
+		case errors.Is(err, model.ErrTipoEventoWebhookInvalido),
+			errors.Is(err, model.ErrStatusEntregaInvalido):
+			response.ErrorJSON(w, http.StatusBadRequest, "filtro inválido ao listar entregas de webhooks", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerEntregaWebhook),
+			errors.Is(err, repository.ErrScan):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao listar entregas de webhooks", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao listar entregas de webhooks", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao listar entregas de webhooks", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao listar entregas de webhooks", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, response.PageResponse[model.EntregaWebhook]{
+		Total:  total,
+		Pagina: filtroCorrigido.Pagina,
+		Limite: filtroCorrigido.Limite,
+		Items:  items,
+	})
+}
+
+// BuscarEntregaPorID godoc
+// @Summary Buscar entrega de webhook por ID
+// @Description Retorna uma entrega pelo ID, com o payload enviado e o registro de cada tentativa
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da entrega"
+// @Success 200 {object} model.EntregaWebhook
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/entregas/buscar-por-id/{id} [get]
+// BuscarEntregaPorID busca uma entrega de webhook pelo ID.
+func (h *WebhookHandler) BuscarEntregaPorID(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodGet) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	entrega, err := h.Usecase.BuscarEntregaWebhookPorID(ctx, id)
+	if err != nil {
+		switch {
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrEntregaWebhookIDInvalido),
+			errors.Is(err, repository.ErrEntregaWebhookNaoEncontrada):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao buscar entrega de webhook", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrScannerEntregaWebhook):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao buscar entrega de webhook", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao buscar entrega de webhook", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao buscar entrega de webhook", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao buscar entrega de webhook", err.Error())
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, entrega)
+}
+
+// ReenviarEntrega godoc
+// @Summary Reenviar entrega de webhook
+// @Description Inclui na fila uma nova entrega com o mesmo payload da entrega informada, qualquer que seja a sua situação. O webhook precisa estar ativo.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da entrega"
+// @Success 201 {object} model.EntregaWebhook
+// @Failure 404 {object} any
+// @Failure 405 {object} any
+// @Failure 408 {object} any
+// @Failure 409 {object} any
+// @Failure 500 {object} any
+// @Router /webhooks/entregas/reenviar/{id} [post]
+// ReenviarEntrega reenvia uma entrega de webhook.
+func (h *WebhookHandler) ReenviarEntrega(w http.ResponseWriter, r *http.Request) {
+	if !metodoHttpValido(w, r, http.MethodPost) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+	reenvio, err := h.Usecase.ReenviarEntregaWebhook(ctx, id)
+	if err != nil {
+		switch {
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrEntregaWebhookIDInvalido),
+			errors.Is(err, repository.ErrEntregaWebhookNaoEncontrada),
+			errors.Is(err, repository.ErrWebhookNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao reenviar entrega de webhook", err.Error())
+			return
+
+		// conflitos - 409
+		case errors.Is(err, model.ErrWebhookInativo):
+			response.ErrorJSON(w, http.StatusConflict, "não é possível reenviar entregas de um webhook desativado", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, utils.ErrUUIDv7Generation),
+			errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext),
+			errors.Is(err, repository.ErrTransacao):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao reenviar entrega de webhook", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao reenviar entrega de webhook", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao reenviar entrega de webhook", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao reenviar entrega de webhook", err.Error())
+			return
+		}
+	}
+
+	err = h.UsecaseLog.CriarLog(
+		ctx,
+		model.AcaoCriar,
+		entidadeEntregaWebhook,
+		fmt.Sprintf("Entrega de webhook reenviada via API: %s", reenvio.String()),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, reenvio)
+}
+
+// alterarStatus trata as requisições de ativação e desativação de webhooks.
+func (h *WebhookHandler) alterarStatus(w http.ResponseWriter, r *http.Request, metodo string, ativo bool) {
+	if !metodoHttpValido(w, r, metodo) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeoutPadrao)
+	defer cancel()
+
+	id := lastSegment(r.URL.Path)
+
+	acao, operacao := model.AcaoAtivar, "ativar"
+	alterar := h.Usecase.AtivarWebhook
+	if !ativo {
+		acao, operacao = model.AcaoDesativar, "desativar"
+		alterar = h.Usecase.DesativarWebhook
+	}
+
+	if err := alterar(ctx, id); err != nil {
+		switch {
+		// recurso não encontrado - 404
+		case errors.Is(err, model.ErrWebhookIDInvalido),
+			errors.Is(err, repository.ErrWebhookNaoEncontrado):
+			response.ErrorJSON(w, http.StatusNotFound, "ID inválido ao "+operacao+" webhook", err.Error())
+			return
+
+		// erros internos - 500
+		case errors.Is(err, repository.ErrExecContext),
+			errors.Is(err, repository.ErrQueryContext):
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro interno ao "+operacao+" webhook", err.Error())
+			return
+
+		// erros de contexto - 408
+		case errors.Is(err, context.DeadlineExceeded):
+			response.ErrorJSON(w, http.StatusRequestTimeout, "tempo de requisição excedido ao "+operacao+" webhook", err.Error())
+			return
+
+		// erros de contexto - 400
+		case errors.Is(err, context.Canceled):
+			response.ErrorJSON(w, http.StatusBadRequest, "requisição cancelada ao "+operacao+" webhook", err.Error())
+			return
+
+		// fallback de segurança - 500
+		default:
+			response.ErrorJSON(w, http.StatusInternalServerError, "erro inesperado ao "+operacao+" webhook", err.Error())
+			return
+		}
+	}
+
+	err := h.UsecaseLog.CriarLog(
+		ctx,
+		acao,
+		entidadeWebhook,
+		fmt.Sprintf("Webhook alterado via API: webhook ID(%s) ativo(%t)", id, ativo),
+	)
+	if err != nil {
+		response.ErrorJSON(w, http.StatusInternalServerError, erroLogMsg, err.Error())
+		return
+	}
+
+	response.JSON(w, http.StatusOK, response.StatusWebhook{Ativo: ativo})
+}
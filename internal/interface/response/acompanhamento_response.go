@@ -13,8 +13,12 @@ type AcompanhamentoResponse struct {
 	ChamadoID       string    `json:"chamadoId"`
 	UsuarioID       string    `json:"usuarioId"`
 	Remetente       model.Permissao `json:"remetente"`
+	Visibilidade    model.VisibilidadeAcompanhamento `json:"visibilidade"`
 	CriadoEm        time.Time `json:"criadoEm"`
 	AtualizadoEm    time.Time `json:"atualizadoEm"`
+	EditadoEm       *time.Time `json:"editadoEm"`
+	RemovidoEm      *time.Time `json:"removidoEm"`
+	RemovidoPorID   *string    `json:"removidoPorId"`
 }
 
 // ToAcompanhamentoResponse converte um modelo Acompanhamento para AcompanhamentoResponse
@@ -25,7 +29,34 @@ func ToAcompanhamentoResponse(a *model.Acompanhamento) *AcompanhamentoResponse {
 		ChamadoID:    a.ChamadoID,
 		UsuarioID:    a.UsuarioID,
 		Remetente:    a.Remetente,
+		Visibilidade: a.Visibilidade,
 		CriadoEm:     a.CriadoEm,
 		AtualizadoEm: a.AtualizadoEm,
+		EditadoEm:     a.EditadoEm,
+		RemovidoEm:    a.RemovidoEm,
+		RemovidoPorID: a.RemovidoPorID,
 	}
-}
\ No newline at end of file
+}
+// RevisaoAcompanhamentoResponse representa a estrutura de resposta para uma revisão de acompanhamento
+type RevisaoAcompanhamentoResponse struct {
+	ID                   string                           `json:"id"`
+	AcompanhamentoID     string                           `json:"acompanhamentoId"`
+	Tipo                 model.TipoRevisao                `json:"tipo"`
+	ConteudoAnterior     string                           `json:"conteudoAnterior"`
+	VisibilidadeAnterior model.VisibilidadeAcompanhamento `json:"visibilidadeAnterior"`
+	AlteradoPorID        string                           `json:"alteradoPorId"`
+	CriadoEm             time.Time                        `json:"criadoEm"`
+}
+
+// ToRevisaoAcompanhamentoResponse converte um modelo RevisaoAcompanhamento para RevisaoAcompanhamentoResponse
+func ToRevisaoAcompanhamentoResponse(r *model.RevisaoAcompanhamento) *RevisaoAcompanhamentoResponse {
+	return &RevisaoAcompanhamentoResponse{
+		ID:                   r.ID,
+		AcompanhamentoID:     r.AcompanhamentoID,
+		Tipo:                 r.Tipo,
+		ConteudoAnterior:     r.ConteudoAnterior,
+		VisibilidadeAnterior: r.VisibilidadeAnterior,
+		AlteradoPorID:        r.AlteradoPorID,
+		CriadoEm:             r.CriadoEm,
+	}
+}
@@ -0,0 +1,34 @@
+package response
+
+import (
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+type AnexoResponse struct {
+	ID               string    `json:"id"`
+	ChamadoID        string    `json:"chamadoId"`
+	AcompanhamentoID *string   `json:"acompanhamentoId"`
+	NomeArquivo      string    `json:"nomeArquivo"`
+	TipoMIME         string    `json:"tipoMime"`
+	Tamanho          int64     `json:"tamanho"`
+	Hash             string    `json:"hash"`
+	EnviadoPorID     string    `json:"enviadoPorId"`
+	CriadoEm         time.Time `json:"criadoEm"`
+}
+
+// ToAnexoResponse converte um modelo Anexo para AnexoResponse
+func ToAnexoResponse(a *model.Anexo) *AnexoResponse {
+	return &AnexoResponse{
+		ID:               a.ID,
+		ChamadoID:        a.ChamadoID,
+		AcompanhamentoID: a.AcompanhamentoID,
+		NomeArquivo:      a.NomeArquivo,
+		TipoMIME:         a.TipoMIME,
+		Tamanho:          a.Tamanho,
+		Hash:             a.Hash,
+		EnviadoPorID:     a.EnviadoPorID,
+		CriadoEm:         a.CriadoEm,
+	}
+}
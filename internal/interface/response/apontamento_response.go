@@ -0,0 +1,53 @@
+package response
+
+import (
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+type ApontamentoResponse struct {
+	ID            string     `json:"id"`
+	AtendimentoID string     `json:"atendimentoId"`
+	ChamadoID     string     `json:"chamadoId"`
+	UsuarioID     string     `json:"usuarioId"`
+	IniciadoEm    time.Time  `json:"iniciadoEm"`
+	FinalizadoEm  *time.Time `json:"finalizadoEm"`
+	Minutos       int        `json:"minutos"` // no cronômetro em andamento, o tempo corrido até agora
+	Descricao     string     `json:"descricao"`
+	Faturavel     bool       `json:"faturavel"`
+	EmAndamento   bool       `json:"emAndamento"`
+	CriadoEm      time.Time  `json:"criadoEm"`
+	AtualizadoEm  time.Time  `json:"atualizadoEm"`
+}
+
+// ToApontamentoResponse converte um modelo Apontamento para ApontamentoResponse
+func ToApontamentoResponse(a *model.Apontamento) *ApontamentoResponse {
+	minutos := a.Minutos
+	if a.EmAndamento() {
+		minutos = int(time.Since(a.IniciadoEm) / time.Minute)
+	}
+
+	return &ApontamentoResponse{
+		ID:            a.ID,
+		AtendimentoID: a.AtendimentoID,
+		ChamadoID:     a.ChamadoID,
+		UsuarioID:     a.UsuarioID,
+		IniciadoEm:    a.IniciadoEm,
+		FinalizadoEm:  a.FinalizadoEm,
+		Minutos:       minutos,
+		Descricao:     a.Descricao,
+		Faturavel:     a.Faturavel,
+		EmAndamento:   a.EmAndamento(),
+		CriadoEm:      a.CriadoEm,
+		AtualizadoEm:  a.AtualizadoEm,
+	}
+}
+
+// TotaisApontamentoResponse representa as horas apontadas agrupadas por chamado, categoria ou técnico
+type TotaisApontamentoResponse struct {
+	AgruparPor model.AgrupamentoApontamento `json:"agruparPor"`
+	Inicio     *time.Time                   `json:"inicio"`
+	Fim        *time.Time                   `json:"fim"`
+	Totais     []model.TotalApontamento     `json:"totais"`
+}
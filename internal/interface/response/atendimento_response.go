@@ -12,6 +12,14 @@ type AtendimentoResponse struct {
 	ChamadoID    string    `json:"chamadoId"`
 	CriadoEm     time.Time `json:"criadoEm"`
 	AtualizadoEm time.Time `json:"atualizadoEm"`
+
+	AtribuidoPorID    *string    `json:"atribuidoPorId"`
+	Motivo            *string    `json:"motivo"`
+	FinalizadoEm      *time.Time `json:"finalizadoEm"`
+	FinalizadoPorID   *string    `json:"finalizadoPorId"`
+	MotivoFinalizacao *string    `json:"motivoFinalizacao"`
+	Ativo             bool       `json:"ativo"`
+	DuracaoMinutos    int64      `json:"duracaoMinutos"` // tempo corrido com o técnico
 }
 
 // ToAtendimentoResponse converte um modelo Atendimento para AtendimentoResponse
@@ -22,5 +30,13 @@ func ToAtendimentoResponse(a *model.Atendimento) *AtendimentoResponse {
 		ChamadoID:    a.ChamadoID,
 		CriadoEm:     a.CriadoEm,
 		AtualizadoEm: a.AtualizadoEm,
+
+		AtribuidoPorID:    a.AtribuidoPorID,
+		Motivo:            a.Motivo,
+		FinalizadoEm:      a.FinalizadoEm,
+		FinalizadoPorID:   a.FinalizadoPorID,
+		MotivoFinalizacao: a.MotivoFinalizacao,
+		Ativo:             a.Ativo(),
+		DuracaoMinutos:    int64(a.Duracao(time.Now()) / time.Minute),
 	}
 }
@@ -0,0 +1,40 @@
+package response
+
+import (
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+type AvaliacaoResponse struct {
+	ID            string    `json:"id"`
+	ChamadoID     string    `json:"chamadoId"`
+	AvaliadorID   string    `json:"avaliadorId"`
+	TecnicoID     *string   `json:"tecnicoId"`
+	Nota          int       `json:"nota"`
+	Comentario    *string   `json:"comentario"`
+	SolucaoAceita bool      `json:"solucaoAceita"`
+	CriadoEm      time.Time `json:"criadoEm"`
+}
+
+// ToAvaliacaoResponse converte um modelo Avaliacao para AvaliacaoResponse
+func ToAvaliacaoResponse(a *model.Avaliacao) *AvaliacaoResponse {
+	return &AvaliacaoResponse{
+		ID:            a.ID,
+		ChamadoID:     a.ChamadoID,
+		AvaliadorID:   a.AvaliadorID,
+		TecnicoID:     a.TecnicoID,
+		Nota:          a.Nota,
+		Comentario:    a.Comentario,
+		SolucaoAceita: a.SolucaoAceita,
+		CriadoEm:      a.CriadoEm,
+	}
+}
+
+// CSATResponse representa a satisfação dos usuários agregada por técnico, categoria ou mês
+type CSATResponse struct {
+	AgruparPor model.AgrupamentoCSAT `json:"agruparPor"`
+	Inicio     *time.Time            `json:"inicio"`
+	Fim        *time.Time            `json:"fim"`
+	Totais     []model.TotalCSAT     `json:"totais"`
+}
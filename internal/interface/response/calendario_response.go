@@ -0,0 +1,80 @@
+package response
+
+import (
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// StatusCalendario representa o status de um calendário.
+type StatusCalendario struct {
+	Ativo bool `json:"ativo"`
+}
+
+// FeriadosImportados representa o resultado da importação de feriados.
+type FeriadosImportados struct {
+	Importados int `json:"importados"`
+}
+
+// TempoUtilResponse representa o tempo de expediente decorrido entre dois instantes.
+type TempoUtilResponse struct {
+	Inicio           time.Time `json:"inicio"`
+	Fim              time.Time `json:"fim"`
+	TempoUtilMinutos int64     `json:"tempo_util_minutos"`
+}
+
+// ExpedienteResponse representa o horário de expediente de um dia da semana.
+type ExpedienteResponse struct {
+	DiaSemana int    `json:"dia_semana"`
+	Inicio    string `json:"inicio"`
+	Fim       string `json:"fim"`
+}
+
+// FeriadoResponse representa um feriado do calendário.
+type FeriadoResponse struct {
+	Data      string `json:"data"`
+	Descricao string `json:"descricao"`
+}
+
+// CalendarioResponse representa a estrutura de resposta para um calendário.
+type CalendarioResponse struct {
+	ID           string               `json:"id"`
+	Nome         string               `json:"nome"`
+	CategoriaID  *string              `json:"categoria_id"`
+	Status       bool                 `json:"status"`
+	Expedientes  []ExpedienteResponse `json:"expedientes"`
+	Feriados     []FeriadoResponse    `json:"feriados"`
+	CriadoEm     time.Time            `json:"criado_em"`
+	AtualizadoEm time.Time            `json:"atualizado_em"`
+}
+
+// ToCalendarioResponse converte um modelo Calendario para CalendarioResponse
+func ToCalendarioResponse(c *model.Calendario) *CalendarioResponse {
+	expedientes := make([]ExpedienteResponse, 0, len(c.Expedientes))
+	for _, e := range c.Expedientes {
+		expedientes = append(expedientes, ExpedienteResponse{
+			DiaSemana: int(e.DiaSemana),
+			Inicio:    e.Inicio,
+			Fim:       e.Fim,
+		})
+	}
+
+	feriados := make([]FeriadoResponse, 0, len(c.Feriados))
+	for _, f := range c.Feriados {
+		feriados = append(feriados, FeriadoResponse{
+			Data:      f.Data,
+			Descricao: f.Descricao,
+		})
+	}
+
+	return &CalendarioResponse{
+		ID:           c.ID,
+		Nome:         c.Nome,
+		CategoriaID:  c.CategoriaID,
+		Status:       c.Status,
+		Expedientes:  expedientes,
+		Feriados:     feriados,
+		CriadoEm:     c.CriadoEm,
+		AtualizadoEm: c.AtualizadoEm,
+	}
+}
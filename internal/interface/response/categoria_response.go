@@ -13,20 +13,22 @@ type StatusCategoria struct {
 
 // CategoriaResponse representa a estrutura de resposta para uma categoria.
 type CategoriaResponse struct {
-	ID           string    `json:"id"`
-	Nome         string    `json:"nome"`
-	Status       bool      `json:"status"`
-	CriadoEm     time.Time `json:"criado_em"`
-	AtualizadoEm time.Time `json:"atualizado_em"`
+	ID                   string    `json:"id"`
+	Nome                 string    `json:"nome"`
+	Status               bool      `json:"status"`
+	EstrategiaAtribuicao string    `json:"estrategia_atribuicao"`
+	CriadoEm             time.Time `json:"criado_em"`
+	AtualizadoEm         time.Time `json:"atualizado_em"`
 }
 
 // ToCategoriaResponse converte um modelo Categoria para CategoriaResponse
 func ToCategoriaResponse(c *model.Categoria) *CategoriaResponse {
 	return &CategoriaResponse{
-		ID:           c.ID,
-		Nome:         c.Nome,
-		Status:       c.Status,
-		CriadoEm:     c.CriadoEm,
-		AtualizadoEm: c.AtualizadoEm,
+		ID:                   c.ID,
+		Nome:                 c.Nome,
+		Status:               c.Status,
+		EstrategiaAtribuicao: string(c.EstrategiaAtribuicao),
+		CriadoEm:             c.CriadoEm,
+		AtualizadoEm:         c.AtualizadoEm,
 	}
 }
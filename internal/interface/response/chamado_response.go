@@ -27,12 +27,49 @@ type ChamadoResponse struct {
 	CategoriaID    string     `json:"categoria_id"`
 	SubcategoriaID string     `json:"subcategoria_id"`
 	CriadorID      string     `json:"criador_id"`
-	AtribuidoID    *string     `json:"atribuido_id"`
+	AtribuidoID    *string    `json:"atribuido_id"`
+	Impacto        string     `json:"impacto"`
+	Urgencia       string     `json:"urgencia"`
+	Prioridade     string     `json:"prioridade"`
+
+	PrazoPrimeiraResposta      *time.Time `json:"prazo_primeira_resposta"`
+	PrazoSolucao               *time.Time `json:"prazo_solucao"`
+	PrimeiraRespostaEm         *time.Time `json:"primeira_resposta_em"`
+	SLAPausadoEm               *time.Time `json:"sla_pausado_em"`
+	SLAPrimeiraRespostaViolado bool       `json:"sla_primeira_resposta_violado"`
+	SLASolucaoViolado          bool       `json:"sla_solucao_violado"`
+
+	// Tempos em minutos de expediente, segundo o calendário da categoria
+	IdadeUtilMinutos          *int64 `json:"idade_util_minutos"`
+	TempoResolucaoUtilMinutos *int64 `json:"tempo_resolucao_util_minutos"`
+	TempoAtribuidoUtilMinutos *int64 `json:"tempo_atribuido_util_minutos"`
+
+	// Reaberturas após a solução do chamado
+	Reaberturas int        `json:"reaberturas"`
+	ReabertoEm  *time.Time `json:"reaberto_em"`
+
+	// TransicoesPermitidas lista os próximos status que o usuário pode aplicar ao chamado
+	TransicoesPermitidas []string `json:"transicoes_permitidas"`
+
+	// Atendimentos traz a linha do tempo de técnicos do chamado, apenas na busca por ID
+	Atendimentos []AtendimentoResponse `json:"atendimentos,omitempty"`
+
+	// AvaliacaoPendente indica que o criador ainda precisa avaliar a solução do chamado resolvido
+	AvaliacaoPendente bool `json:"avaliacao_pendente"`
+
+	// Avaliacoes traz as respostas à pesquisa de satisfação, apenas na busca por ID
+	Avaliacoes []AvaliacaoResponse `json:"avaliacoes,omitempty"`
 }
 
-// ToChamadoResponse converte um modelo Chamado para ChamadoResponse
-func ToChamadoResponse(c *model.Chamado) *ChamadoResponse {
-	return &ChamadoResponse{
+// ToChamadoResponse converte um modelo Chamado para ChamadoResponse,
+// incluindo as transições de status permitidas para a permissão informada
+func ToChamadoResponse(c *model.Chamado, permissao model.Permissao) *ChamadoResponse {
+	transicoes := []string{}
+	for _, status := range c.Status.TransicoesPermitidas(permissao) {
+		transicoes = append(transicoes, string(status))
+	}
+
+	resposta := &ChamadoResponse{
 		ID:             c.ID,
 		Titulo:         c.Titulo,
 		Descricao:      c.Descricao,
@@ -45,6 +82,49 @@ func ToChamadoResponse(c *model.Chamado) *ChamadoResponse {
 		CategoriaID:    c.CategoriaID,
 		SubcategoriaID: c.SubcategoriaID,
 		CriadorID:      c.CriadorID,
+		Impacto:        string(c.Impacto),
+		Urgencia:       string(c.Urgencia),
+		Prioridade:     string(c.Prioridade),
+
+		PrazoPrimeiraResposta:      c.PrazoPrimeiraResposta,
+		PrazoSolucao:               c.PrazoSolucao,
+		PrimeiraRespostaEm:         c.PrimeiraRespostaEm,
+		SLAPausadoEm:               c.SLAPausadoEm,
+		SLAPrimeiraRespostaViolado: c.SLAPrimeiraRespostaViolado,
+		SLASolucaoViolado:          c.SLASolucaoViolado,
+
+		Reaberturas: c.Reaberturas,
+		ReabertoEm:  c.ReabertoEm,
+
+		TransicoesPermitidas: transicoes,
+		AvaliacaoPendente:    c.Status == model.StatusResolvido,
+	}
+
+	if c.Tempos != nil {
+		resposta.IdadeUtilMinutos = &c.Tempos.IdadeUtilMinutos
+		resposta.TempoResolucaoUtilMinutos = c.Tempos.TempoResolucaoUtilMinutos
+		resposta.TempoAtribuidoUtilMinutos = &c.Tempos.TempoAtribuidoUtilMinutos
+	}
+
+	for i := range c.Atendimentos {
+		atendimento := &c.Atendimentos[i]
+		if atendimento.Ativo() {
+			resposta.AtribuidoID = &atendimento.AtribuidoID
+		}
+		resposta.Atendimentos = append(resposta.Atendimentos, *ToAtendimentoResponse(atendimento))
+	}
+
+	for i := range c.Avaliacoes {
+		resposta.Avaliacoes = append(resposta.Avaliacoes, *ToAvaliacaoResponse(&c.Avaliacoes[i]))
 	}
+
+	return resposta
 }
 
+// ReaberturasResponse representa as reaberturas agregadas por técnico, categoria ou mês
+type ReaberturasResponse struct {
+	AgruparPor model.AgrupamentoReabertura `json:"agruparPor"`
+	Inicio     *time.Time                  `json:"inicio"`
+	Fim        *time.Time                  `json:"fim"`
+	Totais     []model.TotalReabertura     `json:"totais"`
+}
@@ -12,6 +12,7 @@ type LogResponse struct {
 	UsuarioID string `json:"usuario_id"`
 	Acao      string `json:"acao"`
 	Entidade  string `json:"entidade"`
+	ChamadoID *string `json:"chamado_id,omitempty"`
 	Detalhes  string `json:"detalhes"`
 	CriadoEm  time.Time `json:"criado_em"`
 }
@@ -23,6 +24,7 @@ func ToLogResponse(log *model.Log) LogResponse {
 		UsuarioID: log.UsuarioID,
 		Acao:      string(log.Acao),
 		Entidade:  log.Entidade,
+		ChamadoID: log.ChamadoID,
 		Detalhes:  log.Detalhes,
 		CriadoEm:  log.CriadoEm,
 	}
@@ -0,0 +1,11 @@
+package response
+
+// ContagemNotificacoes representa a quantidade de notificações não lidas do usuário.
+type ContagemNotificacoes struct {
+	NaoLidas int `json:"naoLidas"`
+}
+
+// NotificacoesMarcadas representa a quantidade de notificações marcadas como lidas.
+type NotificacoesMarcadas struct {
+	Marcadas int `json:"marcadas"`
+}
@@ -0,0 +1,39 @@
+package response
+
+import (
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// PapelUsuario representa a estrutura de resposta para o papel atribuído a um usuário
+type PapelUsuario struct {
+	UsuarioID string `json:"usuario_id"`
+	Papel     string `json:"papel"`
+}
+
+// PapelResponse representa a estrutura de resposta para um papel
+type PapelResponse struct {
+	Codigo        string                   `json:"codigo"`
+	Nome          string                   `json:"nome"`
+	Descricao     *string                  `json:"descricao,omitempty"`
+	PermissaoBase model.Permissao          `json:"permissao_base"`
+	Sistema       bool                     `json:"sistema"`
+	Permissoes    []model.PermissaoNomeada `json:"permissoes"`
+	CriadoEm      time.Time                `json:"criado_em"`
+	AtualizadoEm  time.Time                `json:"atualizado_em"`
+}
+
+// ToPapelResponse converte um modelo Papel para PapelResponse
+func ToPapelResponse(p *model.Papel) *PapelResponse {
+	return &PapelResponse{
+		Codigo:        p.Codigo,
+		Nome:          p.Nome,
+		Descricao:     p.Descricao,
+		PermissaoBase: p.PermissaoBase,
+		Sistema:       p.Sistema,
+		Permissoes:    p.Permissoes,
+		CriadoEm:      p.CriadoEm,
+		AtualizadoEm:  p.AtualizadoEm,
+	}
+}
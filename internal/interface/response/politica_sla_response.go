@@ -0,0 +1,42 @@
+package response
+
+import (
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// StatusPoliticaSLA representa o status de uma política de SLA.
+type StatusPoliticaSLA struct {
+	Ativo bool `json:"ativo"`
+}
+
+// PoliticaSLAResponse representa a estrutura de resposta para uma política de SLA.
+type PoliticaSLAResponse struct {
+	ID                      string    `json:"id"`
+	Nome                    string    `json:"nome"`
+	CategoriaID             string    `json:"categoria_id"`
+	SubcategoriaID          *string   `json:"subcategoria_id"`
+	PrimeiraRespostaMinutos int       `json:"primeira_resposta_minutos"`
+	SolucaoMinutos          int       `json:"solucao_minutos"`
+	PausarAguardandoUsuario bool      `json:"pausar_aguardando_usuario"`
+	Status                  bool      `json:"status"`
+	CriadoEm                time.Time `json:"criado_em"`
+	AtualizadoEm            time.Time `json:"atualizado_em"`
+}
+
+// ToPoliticaSLAResponse converte um modelo PoliticaSLA para PoliticaSLAResponse
+func ToPoliticaSLAResponse(p *model.PoliticaSLA) *PoliticaSLAResponse {
+	return &PoliticaSLAResponse{
+		ID:                      p.ID,
+		Nome:                    p.Nome,
+		CategoriaID:             p.CategoriaID,
+		SubcategoriaID:          p.SubcategoriaID,
+		PrimeiraRespostaMinutos: p.PrimeiraRespostaMinutos,
+		SolucaoMinutos:          p.SolucaoMinutos,
+		PausarAguardandoUsuario: p.PausarAguardandoUsuario,
+		Status:                  p.Status,
+		CriadoEm:                p.CriadoEm,
+		AtualizadoEm:            p.AtualizadoEm,
+	}
+}
@@ -46,6 +46,7 @@ type UsuarioResponse struct {
 	Login        string          `json:"login"`
 	Email        string          `json:"email"`
 	Permissao    model.Permissao `json:"permissao"`
+	Papel        string          `json:"papel"`
 	Status       bool            `json:"status"`
 	Avatar       *string         `json:"avatar,omitempty"`
 	UltimoLogin  time.Time       `json:"ultimoLogin"`
@@ -61,6 +62,7 @@ func ToUsuarioResponse(u *model.Usuario) *UsuarioResponse {
 		Login:        u.Login,
 		Email:        u.Email,
 		Permissao:    u.Permissao,
+		Papel:        u.PapelEfetivo(),
 		Status:       u.Status,
 		Avatar:       u.Avatar,
 		UltimoLogin:  u.UltimoLogin,
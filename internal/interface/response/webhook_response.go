@@ -0,0 +1,37 @@
+package response
+
+import (
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// StatusWebhook representa o status de um webhook.
+type StatusWebhook struct {
+	Ativo bool `json:"ativo"`
+}
+
+// WebhookResponse representa a estrutura de resposta para um webhook. O segredo é
+// preenchido apenas na criação.
+type WebhookResponse struct {
+	ID           string             `json:"id"`
+	URL          string             `json:"url"`
+	Segredo      string             `json:"segredo,omitempty"`
+	TiposEvento  []model.TipoEvento `json:"tipos_evento"`
+	Status       bool               `json:"status"`
+	CriadoEm     time.Time          `json:"criado_em"`
+	AtualizadoEm time.Time          `json:"atualizado_em"`
+}
+
+// ToWebhookResponse converte um modelo Webhook para WebhookResponse
+func ToWebhookResponse(w *model.Webhook) *WebhookResponse {
+	return &WebhookResponse{
+		ID:           w.ID,
+		URL:          w.URL,
+		Segredo:      w.Segredo,
+		TiposEvento:  w.TiposEvento,
+		Status:       w.Status,
+		CriadoEm:     w.CriadoEm,
+		AtualizadoEm: w.AtualizadoEm,
+	}
+}
@@ -1,9 +1,12 @@
 package router
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,8 +14,16 @@ import (
 	mid "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/provider/ldap"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/config"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	dominio "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/email"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/eventos"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/storage"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/webhook"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/handler"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/job"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/middleware"
 	auth "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/usecase"
 	uc "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/usecase"
@@ -25,42 +36,261 @@ var prefixosPublicos = [4]string{
 	"/swagger",
 }
 
-// InicializarRoteadorHTTP configura e retorna o roteador HTTP da aplicação
-func InicializarRoteadorHTTP(cfg config.Config, db *sql.DB) http.Handler {
+// InicializarBarramentoEventos configura e retorna o barramento de eventos em tempo real,
+// compartilhado entre o roteador HTTP e as rotinas automáticas
+func InicializarBarramentoEventos(cfg config.Config) (*eventos.BarramentoMemoria, error) {
+	capacidade, err := strconv.Atoi(cfg.EventosBuffer)
+	if err != nil || capacidade <= 0 {
+		return nil, fmt.Errorf("[router.InicializarBarramentoEventos]: EVENTOS_BUFFER inválido: %q", cfg.EventosBuffer)
+	}
+	return eventos.NewBarramentoMemoria(capacidade), nil
+}
+
+// CasosDeUso reúne os casos de uso da aplicação, montados uma única vez e compartilhados entre
+// o roteador HTTP, as rotinas automáticas e a leitura da caixa de entrada de e-mails
+type CasosDeUso struct {
+	unidadeTrabalho               *repository.MySQLUnidadeDeTrabalho
+	regrasAnexo                   model.RegrasAnexo
+	chamadoRepository             *repository.MySQLChamadoRepository
+	usuarioRepository             *repository.MySQLUsuarioRepository
+	revogacaoTokenUsecase         *uc.RevogacaoTokenUsecase
+	sessaoUsecase                 *uc.SessaoUsecase
+	usuarioUsecase                *uc.UsuarioUsecase
+	papelUsecase                  *uc.PapelUsecase
+	categoriaUsecase              *uc.CategoriaUsecase
+	categoriaPermissaoUsecase     *uc.CategoriaPermissaoUsecase
+	autorizacaoUsecase            *uc.AutorizacaoUsecase
+	subcategoriaUsecase           *uc.SubcategoriaUsecase
+	logUsecase                    *uc.LogUsecase
+	eventoUsecase                 *uc.EventoUsecase
+	preferenciaNotificacaoUsecase *uc.PreferenciaNotificacaoUsecase
+	centralNotificacoesUsecase    *uc.CentralNotificacoesUsecase
+	politicaSLAUsecase            *uc.PoliticaSLAUsecase
+	webhookUsecase                *uc.WebhookUsecase
+	calendarioUsecase             *uc.CalendarioUsecase
+	matrizPrioridadeUsecase       *uc.MatrizPrioridadeUsecase
+	atribuicaoUsecase             *uc.AtribuicaoUsecase
+	chamadoUsecase                *uc.ChamadoUsecase
+	atendimentoUsecase            *uc.AtendimentoUsecase
+	apontamentoUsecase            *uc.ApontamentoUsecase
+	acompanhamentoUsecase         *uc.AcompanhamentoUsecase
+	anexoUsecase                  *uc.AnexoUsecase
+	avaliacaoUsecase              *uc.AvaliacaoUsecase
+	observadorUsecase             *uc.ObservadorUsecase
+}
+
+// InicializarCasosDeUso configura e retorna os repositórios e os casos de uso da aplicação
+func InicializarCasosDeUso(cfg config.Config, db *sql.DB, barramento *eventos.BarramentoMemoria) (*CasosDeUso, error) {
+	tamanhoMaximoAnexo, err := strconv.ParseInt(cfg.AnexosTamanhoMaximo, 10, 64)
+	if err != nil || tamanhoMaximoAnexo <= 0 {
+		return nil, fmt.Errorf("[router.InicializarCasosDeUso]: ANEXOS_TAMANHO_MAXIMO inválido: %q", cfg.AnexosTamanhoMaximo)
+	}
+	regrasAnexo := model.NewRegrasAnexo(tamanhoMaximoAnexo, strings.Split(cfg.AnexosTiposPermitidos, ","))
+
+	// Armazenamento do conteúdo dos anexos
+	armazenamentoAnexos, err := storage.NewArmazenamentoLocal(cfg.AnexosDiretorio)
+	if err != nil {
+		return nil, fmt.Errorf("[router.InicializarCasosDeUso]: %w", err)
+	}
+
 	// Injeção de dependências:
 
+	// Unidade de trabalho das transações da requisição e das rotinas automáticas
+	unidadeTrabalho := repository.NewMySQLUnidadeDeTrabalho(db)
+
+	// Repositórios e casos de uso das sessões e da revogação de tokens
+	sessaoRepository := repository.NewMySQLSessaoRepository(db)
+	tokenRevogadoRepository := repository.NewMySQLTokenRevogadoRepository(db)
+	revogacaoTokenUsecase := uc.NewRevogacaoTokenUsecase(tokenRevogadoRepository, sessaoRepository, unidadeTrabalho)
+	sessaoUsecase := uc.NewSessaoUsecase(sessaoRepository, revogacaoTokenUsecase)
+
 	// Repositório e casos de uso de usuários
 	usuarioRepository := repository.NewMySQLUsuarioRepository(db)
-	usuarioUsecase := uc.NewUsuarioUsecase(usuarioRepository)
+	usuarioUsecase := uc.NewUsuarioUsecase(usuarioRepository, revogacaoTokenUsecase)
 
-	// Repositório e caso de uso de chamados
-	chamadoRepository := repository.NewMySQLChamadoRepository(db)
-	chamadoUsecase := uc.NewChamadoUsecase(chamadoRepository)
+	// Repositório e caso de uso de papéis
+	papelRepository := repository.NewMySQLPapelRepository(db)
+	papelUsecase := uc.NewPapelUsecase(papelRepository, revogacaoTokenUsecase)
 
 	// Repositório e caso de uso de categorias
 	categoriaRepository := repository.NewMySQLCategoriaRepository(db)
 	categoriaUsecase := uc.NewCategoriaUsecase(categoriaRepository)
 
+	// Repositório e caso de uso de categoriaPermissão
+	categoriaPermissaoRepository := repository.NewMySQLCategoriaPermissaoRepository(db)
+	categoriaPermissaoUsecase := uc.NewCategoriaPermissaoUsecase(categoriaPermissaoRepository)
+
+	// Caso de uso da autorização das operações sobre os chamados por categoria
+	autorizacaoUsecase := uc.NewAutorizacaoUsecase(categoriaPermissaoRepository, categoriaRepository, papelUsecase)
+
 	// Repositório e caso de uso de subcategorias
 	subcategoriaRepository := repository.NewMySQLSubcategoriaRepository(db)
 	subcategoriaUsecase := uc.NewSubcategoriaUsecase(subcategoriaRepository)
 
+	// Repositório da outbox, onde logs e eventos são gravados na transação da requisição
+	outboxRepository := repository.NewMySQLOutboxRepository(db)
+
+	// Repositório de chamados
+	chamadoRepository := repository.NewMySQLChamadoRepository(db)
+
 	// Repositório e caso de uso de logs
 	logRepository := repository.NewMySQLLogRepository(db)
-	logUsecase := uc.NewLogUsecase(logRepository)
+	logUsecase := uc.NewLogUsecase(logRepository, chamadoRepository, outboxRepository)
 
-	// Repositório e caso de uso de acompanhamentos
-	acompanhamentoRepository := repository.NewMySQLAcompanhamentoRepository(db)
-	acompanhamentoUsecase := uc.NewAcompanhamentoUsecase(acompanhamentoRepository)
+	// Repositório de menções em acompanhamentos
+	mencaoRepository := repository.NewMySQLMencaoRepository(db)
+
+	// Caso de uso de eventos em tempo real
+	eventoUsecase := uc.NewEventoUsecase(
+		barramento,
+		outboxRepository,
+		mencaoRepository,
+		categoriaPermissaoRepository,
+		repository.NewMySQLAtendimentoRepository(db),
+		repository.NewMySQLObservadorRepository(db),
+	)
+
+	// Repositório e caso de uso de preferências de notificação
+	preferenciaNotificacaoRepository := repository.NewMySQLPreferenciaNotificacaoRepository(db)
+	preferenciaNotificacaoUsecase := uc.NewPreferenciaNotificacaoUsecase(preferenciaNotificacaoRepository)
+
+	// Repositório e caso de uso da central de notificações
+	notificacaoUsuarioRepository := repository.NewMySQLNotificacaoUsuarioRepository(db)
+	centralNotificacoesUsecase := uc.NewCentralNotificacoesUsecase(notificacaoUsuarioRepository)
+
+	// Repositório e caso de uso de políticas de SLA
+	politicaSLARepository := repository.NewMySQLPoliticaSLARepository(db)
+	politicaSLAUsecase := uc.NewPoliticaSLAUsecase(politicaSLARepository)
+
+	// Repositórios e caso de uso de webhooks
+	webhookUsecase := uc.NewWebhookUsecase(
+		repository.NewMySQLWebhookRepository(db),
+		repository.NewMySQLEntregaWebhookRepository(db),
+	)
+
+	// Repositório e caso de uso de calendários de expediente
+	calendarioRepository := repository.NewMySQLCalendarioRepository(db)
+	calendarioUsecase := uc.NewCalendarioUsecase(calendarioRepository)
 
-	// Repositório e caso de uso de atendimentos
+	// Repositório e caso de uso da matriz de prioridade
+	matrizPrioridadeRepository := repository.NewMySQLMatrizPrioridadeRepository(db)
+	matrizPrioridadeUsecase := uc.NewMatrizPrioridadeUsecase(matrizPrioridadeRepository)
+
+	// Repositório e caso de uso da atribuição automática de chamados
+	atribuicaoRepository := repository.NewMySQLAtribuicaoRepository(db)
+	atribuicaoUsecase := uc.NewAtribuicaoUsecase(atribuicaoRepository, categoriaRepository)
+
+	// Repositório de atendimentos
 	atendimentoRepository := repository.NewMySQLAtendimentoRepository(db)
-	atendimentoUsecase := uc.NewAtendimentoUsecase(atendimentoRepository)
 
-	// Repositório e caso de uso de categoriaPermissão
-	categoriaPermissaoRepository := repository.NewMySQLCategoriaPermissaoRepository(db)
-	categoriaPermissaoUsecase := uc.NewCategoriaPermissaoUsecase(categoriaPermissaoRepository)
+	// Repositório de avaliações
+	avaliacaoRepository := repository.NewMySQLAvaliacaoRepository(db)
+
+	// Repositório de acompanhamentos
+	acompanhamentoRepository := repository.NewMySQLAcompanhamentoRepository(db)
+
+	// Repositório de anexos
+	anexoRepository := repository.NewMySQLAnexoRepository(db)
+
+	// Caso de uso de chamados
+	chamadoUsecase := uc.NewChamadoUsecase(
+		chamadoRepository,
+		politicaSLARepository,
+		calendarioRepository,
+		matrizPrioridadeRepository,
+		atendimentoRepository,
+		avaliacaoRepository,
+		acompanhamentoRepository,
+		unidadeTrabalho,
+		atribuicaoUsecase,
+		autorizacaoUsecase,
+		logUsecase,
+		eventoUsecase,
+		converterDuracao(cfg.PrazoReabertura),
+	)
+
+	// Caso de uso de atendimentos
+	atendimentoUsecase := uc.NewAtendimentoUsecase(atendimentoRepository, chamadoRepository, chamadoUsecase, autorizacaoUsecase, eventoUsecase)
+
+	// Repositório e caso de uso de apontamentos de horas
+	apontamentoRepository := repository.NewMySQLApontamentoRepository(db)
+	apontamentoUsecase := uc.NewApontamentoUsecase(apontamentoRepository, atendimentoRepository)
+
+	// Caso de uso de acompanhamentos
+	acompanhamentoUsecase := uc.NewAcompanhamentoUsecase(
+		acompanhamentoRepository,
+		chamadoRepository,
+		anexoRepository,
+		mencaoRepository,
+		usuarioRepository,
+		armazenamentoAnexos,
+		chamadoUsecase,
+		autorizacaoUsecase,
+		eventoUsecase,
+		converterDuracao(cfg.PrazoEdicao),
+	)
+
+	// Caso de uso de anexos
+	anexoUsecase := uc.NewAnexoUsecase(
+		anexoRepository,
+		chamadoRepository,
+		acompanhamentoRepository,
+		armazenamentoAnexos,
+		unidadeTrabalho,
+		regrasAnexo,
+	)
+
+	// Caso de uso da pesquisa de satisfação
+	avaliacaoUsecase := uc.NewAvaliacaoUsecase(
+		avaliacaoRepository,
+		chamadoRepository,
+		atendimentoRepository,
+		chamadoUsecase,
+	)
+
+	// Repositório e caso de uso dos observadores dos chamados
+	observadorUsecase := uc.NewObservadorUsecase(
+		repository.NewMySQLObservadorRepository(db),
+		chamadoRepository,
+		usuarioRepository,
+		autorizacaoUsecase,
+		eventoUsecase,
+	)
 
+	return &CasosDeUso{
+		unidadeTrabalho:               unidadeTrabalho,
+		regrasAnexo:                   regrasAnexo,
+		chamadoRepository:             chamadoRepository,
+		usuarioRepository:             usuarioRepository,
+		revogacaoTokenUsecase:         revogacaoTokenUsecase,
+		sessaoUsecase:                 sessaoUsecase,
+		usuarioUsecase:                usuarioUsecase,
+		papelUsecase:                  papelUsecase,
+		categoriaUsecase:              categoriaUsecase,
+		categoriaPermissaoUsecase:     categoriaPermissaoUsecase,
+		autorizacaoUsecase:            autorizacaoUsecase,
+		subcategoriaUsecase:           subcategoriaUsecase,
+		logUsecase:                    logUsecase,
+		eventoUsecase:                 eventoUsecase,
+		preferenciaNotificacaoUsecase: preferenciaNotificacaoUsecase,
+		centralNotificacoesUsecase:    centralNotificacoesUsecase,
+		politicaSLAUsecase:            politicaSLAUsecase,
+		webhookUsecase:                webhookUsecase,
+		calendarioUsecase:             calendarioUsecase,
+		matrizPrioridadeUsecase:       matrizPrioridadeUsecase,
+		atribuicaoUsecase:             atribuicaoUsecase,
+		chamadoUsecase:                chamadoUsecase,
+		atendimentoUsecase:            atendimentoUsecase,
+		apontamentoUsecase:            apontamentoUsecase,
+		acompanhamentoUsecase:         acompanhamentoUsecase,
+		anexoUsecase:                  anexoUsecase,
+		avaliacaoUsecase:              avaliacaoUsecase,
+		observadorUsecase:             observadorUsecase,
+	}, nil
+}
+
+// InicializarRoteadorHTTP configura e retorna o roteador HTTP da aplicação
+func InicializarRoteadorHTTP(cfg config.Config, db *sql.DB, casos *CasosDeUso) (http.Handler, error) {
 	// Gerenciador JWT
 	gerenteJWT := jwt.NewGerenteJWT(
 		[]byte(cfg.JWTSecret),
@@ -80,18 +310,32 @@ func InicializarRoteadorHTTP(cfg config.Config, db *sql.DB) http.Handler {
 	)
 
 	// Caso de uso de autenticação
-	authUsecase := auth.NewAuthInternoUsecase(usuarioUsecase, gerenteJWT, clienteLDAP, logUsecase, cfg)
+	authUsecase := auth.NewAuthInternoUsecase(casos.usuarioUsecase, gerenteJWT, clienteLDAP, casos.logUsecase, casos.revogacaoTokenUsecase, casos.sessaoUsecase, cfg)
 
 	// Handlers
 	AuthHandler := handler.NewAuthHandler(authUsecase)
-	usuarioHandler := handler.NewUsuarioHandler(usuarioUsecase, authUsecase, clienteLDAP, logUsecase)
-	chamadoHandler := handler.NewChamadoHandler(chamadoUsecase, logUsecase)
-	categoriaHandler := handler.NewCategoriaHandler(categoriaUsecase, logUsecase)
-	subcategoriaHandler := handler.NewSubcategoriaHandler(subcategoriaUsecase, logUsecase)
-	logHandler := handler.NewLogHandler(logUsecase)
-	acompanhamentoHandler := handler.NewAcompanhamentoHandler(acompanhamentoUsecase, logUsecase)
-	atendimentoHandler := handler.NewAtendimentoHandler(atendimentoUsecase, logUsecase)
-	categoriaPermissaoHandler := handler.NewCategoriaPermissaoHandler(categoriaPermissaoUsecase, logUsecase)
+	usuarioHandler := handler.NewUsuarioHandler(casos.usuarioUsecase, authUsecase, clienteLDAP, casos.logUsecase)
+	chamadoHandler := handler.NewChamadoHandler(casos.chamadoUsecase, casos.logUsecase)
+	categoriaHandler := handler.NewCategoriaHandler(casos.categoriaUsecase, casos.logUsecase)
+	subcategoriaHandler := handler.NewSubcategoriaHandler(casos.subcategoriaUsecase, casos.logUsecase)
+	logHandler := handler.NewLogHandler(casos.logUsecase)
+	acompanhamentoHandler := handler.NewAcompanhamentoHandler(casos.acompanhamentoUsecase, casos.logUsecase)
+	anexoHandler := handler.NewAnexoHandler(casos.anexoUsecase, casos.logUsecase, casos.regrasAnexo.TamanhoMaximo)
+	atendimentoHandler := handler.NewAtendimentoHandler(casos.atendimentoUsecase, casos.logUsecase)
+	apontamentoHandler := handler.NewApontamentoHandler(casos.apontamentoUsecase, casos.logUsecase)
+	avaliacaoHandler := handler.NewAvaliacaoHandler(casos.avaliacaoUsecase, casos.logUsecase)
+	observadorHandler := handler.NewObservadorHandler(casos.observadorUsecase, casos.logUsecase)
+	categoriaPermissaoHandler := handler.NewCategoriaPermissaoHandler(casos.categoriaPermissaoUsecase, casos.logUsecase)
+	politicaSLAHandler := handler.NewPoliticaSLAHandler(casos.politicaSLAUsecase, casos.logUsecase)
+	calendarioHandler := handler.NewCalendarioHandler(casos.calendarioUsecase, casos.logUsecase)
+	matrizPrioridadeHandler := handler.NewMatrizPrioridadeHandler(casos.matrizPrioridadeUsecase, casos.logUsecase)
+	atribuicaoHandler := handler.NewAtribuicaoHandler(casos.atribuicaoUsecase)
+	autorizacaoHandler := handler.NewAutorizacaoHandler(casos.autorizacaoUsecase)
+	eventoHandler := handler.NewEventoHandler(casos.eventoUsecase)
+	notificacaoHandler := handler.NewNotificacaoHandler(casos.preferenciaNotificacaoUsecase, casos.centralNotificacoesUsecase, casos.logUsecase)
+	webhookHandler := handler.NewWebhookHandler(casos.webhookUsecase, casos.logUsecase)
+	papelHandler := handler.NewPapelHandler(casos.papelUsecase, casos.logUsecase)
+	sessaoHandler := handler.NewSessaoHandler(casos.sessaoUsecase, casos.logUsecase)
 
 	// Rotas públicas
 	publico := http.NewServeMux()
@@ -102,26 +346,299 @@ func InicializarRoteadorHTTP(cfg config.Config, db *sql.DB) http.Handler {
 	// Rotas protegidas
 	muxProtegido := http.NewServeMux()
 	muxProtegido.HandleFunc("/eu", AuthHandler.Me)
-	UsuarioRegistrarRotas(muxProtegido, usuarioHandler, gerenteJWT, usuarioUsecase)
-	ChamadoRegistrarRotas(muxProtegido, chamadoHandler, gerenteJWT, usuarioUsecase)
-	CategoriaRegistrarRotas(muxProtegido, categoriaHandler, gerenteJWT, usuarioUsecase)
-	SubcategoriaRegistrarRotas(muxProtegido, subcategoriaHandler, gerenteJWT, usuarioUsecase)
-	LogRegistrarRotas(muxProtegido, logHandler, gerenteJWT, usuarioUsecase)
-	AcompanhamentoRegistrarRotas(muxProtegido, acompanhamentoHandler, gerenteJWT, usuarioUsecase)
-	AtendimentoRegistrarRotas(muxProtegido, atendimentoHandler, gerenteJWT, usuarioUsecase)
-	CategoriaPermissaoRegistrarRotas(muxProtegido, categoriaPermissaoHandler, gerenteJWT, usuarioUsecase)
+	muxProtegido.HandleFunc("/logout", AuthHandler.Logout)
+	muxProtegido.HandleFunc("/logout/todas-sessoes", AuthHandler.LogoutTodasSessoes)
+	UsuarioRegistrarRotas(muxProtegido, usuarioHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	ChamadoRegistrarRotas(muxProtegido, chamadoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	CategoriaRegistrarRotas(muxProtegido, categoriaHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	SubcategoriaRegistrarRotas(muxProtegido, subcategoriaHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	LogRegistrarRotas(muxProtegido, logHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	AcompanhamentoRegistrarRotas(muxProtegido, acompanhamentoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	AnexoRegistrarRotas(muxProtegido, anexoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	AtendimentoRegistrarRotas(muxProtegido, atendimentoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	ApontamentoRegistrarRotas(muxProtegido, apontamentoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	AvaliacaoRegistrarRotas(muxProtegido, avaliacaoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	ObservadorRegistrarRotas(muxProtegido, observadorHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	CategoriaPermissaoRegistrarRotas(muxProtegido, categoriaPermissaoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	PoliticaSLARegistrarRotas(muxProtegido, politicaSLAHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	CalendarioRegistrarRotas(muxProtegido, calendarioHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	MatrizPrioridadeRegistrarRotas(muxProtegido, matrizPrioridadeHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	AtribuicaoRegistrarRotas(muxProtegido, atribuicaoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	AutorizacaoRegistrarRotas(muxProtegido, autorizacaoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	EventoRegistrarRotas(muxProtegido, eventoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	NotificacaoRegistrarRotas(muxProtegido, notificacaoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	WebhookRegistrarRotas(muxProtegido, webhookHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	PapelRegistrarRotas(muxProtegido, papelHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+	SessaoRegistrarRotas(muxProtegido, sessaoHandler, gerenteJWT, casos.usuarioUsecase, casos.papelUsecase)
+
+	// As requisições de escrita das rotas protegidas são executadas em uma única transação
+	protegido := middleware.Transacional(casos.unidadeTrabalho)(muxProtegido)
 
 	// Roteador principal com CORS
-	rotas := CriarRoteadorAutenticacao(publico, muxProtegido, gerenteJWT, usuarioUsecase)
+	rotas := CriarRoteadorAutenticacao(publico, protegido, gerenteJWT, casos.usuarioUsecase, casos.revogacaoTokenUsecase)
 	rotas = middleware.CORS(cfg.CORSOrigin)(rotas)
 	rotas = middleware.RecuperarDePanico(rotas)
 
 	log.Println("CORS liberado para:", cfg.CORSOrigin)
-	return rotas
+	return rotas, nil
+}
+
+// InicializarJobs configura e retorna o executor das rotinas automáticas da aplicação
+func InicializarJobs(cfg config.Config, casos *CasosDeUso) (*job.Executor, error) {
+	diasUteisFechamento, err := strconv.Atoi(cfg.FechamentoDiasUteis)
+	if err != nil || diasUteisFechamento <= 0 {
+		return nil, fmt.Errorf("[router.InicializarJobs]: FECHAMENTO_DIAS_UTEIS inválido: %q", cfg.FechamentoDiasUteis)
+	}
+
+	diasArquivamento, err := strconv.Atoi(cfg.ArquivamentoDias)
+	if err != nil || diasArquivamento <= 0 {
+		return nil, fmt.Errorf("[router.InicializarJobs]: ARQUIVAMENTO_DIAS inválido: %q", cfg.ArquivamentoDias)
+	}
+
+	diasNotificacoes, err := strconv.Atoi(cfg.NotificacoesDias)
+	if err != nil || diasNotificacoes <= 0 {
+		return nil, fmt.Errorf("[router.InicializarJobs]: NOTIFICACOES_DIAS inválido: %q", cfg.NotificacoesDias)
+	}
+
+	antecedenciaAvisoSLA := converterDuracao(cfg.SLAAvisoAntecedencia)
+	if antecedenciaAvisoSLA <= 0 {
+		return nil, fmt.Errorf("[router.InicializarJobs]: SLA_AVISO_ANTECEDENCIA inválido: %q", cfg.SLAAvisoAntecedencia)
+	}
+
+	executor, err := job.NewExecutor(
+		converterDuracao(cfg.JobIntervalo),
+		cfg.UsuarioSistemaID,
+		job.NewFechamentoAutomaticoJob(casos.chamadoUsecase, diasUteisFechamento),
+		job.NewArquivamentoAutomaticoJob(casos.chamadoUsecase, diasArquivamento),
+		job.NewAvisoSLAJob(casos.chamadoUsecase, antecedenciaAvisoSLA),
+		job.NewViolacaoSLAJob(casos.chamadoUsecase),
+		job.NewExpurgoNotificacoesJob(casos.centralNotificacoesUsecase, diasNotificacoes),
+		job.NewExpurgoTokensRevogadosJob(casos.revogacaoTokenUsecase),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[router.InicializarJobs]: %w", err)
+	}
+
+	return executor, nil
+}
+
+// Notificacoes reúne as notificações dos eventos despachados da outbox e o envio de e-mails
+// em segundo plano
+type Notificacoes struct {
+	entregador *email.Entregador // nil quando o envio de e-mails está desativado
+	usecase    *uc.NotificacaoUsecase
+}
+
+// Iniciar envia os e-mails das notificações até que o contexto seja cancelado. Bloqueia a
+// goroutine chamadora.
+func (n *Notificacoes) Iniciar(ctx context.Context) {
+	if n.entregador != nil {
+		n.entregador.Iniciar(ctx)
+	}
+}
+
+// InicializarNotificacoes configura e retorna as notificações da aplicação. Quando nenhum
+// servidor SMTP está configurado, as notificações são incluídas apenas na central de notificações.
+func InicializarNotificacoes(cfg config.Config, db *sql.DB) (*Notificacoes, error) {
+	entregador, err := inicializarEntregadorEmail(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("[router.InicializarNotificacoes]: %w", err)
+	}
+
+	var entrega dominio.EntregaNotificacao
+	if entregador != nil {
+		entrega = entregador
+	}
+
+	// Injeção de dependências do caso de uso de notificações
+	notificacaoUsecase := uc.NewNotificacaoUsecase(
+		repository.NewMySQLChamadoRepository(db),
+		repository.NewMySQLUsuarioRepository(db),
+		repository.NewMySQLAtendimentoRepository(db),
+		repository.NewMySQLPreferenciaNotificacaoRepository(db),
+		repository.NewMySQLNotificacaoUsuarioRepository(db),
+		entrega,
+	)
+
+	return &Notificacoes{entregador: entregador, usecase: notificacaoUsecase}, nil
+}
+
+// inicializarEntregadorEmail configura e retorna o envio das notificações por e-mail.
+// Retorna nil quando nenhum servidor SMTP está configurado.
+func inicializarEntregadorEmail(cfg config.Config) (*email.Entregador, error) {
+	if cfg.SMTPHost == "" {
+		log.Println("[router.InicializarNotificacoes] SMTP_HOST não definido, envio de e-mails desativado")
+		return nil, nil
+	}
+
+	tentativas, err := strconv.Atoi(cfg.SMTPTentativas)
+	if err != nil || tentativas <= 0 {
+		return nil, fmt.Errorf("[router.inicializarEntregadorEmail]: SMTP_TENTATIVAS inválido: %q", cfg.SMTPTentativas)
+	}
+
+	capacidadeFila, err := strconv.Atoi(cfg.EmailFila)
+	if err != nil || capacidadeFila <= 0 {
+		return nil, fmt.Errorf("[router.inicializarEntregadorEmail]: EMAIL_FILA inválido: %q", cfg.EmailFila)
+	}
+
+	modelos, err := email.NewModelos(cfg.FrontendURL, cfg.EmailResponderPara != "")
+	if err != nil {
+		return nil, fmt.Errorf("[router.inicializarEntregadorEmail]: %w", err)
+	}
+
+	enviador, err := email.NewEnviadorSMTP(email.ConfigSMTP{
+		Host:          cfg.SMTPHost,
+		Porta:         cfg.SMTPPorta,
+		Usuario:       cfg.SMTPUsuario,
+		Senha:         cfg.SMTPSenha,
+		Remetente:     cfg.SMTPRemetente,
+		ResponderPara: cfg.EmailResponderPara,
+		Tentativas:    tentativas,
+		Espera:        converterDuracao(cfg.SMTPEspera),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[router.inicializarEntregadorEmail]: %w", err)
+	}
+
+	return email.NewEntregador(modelos, enviador, capacidadeFila), nil
+}
+
+// InicializarEntradaEmail configura e retorna o executor da leitura da caixa de entrada de
+// e-mails, que abre e responde chamados. Retorna nil quando nenhuma caixa de entrada está configurada.
+func InicializarEntradaEmail(cfg config.Config, db *sql.DB, casos *CasosDeUso) (*job.Executor, error) {
+	if cfg.EmailEntradaMaildir == "" {
+		log.Println("[router.InicializarEntradaEmail] EMAIL_ENTRADA_MAILDIR não definido, recebimento de e-mails desativado")
+		return nil, nil
+	}
+	if cfg.EmailCategoriaID == "" || cfg.EmailSubcategoriaID == "" {
+		return nil, fmt.Errorf("[router.InicializarEntradaEmail]: defina EMAIL_CATEGORIA_ID e EMAIL_SUBCATEGORIA_ID para os chamados abertos por e-mail")
+	}
+
+	caixa, err := email.NewCaixaMaildir(cfg.EmailEntradaMaildir)
+	if err != nil {
+		return nil, fmt.Errorf("[router.InicializarEntradaEmail]: %w", err)
+	}
+
+	// Remetentes ainda sem cadastro são buscados no LDAP, quando configurado
+	var diretorio usecase.AuthExternoUsecase
+	if cfg.LDAPServer != "" {
+		diretorio = ldap.NewClienteLDAP(
+			cfg.LDAPServer,
+			cfg.LDAPDomain,
+			cfg.LDAPBase,
+			cfg.LDAPUser,
+			cfg.LDAPPass,
+			cfg.LDAPLoginAttr,
+		)
+	}
+
+	entradaEmailUsecase := uc.NewEntradaEmailUsecase(
+		repository.NewMySQLEmailRecebidoRepository(db),
+		caixa,
+		casos.usuarioRepository,
+		casos.unidadeTrabalho,
+		casos.chamadoRepository,
+		casos.chamadoUsecase,
+		casos.acompanhamentoUsecase,
+		casos.anexoUsecase,
+		casos.logUsecase,
+		diretorio,
+		cfg.EmailCategoriaID,
+		cfg.EmailSubcategoriaID,
+	)
+
+	executor, err := job.NewExecutor(
+		converterDuracao(cfg.EmailEntradaIntervalo),
+		cfg.UsuarioSistemaID,
+		job.NewEntradaEmailJob(entradaEmailUsecase),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[router.InicializarEntradaEmail]: %w", err)
+	}
+
+	return executor, nil
+}
+
+// Webhooks reúne o enfileiramento dos eventos despachados da outbox para os webhooks e o
+// envio das entregas pendentes em segundo plano
+type Webhooks struct {
+	executor *job.Executor
+	usecase  *uc.DisparoWebhookUsecase
+}
+
+// Iniciar envia as entregas até que o contexto seja cancelado. Bloqueia a goroutine chamadora.
+func (w *Webhooks) Iniciar(ctx context.Context) {
+	w.executor.Iniciar(ctx)
+}
+
+// InicializarWebhooks configura e retorna o envio dos eventos aos webhooks cadastrados
+func InicializarWebhooks(cfg config.Config, db *sql.DB) (*Webhooks, error) {
+	tentativas, err := strconv.Atoi(cfg.WebhookTentativas)
+	if err != nil || tentativas <= 0 {
+		return nil, fmt.Errorf("[router.InicializarWebhooks]: WEBHOOK_TENTATIVAS inválido: %q", cfg.WebhookTentativas)
+	}
+
+	espera := converterDuracao(cfg.WebhookEspera)
+	if espera <= 0 {
+		return nil, fmt.Errorf("[router.InicializarWebhooks]: WEBHOOK_ESPERA inválido: %q", cfg.WebhookEspera)
+	}
+
+	timeout := converterDuracao(cfg.WebhookTimeout)
+	if timeout <= 0 {
+		return nil, fmt.Errorf("[router.InicializarWebhooks]: WEBHOOK_TIMEOUT inválido: %q", cfg.WebhookTimeout)
+	}
+
+	// Injeção de dependências do caso de uso de disparo dos webhooks
+	disparoWebhookUsecase := uc.NewDisparoWebhookUsecase(
+		repository.NewMySQLWebhookRepository(db),
+		repository.NewMySQLEntregaWebhookRepository(db),
+		repository.NewMySQLChamadoRepository(db),
+		webhook.NewEnviadorHTTP(timeout),
+		tentativas,
+		espera,
+		timeout,
+	)
+
+	executor, err := job.NewExecutor(
+		converterDuracao(cfg.WebhookIntervalo),
+		cfg.UsuarioSistemaID,
+		job.NewEntregaWebhookJob(disparoWebhookUsecase),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[router.InicializarWebhooks]: %w", err)
+	}
+
+	return &Webhooks{executor: executor, usecase: disparoWebhookUsecase}, nil
+}
+
+// InicializarOutbox configura e retorna o despacho dos logs e eventos gravados na outbox. Os
+// eventos são entregues aos webhooks e às notificações antes de publicados no barramento.
+func InicializarOutbox(cfg config.Config, db *sql.DB, barramento *eventos.BarramentoMemoria, webhooks *Webhooks, notificacoes *Notificacoes) (*job.Executor, error) {
+	// Injeção de dependências do caso de uso de despacho da outbox. As notificações são as
+	// últimas, pois o e-mail enfileirado não é desfeito se a transação falhar
+	despachoOutboxUsecase := uc.NewDespachoOutboxUsecase(
+		repository.NewMySQLOutboxRepository(db),
+		repository.NewMySQLLogRepository(db),
+		barramento,
+		repository.NewMySQLUnidadeDeTrabalho(db),
+		webhooks.usecase,
+		notificacoes.usecase,
+	)
+
+	executor, err := job.NewExecutor(
+		converterDuracao(cfg.OutboxIntervalo),
+		cfg.UsuarioSistemaID,
+		job.NewDespachoOutboxJob(despachoOutboxUsecase),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[router.InicializarOutbox]: %w", err)
+	}
+
+	return executor, nil
 }
 
 // CriarRoteadorAutenticacao cria um roteador que diferencia rotas públicas de protegidas com autenticação
-func CriarRoteadorAutenticacao(publico, protegido http.Handler, gerenteJWT jwt.JWTUsecase, usrUsecase *uc.UsuarioUsecase) http.Handler {
+func CriarRoteadorAutenticacao(publico, protegido http.Handler, gerenteJWT jwt.JWTUsecase, usrUsecase *uc.UsuarioUsecase, revogacoes usecase.VerificarRevogacaoToken) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		log.Printf("%s %s", r.Method, r.URL.Path)
 
@@ -133,8 +650,8 @@ func CriarRoteadorAutenticacao(publico, protegido http.Handler, gerenteJWT jwt.J
 			}
 		}
 
-		// Rotas protegidas com middleware de autenticação
-		protegidoAuth := mid.AutenticarUsuario(protegido, gerenteJWT, usrUsecase)
+		// Rotas protegidas com middleware de autenticação, que recusa os tokens revogados
+		protegidoAuth := mid.AutenticarUsuario(mid.RejeitarTokenRevogado(protegido, revogacoes), gerenteJWT, usrUsecase)
 		protegidoAuth.ServeHTTP(w, r)
 	})
 }
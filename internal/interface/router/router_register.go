@@ -8,6 +8,7 @@ import (
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/jwt"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/middleware"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/handler"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
@@ -58,147 +59,385 @@ func AuthRegistrarRotas(mux *http.ServeMux, authH *handler.AuthHandler) {
 }
 
 // UsuarioRegistrarRotas registra as rotas de usuário
-func UsuarioRegistrarRotas(mux *http.ServeMux, usrH *handler.UsuarioHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase) {
+func UsuarioRegistrarRotas(mux *http.ServeMux, usrH *handler.UsuarioHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
 	// helper para aplicar autenticação + permissões
-	aplicarPermissoes := func(handler http.HandlerFunc, perms ...string) http.Handler {
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
 		return middleware.AutenticarUsuario(
-			middleware.RequerPermissoes(perms...)(handler),
+			middleware.RequerPermissao(permissao, papeis)(handler),
 			jwtManager, svc,
 		)
 	}
 
-	mux.Handle("/usuarios/criar", aplicarPermissoes(usrH.Criar, "ADM"))
-	mux.Handle("/usuarios/buscar-tudo", aplicarPermissoes(usrH.BuscarTudo, "ADM"))
-	mux.Handle("/usuarios/buscar-por-id/", aplicarPermissoes(usrH.BuscarPorID, "ADM"))
-	mux.Handle("/usuarios/atualizar/", aplicarPermissoes(usrH.Atualizar, "ADM"))
-	mux.Handle("/usuarios/atualizar-permissao/", aplicarPermissoes(usrH.AtualizarPermissao, "ADM"))
-	mux.Handle("/usuarios/lista-completa", aplicarPermissoes(usrH.ListaCompleta, "ADM"))
-	mux.Handle("/usuarios/buscar-tecnicos", aplicarPermissoes(usrH.BuscarTecnicos, "ADM"))
-	mux.Handle("/usuarios/desativar/", aplicarPermissoes(usrH.Desativar, "ADM"))
-	mux.Handle("/usuarios/autorizar/", aplicarPermissoes(usrH.Autorizar, "ADM"))
-	mux.Handle("/usuarios/buscar-novo/", aplicarPermissoes(usrH.BuscarNovo, "ADM"))
-	mux.Handle("/usuarios/ativar/", aplicarPermissoes(usrH.Ativar, "ADM"))
+	mux.Handle("/usuarios/criar", aplicarPermissoes(usrH.Criar, model.PermissaoUsuarioCriar))
+	mux.Handle("/usuarios/buscar-tudo", aplicarPermissoes(usrH.BuscarTudo, model.PermissaoUsuarioLer))
+	mux.Handle("/usuarios/buscar-por-id/", aplicarPermissoes(usrH.BuscarPorID, model.PermissaoUsuarioLer))
+	mux.Handle("/usuarios/atualizar/", aplicarPermissoes(usrH.Atualizar, model.PermissaoUsuarioEditar))
+	mux.Handle("/usuarios/atualizar-permissao/", aplicarPermissoes(usrH.AtualizarPermissao, model.PermissaoUsuarioEditarPermissao))
+	mux.Handle("/usuarios/lista-completa", aplicarPermissoes(usrH.ListaCompleta, model.PermissaoUsuarioLer))
+	mux.Handle("/usuarios/buscar-tecnicos", aplicarPermissoes(usrH.BuscarTecnicos, model.PermissaoUsuarioLer))
+	mux.Handle("/usuarios/desativar/", aplicarPermissoes(usrH.Desativar, model.PermissaoUsuarioAtivar))
+	mux.Handle("/usuarios/autorizar/", aplicarPermissoes(usrH.Autorizar, model.PermissaoUsuarioAtivar))
+	mux.Handle("/usuarios/buscar-novo/", aplicarPermissoes(usrH.BuscarNovo, model.PermissaoUsuarioCriar))
+	mux.Handle("/usuarios/ativar/", aplicarPermissoes(usrH.Ativar, model.PermissaoUsuarioAtivar))
 	mux.HandleFunc("/usuarios/valida-usuario", usrH.ValidaUsuario) // não precisa de permissão ADM
 }
 
 // ChamadoRegistrarRotas registra as rotas de chamado
-func ChamadoRegistrarRotas(mux *http.ServeMux, chmH *handler.ChamadoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase) {
+func ChamadoRegistrarRotas(mux *http.ServeMux, chmH *handler.ChamadoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
 	// helper para aplicar autenticação + permissões
-	aplicarPermissoes := func(handler http.HandlerFunc, perms ...string) http.Handler {
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
 		return middleware.AutenticarUsuario(
-			middleware.RequerPermissoes(perms...)(handler),
+			middleware.RequerPermissao(permissao, papeis)(handler),
 			jwtManager, svc,
 		)
 	}
 
-	mux.Handle("/chamados/criar", aplicarPermissoes(chmH.Criar, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/chamados/atualizar/", aplicarPermissoes(chmH.Atualizar, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/chamados/buscar-por-id/", aplicarPermissoes(chmH.BuscarPorID, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/chamados/buscar-tudo", aplicarPermissoes(chmH.BuscarTudo, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/chamados/lista-completa", aplicarPermissoes(chmH.ListaCompleta, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/chamados/atualizar-status/", aplicarPermissoes(chmH.AtualizarStatus, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/chamados/arquivar/", aplicarPermissoes(chmH.Arquivar, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/chamados/desarquivar/", aplicarPermissoes(chmH.Desarquivar, "ADM", "TEC", "USR", "DEV"))
+	mux.Handle("/chamados/criar", aplicarPermissoes(chmH.Criar, model.PermissaoChamadoCriar))
+	mux.Handle("/chamados/atualizar/", aplicarPermissoes(chmH.Atualizar, model.PermissaoChamadoEditar))
+	mux.Handle("/chamados/buscar-por-id/", aplicarPermissoes(chmH.BuscarPorID, model.PermissaoChamadoLer))
+	mux.Handle("/chamados/buscar-tudo", aplicarPermissoes(chmH.BuscarTudo, model.PermissaoChamadoLer))
+	mux.Handle("/chamados/lista-completa", aplicarPermissoes(chmH.ListaCompleta, model.PermissaoChamadoLer))
+	mux.Handle("/chamados/atualizar-status/", aplicarPermissoes(chmH.AtualizarStatus, model.PermissaoChamadoAlterarStatus))
+	mux.Handle("/chamados/atualizar-prioridade/", aplicarPermissoes(chmH.AtualizarPrioridade, model.PermissaoChamadoAlterarPrioridade))
+	mux.Handle("/chamados/reabrir/", aplicarPermissoes(chmH.Reabrir, model.PermissaoChamadoReabrir))
+	mux.Handle("/chamados/reaberturas", aplicarPermissoes(chmH.Reaberturas, model.PermissaoChamadoLerReaberturas))
+	mux.Handle("/chamados/arquivar/", aplicarPermissoes(chmH.Arquivar, model.PermissaoChamadoArquivar))
+	mux.Handle("/chamados/desarquivar/", aplicarPermissoes(chmH.Desarquivar, model.PermissaoChamadoArquivar))
 }
 
 // CategoriaRegistrarRotas registra as rotas de categoria
-func CategoriaRegistrarRotas(mux *http.ServeMux, catH *handler.CategoriaHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase) {
+func CategoriaRegistrarRotas(mux *http.ServeMux, catH *handler.CategoriaHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
 	// helper para aplicar autenticação + permissões
-	aplicarPermissoes := func(handler http.HandlerFunc, perms ...string) http.Handler {
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
 		return middleware.AutenticarUsuario(
-			middleware.RequerPermissoes(perms...)(handler),
+			middleware.RequerPermissao(permissao, papeis)(handler),
 			jwtManager, svc,
 		)
 	}
 
-	mux.Handle("/categorias/criar", aplicarPermissoes(catH.Criar, "ADM"))
-	mux.Handle("/categorias/atualizar/", aplicarPermissoes(catH.Atualizar, "ADM"))
-	mux.Handle("/categorias/buscar-por-id/", aplicarPermissoes(catH.BuscarPorID, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/categorias/buscar-tudo", aplicarPermissoes(catH.BuscarTudo, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/categorias/lista-completa", aplicarPermissoes(catH.ListaCompleta, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/categorias/desativar/", aplicarPermissoes(catH.Desativar, "ADM"))
-	mux.Handle("/categorias/ativar/", aplicarPermissoes(catH.Ativar, "ADM"))
+	mux.Handle("/categorias/criar", aplicarPermissoes(catH.Criar, model.PermissaoCategoriaCriar))
+	mux.Handle("/categorias/atualizar/", aplicarPermissoes(catH.Atualizar, model.PermissaoCategoriaEditar))
+	mux.Handle("/categorias/buscar-por-id/", aplicarPermissoes(catH.BuscarPorID, model.PermissaoCategoriaLer))
+	mux.Handle("/categorias/buscar-tudo", aplicarPermissoes(catH.BuscarTudo, model.PermissaoCategoriaLer))
+	mux.Handle("/categorias/lista-completa", aplicarPermissoes(catH.ListaCompleta, model.PermissaoCategoriaLer))
+	mux.Handle("/categorias/desativar/", aplicarPermissoes(catH.Desativar, model.PermissaoCategoriaAtivar))
+	mux.Handle("/categorias/ativar/", aplicarPermissoes(catH.Ativar, model.PermissaoCategoriaAtivar))
 }
 
 // SubcategoriaRegistrarRotas registra as rotas de subcategoria
-func SubcategoriaRegistrarRotas(mux *http.ServeMux, subcatH *handler.SubcategoriaHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase) {
+func SubcategoriaRegistrarRotas(mux *http.ServeMux, subcatH *handler.SubcategoriaHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
 	// helper para aplicar autenticação + permissões
-	aplicarPermissoes := func(handler http.HandlerFunc, perms ...string) http.Handler {
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
 		return middleware.AutenticarUsuario(
-			middleware.RequerPermissoes(perms...)(handler),
+			middleware.RequerPermissao(permissao, papeis)(handler),
 			jwtManager, svc,
 		)
 	}
 
-	mux.Handle("/subcategorias/criar", aplicarPermissoes(subcatH.Criar, "ADM"))
-	mux.Handle("/subcategorias/atualizar/", aplicarPermissoes(subcatH.Atualizar, "ADM"))
-	mux.Handle("/subcategorias/buscar-por-id/", aplicarPermissoes(subcatH.BuscarPorID, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/subcategorias/buscar-tudo", aplicarPermissoes(subcatH.BuscarTudo, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/subcategorias/lista-completa", aplicarPermissoes(subcatH.ListaCompleta, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/subcategorias/desativar/", aplicarPermissoes(subcatH.Desativar, "ADM"))
-	mux.Handle("/subcategorias/ativar/", aplicarPermissoes(subcatH.Ativar, "ADM"))
+	mux.Handle("/subcategorias/criar", aplicarPermissoes(subcatH.Criar, model.PermissaoSubcategoriaCriar))
+	mux.Handle("/subcategorias/atualizar/", aplicarPermissoes(subcatH.Atualizar, model.PermissaoSubcategoriaEditar))
+	mux.Handle("/subcategorias/buscar-por-id/", aplicarPermissoes(subcatH.BuscarPorID, model.PermissaoSubcategoriaLer))
+	mux.Handle("/subcategorias/buscar-tudo", aplicarPermissoes(subcatH.BuscarTudo, model.PermissaoSubcategoriaLer))
+	mux.Handle("/subcategorias/lista-completa", aplicarPermissoes(subcatH.ListaCompleta, model.PermissaoSubcategoriaLer))
+	mux.Handle("/subcategorias/desativar/", aplicarPermissoes(subcatH.Desativar, model.PermissaoSubcategoriaAtivar))
+	mux.Handle("/subcategorias/ativar/", aplicarPermissoes(subcatH.Ativar, model.PermissaoSubcategoriaAtivar))
 }
 
 // LogRegistrarRotas registra as rotas de log
-func LogRegistrarRotas(mux *http.ServeMux, logH *handler.LogHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase) {
+func LogRegistrarRotas(mux *http.ServeMux, logH *handler.LogHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
 	// helper para aplicar autenticação + permissões
-	aplicarPermissoes := func(handler http.HandlerFunc, perms ...string) http.Handler {
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
 		return middleware.AutenticarUsuario(
-			middleware.RequerPermissoes(perms...)(handler),
+			middleware.RequerPermissao(permissao, papeis)(handler),
 			jwtManager, svc,
 		)
 	}
 
-	mux.Handle("/logs/buscar-por-id/", aplicarPermissoes(logH.BuscarPorID, "ADM", "TEC"))
-	mux.Handle("/logs/buscar-tudo", aplicarPermissoes(logH.BuscarTudo, "ADM", "TEC"))
+	mux.Handle("/logs/buscar-por-id/", aplicarPermissoes(logH.BuscarPorID, model.PermissaoLogLer))
+	mux.Handle("/logs/buscar-tudo", aplicarPermissoes(logH.BuscarTudo, model.PermissaoLogLer))
 }
 
 // AcompanhamentoRegistrarRotas registra as rotas de acompanhamento
-func AcompanhamentoRegistrarRotas(mux *http.ServeMux, acmH *handler.AcompanhamentoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase) {
+func AcompanhamentoRegistrarRotas(mux *http.ServeMux, acmH *handler.AcompanhamentoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
 	// helper para aplicar autenticação + permissões
-	aplicarPermissoes := func(handler http.HandlerFunc, perms ...string) http.Handler {
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
 		return middleware.AutenticarUsuario(
-			middleware.RequerPermissoes(perms...)(handler),
+			middleware.RequerPermissao(permissao, papeis)(handler),
 			jwtManager, svc,
 		)
 	}
 
-	mux.Handle("/acompanhamentos/criar", aplicarPermissoes(acmH.Criar, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/acompanhamentos/buscar-por-id/", aplicarPermissoes(acmH.BuscarPorID, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/acompanhamentos/buscar-tudo", aplicarPermissoes(acmH.BuscarTudo, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/acompanhamentos/atualizar/", aplicarPermissoes(acmH.Atualizar, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/acompanhamentos/deletar/", aplicarPermissoes(acmH.Deletar, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/acompanhamentos/buscar-por-chamado-id/", aplicarPermissoes(acmH.BuscarPorChamadoID, "ADM", "TEC", "USR", "DEV"))
+	mux.Handle("/acompanhamentos/criar", aplicarPermissoes(acmH.Criar, model.PermissaoAcompanhamentoCriar))
+	mux.Handle("/acompanhamentos/buscar-por-id/", aplicarPermissoes(acmH.BuscarPorID, model.PermissaoAcompanhamentoLer))
+	mux.Handle("/acompanhamentos/buscar-tudo", aplicarPermissoes(acmH.BuscarTudo, model.PermissaoAcompanhamentoLer))
+	mux.Handle("/acompanhamentos/atualizar/", aplicarPermissoes(acmH.Atualizar, model.PermissaoAcompanhamentoEditar))
+	mux.Handle("/acompanhamentos/deletar/", aplicarPermissoes(acmH.Deletar, model.PermissaoAcompanhamentoDeletar))
+	mux.Handle("/acompanhamentos/buscar-por-chamado-id/", aplicarPermissoes(acmH.BuscarPorChamadoID, model.PermissaoAcompanhamentoLer))
+	mux.Handle("/acompanhamentos/revisoes/", aplicarPermissoes(acmH.Revisoes, model.PermissaoAcompanhamentoLerRevisao))
+}
+
+// AnexoRegistrarRotas registra as rotas de anexos
+func AnexoRegistrarRotas(mux *http.ServeMux, anxH *handler.AnexoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/anexos/enviar/", aplicarPermissoes(anxH.Enviar, model.PermissaoAnexoEnviar))
+	mux.Handle("/anexos/baixar/", aplicarPermissoes(anxH.Baixar, model.PermissaoAnexoLer))
+	mux.Handle("/anexos/buscar-por-chamado/", aplicarPermissoes(anxH.BuscarPorChamado, model.PermissaoAnexoLer))
+	mux.Handle("/anexos/deletar/", aplicarPermissoes(anxH.Deletar, model.PermissaoAnexoDeletar))
+}
+
+// EventoRegistrarRotas registra as rotas de eventos em tempo real
+func EventoRegistrarRotas(mux *http.ServeMux, evtH *handler.EventoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/eventos/assinar", aplicarPermissoes(evtH.Assinar, model.PermissaoEventoAssinar))
+}
+
+// NotificacaoRegistrarRotas registra as rotas da central e das preferências de notificação
+func NotificacaoRegistrarRotas(mux *http.ServeMux, ntfH *handler.NotificacaoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/notificacoes/preferencias", aplicarPermissoes(ntfH.BuscarPreferencias, model.PermissaoNotificacaoLer))
+	mux.Handle("/notificacoes/preferencias/atualizar", aplicarPermissoes(ntfH.AtualizarPreferencias, model.PermissaoNotificacaoEditar))
+	mux.Handle("/notificacoes/buscar-tudo", aplicarPermissoes(ntfH.BuscarTudo, model.PermissaoNotificacaoLer))
+	mux.Handle("/notificacoes/nao-lidas", aplicarPermissoes(ntfH.ContarNaoLidas, model.PermissaoNotificacaoLer))
+	mux.Handle("/notificacoes/marcar-lida/", aplicarPermissoes(ntfH.MarcarLida, model.PermissaoNotificacaoEditar))
+	mux.Handle("/notificacoes/marcar-todas-lidas", aplicarPermissoes(ntfH.MarcarTodasLidas, model.PermissaoNotificacaoEditar))
 }
 
 // AtendimentoRegistrarRotas registra as rotas de atendimento
-func AtendimentoRegistrarRotas(mux *http.ServeMux, atdH *handler.AtendimentoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase) {
+func AtendimentoRegistrarRotas(mux *http.ServeMux, atdH *handler.AtendimentoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/atendimentos/criar", aplicarPermissoes(atdH.Criar, model.PermissaoAtendimentoCriar))
+	mux.Handle("/atendimentos/buscar-por-id/", aplicarPermissoes(atdH.BuscarPorID, model.PermissaoAtendimentoLer))
+	mux.Handle("/atendimentos/buscar-tudo", aplicarPermissoes(atdH.BuscarTudo, model.PermissaoAtendimentoLer))
+	mux.Handle("/atendimentos/transferir/", aplicarPermissoes(atdH.Transferir, model.PermissaoAtendimentoTransferir))
+	mux.Handle("/atendimentos/devolver/", aplicarPermissoes(atdH.Devolver, model.PermissaoAtendimentoTransferir))
+}
+
+// ApontamentoRegistrarRotas registra as rotas de apontamento de horas
+func ApontamentoRegistrarRotas(mux *http.ServeMux, aptH *handler.ApontamentoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/apontamentos/criar", aplicarPermissoes(aptH.Criar, model.PermissaoApontamentoCriar))
+	mux.Handle("/apontamentos/buscar-por-id/", aplicarPermissoes(aptH.BuscarPorID, model.PermissaoApontamentoLer))
+	mux.Handle("/apontamentos/buscar-tudo", aplicarPermissoes(aptH.BuscarTudo, model.PermissaoApontamentoLer))
+	mux.Handle("/apontamentos/atualizar/", aplicarPermissoes(aptH.Atualizar, model.PermissaoApontamentoEditar))
+	mux.Handle("/apontamentos/deletar/", aplicarPermissoes(aptH.Deletar, model.PermissaoApontamentoDeletar))
+	mux.Handle("/apontamentos/iniciar", aplicarPermissoes(aptH.Iniciar, model.PermissaoApontamentoCriar))
+	mux.Handle("/apontamentos/parar", aplicarPermissoes(aptH.Parar, model.PermissaoApontamentoCriar))
+	mux.Handle("/apontamentos/em-andamento", aplicarPermissoes(aptH.EmAndamento, model.PermissaoApontamentoLer))
+	mux.Handle("/apontamentos/totais", aplicarPermissoes(aptH.Totais, model.PermissaoApontamentoLer))
+}
+
+// AvaliacaoRegistrarRotas registra as rotas da pesquisa de satisfação
+func AvaliacaoRegistrarRotas(mux *http.ServeMux, avH *handler.AvaliacaoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/avaliacoes/responder/", aplicarPermissoes(avH.Responder, model.PermissaoAvaliacaoResponder))
+	mux.Handle("/avaliacoes/buscar-por-chamado/", aplicarPermissoes(avH.BuscarPorChamado, model.PermissaoAvaliacaoLer))
+	mux.Handle("/avaliacoes/csat", aplicarPermissoes(avH.CSAT, model.PermissaoAvaliacaoLerCSAT))
+}
+
+// ObservadorRegistrarRotas registra as rotas dos observadores dos chamados
+func ObservadorRegistrarRotas(mux *http.ServeMux, obsH *handler.ObservadorHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
 	// helper para aplicar autenticação + permissões
-	aplicarPermissoes := func(handler http.HandlerFunc, perms ...string) http.Handler {
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
 		return middleware.AutenticarUsuario(
-			middleware.RequerPermissoes(perms...)(handler),
+			middleware.RequerPermissao(permissao, papeis)(handler),
 			jwtManager, svc,
 		)
 	}
 
-	mux.Handle("/atendimentos/criar", aplicarPermissoes(atdH.Criar, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/atendimentos/buscar-por-id/", aplicarPermissoes(atdH.BuscarPorID, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/atendimentos/buscar-tudo", aplicarPermissoes(atdH.BuscarTudo, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/atendimentos/atualizar/", aplicarPermissoes(atdH.Atualizar, "ADM", "TEC", "USR", "DEV"))
+	mux.Handle("/observadores/adicionar/", aplicarPermissoes(obsH.Adicionar, model.PermissaoChamadoObservar))
+	mux.Handle("/observadores/remover/", aplicarPermissoes(obsH.Remover, model.PermissaoChamadoObservar))
+	mux.Handle("/observadores/buscar-por-chamado/", aplicarPermissoes(obsH.BuscarPorChamado, model.PermissaoChamadoLer))
 }
 
 // CategoriaPermissaoRegistrarRotas registra as rotas de categoria-permissão
-func CategoriaPermissaoRegistrarRotas(mux *http.ServeMux, catPermH *handler.CategoriaPermissaoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase) {
+func CategoriaPermissaoRegistrarRotas(mux *http.ServeMux, catPermH *handler.CategoriaPermissaoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/categoria-permissoes/criar", aplicarPermissoes(catPermH.Criar, model.PermissaoCategoriaPermissaoEditar))
+	mux.Handle("/categoria-permissoes/atualizar/", aplicarPermissoes(catPermH.Atualizar, model.PermissaoCategoriaPermissaoEditar))
+	mux.Handle("/categoria-permissoes/buscar-tudo", aplicarPermissoes(catPermH.BuscarTudo, model.PermissaoCategoriaPermissaoLer))
+	mux.Handle("/categoria-permissoes/deletar/", aplicarPermissoes(catPermH.Deletar, model.PermissaoCategoriaPermissaoEditar))
+}
+// PoliticaSLARegistrarRotas registra as rotas de políticas de SLA
+func PoliticaSLARegistrarRotas(mux *http.ServeMux, politicaSLAH *handler.PoliticaSLAHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/politicas-sla/criar", aplicarPermissoes(politicaSLAH.Criar, model.PermissaoPoliticaSLAEditar))
+	mux.Handle("/politicas-sla/atualizar/", aplicarPermissoes(politicaSLAH.Atualizar, model.PermissaoPoliticaSLAEditar))
+	mux.Handle("/politicas-sla/ativar/", aplicarPermissoes(politicaSLAH.Ativar, model.PermissaoPoliticaSLAEditar))
+	mux.Handle("/politicas-sla/desativar/", aplicarPermissoes(politicaSLAH.Desativar, model.PermissaoPoliticaSLAEditar))
+	mux.Handle("/politicas-sla/buscar-por-id/", aplicarPermissoes(politicaSLAH.BuscarPorID, model.PermissaoPoliticaSLALer))
+	mux.Handle("/politicas-sla/buscar-tudo", aplicarPermissoes(politicaSLAH.BuscarTudo, model.PermissaoPoliticaSLALer))
+}
+
+// CalendarioRegistrarRotas registra as rotas de calendários de expediente
+func CalendarioRegistrarRotas(mux *http.ServeMux, calendarioH *handler.CalendarioHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/calendarios/criar", aplicarPermissoes(calendarioH.Criar, model.PermissaoCalendarioEditar))
+	mux.Handle("/calendarios/atualizar/", aplicarPermissoes(calendarioH.Atualizar, model.PermissaoCalendarioEditar))
+	mux.Handle("/calendarios/ativar/", aplicarPermissoes(calendarioH.Ativar, model.PermissaoCalendarioEditar))
+	mux.Handle("/calendarios/desativar/", aplicarPermissoes(calendarioH.Desativar, model.PermissaoCalendarioEditar))
+	mux.Handle("/calendarios/importar-feriados/", aplicarPermissoes(calendarioH.ImportarFeriados, model.PermissaoCalendarioEditar))
+	mux.Handle("/calendarios/remover-feriado/", aplicarPermissoes(calendarioH.RemoverFeriado, model.PermissaoCalendarioEditar))
+	mux.Handle("/calendarios/buscar-por-id/", aplicarPermissoes(calendarioH.BuscarPorID, model.PermissaoCalendarioLer))
+	mux.Handle("/calendarios/buscar-tudo", aplicarPermissoes(calendarioH.BuscarTudo, model.PermissaoCalendarioLer))
+	mux.Handle("/calendarios/tempo-util", aplicarPermissoes(calendarioH.TempoUtil, model.PermissaoCalendarioTempoUtil))
+}
+
+// MatrizPrioridadeRegistrarRotas registra as rotas da matriz de prioridade
+func MatrizPrioridadeRegistrarRotas(mux *http.ServeMux, matrizH *handler.MatrizPrioridadeHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/prioridades/matriz", aplicarPermissoes(matrizH.BuscarMatriz, model.PermissaoPrioridadeLer))
+	mux.Handle("/prioridades/matriz/atualizar", aplicarPermissoes(matrizH.AtualizarMatriz, model.PermissaoPrioridadeEditar))
+}
+
+// AtribuicaoRegistrarRotas registra as rotas da atribuição automática de chamados
+func AtribuicaoRegistrarRotas(mux *http.ServeMux, atrH *handler.AtribuicaoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/atribuicoes/previa/", aplicarPermissoes(atrH.Previa, model.PermissaoAtribuicaoPrevia))
+}
+
+// AutorizacaoRegistrarRotas registra as rotas das permissões efetivas por categoria
+func AutorizacaoRegistrarRotas(mux *http.ServeMux, autH *handler.AutorizacaoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/autorizacao/minhas-permissoes", aplicarPermissoes(autH.MinhasPermissoes, model.PermissaoAutorizacaoLer))
+}
+
+// WebhookRegistrarRotas registra as rotas de webhooks e das suas entregas
+func WebhookRegistrarRotas(mux *http.ServeMux, webhookH *handler.WebhookHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/webhooks/criar", aplicarPermissoes(webhookH.Criar, model.PermissaoWebhookEditar))
+	mux.Handle("/webhooks/atualizar/", aplicarPermissoes(webhookH.Atualizar, model.PermissaoWebhookEditar))
+	mux.Handle("/webhooks/ativar/", aplicarPermissoes(webhookH.Ativar, model.PermissaoWebhookEditar))
+	mux.Handle("/webhooks/desativar/", aplicarPermissoes(webhookH.Desativar, model.PermissaoWebhookEditar))
+	mux.Handle("/webhooks/buscar-por-id/", aplicarPermissoes(webhookH.BuscarPorID, model.PermissaoWebhookLer))
+	mux.Handle("/webhooks/buscar-tudo", aplicarPermissoes(webhookH.BuscarTudo, model.PermissaoWebhookLer))
+	mux.Handle("/webhooks/entregas/buscar-tudo", aplicarPermissoes(webhookH.BuscarEntregas, model.PermissaoWebhookLer))
+	mux.Handle("/webhooks/entregas/buscar-por-id/", aplicarPermissoes(webhookH.BuscarEntregaPorID, model.PermissaoWebhookLer))
+	mux.Handle("/webhooks/entregas/reenviar/", aplicarPermissoes(webhookH.ReenviarEntrega, model.PermissaoWebhookEditar))
+}
+
+// PapelRegistrarRotas registra as rotas dos papéis e das permissões nomeadas
+func PapelRegistrarRotas(mux *http.ServeMux, papH *handler.PapelHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
+	// helper para aplicar autenticação + permissões
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
+		return middleware.AutenticarUsuario(
+			middleware.RequerPermissao(permissao, papeis)(handler),
+			jwtManager, svc,
+		)
+	}
+
+	mux.Handle("/papeis/criar", aplicarPermissoes(papH.Criar, model.PermissaoPapelEditar))
+	mux.Handle("/papeis/buscar-tudo", aplicarPermissoes(papH.BuscarTudo, model.PermissaoPapelLer))
+	mux.Handle("/papeis/buscar-por-codigo/", aplicarPermissoes(papH.BuscarPorCodigo, model.PermissaoPapelLer))
+	mux.Handle("/papeis/permissoes-disponiveis", aplicarPermissoes(papH.PermissoesDisponiveis, model.PermissaoPapelLer))
+	mux.Handle("/papeis/atualizar/", aplicarPermissoes(papH.Atualizar, model.PermissaoPapelEditar))
+	mux.Handle("/papeis/atualizar-permissoes/", aplicarPermissoes(papH.AtualizarPermissoes, model.PermissaoPapelEditar))
+	mux.Handle("/papeis/deletar/", aplicarPermissoes(papH.Deletar, model.PermissaoPapelEditar))
+	mux.Handle("/papeis/atribuir/", aplicarPermissoes(papH.AtribuirUsuario, model.PermissaoUsuarioEditarPermissao))
+}
+
+// SessaoRegistrarRotas registra as rotas relacionadas às sessões dos usuários
+func SessaoRegistrarRotas(mux *http.ServeMux, sesH *handler.SessaoHandler, jwtManager *jwt.GerenteJWT, svc usecase.UsuarioUsecase, papeis usecase.VerificarPermissaoPapel) {
 	// helper para aplicar autenticação + permissões
-	aplicarPermissoes := func(handler http.HandlerFunc, perms ...string) http.Handler {
+	aplicarPermissoes := func(handler http.HandlerFunc, permissao model.PermissaoNomeada) http.Handler {
 		return middleware.AutenticarUsuario(
-			middleware.RequerPermissoes(perms...)(handler),
+			middleware.RequerPermissao(permissao, papeis)(handler),
 			jwtManager, svc,
 		)
 	}
 
-	mux.Handle("/categoria-permissoes/criar", aplicarPermissoes(catPermH.Criar, "ADM"))
-	mux.Handle("/categoria-permissoes/atualizar/", aplicarPermissoes(catPermH.Atualizar, "ADM"))
-	mux.Handle("/categoria-permissoes/buscar-tudo", aplicarPermissoes(catPermH.BuscarTudo, "ADM", "TEC", "USR", "DEV"))
-	mux.Handle("/categoria-permissoes/deletar/", aplicarPermissoes(catPermH.Deletar, "ADM"))
-}
\ No newline at end of file
+	mux.Handle("/sessoes/minhas", aplicarPermissoes(sesH.MinhasSessoes, model.PermissaoSessaoPropria))
+	mux.Handle("/sessoes/encerrar-minha/", aplicarPermissoes(sesH.EncerrarMinhaSessao, model.PermissaoSessaoPropria))
+	mux.Handle("/sessoes/buscar-por-usuario/", aplicarPermissoes(sesH.BuscarPorUsuario, model.PermissaoSessaoGerenciar))
+	mux.Handle("/sessoes/encerrar/", aplicarPermissoes(sesH.Encerrar, model.PermissaoSessaoGerenciar))
+}
@@ -0,0 +1,260 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+)
+
+// FechamentoAutomaticoJob fecha os chamados resolvidos que o usuário não confirmou
+// dentro do prazo em dias úteis.
+type FechamentoAutomaticoJob struct {
+	usecase   usecase.ManutencaoChamado
+	diasUteis int
+}
+
+// NewFechamentoAutomaticoJob cria uma nova instância de FechamentoAutomaticoJob.
+func NewFechamentoAutomaticoJob(usecase usecase.ManutencaoChamado, diasUteis int) *FechamentoAutomaticoJob {
+	return &FechamentoAutomaticoJob{usecase: usecase, diasUteis: diasUteis}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *FechamentoAutomaticoJob) Nome() string {
+	return "FechamentoAutomatico"
+}
+
+// Executar fecha os chamados resolvidos há mais dias úteis que o prazo configurado.
+func (j *FechamentoAutomaticoJob) Executar(ctx context.Context) error {
+	fechados, err := j.usecase.FecharChamadosResolvidos(ctx, j.diasUteis)
+	if len(fechados) > 0 {
+		log.Printf("[job.FechamentoAutomatico] %d chamado(s) fechado(s) automaticamente", len(fechados))
+	}
+	if err != nil {
+		return fmt.Errorf("[job.FechamentoAutomatico]: %w", err)
+	}
+	return nil
+}
+
+// ArquivamentoAutomaticoJob arquiva os chamados fechados há mais dias que o prazo configurado.
+type ArquivamentoAutomaticoJob struct {
+	usecase usecase.ManutencaoChamado
+	dias    int
+}
+
+// NewArquivamentoAutomaticoJob cria uma nova instância de ArquivamentoAutomaticoJob.
+func NewArquivamentoAutomaticoJob(usecase usecase.ManutencaoChamado, dias int) *ArquivamentoAutomaticoJob {
+	return &ArquivamentoAutomaticoJob{usecase: usecase, dias: dias}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *ArquivamentoAutomaticoJob) Nome() string {
+	return "ArquivamentoAutomatico"
+}
+
+// Executar arquiva os chamados fechados há mais dias que o prazo configurado.
+func (j *ArquivamentoAutomaticoJob) Executar(ctx context.Context) error {
+	arquivados, err := j.usecase.ArquivarChamadosFechados(ctx, j.dias)
+	if len(arquivados) > 0 {
+		log.Printf("[job.ArquivamentoAutomatico] %d chamado(s) arquivado(s) automaticamente", len(arquivados))
+	}
+	if err != nil {
+		return fmt.Errorf("[job.ArquivamentoAutomatico]: %w", err)
+	}
+	return nil
+}
+
+// AvisoSLAJob avisa a equipe técnica dos chamados cujo prazo de solução está perto de vencer.
+type AvisoSLAJob struct {
+	usecase      usecase.ManutencaoChamado
+	antecedencia time.Duration
+}
+
+// NewAvisoSLAJob cria uma nova instância de AvisoSLAJob.
+func NewAvisoSLAJob(usecase usecase.ManutencaoChamado, antecedencia time.Duration) *AvisoSLAJob {
+	return &AvisoSLAJob{usecase: usecase, antecedencia: antecedencia}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *AvisoSLAJob) Nome() string {
+	return "AvisoSLA"
+}
+
+// Executar avisa os chamados cujo prazo de solução vence dentro da antecedência configurada.
+func (j *AvisoSLAJob) Executar(ctx context.Context) error {
+	avisados, err := j.usecase.AvisarSLAEmRisco(ctx, j.antecedencia)
+	if len(avisados) > 0 {
+		log.Printf("[job.AvisoSLA] %d chamado(s) com prazo de solução em risco", len(avisados))
+	}
+	if err != nil {
+		return fmt.Errorf("[job.AvisoSLA]: %w", err)
+	}
+	return nil
+}
+
+// ViolacaoSLAJob registra as violações dos prazos de SLA que venceram sem que o chamado
+// fosse consultado ou alterado.
+type ViolacaoSLAJob struct {
+	usecase usecase.ManutencaoChamado
+}
+
+// NewViolacaoSLAJob cria uma nova instância de ViolacaoSLAJob.
+func NewViolacaoSLAJob(usecase usecase.ManutencaoChamado) *ViolacaoSLAJob {
+	return &ViolacaoSLAJob{usecase: usecase}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *ViolacaoSLAJob) Nome() string {
+	return "ViolacaoSLA"
+}
+
+// Executar registra as violações dos chamados cujo prazo de SLA já venceu.
+func (j *ViolacaoSLAJob) Executar(ctx context.Context) error {
+	violados, err := j.usecase.RegistrarSLAVencido(ctx)
+	if len(violados) > 0 {
+		log.Printf("[job.ViolacaoSLA] %d chamado(s) com prazo de SLA violado", len(violados))
+	}
+	if err != nil {
+		return fmt.Errorf("[job.ViolacaoSLA]: %w", err)
+	}
+	return nil
+}
+
+// EntradaEmailJob processa as mensagens recebidas na caixa de entrada de e-mails, abrindo e
+// respondendo chamados.
+type EntradaEmailJob struct {
+	usecase usecase.EntradaEmailUsecase
+}
+
+// NewEntradaEmailJob cria uma nova instância de EntradaEmailJob.
+func NewEntradaEmailJob(usecase usecase.EntradaEmailUsecase) *EntradaEmailJob {
+	return &EntradaEmailJob{usecase: usecase}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *EntradaEmailJob) Nome() string {
+	return "EntradaEmail"
+}
+
+// Executar processa as mensagens pendentes da caixa de entrada.
+func (j *EntradaEmailJob) Executar(ctx context.Context) error {
+	processadas, rejeitadas, err := j.usecase.ProcessarCaixaEntrada(ctx)
+	if processadas > 0 || rejeitadas > 0 {
+		log.Printf("[job.EntradaEmail] %d mensagem(ns) processada(s) e %d rejeitada(s)", processadas, rejeitadas)
+	}
+	if err != nil {
+		return fmt.Errorf("[job.EntradaEmail]: %w", err)
+	}
+	return nil
+}
+
+// EntregaWebhookJob envia aos webhooks as entregas pendentes da fila, repetindo as que falharam.
+type EntregaWebhookJob struct {
+	usecase usecase.DisparoWebhook
+}
+
+// NewEntregaWebhookJob cria uma nova instância de EntregaWebhookJob.
+func NewEntregaWebhookJob(usecase usecase.DisparoWebhook) *EntregaWebhookJob {
+	return &EntregaWebhookJob{usecase: usecase}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *EntregaWebhookJob) Nome() string {
+	return "EntregaWebhook"
+}
+
+// Executar envia as entregas pendentes cuja próxima tentativa já venceu.
+func (j *EntregaWebhookJob) Executar(ctx context.Context) error {
+	entregues, falhas, err := j.usecase.EntregarPendentes(ctx)
+	if entregues > 0 || falhas > 0 {
+		log.Printf("[job.EntregaWebhook] %d entrega(s) realizada(s) e %d encerrada(s) com falha", entregues, falhas)
+	}
+	if err != nil {
+		return fmt.Errorf("[job.EntregaWebhook]: %w", err)
+	}
+	return nil
+}
+
+// DespachoOutboxJob publica os logs e os eventos de domínio gravados na outbox.
+type DespachoOutboxJob struct {
+	usecase usecase.DespachoOutbox
+}
+
+// NewDespachoOutboxJob cria uma nova instância de DespachoOutboxJob.
+func NewDespachoOutboxJob(usecase usecase.DespachoOutbox) *DespachoOutboxJob {
+	return &DespachoOutboxJob{usecase: usecase}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *DespachoOutboxJob) Nome() string {
+	return "DespachoOutbox"
+}
+
+// Executar publica as mensagens disponíveis da outbox.
+func (j *DespachoOutboxJob) Executar(ctx context.Context) error {
+	_, falhas, err := j.usecase.Despachar(ctx)
+	if falhas > 0 {
+		log.Printf("[job.DespachoOutbox] %d mensagem(ns) com falha na publicação", falhas)
+	}
+	if err != nil {
+		return fmt.Errorf("[job.DespachoOutbox]: %w", err)
+	}
+	return nil
+}
+
+// ExpurgoNotificacoesJob exclui da central as notificações mais antigas que a retenção configurada.
+type ExpurgoNotificacoesJob struct {
+	usecase usecase.ExpurgoNotificacoes
+	dias    int
+}
+
+// NewExpurgoNotificacoesJob cria uma nova instância de ExpurgoNotificacoesJob.
+func NewExpurgoNotificacoesJob(usecase usecase.ExpurgoNotificacoes, dias int) *ExpurgoNotificacoesJob {
+	return &ExpurgoNotificacoesJob{usecase: usecase, dias: dias}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *ExpurgoNotificacoesJob) Nome() string {
+	return "ExpurgoNotificacoes"
+}
+
+// Executar exclui as notificações criadas há mais dias que a retenção configurada.
+func (j *ExpurgoNotificacoesJob) Executar(ctx context.Context) error {
+	removidas, err := j.usecase.ExpurgarNotificacoes(ctx, j.dias)
+	if removidas > 0 {
+		log.Printf("[job.ExpurgoNotificacoes] %d notificação(ões) excluída(s)", removidas)
+	}
+	if err != nil {
+		return fmt.Errorf("[job.ExpurgoNotificacoes]: %w", err)
+	}
+	return nil
+}
+
+// ExpurgoTokensRevogadosJob exclui as revogações dos tokens e as sessões que já expiraram.
+type ExpurgoTokensRevogadosJob struct {
+	usecase usecase.ExpurgoTokensRevogados
+}
+
+// NewExpurgoTokensRevogadosJob cria uma nova instância de ExpurgoTokensRevogadosJob.
+func NewExpurgoTokensRevogadosJob(usecase usecase.ExpurgoTokensRevogados) *ExpurgoTokensRevogadosJob {
+	return &ExpurgoTokensRevogadosJob{usecase: usecase}
+}
+
+// Nome identifica a rotina nos logs da aplicação.
+func (j *ExpurgoTokensRevogadosJob) Nome() string {
+	return "ExpurgoTokensRevogados"
+}
+
+// Executar exclui as revogações dos tokens e as sessões expirados.
+func (j *ExpurgoTokensRevogadosJob) Executar(ctx context.Context) error {
+	removidos, err := j.usecase.ExpurgarTokensRevogados(ctx)
+	if removidos > 0 {
+		log.Printf("[job.ExpurgoTokensRevogados] %d token(s) revogado(s) e sessão(ões) expirado(s) excluído(s)", removidos)
+	}
+	if err != nil {
+		return fmt.Errorf("[job.ExpurgoTokensRevogados]: %w", err)
+	}
+	return nil
+}
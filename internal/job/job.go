@@ -0,0 +1,89 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	uc "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/usecase"
+)
+
+var (
+	ErrIntervaloInvalido      = errors.New("o intervalo entre as execuções das rotinas deve ser maior que zero")
+	ErrUsuarioSistemaInvalido = errors.New("o ID do usuário de sistema é obrigatório para as rotinas automáticas")
+)
+
+// timeoutExecucao limita o tempo de cada execução de uma rotina.
+const timeoutExecucao = 5 * time.Minute
+
+// Job representa uma rotina executada periodicamente em segundo plano.
+type Job interface {
+	// Nome identifica a rotina nos logs da aplicação.
+	Nome() string
+
+	// Executar realiza uma execução da rotina.
+	Executar(ctx context.Context) error
+}
+
+// Executor executa as rotinas registradas a cada intervalo, autenticado como o
+// usuário de sistema para que as ações automáticas fiquem registradas nos logs.
+type Executor struct {
+	intervalo        time.Duration
+	usuarioSistemaID string
+	jobs             []Job
+}
+
+// NewExecutor cria uma nova instância de Executor.
+func NewExecutor(intervalo time.Duration, usuarioSistemaID string, jobs ...Job) (*Executor, error) {
+	if intervalo <= 0 {
+		return nil, fmt.Errorf("[job.NewExecutor]: %w", ErrIntervaloInvalido)
+	}
+	if usuarioSistemaID == "" {
+		return nil, fmt.Errorf("[job.NewExecutor]: %w", ErrUsuarioSistemaInvalido)
+	}
+
+	return &Executor{
+		intervalo:        intervalo,
+		usuarioSistemaID: usuarioSistemaID,
+		jobs:             jobs,
+	}, nil
+}
+
+// Iniciar executa as rotinas imediatamente e depois a cada intervalo, até que o
+// contexto seja cancelado. Bloqueia a goroutine chamadora.
+func (e *Executor) Iniciar(ctx context.Context) {
+	log.Printf("[job.Executor] %d rotina(s) agendada(s) a cada %s", len(e.jobs), e.intervalo)
+
+	ticker := time.NewTicker(e.intervalo)
+	defer ticker.Stop()
+
+	for {
+		e.executarTodos(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Println("[job.Executor] rotinas encerradas")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Metodos auxiliares
+
+// executarTodos executa cada rotina em sequência; a falha de uma não impede as demais.
+func (e *Executor) executarTodos(ctx context.Context) {
+	for _, j := range e.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		ctxJob, cancel := context.WithTimeout(uc.ContextoDoSistema(ctx, e.usuarioSistemaID), timeoutExecucao)
+		if err := j.Executar(ctxJob); err != nil {
+			log.Printf("[job.Executor] erro na rotina %s: %v", j.Nome(), err)
+		}
+		cancel()
+	}
+}
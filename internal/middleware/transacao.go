@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+)
+
+// errRespostaComFalha desfaz a transação da requisição respondida com erro
+var errRespostaComFalha = errors.New("requisição respondida com erro")
+
+// Transacional é middleware que executa cada requisição de escrita em uma única transação,
+// de modo que a alteração, os logs de auditoria e os eventos gravados na outbox sejam
+// confirmados juntos. A resposta é retida até o fim da transação: as respostas com status
+// de erro desfazem a transação e, se a confirmação falhar, a resposta é substituída por um
+// erro interno.
+func Transacional(transacao repository.UnidadeDeTrabalho) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			default:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rw := &respostaRetida{ResponseWriter: w, status: http.StatusOK}
+			err := transacao.Executar(r.Context(), func(ctx context.Context) error {
+				next.ServeHTTP(rw, r.WithContext(ctx))
+				if rw.status >= http.StatusBadRequest {
+					return errRespostaComFalha
+				}
+				return nil
+			})
+			if err != nil && !errors.Is(err, errRespostaComFalha) {
+				log.Printf("[middleware.Transacional] %s %s: %v", r.Method, r.URL.Path, err)
+				response.ErrorJSON(w, http.StatusInternalServerError, "erro ao confirmar a transação", err.Error())
+				return
+			}
+
+			w.WriteHeader(rw.status)
+			w.Write(rw.corpo.Bytes())
+		})
+	}
+}
+
+// respostaRetida wrapa http.ResponseWriter para reter o status code e o corpo da resposta
+type respostaRetida struct {
+	http.ResponseWriter
+	status int
+	corpo  bytes.Buffer
+}
+
+// WriteHeader retém o status code
+func (rw *respostaRetida) WriteHeader(code int) {
+	rw.status = code
+}
+
+// Write retém o corpo da resposta
+func (rw *respostaRetida) Write(b []byte) (int, error) {
+	return rw.corpo.Write(b)
+}
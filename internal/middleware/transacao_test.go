@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// unidadeTrabalhoFake registra o desfecho das transações, podendo simular a falha na
+// confirmação.
+type unidadeTrabalhoFake struct {
+	falhaConfirmacao error
+	iniciadas        int
+	confirmadas      int
+	desfeitas        int
+}
+
+func (u *unidadeTrabalhoFake) Executar(ctx context.Context, operacao func(ctx context.Context) error) error {
+	u.iniciadas++
+	if err := operacao(ctx); err != nil {
+		u.desfeitas++
+		return err
+	}
+	if u.falhaConfirmacao != nil {
+		u.desfeitas++
+		return u.falhaConfirmacao
+	}
+	u.confirmadas++
+	return nil
+}
+
+func (u *unidadeTrabalhoFake) AposConfirmar(_ context.Context, acao func()) { acao() }
+
+func (u *unidadeTrabalhoFake) AposDesfazer(context.Context, func()) {}
+
+func TestTransacional(t *testing.T) {
+	casos := []struct {
+		nome             string
+		metodo           string
+		status           int
+		falhaConfirmacao error
+		statusEsperado   int
+		iniciadas        int
+		confirmadas      int
+		desfeitas        int
+	}{
+		{"leitura fora da transação", http.MethodGet, http.StatusOK, nil, http.StatusOK, 0, 0, 0},
+		{"escrita confirmada", http.MethodPost, http.StatusCreated, nil, http.StatusCreated, 1, 1, 0},
+		{"resposta de erro desfaz a transação", http.MethodPatch, http.StatusConflict, nil, http.StatusConflict, 1, 0, 1},
+		{"falha na confirmação vira erro interno", http.MethodDelete, http.StatusOK, errors.New("deadlock"), http.StatusInternalServerError, 1, 0, 1},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			transacao := &unidadeTrabalhoFake{falhaConfirmacao: c.falhaConfirmacao}
+			handler := Transacional(transacao)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(c.status)
+				w.Write([]byte("corpo"))
+			}))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(c.metodo, "/chamados", nil))
+
+			if rec.Code != c.statusEsperado {
+				t.Errorf("status = %d, esperado %d", rec.Code, c.statusEsperado)
+			}
+			if c.falhaConfirmacao == nil && rec.Body.String() != "corpo" {
+				t.Errorf("corpo = %q, esperado o corpo retido do handler", rec.Body.String())
+			}
+			if transacao.iniciadas != c.iniciadas || transacao.confirmadas != c.confirmadas || transacao.desfeitas != c.desfeitas {
+				t.Errorf("transações iniciadas/confirmadas/desfeitas = %d/%d/%d, esperado %d/%d/%d",
+					transacao.iniciadas, transacao.confirmadas, transacao.desfeitas, c.iniciadas, c.confirmadas, c.desfeitas)
+			}
+		})
+	}
+}
+
+func TestTransacionalRetemRespostaAteConfirmar(t *testing.T) {
+	rec := httptest.NewRecorder()
+	transacao := &unidadeTrabalhoFake{}
+	handler := Transacional(transacao)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("corpo"))
+
+		// nada chega ao cliente antes da confirmação
+		if rec.Body.Len() != 0 || transacao.confirmadas != 0 {
+			t.Error("resposta enviada antes da confirmação da transação")
+		}
+	}))
+
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/chamados", nil))
+	if rec.Code != http.StatusCreated || transacao.confirmadas != 1 {
+		t.Errorf("status = %d e confirmadas = %d, esperado %d e 1", rec.Code, transacao.confirmadas, http.StatusCreated)
+	}
+}
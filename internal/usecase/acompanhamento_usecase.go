@@ -2,21 +2,56 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	infra "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
 )
 
 // AcompanhamentoUsecase representa a camada de caso de uso para operações relacionadas a acompanhamentos.
 type AcompanhamentoUsecase struct {
-	repository repository.AcompanhamentoRepository
+	repository         repository.AcompanhamentoRepository
+	repositoryChamado  repository.BuscarChamado
+	repositoryAnexo    repository.ListarAnexo
+	repositoryMencao   repository.MencaoRepository
+	repositoryUsuario  repository.BuscarUsuario
+	armazenamento      repository.ArmazenamentoArquivo
+	usecaseSLA         usecase.SLAChamado
+	usecaseAutorizacao usecase.AutorizarOperacao
+	usecaseEvento      usecase.PublicarEvento
+	prazoEdicao        time.Duration
 }
 
 // NewAcompanhamentoUsecase cria uma nova instância de AcompanhamentoUsecase.
-func NewAcompanhamentoUsecase(repository repository.AcompanhamentoRepository) *AcompanhamentoUsecase {
-	return &AcompanhamentoUsecase{repository: repository}
+func NewAcompanhamentoUsecase(
+	repository repository.AcompanhamentoRepository,
+	repositoryChamado repository.BuscarChamado,
+	repositoryAnexo repository.ListarAnexo,
+	repositoryMencao repository.MencaoRepository,
+	repositoryUsuario repository.BuscarUsuario,
+	armazenamento repository.ArmazenamentoArquivo,
+	usecaseSLA usecase.SLAChamado,
+	usecaseAutorizacao usecase.AutorizarOperacao,
+	usecaseEvento usecase.PublicarEvento,
+	prazoEdicao time.Duration,
+) *AcompanhamentoUsecase {
+	return &AcompanhamentoUsecase{
+		repository:         repository,
+		repositoryChamado:  repositoryChamado,
+		repositoryAnexo:    repositoryAnexo,
+		repositoryMencao:   repositoryMencao,
+		repositoryUsuario:  repositoryUsuario,
+		armazenamento:      armazenamento,
+		usecaseSLA:         usecaseSLA,
+		usecaseAutorizacao: usecaseAutorizacao,
+		usecaseEvento:      usecaseEvento,
+		prazoEdicao:        prazoEdicao,
+	}
 }
 
 // BuscarAcompanhamentoPorID busca um acompanhamento pelo seu ID.
@@ -30,14 +65,16 @@ func (u *AcompanhamentoUsecase) BuscarAcompanhamentoPorID(ctx context.Context, i
 		)
 	}
 
-	acompanhamento, err := u.repository.BuscarPorID(ctx, id)
+	acompanhamento, err := u.buscarVisivel(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("[usecase.BuscarAcompanhamentoPorID]: %w", err)
 	}
 	return acompanhamento, nil
 }
 
-// BuscarAcompanhamentosPorChamadoID busca acompanhamentos pelo ID do chamado.
+// BuscarAcompanhamentosPorChamadoID busca acompanhamentos pelo ID do chamado, desde que o
+// chamado seja visível ao usuário autenticado. As notas internas são omitidas para quem não
+// atua como equipe técnica na categoria do chamado.
 func (u *AcompanhamentoUsecase) BuscarAcompanhamentosPorChamadoID(ctx context.Context, chamadoID string) ([]model.Acompanhamento, error) {
 	if chamadoID == "" {
 		return nil, utils.NewAppError(
@@ -48,14 +85,29 @@ func (u *AcompanhamentoUsecase) BuscarAcompanhamentosPorChamadoID(ctx context.Co
 		)
 	}
 
-	acompanhamentos, err := u.repository.BuscarPorChamadoID(ctx, chamadoID)
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarAcompanhamentosPorChamadoID]: %w", err)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamadoID); err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarAcompanhamentosPorChamadoID]: %w", err)
+	}
+
+	incluirInternos, err := u.usecaseAutorizacao.PermiteOperacaoNaCategoria(ctx, chamado.CategoriaID, model.OperacaoNotaInterna)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarAcompanhamentosPorChamadoID]: %w", err)
+	}
+
+	acompanhamentos, err := u.repository.BuscarPorChamadoID(ctx, chamadoID, incluirInternos)
 	if err != nil {
 		return nil, fmt.Errorf("[usecase.BuscarAcompanhamentosPorChamadoID]: %w", err)
 	}
 	return acompanhamentos, nil
 }
 
-// CriarAcompanhamento cria um novo acompanhamento.
+// CriarAcompanhamento cria um novo acompanhamento. Apenas a equipe técnica pode criar notas
+// internas. Os usuários mencionados com @login no conteúdo são notificados.
 func (u *AcompanhamentoUsecase) CriarAcompanhamento(ctx context.Context, acompanhamento *model.Acompanhamento) error {
 	const metodo = "[usecase.CriarAcompanhamento]: %w"
 
@@ -72,19 +124,58 @@ func (u *AcompanhamentoUsecase) CriarAcompanhamento(ctx context.Context, acompan
 		acompanhamento.UsuarioID,
 		acompanhamento.Conteudo,
 		acompanhamento.Remetente,
+		acompanhamento.Visibilidade,
 	)
 	if err != nil {
 		return fmt.Errorf(metodo, err)
 	}
 
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, acompanhamento.ChamadoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamado.ID); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if _, err := u.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, model.OperacaoAcompanhar); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.verificarAcessoNotaInterna(ctx, chamado, acompanhamento); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
 	if err := u.repository.Salvar(ctx, acompanhamento); err != nil {
 		return fmt.Errorf(metodo, err)
 	}
+
+	interno := acompanhamento.Visibilidade == model.VisibilidadeInterno
+	if err := u.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoAcompanhamentoCriado, chamado, interno, acompanhamento)); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.registrarMencoes(ctx, chamado, acompanhamento); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	// a primeira mensagem pública da equipe técnica conta como primeira resposta do SLA;
+	// notas internas não são vistas pelo usuário e não contam
+	if acompanhamento.Remetente != model.PermUSR && acompanhamento.Visibilidade == model.VisibilidadePublico {
+		if err := u.usecaseSLA.RegistrarPrimeiraResposta(ctx, acompanhamento.ChamadoID); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+	}
 	return nil
 }
 
-// AtualizarAcompanhamento atualiza as informações de um acompanhamento existente.
+// AtualizarAcompanhamento edita o conteúdo e a visibilidade de um acompanhamento. Apenas o
+// autor pode editar, dentro do prazo de edição, e o estado anterior é mantido como revisão.
+// Apenas os usuários mencionados pela primeira vez no acompanhamento são notificados.
 func (u *AcompanhamentoUsecase) AtualizarAcompanhamento(ctx context.Context, id string, acompanhamento *model.Acompanhamento) error {
+	const metodo = "[usecase.AtualizarAcompanhamento]: %w"
+
 	if id == "" {
 		return utils.NewAppError(
 			"[usecase.AtualizarAcompanhamento]",
@@ -94,18 +185,69 @@ func (u *AcompanhamentoUsecase) AtualizarAcompanhamento(ctx context.Context, id
 		)
 	}
 
-	if err := model.ValidarAcompanhamento(acompanhamento); err != nil {
-		return fmt.Errorf("[usecase.AtualizarAcompanhamento]: %w", err)
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
 	}
 
-	if err := u.repository.Atualizar(ctx, id, acompanhamento); err != nil {
-		return fmt.Errorf("[usecase.AtualizarAcompanhamento]: %w", err)
+	atual, err := u.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
 	}
+
+	if err := atual.PodeSerEditado(usuarioID, u.prazoEdicao, time.Now()); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	// apenas o conteúdo e a visibilidade podem ser editados
+	editado := *atual
+	editado.Conteudo = acompanhamento.Conteudo
+	if acompanhamento.Visibilidade != "" {
+		editado.Visibilidade = acompanhamento.Visibilidade
+	}
+
+	if err := model.ValidarAcompanhamento(&editado); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, editado.ChamadoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.verificarAcessoNotaInterna(ctx, chamado, &editado); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	revisaoID, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	revisao := model.NewRevisaoAcompanhamento(revisaoID, atual, model.RevisaoEdicao, usuarioID)
+
+	if err := u.repository.Atualizar(ctx, id, &editado, revisao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	agora := time.Now()
+	editado.EditadoEm = &agora
+	editado.AtualizadoEm = agora
+
+	if err := u.registrarMencoes(ctx, chamado, &editado); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	*acompanhamento = editado
 	return nil
 }
 
-// DeletarAcompanhamento remove um acompanhamento pelo ID.
+// DeletarAcompanhamento remove um acompanhamento pelo ID, substituindo o conteúdo pela
+// mensagem de remoção. Apenas o autor ou um administrador da categoria do chamado pode
+// remover, e o conteúdo
+// anterior é mantido como revisão. Os metadados dos anexos são removidos junto com o
+// acompanhamento e, em seguida, o conteúdo dos arquivos.
 func (u *AcompanhamentoUsecase) DeletarAcompanhamento(ctx context.Context, id string) error {
+	const metodo = "[usecase.DeletarAcompanhamento]: %w"
+
 	if id == "" {
 		return utils.NewAppError(
 			"[usecase.DeletarAcompanhamento]",
@@ -115,14 +257,86 @@ func (u *AcompanhamentoUsecase) DeletarAcompanhamento(ctx context.Context, id st
 		)
 	}
 
-	if err := u.repository.Deletar(ctx, id); err != nil {
-		return fmt.Errorf("[usecase.DeletarAcompanhamento]: %w", err)
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	atual, err := u.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, atual.ChamadoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	permissao, err := u.usecaseAutorizacao.PermissaoNaCategoria(ctx, chamado.CategoriaID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := atual.PodeSerRemovido(usuarioID, permissao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	anexos, err := u.repositoryAnexo.ListarPorAcompanhamento(ctx, id)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	revisaoID, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	revisao := model.NewRevisaoAcompanhamento(revisaoID, atual, model.RevisaoRemocao, usuarioID)
+
+	if err := u.repository.Deletar(ctx, id, revisao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	for _, anexo := range anexos {
+		if err := u.armazenamento.Remover(ctx, anexo.Chave); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
 	}
 	return nil
 }
 
-// ListarAcompanhamentos lista acompanhamentos com paginação e filtros opcionais.
+// ListarRevisoesAcompanhamento retorna as revisões do acompanhamento, da mais antiga à mais recente.
+func (u *AcompanhamentoUsecase) ListarRevisoesAcompanhamento(ctx context.Context, id string) ([]model.RevisaoAcompanhamento, error) {
+	if id == "" {
+		return nil, utils.NewAppError(
+			"[usecase.ListarRevisoesAcompanhamento]",
+			utils.LevelInfo,
+			"erro ao listar revisões do acompanhamento",
+			model.ErrAcompanhamentoIDInvalido,
+		)
+	}
+
+	if _, err := u.repository.BuscarPorID(ctx, id); err != nil {
+		return nil, fmt.Errorf("[usecase.ListarRevisoesAcompanhamento]: %w", err)
+	}
+
+	revisoes, err := u.repository.ListarRevisoes(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.ListarRevisoesAcompanhamento]: %w", err)
+	}
+	return revisoes, nil
+}
+
+// ListarAcompanhamentos lista acompanhamentos dos chamados visíveis ao usuário autenticado,
+// com paginação e filtros opcionais. As notas internas são omitidas para quem não pertence à
+// equipe técnica.
 func (u *AcompanhamentoUsecase) ListarAcompanhamentos(ctx context.Context, filtro model.AcompanhamentoFiltro) ([]model.Acompanhamento, int, model.AcompanhamentoFiltro, error) {
+	escopo, err := ExtrairEscopoDoContexto(ctx)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarAcompanhamentos]: %w", err)
+	}
+	filtro.IncluirInternos = model.PodeAcessarNotasInternas(escopo.Permissao)
+	filtro.Escopo = escopo
+
 	if filtro.Pagina < 1 {
 		filtro.Pagina = 1
 	}
@@ -136,4 +350,113 @@ func (u *AcompanhamentoUsecase) ListarAcompanhamentos(ctx context.Context, filtr
 		return nil, 0, filtro, fmt.Errorf("[usecase.ListarAcompanhamentos]: %w", err)
 	}
 	return acompanhamentos, total, filtro, nil
-}
\ No newline at end of file
+}
+
+// Métodos auxiliares
+
+// buscarVisivel busca o acompanhamento pelo ID, recusando-o a quem não pode ver o chamado e
+// recusando notas internas a quem não atua como equipe técnica na categoria do chamado.
+func (u *AcompanhamentoUsecase) buscarVisivel(ctx context.Context, id string) (*model.Acompanhamento, error) {
+	acompanhamento, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, acompanhamento.ChamadoID); err != nil {
+		return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+	}
+
+	if acompanhamento.Visibilidade == model.VisibilidadeInterno {
+		chamado, err := u.repositoryChamado.BuscarPorID(ctx, acompanhamento.ChamadoID)
+		if err != nil {
+			return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+		}
+		if err := u.verificarAcessoNotaInterna(ctx, chamado, acompanhamento); err != nil {
+			return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+		}
+	}
+	return acompanhamento, nil
+}
+
+// registrarMencoes registra os usuários mencionados com @login no conteúdo do acompanhamento e
+// publica um evento de menção para cada um. São ignorados os logins sem cadastro, os usuários
+// inativos, o próprio autor, os já mencionados no acompanhamento e, em notas internas, quem não
+// pertence à equipe técnica. As menções continuam registradas se o conteúdo for editado.
+func (u *AcompanhamentoUsecase) registrarMencoes(ctx context.Context, chamado *model.Chamado, acompanhamento *model.Acompanhamento) error {
+	const metodo = "[usecase.registrarMencoes]: %w"
+
+	logins := model.ExtrairMencoes(acompanhamento.Conteudo)
+	if len(logins) == 0 {
+		return nil
+	}
+
+	registradas, err := u.repositoryMencao.ListarPorAcompanhamento(ctx, acompanhamento.ID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	mencionados := map[string]struct{}{acompanhamento.UsuarioID: {}}
+	for _, m := range registradas {
+		mencionados[m.UsuarioID] = struct{}{}
+	}
+
+	interno := acompanhamento.Visibilidade == model.VisibilidadeInterno
+	novas := []model.Mencao{}
+	for _, login := range logins {
+		usuario, err := u.repositoryUsuario.BuscarPorLogin(ctx, login)
+		if err != nil {
+			if errors.Is(err, infra.ErrUsuarioNaoEncontrado) {
+				continue
+			}
+			return fmt.Errorf(metodo, err)
+		}
+
+		if _, ok := mencionados[usuario.ID]; ok || !usuario.Status {
+			continue
+		}
+		if interno && !model.PodeAcessarNotasInternas(usuario.Permissao) {
+			continue
+		}
+
+		mencionados[usuario.ID] = struct{}{}
+		novas = append(novas, *model.NewMencao(acompanhamento, usuario.ID))
+	}
+
+	if len(novas) == 0 {
+		return nil
+	}
+
+	if err := u.repositoryMencao.Salvar(ctx, novas); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	for _, m := range novas {
+		dados := model.MencaoEvento{UsuarioID: m.UsuarioID, Acompanhamento: acompanhamento}
+		if err := u.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoUsuarioMencionado, chamado, interno, dados)); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+	}
+	return nil
+}
+
+// verificarAcessoNotaInterna garante que apenas quem atua como equipe técnica na categoria do
+// chamado manipule notas internas.
+func (u *AcompanhamentoUsecase) verificarAcessoNotaInterna(ctx context.Context, chamado *model.Chamado, acompanhamento *model.Acompanhamento) error {
+	if acompanhamento.Visibilidade != model.VisibilidadeInterno {
+		return nil
+	}
+
+	_, err := u.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, model.OperacaoNotaInterna)
+	if errors.Is(err, model.ErrOperacaoNaoPermitida) {
+		return utils.NewAppError(
+			"[usecase.verificarAcessoNotaInterna]",
+			utils.LevelInfo,
+			fmt.Sprintf("o acompanhamento %s é uma nota interna", acompanhamento.ID),
+			model.ErrNotaInternaNaoPermitida,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("[usecase.verificarAcessoNotaInterna]: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,324 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// bytesDeteccaoTipo é a quantidade de bytes inspecionada para identificar o tipo do arquivo
+const bytesDeteccaoTipo = 512
+
+// AnexoUsecase representa a camada de caso de uso dos anexos de chamados e acompanhamentos.
+type AnexoUsecase struct {
+	repository               repository.AnexoRepository
+	repositoryChamado        repository.ChamadoRepository
+	repositoryAcompanhamento repository.AcompanhamentoRepository
+	armazenamento            repository.ArmazenamentoArquivo
+	transacao                repository.UnidadeDeTrabalho // sincroniza o armazenamento com a transação
+	regras                   model.RegrasAnexo
+}
+
+// NewAnexoUsecase cria uma nova instância de AnexoUsecase.
+func NewAnexoUsecase(
+	repository repository.AnexoRepository,
+	repositoryChamado repository.ChamadoRepository,
+	repositoryAcompanhamento repository.AcompanhamentoRepository,
+	armazenamento repository.ArmazenamentoArquivo,
+	transacao repository.UnidadeDeTrabalho,
+	regras model.RegrasAnexo,
+) *AnexoUsecase {
+	return &AnexoUsecase{
+		repository:               repository,
+		repositoryChamado:        repositoryChamado,
+		repositoryAcompanhamento: repositoryAcompanhamento,
+		armazenamento:            armazenamento,
+		transacao:                transacao,
+		regras:                   regras,
+	}
+}
+
+// EnviarAnexo valida o arquivo enviado ao chamado ou a um de seus acompanhamentos,
+// grava o conteúdo no armazenamento e registra os metadados. O tipo MIME é
+// identificado pelo próprio conteúdo, e não pelo que o cliente declarou. Se a transação
+// em andamento for desfeita, o conteúdo gravado é removido.
+func (u *AnexoUsecase) EnviarAnexo(ctx context.Context, envio *model.EnvioAnexo, conteudo io.Reader) (*model.Anexo, error) {
+	const metodo = "[usecase.EnviarAnexo]"
+
+	if envio.ChamadoID == "" {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o ID do chamado é obrigatório para enviar o anexo",
+			model.ErrChamadoIDInvalido,
+		)
+	}
+
+	nome, err := model.NormalizarNomeAnexo(envio.NomeArquivo)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	usuarioID, permissao, err := u.verificarVisibilidade(ctx, envio.ChamadoID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if envio.AcompanhamentoID != nil {
+		acompanhamento, err := u.repositoryAcompanhamento.BuscarPorID(ctx, *envio.AcompanhamentoID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		if acompanhamento.ChamadoID != envio.ChamadoID {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelInfo,
+				fmt.Sprintf("o acompanhamento %s pertence ao chamado %s", acompanhamento.ID, acompanhamento.ChamadoID),
+				model.ErrAnexoDeOutroChamado,
+			)
+		}
+		if !acompanhamento.VisivelPara(permissao) {
+			return nil, utils.NewAppError(
+				metodo,
+				utils.LevelInfo,
+				fmt.Sprintf("o acompanhamento %s é uma nota interna", acompanhamento.ID),
+				model.ErrNotaInternaNaoPermitida,
+			)
+		}
+	}
+
+	leitor := bufio.NewReaderSize(conteudo, bytesDeteccaoTipo)
+	inicio, err := leitor.Peek(bytesDeteccaoTipo)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	if len(inicio) == 0 {
+		return nil, utils.NewAppError(metodo, utils.LevelInfo, "nenhum conteúdo foi enviado", model.ErrAnexoVazio)
+	}
+
+	tipo := http.DetectContentType(inicio)
+	if err := u.regras.ValidarTipo(tipo); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	anexo := &model.Anexo{
+		ID:               id,
+		ChamadoID:        envio.ChamadoID,
+		AcompanhamentoID: envio.AcompanhamentoID,
+		NomeArquivo:      nome,
+		TipoMIME:         tipo,
+		Chave:            envio.ChamadoID + "/" + id,
+		EnviadoPorID:     usuarioID,
+	}
+
+	// lê um byte além do limite para detectar arquivos maiores que o permitido
+	hash := sha256.New()
+	contador := &contadorBytes{}
+	limitado := io.TeeReader(io.LimitReader(leitor, u.regras.TamanhoMaximo+1), io.MultiWriter(hash, contador))
+
+	if err := u.armazenamento.Salvar(ctx, anexo.Chave, limitado); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if contador.total > u.regras.TamanhoMaximo {
+		u.removerConteudo(ctx, anexo.Chave)
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			fmt.Sprintf("o tamanho máximo é de %d bytes", u.regras.TamanhoMaximo),
+			model.ErrTamanhoAnexoExcedido,
+		)
+	}
+
+	anexo.Tamanho = contador.total
+	anexo.Hash = hex.EncodeToString(hash.Sum(nil))
+
+	if err := u.repository.Salvar(ctx, anexo); err != nil {
+		u.removerConteudo(ctx, anexo.Chave)
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	// O registro só existe se a transação for confirmada; desfeita, o conteúdo ficaria órfão
+	u.transacao.AposDesfazer(ctx, func() {
+		u.removerConteudo(ctx, anexo.Chave)
+	})
+
+	return anexo, nil
+}
+
+// AbrirAnexo retorna os metadados e o conteúdo do anexo para download, desde que o
+// chamado seja visível ao usuário autenticado.
+func (u *AnexoUsecase) AbrirAnexo(ctx context.Context, id string) (*model.Anexo, io.ReadCloser, error) {
+	const metodo = "[usecase.AbrirAnexo]"
+
+	anexo, err := u.buscarVisivel(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	conteudo, err := u.armazenamento.Abrir(ctx, anexo.Chave)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	return anexo, conteudo, nil
+}
+
+// BuscarAnexosPorChamado retorna os anexos do chamado e de seus acompanhamentos. Os anexos
+// de notas internas são omitidos para quem não pertence à equipe técnica.
+func (u *AnexoUsecase) BuscarAnexosPorChamado(ctx context.Context, chamadoID string) ([]model.Anexo, error) {
+	if chamadoID == "" {
+		return nil, utils.NewAppError(
+			"[usecase.BuscarAnexosPorChamado]",
+			utils.LevelInfo,
+			"erro ao buscar anexos por chamado",
+			model.ErrChamadoIDInvalido,
+		)
+	}
+
+	_, permissao, err := u.verificarVisibilidade(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarAnexosPorChamado]: %w", err)
+	}
+
+	anexos, err := u.repository.ListarPorChamado(ctx, chamadoID, model.PodeAcessarNotasInternas(permissao))
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarAnexosPorChamado]: %w", err)
+	}
+	return anexos, nil
+}
+
+// DeletarAnexo remove o anexo. Apenas quem o enviou ou um administrador pode removê-lo.
+// O conteúdo é removido do armazenamento após a confirmação da transação em andamento.
+func (u *AnexoUsecase) DeletarAnexo(ctx context.Context, id string) error {
+	const metodo = "[usecase.DeletarAnexo]"
+
+	anexo, err := u.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	permissao, err := ExtrairPermissaoDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if anexo.EnviadoPorID != usuarioID && permissao != model.PermADM {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o usuário não enviou o anexo",
+			model.ErrAnexoDeOutroUsuario,
+		)
+	}
+
+	if err := u.repository.Deletar(ctx, id); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	// Desfeita a transação, o registro volta a existir e o conteúdo precisa ser mantido
+	u.transacao.AposConfirmar(ctx, func() {
+		u.removerConteudo(ctx, anexo.Chave)
+	})
+	return nil
+}
+
+// Métodos auxiliares
+
+// buscarVisivel busca o anexo pelo ID e verifica se o chamado e, quando houver, o
+// acompanhamento dele são visíveis ao usuário autenticado.
+func (u *AnexoUsecase) buscarVisivel(ctx context.Context, id string) (*model.Anexo, error) {
+	if id == "" {
+		return nil, utils.NewAppError(
+			"[usecase.buscarVisivel]",
+			utils.LevelInfo,
+			"o ID do anexo é obrigatório",
+			model.ErrIDInvalido,
+		)
+	}
+
+	anexo, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+	}
+
+	_, permissao, err := u.verificarVisibilidade(ctx, anexo.ChamadoID)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+	}
+
+	if anexo.AcompanhamentoID != nil && !model.PodeAcessarNotasInternas(permissao) {
+		acompanhamento, err := u.repositoryAcompanhamento.BuscarPorID(ctx, *anexo.AcompanhamentoID)
+		if err != nil {
+			return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+		}
+		if !acompanhamento.VisivelPara(permissao) {
+			return nil, utils.NewAppError(
+				"[usecase.buscarVisivel]",
+				utils.LevelInfo,
+				fmt.Sprintf("o anexo %s pertence a uma nota interna", anexo.ID),
+				model.ErrNotaInternaNaoPermitida,
+			)
+		}
+	}
+	return anexo, nil
+}
+
+// verificarVisibilidade garante que o chamado é visível ao usuário autenticado e retorna o ID e a permissão dele.
+func (u *AnexoUsecase) verificarVisibilidade(ctx context.Context, chamadoID string) (string, model.Permissao, error) {
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("[usecase.verificarVisibilidade]: %w", err)
+	}
+
+	permissao, err := ExtrairPermissaoDoContexto(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("[usecase.verificarVisibilidade]: %w", err)
+	}
+
+	if _, err := u.repositoryChamado.BuscarPorID(ctx, chamadoID); err != nil {
+		return "", "", fmt.Errorf("[usecase.verificarVisibilidade]: %w", err)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamadoID); err != nil {
+		return "", "", fmt.Errorf("[usecase.verificarVisibilidade]: %w", err)
+	}
+	return usuarioID, permissao, nil
+}
+
+// removerConteudo remove o conteúdo cujo registro não pôde ser concluído ou foi excluído.
+// Usa um contexto próprio para que o cancelamento da requisição não deixe arquivos órfãos.
+func (u *AnexoUsecase) removerConteudo(ctx context.Context, chave string) {
+	if err := u.armazenamento.Remover(context.WithoutCancel(ctx), chave); err != nil {
+		log.Printf("[usecase.removerConteudo] falha ao remover o conteúdo %s: %v", chave, err)
+	}
+}
+
+// contadorBytes conta os bytes escritos nele.
+type contadorBytes struct {
+	total int64
+}
+
+// Write soma o tamanho de p ao total.
+func (c *contadorBytes) Write(p []byte) (int, error) {
+	c.total += int64(len(p))
+	return len(p), nil
+}
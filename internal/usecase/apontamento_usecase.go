@@ -0,0 +1,301 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// ApontamentoUsecase representa a camada de caso de uso para os apontamentos de horas dos atendimentos.
+type ApontamentoUsecase struct {
+	repository            repository.ApontamentoRepository
+	repositoryAtendimento repository.AtendimentoRepository
+}
+
+// NewApontamentoUsecase cria uma nova instância de ApontamentoUsecase.
+func NewApontamentoUsecase(
+	repository repository.ApontamentoRepository,
+	repositoryAtendimento repository.AtendimentoRepository,
+) *ApontamentoUsecase {
+	return &ApontamentoUsecase{
+		repository:            repository,
+		repositoryAtendimento: repositoryAtendimento,
+	}
+}
+
+// BuscarApontamentoPorID busca um apontamento pelo seu ID.
+func (u *ApontamentoUsecase) BuscarApontamentoPorID(ctx context.Context, id string) (*model.Apontamento, error) {
+	if id == "" {
+		return nil, utils.NewAppError(
+			"[usecase.BuscarApontamentoPorID]",
+			utils.LevelInfo,
+			"erro ao buscar apontamento por id",
+			model.ErrApontamentoIDInvalido,
+		)
+	}
+
+	apontamento, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarApontamentoPorID]: %w", err)
+	}
+	return apontamento, nil
+}
+
+// BuscarApontamentoEmAndamento retorna o cronômetro em andamento do usuário autenticado.
+func (u *ApontamentoUsecase) BuscarApontamentoEmAndamento(ctx context.Context) (*model.Apontamento, error) {
+	const metodo = "[usecase.BuscarApontamentoEmAndamento]"
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	apontamento, err := u.repository.BuscarEmAndamento(ctx, usuarioID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	if apontamento == nil {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o usuário não possui cronômetro em andamento",
+			model.ErrSemApontamentoEmAndamento,
+		)
+	}
+	return apontamento, nil
+}
+
+// CriarApontamento registra um apontamento já concluído para o usuário autenticado.
+func (u *ApontamentoUsecase) CriarApontamento(ctx context.Context, apontamento *model.Apontamento) error {
+	const metodo = "[usecase.CriarApontamento]: %w"
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	novo, err := model.NewApontamento(
+		id,
+		apontamento.AtendimentoID,
+		usuarioID,
+		apontamento.IniciadoEm,
+		apontamento.FinalizadoEm,
+		apontamento.Minutos,
+		apontamento.Descricao,
+		apontamento.Faturavel,
+	)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	atendimento, err := u.repositoryAtendimento.BuscarPorID(ctx, novo.AtendimentoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	novo.ChamadoID = atendimento.ChamadoID
+
+	if err := u.repository.Salvar(ctx, novo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	*apontamento = *novo
+	return nil
+}
+
+// AtualizarApontamento modifica o período, a descrição e o faturamento de um apontamento.
+// O cronômetro em andamento só tem a descrição e o faturamento alterados.
+func (u *ApontamentoUsecase) AtualizarApontamento(ctx context.Context, id string, apontamento *model.Apontamento) error {
+	const metodo = "[usecase.AtualizarApontamento]"
+
+	atual, err := u.buscarDoAutor(ctx, metodo, id)
+	if err != nil {
+		return err
+	}
+
+	atual.Descricao = strings.TrimSpace(apontamento.Descricao)
+	atual.Faturavel = apontamento.Faturavel
+	if !atual.EmAndamento() {
+		if err := atual.DefinirPeriodo(apontamento.IniciadoEm, apontamento.FinalizadoEm, apontamento.Minutos, time.Now()); err != nil {
+			return fmt.Errorf("%s: %w", metodo, err)
+		}
+	}
+
+	if err := model.ValidarApontamento(atual); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := u.repository.Atualizar(ctx, id, atual); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	return nil
+}
+
+// DeletarApontamento remove um apontamento pelo seu ID.
+func (u *ApontamentoUsecase) DeletarApontamento(ctx context.Context, id string) error {
+	const metodo = "[usecase.DeletarApontamento]"
+
+	if _, err := u.buscarDoAutor(ctx, metodo, id); err != nil {
+		return err
+	}
+
+	if err := u.repository.Deletar(ctx, id); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	return nil
+}
+
+// IniciarApontamento inicia o cronômetro do usuário autenticado em um atendimento vigente.
+// Cada técnico possui no máximo um cronômetro em andamento.
+func (u *ApontamentoUsecase) IniciarApontamento(ctx context.Context, apontamento *model.Apontamento) error {
+	const metodo = "[usecase.IniciarApontamento]"
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	emAndamento, err := u.repository.BuscarEmAndamento(ctx, usuarioID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if emAndamento != nil {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			fmt.Sprintf("o cronômetro do apontamento %s precisa ser parado antes de iniciar outro", emAndamento.ID),
+			model.ErrApontamentoEmAndamento,
+		)
+	}
+
+	atendimento, err := u.repositoryAtendimento.BuscarPorID(ctx, apontamento.AtendimentoID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	if !atendimento.Ativo() {
+		return utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o cronômetro só pode ser iniciado em um atendimento vigente",
+			model.ErrChamadoSemAtendimentoAtivo,
+		)
+	}
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	novo, err := model.IniciarApontamento(id, atendimento.ID, usuarioID, apontamento.Descricao, apontamento.Faturavel)
+	if err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+	novo.ChamadoID = atendimento.ChamadoID
+
+	if err := u.repository.Salvar(ctx, novo); err != nil {
+		return fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	*apontamento = *novo
+	return nil
+}
+
+// PararApontamento encerra o cronômetro em andamento do usuário autenticado.
+func (u *ApontamentoUsecase) PararApontamento(ctx context.Context) (*model.Apontamento, error) {
+	const metodo = "[usecase.PararApontamento]"
+
+	apontamento, err := u.BuscarApontamentoEmAndamento(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := apontamento.Parar(time.Now()); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := u.repository.Atualizar(ctx, apontamento.ID, apontamento); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	return apontamento, nil
+}
+
+// ListarApontamentos lista apontamentos com paginação e filtros opcionais.
+func (u *ApontamentoUsecase) ListarApontamentos(ctx context.Context, filtro model.ApontamentoFiltro) ([]model.Apontamento, int, model.ApontamentoFiltro, error) {
+	if filtro.Pagina < 1 {
+		filtro.Pagina = 1
+	}
+
+	if filtro.Limite <= 0 || filtro.Limite > 100 {
+		filtro.Limite = 10
+	}
+
+	apontamentos, total, err := u.repository.Listar(ctx, filtro)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarApontamentos]: %w", err)
+	}
+	return apontamentos, total, filtro, nil
+}
+
+// TotalizarApontamentos soma os minutos apontados agrupados por chamado, categoria ou técnico.
+func (u *ApontamentoUsecase) TotalizarApontamentos(ctx context.Context, filtro model.TotaisApontamentoFiltro) ([]model.TotalApontamento, error) {
+	if filtro.AgruparPor == "" {
+		filtro.AgruparPor = model.AgruparPorChamado
+	}
+
+	if err := model.ValidarAgrupamentoApontamento(filtro.AgruparPor); err != nil {
+		return nil, fmt.Errorf("[usecase.TotalizarApontamentos]: %w", err)
+	}
+
+	totais, err := u.repository.Totalizar(ctx, filtro)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.TotalizarApontamentos]: %w", err)
+	}
+	return totais, nil
+}
+
+// buscarDoAutor busca o apontamento e garante que o usuário autenticado possa alterá-lo:
+// apenas o autor, administradores e desenvolvedores modificam apontamentos.
+func (u *ApontamentoUsecase) buscarDoAutor(ctx context.Context, metodo, id string) (*model.Apontamento, error) {
+	if id == "" {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o ID do apontamento é obrigatório",
+			model.ErrApontamentoIDInvalido,
+		)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	permissao, err := ExtrairPermissaoDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	apontamento, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if apontamento.UsuarioID != usuarioID && permissao != model.PermADM && permissao != model.PermDEV {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o apontamento pertence a outro técnico",
+			model.ErrApontamentoDeOutroUsuario,
+		)
+	}
+	return apontamento, nil
+}
@@ -3,23 +3,42 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
 )
 
 // AtendimentoUsecase representa a camada de caso de uso para operações relacionadas a atendimentos.
 type AtendimentoUsecase struct {
-	repository repository.AtendimentoRepository
+	repository         repository.AtendimentoRepository
+	repositoryChamado  repository.ChamadoRepository
+	usecaseChamado     usecase.AtualizarChamado
+	usecaseAutorizacao usecase.AutorizarOperacao
+	usecaseEvento      usecase.PublicarEvento
 }
 
 // NewAtendimentoUsecase cria uma nova instância de AtendimentoUsecase.
-func NewAtendimentoUsecase(repository repository.AtendimentoRepository) *AtendimentoUsecase {
-	return &AtendimentoUsecase{repository: repository}
+func NewAtendimentoUsecase(
+	repository repository.AtendimentoRepository,
+	repositoryChamado repository.ChamadoRepository,
+	usecaseChamado usecase.AtualizarChamado,
+	usecaseAutorizacao usecase.AutorizarOperacao,
+	usecaseEvento usecase.PublicarEvento,
+) *AtendimentoUsecase {
+	return &AtendimentoUsecase{
+		repository:         repository,
+		repositoryChamado:  repositoryChamado,
+		usecaseChamado:     usecaseChamado,
+		usecaseAutorizacao: usecaseAutorizacao,
+		usecaseEvento:      usecaseEvento,
+	}
 }
 
-// BuscarAtendimentoPorID busca um atendimento pelo seu ID.
+// BuscarAtendimentoPorID busca um atendimento pelo seu ID, desde que o chamado seja visível
+// ao usuário autenticado.
 func (u *AtendimentoUsecase) BuscarAtendimentoPorID(ctx context.Context, id string) (*model.Atendimento, error) {
 	if id == "" {
 		return nil, utils.NewAppError(
@@ -34,10 +53,15 @@ func (u *AtendimentoUsecase) BuscarAtendimentoPorID(ctx context.Context, id stri
 	if err != nil {
 		return nil, fmt.Errorf("[usecase.BuscarAtendimentoPorID]: %w", err)
 	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, atendimento.ChamadoID); err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarAtendimentoPorID]: %w", err)
+	}
 	return atendimento, nil
 }
 
-// CriarAtendimento salva um novo atendimento.
+// CriarAtendimento salva um novo atendimento. O chamado não pode possuir um
+// atendimento vigente: a troca de técnico é feita por TransferirChamado.
 func (u *AtendimentoUsecase) CriarAtendimento(ctx context.Context, atendimento *model.Atendimento) error {
 	const metodo = "[usecase.CriarAtendimento]: %w"
 
@@ -46,48 +70,230 @@ func (u *AtendimentoUsecase) CriarAtendimento(ctx context.Context, atendimento *
 		return fmt.Errorf(metodo, err)
 	}
 
-	atendimento.ID = id
-
-	// TODO 
+	atribuidoPorID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
 
-	atendimento, err = model.NewAtendimento(
-		atendimento.ID,
+	novo, err := model.NewAtendimento(
+		id,
 		atendimento.AtribuidoID,
 		atendimento.ChamadoID,
 	)
 	if err != nil {
 		return fmt.Errorf(metodo, err)
 	}
+	novo.AtribuidoPorID = &atribuidoPorID
+	novo.Motivo = atendimento.Motivo
 
-	if err := u.repository.Salvar(ctx, atendimento); err != nil {
+	ativo, err := u.repository.BuscarAtivo(ctx, novo.ChamadoID)
+	if err != nil {
 		return fmt.Errorf(metodo, err)
 	}
+	if ativo != nil {
+		return utils.NewAppError(
+			"[usecase.CriarAtendimento]",
+			utils.LevelInfo,
+			"erro ao criar atendimento",
+			model.ErrAtendimentoAtivoExistente,
+		)
+	}
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, novo.ChamadoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamado.ID); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if _, err := u.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, model.OperacaoAtribuir); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.Salvar(ctx, novo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if err := u.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoChamadoAtribuido, chamado, false, novo)); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	*atendimento = *novo
 	return nil
 }
 
-// AtualizarAtendimento atualiza um atendimento existente.
-func (u *AtendimentoUsecase) AtualizarAtendimento(ctx context.Context, id string, atendimento *model.Atendimento) error {
-	if id == "" {
+// TransferirChamado finaliza o atendimento vigente do chamado e o atribui ao técnico
+// informado. Um chamado ainda na fila é atribuído e passa para o status ATRIBUIDO.
+func (u *AtendimentoUsecase) TransferirChamado(ctx context.Context, chamadoID string, t *model.TransferenciaAtendimento) (*model.Atendimento, error) {
+	const metodo = "[usecase.TransferirChamado]: %w"
+
+	if chamadoID == "" {
+		return nil, utils.NewAppError(
+			"[usecase.TransferirChamado]",
+			utils.LevelInfo,
+			"erro ao transferir chamado",
+			model.ErrChamadoIDInvalido,
+		)
+	}
+
+	if err := model.ValidarTransferenciaAtendimento(t); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamadoID); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	if _, err := u.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, model.OperacaoTransferir); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	switch chamado.Status {
+	case model.StatusAberto, model.StatusAtribuido, model.StatusAguardando:
+	default:
+		return nil, utils.NewAppError(
+			"[usecase.TransferirChamado]",
+			utils.LevelInfo,
+			fmt.Sprintf("erro ao transferir chamado no status %s", chamado.Status),
+			model.ErrTransferenciaNaoPermitida,
+		)
+	}
+
+	ativo, err := u.repository.BuscarAtivo(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	if ativo != nil && ativo.AtribuidoID == t.AtribuidoID {
+		return nil, utils.NewAppError(
+			"[usecase.TransferirChamado]",
+			utils.LevelInfo,
+			"erro ao transferir chamado",
+			model.ErrTransferenciaMesmoTecnico,
+		)
+	}
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	novo, err := model.NewAtendimento(id, t.AtribuidoID, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	motivo := strings.TrimSpace(t.Motivo)
+	novo.AtribuidoPorID = &usuarioID
+	novo.Motivo = &motivo
+
+	if ativo == nil {
+		err = u.repository.Salvar(ctx, novo)
+	} else {
+		err = u.repository.Transferir(ctx, ativo.ID, &usuarioID, motivo, novo)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	if err := u.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoChamadoAtribuido, chamado, false, novo)); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	if chamado.Status == model.StatusAberto {
+		err := u.usecaseChamado.AtualizarStatusChamado(ctx, chamadoID, string(model.StatusAtribuido), nil)
+		if err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
+	}
+
+	return novo, nil
+}
+
+// DevolverChamado finaliza o atendimento vigente e devolve o chamado para a fila,
+// com o status ABERTO.
+func (u *AtendimentoUsecase) DevolverChamado(ctx context.Context, chamadoID string, d *model.DevolucaoAtendimento) error {
+	const metodo = "[usecase.DevolverChamado]: %w"
+
+	if chamadoID == "" {
 		return utils.NewAppError(
-			"[usecase.AtualizarAtendimento]",
+			"[usecase.DevolverChamado]",
 			utils.LevelInfo,
-			"erro ao atualizar atendimento",
-			model.ErrAtendimentoIDInvalido,
+			"erro ao devolver chamado",
+			model.ErrChamadoIDInvalido,
 		)
 	}
 
-	if err := model.ValidarAtendimento(atendimento); err != nil {
-		return fmt.Errorf("[usecase.AtualizarAtendimento]: %w", err)
+	motivo := strings.TrimSpace(d.Motivo)
+	if motivo == "" {
+		return fmt.Errorf(metodo, model.ErrMotivoAtendimentoObrigatorio)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
 	}
 
-	if err := u.repository.Atualizar(ctx, id, atendimento); err != nil {
-		return fmt.Errorf("[usecase.AtualizarAtendimento]: %w", err)
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, chamadoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
 	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamadoID); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	permissao, err := u.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, model.OperacaoTransferir)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	// a transição é validada antes de finalizar o atendimento para que
+	// uma devolução recusada não deixe o chamado sem técnico
+	if err := model.ValidarTransicaoStatus(chamado.Status, model.StatusAberto, permissao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	ativo, err := u.repository.BuscarAtivo(ctx, chamadoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if ativo == nil {
+		return utils.NewAppError(
+			"[usecase.DevolverChamado]",
+			utils.LevelInfo,
+			"erro ao devolver chamado",
+			model.ErrChamadoSemAtendimentoAtivo,
+		)
+	}
+
+	if err := u.repository.Finalizar(ctx, ativo.ID, &usuarioID, motivo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.usecaseChamado.AtualizarStatusChamado(ctx, chamadoID, string(model.StatusAberto), nil); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
 	return nil
 }
 
-// ListarAtendimentos lista atendimentos com base em filtros.
+// ListarAtendimentos lista atendimentos dos chamados visíveis ao usuário autenticado com base em filtros.
 func (u *AtendimentoUsecase) ListarAtendimentos(ctx context.Context, filtro model.AtendimentoFiltro) ([]model.Atendimento, int, model.AtendimentoFiltro, error) {
+	escopo, err := ExtrairEscopoDoContexto(ctx)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarAtendimentos]: %w", err)
+	}
+	filtro.Escopo = escopo
+
 	if filtro.Pagina < 1 {
 		filtro.Pagina = 1
 	}
@@ -101,4 +307,4 @@ func (u *AtendimentoUsecase) ListarAtendimentos(ctx context.Context, filtro mode
 		return nil, 0, filtro, fmt.Errorf("[usecase.ListarAtendimentos]: %w", err)
 	}
 	return atendimentos, total, filtro, nil
-}
\ No newline at end of file
+}
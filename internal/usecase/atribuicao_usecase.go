@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// AtribuicaoUsecase representa a camada de caso de uso para a atribuição automática de chamados.
+type AtribuicaoUsecase struct {
+	repository          repository.AtribuicaoRepository
+	repositoryCategoria repository.CategoriaRepository
+}
+
+// NewAtribuicaoUsecase cria uma nova instância de AtribuicaoUsecase.
+func NewAtribuicaoUsecase(repository repository.AtribuicaoRepository, repositoryCategoria repository.CategoriaRepository) *AtribuicaoUsecase {
+	return &AtribuicaoUsecase{
+		repository:          repository,
+		repositoryCategoria: repositoryCategoria,
+	}
+}
+
+// SugerirTecnico aplica a estratégia de atribuição da categoria e retorna o técnico
+// escolhido, o motivo da escolha e os candidatos avaliados.
+func (u *AtribuicaoUsecase) SugerirTecnico(ctx context.Context, categoriaID string) (*model.SugestaoAtribuicao, error) {
+	const metodo = "[usecase.SugerirTecnico]: %w"
+
+	if categoriaID == "" {
+		return nil, utils.NewAppError(
+			"[usecase.SugerirTecnico]",
+			utils.LevelInfo,
+			"erro ao sugerir técnico para a categoria",
+			model.ErrCategoriaIDInvalido,
+		)
+	}
+
+	categoria, err := u.repositoryCategoria.BuscarPorID(ctx, categoriaID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	sugestao := &model.SugestaoAtribuicao{
+		CategoriaID: categoria.ID,
+		Estrategia:  categoria.EstrategiaAtribuicao,
+		Candidatos:  []model.CandidatoAtribuicao{},
+	}
+
+	// na atribuição manual não há por que consultar a carga dos técnicos
+	if categoria.EstrategiaAtribuicao != model.AtribuicaoManual {
+		sugestao.Candidatos, err = u.repository.ListarCandidatos(ctx, categoria.ID)
+		if err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
+	}
+
+	sugestao.Tecnico, sugestao.Motivo = model.EscolherTecnico(sugestao.Estrategia, sugestao.Candidatos)
+	return sugestao, nil
+}
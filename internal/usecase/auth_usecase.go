@@ -6,13 +6,13 @@ import (
 	"fmt"
 	"time"
 
-	gojwt "github.com/golang-jwt/jwt/v5"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/auth/jwt"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/config"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/interface/response"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
 )
 
 type authUsecase struct {
@@ -20,6 +20,8 @@ type authUsecase struct {
 	UsecaseJWT     jwt.JWTUsecase
 	UsecaseLDAP    usecase.AuthExternoUsecase
 	UsecaseLog     usecase.LogUsecase
+	UsecaseRevog   usecase.RevogacaoTokenUsecase
+	UsecaseSessao  usecase.ManterSessao
 	Config         config.Config
 }
 
@@ -28,10 +30,12 @@ func NewAuthInternoUsecase(
 	usecaseJWT jwt.JWTUsecase,
 	usecaseLDAP usecase.AuthExternoUsecase,
 	usecaseLog usecase.LogUsecase,
+	usecaseRevog usecase.RevogacaoTokenUsecase,
+	usecaseSessao usecase.ManterSessao,
 	config config.Config,
 ) usecase.AuthInternoUsecase {
 
-	return &authUsecase{usecaseUsuario, usecaseJWT, usecaseLDAP, usecaseLog, config}
+	return &authUsecase{usecaseUsuario, usecaseJWT, usecaseLDAP, usecaseLog, usecaseRevog, usecaseSessao, config}
 }
 
 // --- Auxiliares internos ---
@@ -90,13 +94,63 @@ func createClaims(u *model.Usuario) jwt.Claims {
 		Nome:      u.Nome,
 		Email:     u.Email,
 		Permissao: string(u.Permissao),
+		Papel:     u.PapelEfetivo(),
 	}
 }
 
+// emitirTokens gera o par de tokens da sessão. O jti do token de refresh é gerado aqui para
+// ser registrado na sessão, que só aceita o último token de refresh emitido.
+func (a *authUsecase) emitirTokens(u *model.Usuario, sessaoID string) (*response.TokenPair, string, error) {
+	const metodo = "[usecase.auth.emitirTokens]: %w"
+
+	claims := createClaims(u)
+	claims.Sessao = sessaoID
+
+	access, err := a.UsecaseJWT.GerarToken(claims)
+	if err != nil {
+		return nil, "", fmt.Errorf(metodo, err)
+	}
+
+	refreshJTI, err := utils.NewUUIDv7String()
+	if err != nil {
+		return nil, "", fmt.Errorf(metodo, err)
+	}
+
+	claims.RegisteredClaims.ID = refreshJTI
+	refresh, err := a.UsecaseJWT.GerarRefreshToken(claims)
+	if err != nil {
+		return nil, "", fmt.Errorf(metodo, err)
+	}
+
+	return &response.TokenPair{AccessToken: access, RefreshToken: refresh}, refreshJTI, nil
+}
+
+// iniciarSessao abre uma nova sessão para o usuário e retorna o seu par de tokens.
+func (a *authUsecase) iniciarSessao(ctx context.Context, u *model.Usuario, origem model.OrigemSessao) (*response.TokenPair, error) {
+	const metodo = "[usecase.auth.iniciarSessao]: %w"
+
+	sessaoID, err := utils.NewUUIDv7String()
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	tokens, refreshJTI, err := a.emitirTokens(u, sessaoID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	expiraEm := time.Now().Add(a.UsecaseJWT.ValidadeRefresh())
+	if err := a.UsecaseSessao.IniciarSessao(ctx, sessaoID, u.ID, refreshJTI, origem, expiraEm); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	return tokens, nil
+}
+
 // --- Implementações da interface ---
 
-// Login autentica o usuário e retorna um par de tokens (access e refresh).
-func (a *authUsecase) Login(ctx context.Context, login, senha string) (*response.TokenPair, error) {
+// Login autentica o usuário, abre uma nova sessão e retorna um par de tokens (access e refresh).
+func (a *authUsecase) Login(ctx context.Context, login, senha string, origem model.OrigemSessao) (*response.TokenPair, error) {
 	const metodo = "[usecase.auth.Login]: %w"
 
 	usuario, err := a.UsecaseUsuario.BuscarUsuarioPorLogin(ctx, login)
@@ -117,48 +171,73 @@ func (a *authUsecase) Login(ctx context.Context, login, senha string) (*response
 		return nil, fmt.Errorf(metodo, err)
 	}
 
+	if !usuario.Status {
+		return nil, fmt.Errorf(metodo, model.ErrUsuarioInativo)
+	}
+
 	_ = a.UsecaseUsuario.AtualizarUltimoLoginUsuario(ctx, usuario.ID)
 
-	claims := createClaims(usuario)
-	access, err := a.UsecaseJWT.GerarToken(claims)
-	if err != nil {
-		return nil, fmt.Errorf(metodo, err)
-	}
-	refresh, err := a.UsecaseJWT.GerarRefreshToken(claims)
+	tokens, err := a.iniciarSessao(ctx, usuario, origem)
 	if err != nil {
 		return nil, fmt.Errorf(metodo, err)
 	}
-
-	return &response.TokenPair{AccessToken: access, RefreshToken: refresh}, nil
+	return tokens, nil
 }
 
-// Refresh valida o refresh token e retorna um novo par de tokens (access e refresh).
-func (a *authUsecase) Refresh(ctx context.Context, refreshToken string) (*response.TokenPair, error) {
+// Refresh valida o refresh token e retorna um novo par de tokens (access e refresh). O token
+// apresentado é substituído na sessão e não pode ser usado de novo.
+func (a *authUsecase) Refresh(ctx context.Context, refreshToken string, origem model.OrigemSessao) (*response.TokenPair, error) {
 	const metodo = "[usecase.auth.Refresh]: %w"
 	claims, err := a.UsecaseJWT.ValidarRefreshToken(refreshToken)
 	if err != nil {
 		return nil, fmt.Errorf(metodo, err)
 	}
 
+	revogado, err := a.UsecaseRevog.TokenRevogado(ctx, claims.ID, claims.Sessao, claims.JTI(), claims.EmitidoEm())
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	if revogado {
+		return nil, fmt.Errorf(metodo, model.ErrTokenRevogado)
+	}
+
 	usuario, err := a.UsecaseUsuario.BuscarUsuarioPorID(ctx, claims.ID)
 	if err != nil || usuario == nil {
 		return nil, fmt.Errorf(metodo, err)
 	}
 
+	if !usuario.Status {
+		return nil, fmt.Errorf(metodo, model.ErrUsuarioInativo)
+	}
+
 	_ = a.UsecaseUsuario.AtualizarUltimoLoginUsuario(ctx, usuario.ID)
 
-	claims.RegisteredClaims.IssuedAt = gojwt.NewNumericDate(time.Now())
+	// Tokens emitidos antes das sessões: o token é revogado e substituído por uma nova sessão
+	if claims.Sessao == "" {
+		if err := a.UsecaseRevog.RevogarToken(ctx, usuario.ID, claims.JTI(), claims.ExpiresAt.Time); err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
 
-	access, err := a.UsecaseJWT.GerarToken(*claims)
+		tokens, err := a.iniciarSessao(ctx, usuario, origem)
+		if err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
+		return tokens, nil
+	}
+
+	// As claims são recriadas, pois a permissão e o papel podem ter sido alterados desde a emissão do token
+	tokens, refreshJTI, err := a.emitirTokens(usuario, claims.Sessao)
 	if err != nil {
 		return nil, fmt.Errorf(metodo, err)
 	}
-	refresh, err := a.UsecaseJWT.GerarRefreshToken(*claims)
+
+	expiraEm := time.Now().Add(a.UsecaseJWT.ValidadeRefresh())
+	err = a.UsecaseSessao.RotacionarSessao(ctx, claims.Sessao, usuario.ID, claims.JTI(), refreshJTI, origem, expiraEm)
 	if err != nil {
 		return nil, fmt.Errorf(metodo, err)
 	}
 
-	return &response.TokenPair{AccessToken: access, RefreshToken: refresh}, nil
+	return tokens, nil
 }
 
 // Me retorna os dados do usuário autenticado.
@@ -169,3 +248,45 @@ func (a *authUsecase) Me(ctx context.Context, userID string) (*response.UsuarioR
 	}
 	return response.ToUsuarioResponse(usuario), nil
 }
+
+// Logout encerra a sessão do token de acesso e revoga, quando informado, o token de
+// atualização, que deve pertencer ao mesmo usuário. Os tokens emitidos antes das sessões são
+// revogados individualmente.
+func (a *authUsecase) Logout(ctx context.Context, usuarioID, sessaoID, jti string, expiraEm time.Time, refreshToken string) error {
+	const metodo = "[usecase.auth.Logout]: %w"
+
+	if refreshToken != "" {
+		claims, err := a.UsecaseJWT.ValidarRefreshToken(refreshToken)
+		if err != nil {
+			return fmt.Errorf(metodo, fmt.Errorf(utils.FmtErroWrap, model.ErrRefreshInvalido, err))
+		}
+		if claims.ID != usuarioID {
+			return fmt.Errorf(metodo, model.ErrTokenOutroUsuario)
+		}
+
+		if err := a.UsecaseRevog.RevogarToken(ctx, usuarioID, claims.JTI(), claims.ExpiresAt.Time); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+	}
+
+	if sessaoID != "" {
+		if err := a.UsecaseRevog.RevogarSessao(ctx, usuarioID, sessaoID); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		return nil
+	}
+
+	if err := a.UsecaseRevog.RevogarToken(ctx, usuarioID, jti, expiraEm); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// LogoutTodasSessoes encerra todas as sessões e revoga todos os tokens de acesso e de
+// atualização emitidos para o usuário.
+func (a *authUsecase) LogoutTodasSessoes(ctx context.Context, usuarioID string) error {
+	if err := a.UsecaseRevog.RevogarTokensUsuario(ctx, usuarioID); err != nil {
+		return fmt.Errorf("[usecase.auth.LogoutTodasSessoes]: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// limiteCategoriasPorPagina limita as categorias lidas a cada consulta ao listar as
+// permissões efetivas.
+const limiteCategoriasPorPagina = 100
+
+// AutorizacaoUsecase representa a camada de caso de uso que autoriza as operações sobre os
+// chamados, combinando a permissão global do usuário com as permissões concedidas a ele em
+// cada categoria. Vale a maior das permissões: um usuário comum com permissão de técnico em
+// uma categoria atua como técnico nos chamados dessa categoria. Os usuários de papel
+// personalizado realizam as operações concedidas pelas permissões nomeadas do papel, somadas
+// às da permissão concedida na categoria.
+type AutorizacaoUsecase struct {
+	repository          repository.BuscarCategoriaPermissao
+	repositoryCategoria repository.ListarCategoria
+	usecasePapel        usecase.VerificarPermissaoPapel
+}
+
+// NewAutorizacaoUsecase cria uma nova instância de AutorizacaoUsecase.
+func NewAutorizacaoUsecase(
+	repository repository.BuscarCategoriaPermissao,
+	repositoryCategoria repository.ListarCategoria,
+	usecasePapel usecase.VerificarPermissaoPapel,
+) *AutorizacaoUsecase {
+	return &AutorizacaoUsecase{
+		repository:          repository,
+		repositoryCategoria: repositoryCategoria,
+		usecasePapel:        usecasePapel,
+	}
+}
+
+// Autorizar verifica se o usuário, com a permissão global e o papel informados, pode
+// realizar a operação nos chamados da categoria, e retorna a sua permissão efetiva na categoria.
+func (u *AutorizacaoUsecase) Autorizar(ctx context.Context, usuarioID string, permissao model.Permissao, papel, categoriaID string, operacao model.OperacaoChamado) (model.Permissao, error) {
+	const metodo = "[usecase.Autorizar]: %w"
+
+	if err := model.ValidarOperacao(operacao); err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	efetiva, err := u.permissaoEfetiva(ctx, usuarioID, permissao, categoriaID)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	permite, err := u.permiteOperacao(ctx, permissao, papel, efetiva, operacao)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	if !permite {
+		return "", utils.NewAppError(
+			"[usecase.Autorizar]",
+			utils.LevelInfo,
+			fmt.Sprintf("a permissão %s não permite a operação %s na categoria %s", efetiva, operacao, categoriaID),
+			model.ErrOperacaoNaoPermitida,
+		)
+	}
+	return efetiva, nil
+}
+
+// AutorizarOperacao aplica Autorizar ao usuário autenticado.
+func (u *AutorizacaoUsecase) AutorizarOperacao(ctx context.Context, categoriaID string, operacao model.OperacaoChamado) (model.Permissao, error) {
+	const metodo = "[usecase.AutorizarOperacao]: %w"
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	permissao, err := ExtrairPermissaoDoContexto(ctx)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	papel, err := ExtrairPapelDoContexto(ctx)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	efetiva, err := u.Autorizar(ctx, usuarioID, permissao, papel, categoriaID, operacao)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+	return efetiva, nil
+}
+
+// PermiteOperacaoNaCategoria indica se o usuário autenticado pode realizar a operação nos
+// chamados da categoria.
+func (u *AutorizacaoUsecase) PermiteOperacaoNaCategoria(ctx context.Context, categoriaID string, operacao model.OperacaoChamado) (bool, error) {
+	const metodo = "[usecase.PermiteOperacaoNaCategoria]: %w"
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return false, fmt.Errorf(metodo, err)
+	}
+
+	permissao, err := ExtrairPermissaoDoContexto(ctx)
+	if err != nil {
+		return false, fmt.Errorf(metodo, err)
+	}
+
+	papel, err := ExtrairPapelDoContexto(ctx)
+	if err != nil {
+		return false, fmt.Errorf(metodo, err)
+	}
+
+	efetiva, err := u.permissaoEfetiva(ctx, usuarioID, permissao, categoriaID)
+	if err != nil {
+		return false, fmt.Errorf(metodo, err)
+	}
+
+	permite, err := u.permiteOperacao(ctx, permissao, papel, efetiva, operacao)
+	if err != nil {
+		return false, fmt.Errorf(metodo, err)
+	}
+	return permite, nil
+}
+
+// PermissaoNaCategoria retorna a permissão efetiva do usuário autenticado na categoria.
+func (u *AutorizacaoUsecase) PermissaoNaCategoria(ctx context.Context, categoriaID string) (model.Permissao, error) {
+	const metodo = "[usecase.PermissaoNaCategoria]: %w"
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	permissao, err := ExtrairPermissaoDoContexto(ctx)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	efetiva, err := u.permissaoEfetiva(ctx, usuarioID, permissao, categoriaID)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+	return efetiva, nil
+}
+
+// ListarPermissoesEfetivas retorna a permissão efetiva do usuário autenticado em cada
+// categoria ativa e as operações que ela permite.
+func (u *AutorizacaoUsecase) ListarPermissoesEfetivas(ctx context.Context) (*model.PermissoesEfetivas, error) {
+	const metodo = "[usecase.ListarPermissoesEfetivas]: %w"
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	permissao, err := ExtrairPermissaoDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	papel, err := ExtrairPapelDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	concessoes, err := u.repository.ListarPorUsuario(ctx, usuarioID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	ativa := true
+	filtro := model.CategoriaFiltro{Pagina: 1, Limite: limiteCategoriasPorPagina, Status: &ativa}
+
+	permissoes := &model.PermissoesEfetivas{
+		UsuarioID:  usuarioID,
+		Permissao:  permissao,
+		Categorias: []model.PermissaoEfetivaCategoria{},
+	}
+	for {
+		categorias, total, err := u.repositoryCategoria.Listar(ctx, filtro)
+		if err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
+
+		for _, categoria := range categorias {
+			efetiva := permissao
+			if !model.PermissaoIrrestrita(permissao) {
+				efetiva = model.PermissaoEfetiva(permissao, categoria.ID, concessoes)
+			}
+
+			operacoes, err := u.operacoesPermitidas(ctx, permissao, papel, efetiva)
+			if err != nil {
+				return nil, fmt.Errorf(metodo, err)
+			}
+			permissoes.Categorias = append(permissoes.Categorias, model.PermissaoEfetivaCategoria{
+				CategoriaID: categoria.ID,
+				Categoria:   categoria.Nome,
+				Permissao:   efetiva,
+				Concedida:   efetiva != permissao,
+				Operacoes:   operacoes,
+			})
+		}
+
+		if len(categorias) == 0 || filtro.Pagina*filtro.Limite >= total {
+			break
+		}
+		filtro.Pagina++
+	}
+
+	return permissoes, nil
+}
+
+// Metodos auxiliares
+
+// permissaoEfetiva retorna a maior entre a permissão global e as permissões concedidas ao
+// usuário na categoria. As permissões irrestritas dispensam a consulta às concessões.
+func (u *AutorizacaoUsecase) permissaoEfetiva(ctx context.Context, usuarioID string, permissao model.Permissao, categoriaID string) (model.Permissao, error) {
+	if model.PermissaoIrrestrita(permissao) {
+		return permissao, nil
+	}
+
+	concessoes, err := u.repository.ListarPorUsuario(ctx, usuarioID)
+	if err != nil {
+		return "", fmt.Errorf("[usecase.permissaoEfetiva]: %w", err)
+	}
+	return model.PermissaoEfetiva(permissao, categoriaID, concessoes), nil
+}
+
+// permiteOperacao indica se o usuário, com a permissão global, o papel e a permissão efetiva
+// na categoria informados, pode realizar a operação. Sem papel personalizado, vale a permissão
+// efetiva; com ele, valem as permissões nomeadas do papel, somadas às da permissão concedida
+// na categoria.
+func (u *AutorizacaoUsecase) permiteOperacao(ctx context.Context, permissao model.Permissao, papel string, efetiva model.Permissao, operacao model.OperacaoChamado) (bool, error) {
+	if !model.PapelPersonalizado(papel) {
+		return model.PermiteOperacao(efetiva, operacao), nil
+	}
+
+	if efetiva != permissao && model.PermiteOperacao(efetiva, operacao) {
+		return true, nil
+	}
+
+	possui, err := u.usecasePapel.PapelPossuiPermissao(ctx, papel, model.PermissaoNomeadaDaOperacao(operacao))
+	if err != nil {
+		return false, fmt.Errorf("[usecase.permiteOperacao]: %w", err)
+	}
+	return possui, nil
+}
+
+// operacoesPermitidas retorna, na ordem de apresentação, as operações que o usuário pode
+// realizar com a permissão efetiva na categoria.
+func (u *AutorizacaoUsecase) operacoesPermitidas(ctx context.Context, permissao model.Permissao, papel string, efetiva model.Permissao) ([]model.OperacaoChamado, error) {
+	if !model.PapelPersonalizado(papel) {
+		return model.OperacoesPermitidas(efetiva), nil
+	}
+
+	operacoes := []model.OperacaoChamado{}
+	for _, operacao := range model.OperacoesChamado() {
+		permite, err := u.permiteOperacao(ctx, permissao, papel, efetiva, operacao)
+		if err != nil {
+			return nil, fmt.Errorf("[usecase.operacoesPermitidas]: %w", err)
+		}
+		if permite {
+			operacoes = append(operacoes, operacao)
+		}
+	}
+	return operacoes, nil
+}
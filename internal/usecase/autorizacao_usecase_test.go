@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+)
+
+// concessoesFake devolve as permissões concedidas ao usuário nas categorias.
+type concessoesFake struct {
+	concessoes []model.CategoriaPermissao
+}
+
+func (r *concessoesFake) ListarPorUsuario(_ context.Context, _ string) ([]model.CategoriaPermissao, error) {
+	return r.concessoes, nil
+}
+
+// papeisFake guarda as permissões nomeadas de cada papel em memória.
+type papeisFake struct {
+	permissoes map[string][]model.PermissaoNomeada
+}
+
+func (p *papeisFake) PapelPossuiPermissao(_ context.Context, codigo string, permissao model.PermissaoNomeada) (bool, error) {
+	for _, concedida := range p.permissoes[codigo] {
+		if concedida == permissao {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestAutorizacaoUsecaseAutorizar(t *testing.T) {
+	concessoes := &concessoesFake{concessoes: []model.CategoriaPermissao{
+		{UsuarioID: "usr-1", CategoriaID: "cat-tec", Permissao: model.PermTEC},
+	}}
+	papeis := &papeisFake{permissoes: map[string][]model.PermissaoNomeada{
+		"USR":         {model.PermissaoChamadoArquivar, model.PermissaoAtendimentoTransferir},
+		"TRIAGEM":     {model.PermissaoChamadoArquivar, model.PermissaoAcompanhamentoNotaInterna},
+		"SOLICITANTE": {model.PermissaoAcompanhamentoCriar},
+	}}
+	u := NewAutorizacaoUsecase(concessoes, nil, papeis)
+
+	casos := []struct {
+		nome        string
+		permissao   model.Permissao
+		papel       string
+		categoriaID string
+		operacao    model.OperacaoChamado
+		esperado    bool
+	}{
+		{"papel do sistema segue a permissão", model.PermUSR, "USR", "cat-outra", model.OperacaoArquivar, false},
+		{"token sem papel segue a permissão", model.PermUSR, "", "cat-outra", model.OperacaoTransferir, false},
+		{"papel do sistema com concessão na categoria", model.PermUSR, "USR", "cat-tec", model.OperacaoArquivar, true},
+		{"papel personalizado concede a operação", model.PermUSR, "TRIAGEM", "cat-outra", model.OperacaoArquivar, true},
+		{"papel personalizado concede a nota interna", model.PermUSR, "TRIAGEM", "cat-outra", model.OperacaoNotaInterna, true},
+		{"papel personalizado não concede a operação", model.PermUSR, "SOLICITANTE", "cat-outra", model.OperacaoAtualizar, false},
+		{"concessão na categoria soma-se ao papel personalizado", model.PermUSR, "SOLICITANTE", "cat-tec", model.OperacaoTransferir, true},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			_, err := u.Autorizar(context.Background(), "usr-1", c.permissao, c.papel, c.categoriaID, c.operacao)
+			if c.esperado && err != nil {
+				t.Errorf("Autorizar = %v, esperado nil", err)
+			}
+			if !c.esperado && !errors.Is(err, model.ErrOperacaoNaoPermitida) {
+				t.Errorf("Autorizar = %v, esperado %v", err, model.ErrOperacaoNaoPermitida)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// AvaliacaoUsecase representa a camada de caso de uso da pesquisa de satisfação dos chamados.
+type AvaliacaoUsecase struct {
+	repository            repository.AvaliacaoRepository
+	repositoryChamado     repository.ChamadoRepository
+	repositoryAtendimento repository.AtendimentoRepository
+	usecaseChamado        usecase.AtualizarChamado
+}
+
+// NewAvaliacaoUsecase cria uma nova instância de AvaliacaoUsecase.
+func NewAvaliacaoUsecase(
+	repository repository.AvaliacaoRepository,
+	repositoryChamado repository.ChamadoRepository,
+	repositoryAtendimento repository.AtendimentoRepository,
+	usecaseChamado usecase.AtualizarChamado,
+) *AvaliacaoUsecase {
+	return &AvaliacaoUsecase{
+		repository:            repository,
+		repositoryChamado:     repositoryChamado,
+		repositoryAtendimento: repositoryAtendimento,
+		usecaseChamado:        usecaseChamado,
+	}
+}
+
+// ResponderAvaliacao registra a avaliação do criador do chamado resolvido. Aceitar a
+// solução fecha o chamado; rejeitá-la reabre o chamado com o técnico atual, tendo o
+// comentário como motivo da reabertura.
+func (u *AvaliacaoUsecase) ResponderAvaliacao(ctx context.Context, chamadoID string, resposta *model.RespostaAvaliacao) (*model.Avaliacao, error) {
+	const metodo = "[usecase.ResponderAvaliacao]"
+
+	if chamadoID == "" {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"o ID do chamado é obrigatório para responder a avaliação",
+			model.ErrChamadoIDInvalido,
+		)
+	}
+
+	if err := model.ValidarRespostaAvaliacao(resposta); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if chamado.CriadorID != usuarioID {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			"a avaliação pertence ao criador do chamado",
+			model.ErrAvaliacaoDeOutroUsuario,
+		)
+	}
+	if chamado.Status != model.StatusResolvido {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			fmt.Sprintf("o chamado está com o status %s", chamado.Status),
+			model.ErrChamadoNaoResolvido,
+		)
+	}
+
+	ativo, err := u.repositoryAtendimento.BuscarAtivo(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	var tecnicoID *string
+	if ativo != nil {
+		tecnicoID = &ativo.AtribuidoID
+	}
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	avaliacao, err := model.NewAvaliacao(id, chamadoID, usuarioID, tecnicoID, resposta)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if err := u.repository.Salvar(ctx, avaliacao); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if avaliacao.SolucaoAceita {
+		if err := u.usecaseChamado.AtualizarStatusChamado(ctx, chamadoID, string(model.StatusFechado), nil); err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		return avaliacao, nil
+	}
+
+	reabertura := &model.Reabertura{Motivo: "solução rejeitada na avaliação: " + *avaliacao.Comentario}
+	if err := u.usecaseChamado.ReabrirChamado(ctx, chamadoID, reabertura); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	return avaliacao, nil
+}
+
+// BuscarAvaliacoesPorChamado retorna as avaliações do chamado, desde que seja visível ao usuário autenticado.
+func (u *AvaliacaoUsecase) BuscarAvaliacoesPorChamado(ctx context.Context, chamadoID string) ([]model.Avaliacao, error) {
+	if chamadoID == "" {
+		return nil, utils.NewAppError(
+			"[usecase.BuscarAvaliacoesPorChamado]",
+			utils.LevelInfo,
+			"erro ao buscar avaliações por chamado",
+			model.ErrChamadoIDInvalido,
+		)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamadoID); err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarAvaliacoesPorChamado]: %w", err)
+	}
+
+	avaliacoes, err := u.repository.ListarPorChamado(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarAvaliacoesPorChamado]: %w", err)
+	}
+	return avaliacoes, nil
+}
+
+// AgregarCSAT calcula a satisfação dos usuários agrupada por técnico, categoria ou mês.
+func (u *AvaliacaoUsecase) AgregarCSAT(ctx context.Context, filtro model.CSATFiltro) ([]model.TotalCSAT, error) {
+	if filtro.AgruparPor == "" {
+		filtro.AgruparPor = model.CSATPorTecnico
+	}
+
+	if err := model.ValidarAgrupamentoCSAT(filtro.AgruparPor); err != nil {
+		return nil, fmt.Errorf("[usecase.AgregarCSAT]: %w", err)
+	}
+
+	totais, err := u.repository.AgregarCSAT(ctx, filtro)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.AgregarCSAT]: %w", err)
+	}
+
+	for i := range totais {
+		totais[i].CalcularCSAT()
+	}
+	return totais, nil
+}
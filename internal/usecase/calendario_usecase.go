@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// CalendarioUsecase representa a camada de caso de uso para operações relacionadas a calendários.
+type CalendarioUsecase struct {
+	repository repository.CalendarioRepository
+}
+
+// NewCalendarioUsecase cria uma nova instância de CalendarioUsecase.
+func NewCalendarioUsecase(repository repository.CalendarioRepository) *CalendarioUsecase {
+	return &CalendarioUsecase{repository: repository}
+}
+
+// BuscarCalendarioPorID busca um calendário pelo seu ID.
+func (u *CalendarioUsecase) BuscarCalendarioPorID(ctx context.Context, id string) (*model.Calendario, error) {
+	if id == "" {
+		return nil, utils.NewAppError(
+			"[usecase.BuscarCalendarioPorID]",
+			utils.LevelInfo,
+			"erro ao buscar calendário por id",
+			model.ErrCalendarioIDInvalido,
+		)
+	}
+
+	calendario, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarCalendarioPorID]: %w", err)
+	}
+	return calendario, nil
+}
+
+// CriarCalendario cria um novo calendário.
+func (u *CalendarioUsecase) CriarCalendario(ctx context.Context, calendario *model.Calendario) error {
+	const metodo = "[usecase.CriarCalendario]: %w"
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	novo, err := model.NewCalendario(
+		id,
+		calendario.Nome,
+		calendario.CategoriaID,
+		true,
+		calendario.Expedientes,
+	)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.Salvar(ctx, novo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	*calendario = *novo
+	return nil
+}
+
+// AtualizarCalendario atualiza as informações de um calendário existente.
+func (u *CalendarioUsecase) AtualizarCalendario(ctx context.Context, id string, calendario *model.Calendario) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.AtualizarCalendario]",
+			utils.LevelInfo,
+			"erro ao atualizar calendário",
+			model.ErrCalendarioIDInvalido,
+		)
+	}
+
+	if err := model.ValidarCalendario(calendario); err != nil {
+		return fmt.Errorf("[usecase.AtualizarCalendario]: %w", err)
+	}
+
+	if err := u.repository.Atualizar(ctx, id, calendario); err != nil {
+		return fmt.Errorf("[usecase.AtualizarCalendario]: %w", err)
+	}
+	return nil
+}
+
+// AtivarCalendario ativa um calendário.
+func (u *CalendarioUsecase) AtivarCalendario(ctx context.Context, id string) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.AtivarCalendario]",
+			utils.LevelInfo,
+			"erro ao ativar calendário",
+			model.ErrCalendarioIDInvalido,
+		)
+	}
+
+	if err := u.repository.Ativar(ctx, id); err != nil {
+		return fmt.Errorf("[usecase.AtivarCalendario]: %w", err)
+	}
+	return nil
+}
+
+// DesativarCalendario desativa um calendário.
+func (u *CalendarioUsecase) DesativarCalendario(ctx context.Context, id string) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.DesativarCalendario]",
+			utils.LevelInfo,
+			"erro ao desativar calendário",
+			model.ErrCalendarioIDInvalido,
+		)
+	}
+
+	if err := u.repository.Desativar(ctx, id); err != nil {
+		return fmt.Errorf("[usecase.DesativarCalendario]: %w", err)
+	}
+	return nil
+}
+
+// ImportarFeriados inclui ou atualiza os feriados informados no calendário.
+// As datas são normalizadas para o formato AAAA-MM-DD antes de serem salvas.
+func (u *CalendarioUsecase) ImportarFeriados(ctx context.Context, calendarioID string, feriados []model.Feriado) (int, error) {
+	const metodo = "[usecase.ImportarFeriados]: %w"
+
+	if calendarioID == "" {
+		return 0, utils.NewAppError(
+			"[usecase.ImportarFeriados]",
+			utils.LevelInfo,
+			"erro ao importar feriados",
+			model.ErrCalendarioIDInvalido,
+		)
+	}
+
+	normalizados := make([]model.Feriado, 0, len(feriados))
+	for _, f := range feriados {
+		feriado, err := model.NewFeriado(f.Data, f.Descricao)
+		if err != nil {
+			return 0, fmt.Errorf(metodo, err)
+		}
+		normalizados = append(normalizados, *feriado)
+	}
+
+	total, err := u.repository.SalvarFeriados(ctx, calendarioID, normalizados)
+	if err != nil {
+		return 0, fmt.Errorf(metodo, err)
+	}
+	return total, nil
+}
+
+// RemoverFeriado remove um feriado do calendário.
+func (u *CalendarioUsecase) RemoverFeriado(ctx context.Context, calendarioID, data string) error {
+	const metodo = "[usecase.RemoverFeriado]: %w"
+
+	if calendarioID == "" {
+		return utils.NewAppError(
+			"[usecase.RemoverFeriado]",
+			utils.LevelInfo,
+			"erro ao remover feriado",
+			model.ErrCalendarioIDInvalido,
+		)
+	}
+
+	feriado, err := model.NewFeriado(data, "")
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.RemoverFeriado(ctx, calendarioID, feriado.Data); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// CalcularTempoUtil calcula o tempo de expediente entre dois instantes usando o
+// calendário vigente para a categoria. Sem calendário ativo, considera o tempo corrido.
+func (u *CalendarioUsecase) CalcularTempoUtil(ctx context.Context, categoriaID string, inicio, fim time.Time) (time.Duration, error) {
+	calendario, err := u.repository.BuscarVigente(ctx, categoriaID)
+	if err != nil {
+		return 0, fmt.Errorf("[usecase.CalcularTempoUtil]: %w", err)
+	}
+	return calendario.TempoUtil(inicio, fim), nil
+}
+
+// ListarCalendarios lista calendários com paginação e filtros opcionais.
+func (u *CalendarioUsecase) ListarCalendarios(ctx context.Context, filtro model.CalendarioFiltro) ([]model.Calendario, int, model.CalendarioFiltro, error) {
+	if filtro.Pagina <= 0 {
+		filtro.Pagina = 1
+	}
+
+	if filtro.Limite <= 0 || filtro.Limite > 100 {
+		filtro.Limite = 10
+	}
+
+	calendarios, total, err := u.repository.Listar(ctx, filtro)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarCalendarios]: %w", err)
+	}
+
+	return calendarios, total, filtro, nil
+}
@@ -71,6 +71,7 @@ func (c *CategoriaUsecase) CriarCategoria(ctx context.Context, categoria *model.
 		categoria.ID,
 		categoria.Nome,
 		categoria.Status,
+		categoria.EstrategiaAtribuicao,
 	)
 	if err != nil {
 		return fmt.Errorf(metodo, err)
@@ -93,6 +94,13 @@ func (c *CategoriaUsecase) AtualizarCategoria(ctx context.Context, id string, ca
 		)
 	}
 
+	if categoria.EstrategiaAtribuicao == "" {
+		categoria.EstrategiaAtribuicao = model.AtribuicaoManual
+	}
+	if err := model.ValidarEstrategiaAtribuicao(categoria.EstrategiaAtribuicao); err != nil {
+		return fmt.Errorf("[usecase.AtualizarCategoria]: %w", err)
+	}
+
 	if err := c.repository.Atualizar(ctx, id, categoria); err != nil {
 		return fmt.Errorf("[usecase.AtualizarCategoria]: %w", err)
 	}
@@ -2,24 +2,93 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
 )
 
+const (
+	// entidadeSLA identifica os registros de log referentes a violações de SLA.
+	entidadeSLA = "SLA"
+	// entidadeAtendimento identifica os registros de log referentes a atribuições automáticas.
+	entidadeAtendimento = "ATENDIMENTO"
+	// entidadeChamado identifica os registros de log referentes a fechamentos e arquivamentos automáticos.
+	entidadeChamado = "CHAMADO"
+)
+
+// statusRespostaAoUsuario são os status que, aplicados pela equipe técnica,
+// contam como primeira resposta ao chamado.
+var statusRespostaAoUsuario = map[model.StatusChamado]struct{}{
+	model.StatusAguardando: {},
+	model.StatusResolvido:  {},
+	model.StatusRejeitado:  {},
+}
+
+// statusSemTecnico são os status em que o chamado deixa de estar com um técnico,
+// encerrando o atendimento vigente.
+var statusSemTecnico = map[model.StatusChamado]struct{}{
+	model.StatusAberto:    {},
+	model.StatusRejeitado: {},
+	model.StatusFechado:   {},
+}
+
 // ChamadoUsecase representa a camada de caso de uso para operações relacionadas a chamados.
 type ChamadoUsecase struct {
-	repository repository.ChamadoRepository
+	repository               repository.ChamadoRepository
+	repositorySLA            repository.PoliticaSLARepository
+	repositoryCalendario     repository.CalendarioRepository
+	repositoryMatriz         repository.MatrizPrioridadeRepository
+	repositoryAtendimento    repository.AtendimentoRepository
+	repositoryAvaliacao      repository.AvaliacaoRepository
+	repositoryAcompanhamento repository.AcompanhamentoRepository
+	transacao                repository.UnidadeDeTrabalho // transação de cada chamado nas rotinas automáticas
+	usecaseAtribuicao        usecase.AtribuicaoUsecase
+	usecaseAutorizacao       usecase.AutorizarOperacao
+	usecaseLog               usecase.LogUsecase
+	usecaseEvento            usecase.PublicarEvento
+	prazoReabertura          time.Duration // prazo após a solução em que o chamado pode ser reaberto
 }
 
 // NewChamadoUsecase cria uma nova instância de ChamadoUsecase.
-func NewChamadoUsecase(repository repository.ChamadoRepository) *ChamadoUsecase {
-	return &ChamadoUsecase{repository: repository}
+func NewChamadoUsecase(
+	repository repository.ChamadoRepository,
+	repositorySLA repository.PoliticaSLARepository,
+	repositoryCalendario repository.CalendarioRepository,
+	repositoryMatriz repository.MatrizPrioridadeRepository,
+	repositoryAtendimento repository.AtendimentoRepository,
+	repositoryAvaliacao repository.AvaliacaoRepository,
+	repositoryAcompanhamento repository.AcompanhamentoRepository,
+	transacao repository.UnidadeDeTrabalho,
+	usecaseAtribuicao usecase.AtribuicaoUsecase,
+	usecaseAutorizacao usecase.AutorizarOperacao,
+	usecaseLog usecase.LogUsecase,
+	usecaseEvento usecase.PublicarEvento,
+	prazoReabertura time.Duration,
+) *ChamadoUsecase {
+	return &ChamadoUsecase{
+		repository:               repository,
+		repositorySLA:            repositorySLA,
+		repositoryCalendario:     repositoryCalendario,
+		repositoryMatriz:         repositoryMatriz,
+		repositoryAtendimento:    repositoryAtendimento,
+		repositoryAvaliacao:      repositoryAvaliacao,
+		repositoryAcompanhamento: repositoryAcompanhamento,
+		transacao:                transacao,
+		usecaseAtribuicao:        usecaseAtribuicao,
+		usecaseAutorizacao:       usecaseAutorizacao,
+		usecaseLog:               usecaseLog,
+		usecaseEvento:            usecaseEvento,
+		prazoReabertura:          prazoReabertura,
+	}
 }
 
-// BuscarChamadoPorID busca um chamado pelo seu ID.
+// BuscarChamadoPorID busca um chamado pelo seu ID, desde que seja visível ao usuário autenticado.
 func (c *ChamadoUsecase) BuscarChamadoPorID(ctx context.Context, id string) (*model.Chamado, error) {
 	if id == "" {
 		return nil, utils.NewAppError(
@@ -30,31 +99,49 @@ func (c *ChamadoUsecase) BuscarChamadoPorID(ctx context.Context, id string) (*mo
 		)
 	}
 
-	chamado, err := c.repository.BuscarPorID(ctx, id)
+	chamado, err := c.buscarVisivel(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarChamadoPorID]: %w", err)
+	}
+
+	if err := c.calcularTempos(ctx, chamado); err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarChamadoPorID]: %w", err)
+	}
+
+	chamado.Atendimentos, err = c.repositoryAtendimento.ListarPorChamado(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarChamadoPorID]: %w", err)
+	}
+
+	chamado.Avaliacoes, err = c.repositoryAvaliacao.ListarPorChamado(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("[usecase.BuscarChamadoPorID]: %w", err)
 	}
 	return chamado, nil
 }
 
-// CriarChamado cria um novo chamado.
+// CriarChamado cria um novo chamado em nome do usuário autenticado, que é sempre o seu criador.
+// Todo chamado nasce aberto e fora do arquivo; o status só muda por AtualizarStatusChamado.
 func (c *ChamadoUsecase) CriarChamado(ctx context.Context, chamado *model.Chamado) error {
 	const metodo = "[usecase.CriarChamado]: %w"
 
+	criadorID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
 	id, err := utils.NewUUIDv7String()
 	if err != nil {
 		return fmt.Errorf(metodo, err)
 	}
 
 	chamado.ID = id
+	chamado.CriadorID = criadorID
 
-	if chamado.Status == "" {
-		chamado.Status = model.StatusAberto
-	}
-
+	chamado.Status = model.StatusAberto
 	chamado.Arquivado = false
 
-	chamado, err = model.NewChamado(
+	novo, err := model.NewChamado(
 		chamado.ID,
 		chamado.Titulo,
 		chamado.Descricao,
@@ -68,15 +155,64 @@ func (c *ChamadoUsecase) CriarChamado(ctx context.Context, chamado *model.Chamad
 		return fmt.Errorf(metodo, err)
 	}
 
-	if err := c.repository.Salvar(ctx, chamado); err != nil {
+	matriz, err := c.repositoryMatriz.Buscar(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if err := novo.AplicarPrioridade(model.ImpactoPadrao, model.UrgenciaPadrao, matriz); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	politica, err := c.repositorySLA.BuscarVigente(ctx, novo.CategoriaID, novo.SubcategoriaID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if politica != nil {
+		calendario, err := c.repositoryCalendario.BuscarVigente(ctx, novo.CategoriaID)
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		novo.AplicarPoliticaSLA(politica, calendario, novo.CriadoEm)
+	}
+
+	if err := c.repository.Salvar(ctx, novo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if err := c.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoChamadoCriado, novo, false, nil)); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.atribuirAutomaticamente(ctx, novo); err != nil {
 		return fmt.Errorf(metodo, err)
 	}
 
+	*chamado = *novo
 	return nil
 }
 
 // AtualizarChamado atualiza um chamado existente.
+// O status não é alterado por aqui, apenas por AtualizarStatusChamado.
 func (c *ChamadoUsecase) AtualizarChamado(ctx context.Context, id string, chamado *model.Chamado) error {
+	atual, err := c.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf("[usecase.AtualizarChamado]: %w", err)
+	}
+	chamado.Status = atual.Status
+
+	// a mudança de categoria exige a autorização também na categoria de destino
+	categorias := []string{atual.CategoriaID}
+	if chamado.CategoriaID != "" && chamado.CategoriaID != atual.CategoriaID {
+		categorias = append(categorias, chamado.CategoriaID)
+	}
+	if _, err := c.autorizarNoChamado(ctx, atual, model.OperacaoAtualizar); err != nil {
+		return fmt.Errorf("[usecase.AtualizarChamado]: %w", err)
+	}
+	for _, categoriaID := range categorias[1:] {
+		if _, err := c.usecaseAutorizacao.AutorizarOperacao(ctx, categoriaID, model.OperacaoAtualizar); err != nil {
+			return fmt.Errorf("[usecase.AtualizarChamado]: %w", err)
+		}
+	}
+
 	if err := model.ValidarChamado(chamado); err != nil {
 		return fmt.Errorf("[usecase.AtualizarChamado] %w", err)
 	}
@@ -99,6 +235,15 @@ func (c *ChamadoUsecase) ArquivarChamado(ctx context.Context, id string) error {
 		)
 	}
 
+	chamado, err := c.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf("[usecase.ArquivarChamado]: %w", err)
+	}
+
+	if _, err := c.autorizarNoChamado(ctx, chamado, model.OperacaoArquivar); err != nil {
+		return fmt.Errorf("[usecase.ArquivarChamado]: %w", err)
+	}
+
 	if err := c.repository.Arquivar(ctx, id); err != nil {
 		return fmt.Errorf("[usecase.ArquivarChamado]: %w", err)
 	}
@@ -117,6 +262,15 @@ func (c *ChamadoUsecase) DesarquivarChamado(ctx context.Context, id string) erro
 		)
 	}
 
+	chamado, err := c.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf("[usecase.DesarquivarChamado]: %w", err)
+	}
+
+	if _, err := c.autorizarNoChamado(ctx, chamado, model.OperacaoArquivar); err != nil {
+		return fmt.Errorf("[usecase.DesarquivarChamado]: %w", err)
+	}
+
 	if err := c.repository.Desarquivar(ctx, id); err != nil {
 		return fmt.Errorf("[usecase.DesarquivarChamado]: %w", err)
 	}
@@ -124,8 +278,12 @@ func (c *ChamadoUsecase) DesarquivarChamado(ctx context.Context, id string) erro
 	return nil
 }
 
-// AtualizarStatusChamado atualiza o status de um chamado existente.
+// AtualizarStatusChamado atualiza o status de um chamado existente, respeitando
+// as transições permitidas para o status atual e a permissão do usuário. Se o status
+// mudar entre a leitura e a gravação, a transição é recusada.
 func (c *ChamadoUsecase) AtualizarStatusChamado(ctx context.Context, id string, status string, solucao *string) error {
+	const metodo = "[usecase.AtualizarStatusChamado]: %w"
+
 	if id == "" {
 		return utils.NewAppError(
 			"[usecase.AtualizarStatusChamado]",
@@ -135,19 +293,310 @@ func (c *ChamadoUsecase) AtualizarStatusChamado(ctx context.Context, id string,
 		)
 	}
 
-	if err := model.ValidarStatusChamado(model.StatusChamado(status)); err != nil {
-		return fmt.Errorf("[usecase.AtualizarStatusChamado]: %w", err)
+	destino := model.StatusChamado(status)
+	if err := model.ValidarStatusChamado(destino); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	chamado, err := c.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	// as transições permitidas dependem da permissão efetiva na categoria do chamado
+	permissao, err := c.autorizarNoChamado(ctx, chamado, model.OperacaoAlterarStatus)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := model.ValidarTransicaoStatus(chamado.Status, destino, permissao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if destino == model.StatusResolvido {
+		if solucao == nil || strings.TrimSpace(*solucao) == "" {
+			return fmt.Errorf(metodo, model.ErrSolucaoObrigatoria)
+		}
+	} else {
+		// a solução só é registrada na resolução do chamado
+		solucao = nil
+	}
+
+	if err := c.repository.AtualizarStatus(ctx, id, string(chamado.Status), status, solucao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	calendario, err := c.repositoryCalendario.BuscarVigente(ctx, chamado.CategoriaID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.atualizarTempoAtribuido(ctx, chamado, destino, calendario); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.finalizarAtendimento(ctx, chamado.ID, destino); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	anterior := chamado.Status
+	if err := c.atualizarSLATransicao(ctx, chamado, destino, permissao, calendario); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.publicarAlteracaoStatus(ctx, chamado, anterior, destino); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// AtualizarPrioridadeChamado altera o impacto e a urgência de um chamado e deriva a
+// nova prioridade pela matriz de prioridade. A alteração é preenchida com a
+// prioridade anterior e a nova, para fins de registro.
+func (c *ChamadoUsecase) AtualizarPrioridadeChamado(ctx context.Context, id string, alteracao *model.AlteracaoPrioridade) error {
+	const metodo = "[usecase.AtualizarPrioridadeChamado]: %w"
+
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.AtualizarPrioridadeChamado]",
+			utils.LevelInfo,
+			"erro ao atualizar prioridade do chamado",
+			model.ErrIDInvalido,
+		)
+	}
+
+	if err := model.ValidarAlteracaoPrioridade(alteracao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	chamado, err := c.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if _, err := c.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, model.OperacaoAlterarPrioridade); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	matriz, err := c.repositoryMatriz.Buscar(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	alteracao.ChamadoID = id
+	alteracao.PrioridadeAnterior = chamado.Prioridade
+
+	if err := chamado.AplicarPrioridade(alteracao.Impacto, alteracao.Urgencia, matriz); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	alteracao.Prioridade = chamado.Prioridade
+
+	if err := c.repository.AtualizarPrioridade(ctx, id, chamado.Impacto, chamado.Urgencia, chamado.Prioridade); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	return nil
+}
+
+// ReabrirChamado reabre um chamado resolvido ou fechado dentro do prazo de reabertura.
+// O motivo é registrado como acompanhamento e o chamado volta ATRIBUIDO ao último
+// técnico que o atendeu; sem atendimento anterior, o chamado volta para a fila.
+// A reabertura é preenchida com o status anterior e o técnico, para fins de registro.
+func (c *ChamadoUsecase) ReabrirChamado(ctx context.Context, id string, reabertura *model.Reabertura) error {
+	const metodo = "[usecase.ReabrirChamado]: %w"
+
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.ReabrirChamado]",
+			utils.LevelInfo,
+			"erro ao reabrir chamado",
+			model.ErrIDInvalido,
+		)
+	}
+
+	if err := model.ValidarReabertura(reabertura); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	chamado, err := c.buscarVisivel(ctx, id)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	permissao, err := c.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, model.OperacaoReabrir)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if permissao == model.PermUSR && chamado.CriadorID != usuarioID {
+		return utils.NewAppError(
+			"[usecase.ReabrirChamado]",
+			utils.LevelInfo,
+			"o usuário não é o criador do chamado",
+			model.ErrReaberturaDeOutroUsuario,
+		)
+	}
+
+	if err := chamado.PodeSerReaberto(c.prazoReabertura, time.Now()); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	// chamados resolvidos mantêm o atendimento vigente; no fechamento ele é
+	// finalizado e o último técnico é recuperado da linha do tempo
+	ativo, err := c.repositoryAtendimento.BuscarAtivo(ctx, id)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	var tecnicoID *string
+	if ativo != nil {
+		tecnicoID = &ativo.AtribuidoID
+	} else {
+		historico, err := c.repositoryAtendimento.ListarPorChamado(ctx, id)
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		if len(historico) > 0 {
+			tecnicoID = &historico[len(historico)-1].AtribuidoID
+		}
+	}
+
+	destino := model.StatusAtribuido
+	if tecnicoID == nil {
+		destino = model.StatusAberto
+	}
+
+	if err := c.repository.Reabrir(ctx, id, destino); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if ativo == nil && tecnicoID != nil {
+		atendimentoID, err := utils.NewUUIDv7String()
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+
+		atendimento, err := model.NewAtendimento(atendimentoID, *tecnicoID, id)
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		motivo := "reabertura do chamado: " + strings.TrimSpace(reabertura.Motivo)
+		atendimento.AtribuidoPorID = &usuarioID
+		atendimento.Motivo = &motivo
+
+		if err := c.repositoryAtendimento.Salvar(ctx, atendimento); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		if err := c.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoChamadoAtribuido, chamado, false, atendimento)); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+	}
+
+	acompanhamentoID, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	acompanhamento, err := model.NewAcompanhamento(
+		acompanhamentoID,
+		id,
+		usuarioID,
+		"Chamado reaberto: "+strings.TrimSpace(reabertura.Motivo),
+		permissao,
+		model.VisibilidadePublico,
+	)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.repositoryAcompanhamento.Salvar(ctx, acompanhamento); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if err := c.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoAcompanhamentoCriado, chamado, false, acompanhamento)); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	calendario, err := c.repositoryCalendario.BuscarVigente(ctx, chamado.CategoriaID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.atualizarTempoAtribuido(ctx, chamado, destino, calendario); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.atualizarSLATransicao(ctx, chamado, destino, permissao, calendario); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if err := c.publicarAlteracaoStatus(ctx, chamado, chamado.Status, destino); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	reabertura.ChamadoID = id
+	reabertura.StatusAnterior = chamado.Status
+	reabertura.TecnicoID = tecnicoID
+	return nil
+}
+
+// AgregarReaberturas calcula as reaberturas agrupadas por técnico, categoria ou mês.
+func (c *ChamadoUsecase) AgregarReaberturas(ctx context.Context, filtro model.ReaberturaFiltro) ([]model.TotalReabertura, error) {
+	if filtro.AgruparPor == "" {
+		filtro.AgruparPor = model.ReaberturaPorTecnico
+	}
+
+	if err := model.ValidarAgrupamentoReabertura(filtro.AgruparPor); err != nil {
+		return nil, fmt.Errorf("[usecase.AgregarReaberturas]: %w", err)
+	}
+
+	totais, err := c.repository.AgregarReaberturas(ctx, filtro)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.AgregarReaberturas]: %w", err)
+	}
+
+	for i := range totais {
+		totais[i].CalcularTaxa()
+	}
+	return totais, nil
+}
+
+// RegistrarPrimeiraResposta registra a primeira resposta da equipe técnica ao chamado,
+// registrando em log a violação do prazo de primeira resposta quando houver.
+func (c *ChamadoUsecase) RegistrarPrimeiraResposta(ctx context.Context, id string) error {
+	const metodo = "[usecase.RegistrarPrimeiraResposta]: %w"
+
+	chamado, err := c.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
 	}
 
-	if err := c.repository.AtualizarStatus(ctx, id, status, solucao); err != nil {
-		return fmt.Errorf("[usecase.AtualizarStatusChamado]: %w", err)
+	if chamado.PoliticaSLAID == nil || !chamado.RegistrarPrimeiraResposta(time.Now()) {
+		return nil
+	}
+
+	if err := c.repository.AtualizarSLA(ctx, id, chamado); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if _, err := c.registrarViolacoesSLA(ctx, chamado); err != nil {
+		return fmt.Errorf(metodo, err)
 	}
 
 	return nil
 }
 
-// ListarChamados lista todos os chamados com paginação.
+// ListarChamados lista os chamados visíveis ao usuário autenticado com paginação.
 func (c *ChamadoUsecase) ListarChamados(ctx context.Context, filtro model.ChamadoFiltro) ([]model.Chamado, int, model.ChamadoFiltro, error) {
+	escopo, err := ExtrairEscopoDoContexto(ctx)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarChamados]: %w", err)
+	}
+	filtro.Escopo = escopo
+
 	if filtro.Pagina <= 0 {
 		filtro.Pagina = 1
 	}
@@ -156,10 +605,487 @@ func (c *ChamadoUsecase) ListarChamados(ctx context.Context, filtro model.Chamad
 		filtro.Limite = 10
 	}
 
+	filtro.NormalizarOrdenacao()
+
 	chamados, total, err := c.repository.Listar(ctx, filtro)
 	if err != nil {
 		return nil, 0, filtro, fmt.Errorf("[usecase.ListarChamados]: %w", err)
 	}
 
+	// os chamados da página costumam compartilhar poucas categorias,
+	// então o calendário de cada uma é buscado uma única vez
+	calendarios := make(map[string]*model.Calendario)
+	agora := time.Now()
+	for i := range chamados {
+		calendario, ok := calendarios[chamados[i].CategoriaID]
+		if !ok {
+			calendario, err = c.repositoryCalendario.BuscarVigente(ctx, chamados[i].CategoriaID)
+			if err != nil {
+				return nil, 0, filtro, fmt.Errorf("[usecase.ListarChamados]: %w", err)
+			}
+			calendarios[chamados[i].CategoriaID] = calendario
+		}
+		tempos := chamados[i].CalcularTempos(calendario, agora)
+		chamados[i].Tempos = &tempos
+	}
+
 	return chamados, total, filtro, nil
-}
\ No newline at end of file
+}
+
+// FecharChamadosResolvidos fecha os chamados resolvidos há mais de diasUteis dias
+// úteis, contados no calendário vigente da categoria, sem confirmação do usuário. Cada
+// chamado é fechado em uma transação própria, e uma falha em um chamado não interrompe os
+// demais; os erros são acumulados.
+func (c *ChamadoUsecase) FecharChamadosResolvidos(ctx context.Context, diasUteis int) ([]model.Chamado, error) {
+	const metodo = "[usecase.FecharChamadosResolvidos]: %w"
+
+	agora := time.Now()
+
+	// dias úteis nunca são menos que dias corridos, então o corte corrido pré-filtra os candidatos
+	candidatos, err := c.repository.ListarResolvidosAntesDe(ctx, agora.AddDate(0, 0, -diasUteis))
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	calendarios := make(map[string]*model.Calendario)
+	fechados := []model.Chamado{}
+	var erros []error
+	for _, chamado := range candidatos {
+		calendario, ok := calendarios[chamado.CategoriaID]
+		if !ok {
+			calendario, err = c.repositoryCalendario.BuscarVigente(ctx, chamado.CategoriaID)
+			if err != nil {
+				erros = append(erros, err)
+				continue
+			}
+			calendarios[chamado.CategoriaID] = calendario
+		}
+
+		if calendario.AdicionarDiasUteis(*chamado.SolucionadoEm, diasUteis).After(agora) {
+			continue
+		}
+
+		err := c.transacao.Executar(ctx, func(ctx context.Context) error {
+			if err := c.AtualizarStatusChamado(ctx, chamado.ID, string(model.StatusFechado), nil); err != nil {
+				return err
+			}
+			return c.usecaseLog.CriarLogChamado(
+				ctx,
+				model.AcaoAtualizar,
+				entidadeChamado,
+				chamado.ID,
+				fmt.Sprintf(
+					"Chamado fechado automaticamente: ID(%s) resolvido em %s sem confirmação após %d dias úteis",
+					chamado.ID, chamado.SolucionadoEm.Format(time.RFC3339), diasUteis,
+				),
+			)
+		})
+		if err != nil {
+			erros = append(erros, err)
+			continue
+		}
+		fechados = append(fechados, chamado)
+	}
+
+	if len(erros) > 0 {
+		return fechados, fmt.Errorf(metodo, errors.Join(erros...))
+	}
+	return fechados, nil
+}
+
+// ArquivarChamadosFechados arquiva os chamados fechados há mais de dias dias corridos. Cada
+// chamado é arquivado em uma transação própria, e uma falha em um chamado não interrompe os
+// demais; os erros são acumulados.
+func (c *ChamadoUsecase) ArquivarChamadosFechados(ctx context.Context, dias int) ([]model.Chamado, error) {
+	const metodo = "[usecase.ArquivarChamadosFechados]: %w"
+
+	candidatos, err := c.repository.ListarFechadosAntesDe(ctx, time.Now().AddDate(0, 0, -dias))
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	arquivados := []model.Chamado{}
+	var erros []error
+	for _, chamado := range candidatos {
+		err := c.transacao.Executar(ctx, func(ctx context.Context) error {
+			if err := c.repository.Arquivar(ctx, chamado.ID); err != nil {
+				return err
+			}
+			return c.usecaseLog.CriarLogChamado(
+				ctx,
+				model.AcaoArquivar,
+				entidadeChamado,
+				chamado.ID,
+				fmt.Sprintf(
+					"Chamado arquivado automaticamente: ID(%s) fechado em %s há mais de %d dias",
+					chamado.ID, chamado.FechadoEm.Format(time.RFC3339), dias,
+				),
+			)
+		})
+		if err != nil {
+			erros = append(erros, err)
+			continue
+		}
+		arquivados = append(arquivados, chamado)
+	}
+
+	if len(erros) > 0 {
+		return arquivados, fmt.Errorf(metodo, errors.Join(erros...))
+	}
+	return arquivados, nil
+}
+
+// AvisarSLAEmRisco avisa a equipe técnica dos chamados cujo prazo de solução vence dentro
+// da antecedência informada. Cada prazo é avisado uma única vez, com a marcação e o evento na
+// mesma transação; uma falha em um chamado não interrompe os demais e os erros são acumulados.
+func (c *ChamadoUsecase) AvisarSLAEmRisco(ctx context.Context, antecedencia time.Duration) ([]model.Chamado, error) {
+	const metodo = "[usecase.AvisarSLAEmRisco]: %w"
+
+	candidatos, err := c.repository.ListarSLAEmRisco(ctx, time.Now().Add(antecedencia))
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	avisados := []model.Chamado{}
+	var erros []error
+	for i := range candidatos {
+		chamado := &candidatos[i]
+
+		var marcado bool
+		err := c.transacao.Executar(ctx, func(ctx context.Context) error {
+			var err error
+			marcado, err = c.repository.MarcarAvisoSLA(ctx, chamado.ID)
+			if err != nil || !marcado {
+				return err
+			}
+
+			risco := model.RiscoSLAEvento{Tipo: model.SLASolucao, Prazo: *chamado.PrazoSolucao}
+			return c.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoSLAEmRisco, chamado, true, risco))
+		})
+		if err != nil {
+			erros = append(erros, err)
+			continue
+		}
+		if marcado {
+			avisados = append(avisados, *chamado)
+		}
+	}
+
+	if len(erros) > 0 {
+		return avisados, fmt.Errorf(metodo, errors.Join(erros...))
+	}
+	return avisados, nil
+}
+
+// RegistrarSLAVencido registra as violações dos prazos de SLA que venceram sem que o
+// chamado fosse consultado ou alterado. As marcações, os logs e os eventos de cada chamado
+// ficam na mesma transação; uma falha em um chamado não interrompe os demais e os erros são
+// acumulados.
+func (c *ChamadoUsecase) RegistrarSLAVencido(ctx context.Context) ([]model.Chamado, error) {
+	const metodo = "[usecase.RegistrarSLAVencido]: %w"
+
+	candidatos, err := c.repository.ListarSLAVencido(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	violados := []model.Chamado{}
+	var erros []error
+	for i := range candidatos {
+		chamado := &candidatos[i]
+
+		var registrada bool
+		err := c.transacao.Executar(ctx, func(ctx context.Context) error {
+			var err error
+			registrada, err = c.registrarViolacoesSLA(ctx, chamado)
+			return err
+		})
+		if err != nil {
+			erros = append(erros, err)
+			continue
+		}
+		if registrada {
+			violados = append(violados, *chamado)
+		}
+	}
+
+	if len(erros) > 0 {
+		return violados, fmt.Errorf(metodo, errors.Join(erros...))
+	}
+	return violados, nil
+}
+
+// Metodos auxiliares
+
+// buscarVisivel busca o chamado pelo ID, recusando-o a quem não pode vê-lo.
+func (c *ChamadoUsecase) buscarVisivel(ctx context.Context, id string) (*model.Chamado, error) {
+	chamado, err := c.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+	}
+
+	if err := verificarAcessoChamado(ctx, c.repository, id); err != nil {
+		return nil, fmt.Errorf("[usecase.buscarVisivel]: %w", err)
+	}
+	return chamado, nil
+}
+
+// autorizarNoChamado autoriza a operação na categoria do chamado e retorna a permissão
+// efetiva do usuário autenticado. Sem permissão de técnico na categoria, as operações do
+// criador ficam restritas aos chamados que o usuário criou.
+func (c *ChamadoUsecase) autorizarNoChamado(ctx context.Context, chamado *model.Chamado, operacao model.OperacaoChamado) (model.Permissao, error) {
+	const metodo = "[usecase.autorizarNoChamado]: %w"
+
+	permissao, err := c.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, operacao)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return "", fmt.Errorf(metodo, err)
+	}
+
+	if !model.PermiteOperacaoNoChamado(permissao, operacao, usuarioID, chamado.CriadorID) {
+		return "", utils.NewAppError(
+			"[usecase.autorizarNoChamado]",
+			utils.LevelInfo,
+			fmt.Sprintf("a operação %s no chamado %s é permitida apenas ao seu criador", operacao, chamado.ID),
+			model.ErrOperacaoNaoPermitida,
+		)
+	}
+	return permissao, nil
+}
+
+// verificarAcessoChamado garante que o chamado pertence ao escopo de chamados visíveis ao
+// usuário autenticado.
+func verificarAcessoChamado(ctx context.Context, repositoryChamado repository.BuscarChamado, chamadoID string) error {
+	escopo, err := ExtrairEscopoDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf("[usecase.verificarAcessoChamado]: %w", err)
+	}
+
+	if escopo.Irrestrito() {
+		return nil
+	}
+
+	visivel, err := repositoryChamado.Visivel(ctx, chamadoID, escopo)
+	if err != nil {
+		return fmt.Errorf("[usecase.verificarAcessoChamado]: %w", err)
+	}
+
+	if !visivel {
+		return utils.NewAppError(
+			"[usecase.verificarAcessoChamado]",
+			utils.LevelInfo,
+			fmt.Sprintf("o chamado %s não é visível ao usuário %s", chamadoID, escopo.UsuarioID),
+			model.ErrChamadoNaoVisivel,
+		)
+	}
+	return nil
+}
+
+// atualizarSLATransicao ajusta o SLA do chamado conforme a transição de status:
+// registra a primeira resposta, pausa ou retoma o prazo de solução e registra violações.
+func (c *ChamadoUsecase) atualizarSLATransicao(ctx context.Context, chamado *model.Chamado, destino model.StatusChamado, permissao model.Permissao, calendario *model.Calendario) error {
+	if chamado.PoliticaSLAID == nil {
+		return nil
+	}
+
+	agora := time.Now()
+
+	if _, ok := statusRespostaAoUsuario[destino]; ok && permissao != model.PermUSR {
+		chamado.RegistrarPrimeiraResposta(agora)
+	}
+
+	if chamado.Status == model.StatusAguardando {
+		chamado.RetomarSLA(calendario, agora)
+	}
+
+	if destino == model.StatusAguardando {
+		politica, err := c.repositorySLA.BuscarPorID(ctx, *chamado.PoliticaSLAID)
+		if err != nil {
+			return fmt.Errorf("[usecase.atualizarSLATransicao]: %w", err)
+		}
+		if politica.PausarAguardandoUsuario {
+			chamado.PausarSLA(agora)
+		}
+	}
+
+	if err := c.repository.AtualizarSLA(ctx, chamado.ID, chamado); err != nil {
+		return fmt.Errorf("[usecase.atualizarSLATransicao]: %w", err)
+	}
+
+	_, err := c.registrarViolacoesSLA(ctx, chamado)
+	return err
+}
+
+// atualizarTempoAtribuido contabiliza o tempo útil em que o chamado permaneceu
+// atribuído quando a transição entra ou sai do status ATRIBUIDO.
+func (c *ChamadoUsecase) atualizarTempoAtribuido(ctx context.Context, chamado *model.Chamado, destino model.StatusChamado, calendario *model.Calendario) error {
+	if chamado.Status != model.StatusAtribuido && destino != model.StatusAtribuido {
+		return nil
+	}
+
+	chamado.ContabilizarTempoAtribuido(destino, calendario, time.Now())
+
+	if err := c.repository.AtualizarTempoAtribuido(ctx, chamado.ID, chamado); err != nil {
+		return fmt.Errorf("[usecase.atualizarTempoAtribuido]: %w", err)
+	}
+	return nil
+}
+
+// atribuirAutomaticamente atribui o chamado recém-aberto ao técnico escolhido pela
+// estratégia de atribuição da categoria, criando o atendimento e movendo o chamado
+// para ATRIBUIDO. Sem técnico elegível, o chamado permanece em aberto.
+func (c *ChamadoUsecase) atribuirAutomaticamente(ctx context.Context, chamado *model.Chamado) error {
+	const metodo = "[usecase.atribuirAutomaticamente]: %w"
+
+	sugestao, err := c.usecaseAtribuicao.SugerirTecnico(ctx, chamado.CategoriaID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if sugestao.Tecnico == nil {
+		return nil
+	}
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	atendimento, err := model.NewAtendimento(id, sugestao.Tecnico.UsuarioID, chamado.ID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	motivo := fmt.Sprintf("atribuição automática (%s): %s", sugestao.Estrategia, sugestao.Motivo)
+	atendimento.Motivo = &motivo
+
+	if err := c.repositoryAtendimento.Salvar(ctx, atendimento); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.repository.AtualizarStatus(ctx, chamado.ID, string(chamado.Status), string(model.StatusAtribuido), nil); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	calendario, err := c.repositoryCalendario.BuscarVigente(ctx, chamado.CategoriaID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := c.atualizarTempoAtribuido(ctx, chamado, model.StatusAtribuido, calendario); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if err := c.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoChamadoAtribuido, chamado, false, atendimento)); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if err := c.publicarAlteracaoStatus(ctx, chamado, chamado.Status, model.StatusAtribuido); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	chamado.Status = model.StatusAtribuido
+
+	err = c.usecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoCriar,
+		entidadeAtendimento,
+		chamado.ID,
+		fmt.Sprintf(
+			"Chamado atribuído automaticamente: %s | Estrategia=%s | Motivo=%s",
+			atendimento.String(), sugestao.Estrategia, sugestao.Motivo,
+		),
+	)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	return nil
+}
+
+// finalizarAtendimento encerra o atendimento vigente quando a transição tira o
+// chamado das mãos do técnico. Na devolução pela fila, o atendimento já chega
+// finalizado com o motivo informado e não há o que encerrar.
+func (c *ChamadoUsecase) finalizarAtendimento(ctx context.Context, chamadoID string, destino model.StatusChamado) error {
+	const metodo = "[usecase.finalizarAtendimento]: %w"
+
+	if _, ok := statusSemTecnico[destino]; !ok {
+		return nil
+	}
+
+	ativo, err := c.repositoryAtendimento.BuscarAtivo(ctx, chamadoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if ativo == nil {
+		return nil
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	motivo := fmt.Sprintf("chamado movido para o status %s", destino)
+	if err := c.repositoryAtendimento.Finalizar(ctx, ativo.ID, &usuarioID, motivo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// calcularTempos preenche os tempos do chamado usando o calendário vigente da sua categoria.
+func (c *ChamadoUsecase) calcularTempos(ctx context.Context, chamado *model.Chamado) error {
+	calendario, err := c.repositoryCalendario.BuscarVigente(ctx, chamado.CategoriaID)
+	if err != nil {
+		return fmt.Errorf("[usecase.calcularTempos]: %w", err)
+	}
+
+	tempos := chamado.CalcularTempos(calendario, time.Now())
+	chamado.Tempos = &tempos
+	return nil
+}
+
+// registrarViolacoesSLA marca os prazos de SLA vencidos do chamado, registra cada
+// nova violação nos logs e a publica para a equipe técnica. Retorna se alguma violação
+// foi registrada.
+func (c *ChamadoUsecase) registrarViolacoesSLA(ctx context.Context, chamado *model.Chamado) (bool, error) {
+	id := chamado.ID
+	descricoes := map[model.TipoSLA]string{
+		model.SLAPrimeiraResposta: "primeira resposta",
+		model.SLASolucao:          "solução",
+	}
+
+	registrada := false
+	for _, tipo := range []model.TipoSLA{model.SLAPrimeiraResposta, model.SLASolucao} {
+		violado, err := c.repository.MarcarViolacaoSLA(ctx, id, tipo)
+		if err != nil {
+			return false, fmt.Errorf("[usecase.registrarViolacoesSLA]: %w", err)
+		}
+		if !violado {
+			continue
+		}
+		registrada = true
+
+		err = c.usecaseLog.CriarLogChamado(
+			ctx,
+			model.AcaoViolarSLA,
+			entidadeSLA,
+			id,
+			fmt.Sprintf("Prazo de %s violado: chamado ID(%s) tipo(%s)", descricoes[tipo], id, tipo),
+		)
+		if err != nil {
+			return false, fmt.Errorf("[usecase.registrarViolacoesSLA]: %w", err)
+		}
+
+		if err := c.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoSLAViolado, chamado, true, model.ViolacaoSLAEvento{Tipo: tipo})); err != nil {
+			return false, fmt.Errorf("[usecase.registrarViolacoesSLA]: %w", err)
+		}
+	}
+
+	return registrada, nil
+}
+
+// publicarAlteracaoStatus publica a mudança de status do chamado.
+func (c *ChamadoUsecase) publicarAlteracaoStatus(ctx context.Context, chamado *model.Chamado, anterior, destino model.StatusChamado) error {
+	alteracao := model.AlteracaoStatusEvento{StatusAnterior: anterior, Status: destino}
+	return c.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoStatusAlterado, chamado, false, alteracao))
+}
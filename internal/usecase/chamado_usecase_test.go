@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+)
+
+// chamadoRepositoryFake guarda as violações de SLA ainda não marcadas de cada chamado; os
+// métodos não usados nos testes ficam com a interface embutida.
+type chamadoRepositoryFake struct {
+	repository.ChamadoRepository
+	vencidos  []model.Chamado
+	pendentes map[string][]model.TipoSLA
+	falhas    map[string]error
+}
+
+func (r *chamadoRepositoryFake) ListarSLAVencido(context.Context) ([]model.Chamado, error) {
+	return r.vencidos, nil
+}
+
+func (r *chamadoRepositoryFake) MarcarViolacaoSLA(_ context.Context, id string, tipo model.TipoSLA) (bool, error) {
+	if err := r.falhas[id]; err != nil {
+		return false, err
+	}
+	for i, pendente := range r.pendentes[id] {
+		if pendente == tipo {
+			r.pendentes[id] = append(r.pendentes[id][:i], r.pendentes[id][i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// unidadeTrabalhoFake conta as execuções e guarda as ações registradas durante a operação,
+// executando-as ao fim, conforme a operação seja confirmada ou desfeita.
+type unidadeTrabalhoFake struct {
+	execucoes     int
+	emTransacao   bool
+	aposConfirmar []func()
+	aposDesfazer  []func()
+}
+
+func (u *unidadeTrabalhoFake) Executar(ctx context.Context, operacao func(ctx context.Context) error) error {
+	u.execucoes++
+	u.emTransacao = true
+	err := operacao(ctx)
+	u.emTransacao = false
+
+	acoes := u.aposConfirmar
+	if err != nil {
+		acoes = u.aposDesfazer
+	}
+	u.aposConfirmar, u.aposDesfazer = nil, nil
+	for _, acao := range acoes {
+		acao()
+	}
+	return err
+}
+
+func (u *unidadeTrabalhoFake) AposConfirmar(_ context.Context, acao func()) {
+	if !u.emTransacao {
+		acao()
+		return
+	}
+	u.aposConfirmar = append(u.aposConfirmar, acao)
+}
+
+func (u *unidadeTrabalhoFake) AposDesfazer(_ context.Context, acao func()) {
+	if u.emTransacao {
+		u.aposDesfazer = append(u.aposDesfazer, acao)
+	}
+}
+
+// logUsecaseFake conta os logs de chamado gravados.
+type logUsecaseFake struct {
+	usecase.LogUsecase
+	logs int
+}
+
+func (l *logUsecaseFake) CriarLogChamado(context.Context, model.Acao, string, string, string) error {
+	l.logs++
+	return nil
+}
+
+// eventosFake guarda os eventos publicados.
+type eventosFake struct {
+	eventos []*model.Evento
+}
+
+func (e *eventosFake) PublicarEvento(_ context.Context, evento *model.Evento) error {
+	e.eventos = append(e.eventos, evento)
+	return nil
+}
+
+func TestChamadoUsecaseRegistrarSLAVencido(t *testing.T) {
+	falha := errors.New("falha no banco")
+	repo := &chamadoRepositoryFake{
+		vencidos: []model.Chamado{{ID: "ch-1"}, {ID: "ch-2"}, {ID: "ch-3"}, {ID: "ch-4"}},
+		pendentes: map[string][]model.TipoSLA{
+			"ch-1": {model.SLAPrimeiraResposta, model.SLASolucao},
+			"ch-3": {model.SLASolucao},
+			"ch-4": {model.SLASolucao},
+		},
+		// o ch-2 já teve a violação registrada por outra transição; o ch-3 falha
+		falhas: map[string]error{"ch-3": falha},
+	}
+	transacao := &unidadeTrabalhoFake{}
+	logs := &logUsecaseFake{}
+	eventos := &eventosFake{}
+	c := &ChamadoUsecase{repository: repo, transacao: transacao, usecaseLog: logs, usecaseEvento: eventos}
+
+	violados, err := c.RegistrarSLAVencido(context.Background())
+	if !errors.Is(err, falha) {
+		t.Errorf("RegistrarSLAVencido = %v, esperado %v", err, falha)
+	}
+
+	// a falha em um chamado não interrompe os demais
+	if len(violados) != 2 || violados[0].ID != "ch-1" || violados[1].ID != "ch-4" {
+		t.Errorf("violados = %v, esperado ch-1 e ch-4", violados)
+	}
+	if transacao.execucoes != len(repo.vencidos) {
+		t.Errorf("transações = %d, esperado uma por chamado (%d)", transacao.execucoes, len(repo.vencidos))
+	}
+	if logs.logs != 3 || len(eventos.eventos) != 3 {
+		t.Errorf("logs = %d e eventos = %d, esperado 3 de cada", logs.logs, len(eventos.eventos))
+	}
+	for _, evento := range eventos.eventos {
+		if evento.Tipo != model.EventoSLAViolado {
+			t.Errorf("evento = %s, esperado %s", evento.Tipo, model.EventoSLAViolado)
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	infra "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+const (
+	// entidadeAcompanhamento identifica os registros de log referentes a acompanhamentos recebidos por e-mail.
+	entidadeAcompanhamento = "ACOMPANHAMENTO"
+	// entidadeUsuario identifica os registros de log referentes a usuários cadastrados pelo e-mail.
+	entidadeUsuario = "USUARIO"
+
+	// limiteEmailsPorExecucao limita as mensagens lidas da caixa de entrada a cada execução.
+	limiteEmailsPorExecucao = 50
+
+	// descricaoSomenteAnexos é a descrição dos chamados abertos por e-mails sem texto.
+	descricaoSomenteAnexos = "Chamado aberto por e-mail sem texto; veja os anexos."
+)
+
+// errosPermanentesEmail são os erros que impedem o processamento da mensagem em qualquer
+// nova tentativa; as demais falhas mantêm a mensagem pendente.
+var errosPermanentesEmail = []error{
+	model.ErrRemetenteDesconhecido,
+	model.ErrRemetenteInativo,
+	model.ErrRemetenteSemAcessoChamado,
+	model.ErrEmailSemConteudo,
+	model.ErrEmailAutomatico,
+}
+
+// EntradaEmailUsecase representa a camada de caso de uso da abertura e da resposta de chamados por e-mail.
+type EntradaEmailUsecase struct {
+	repository            repository.EmailRecebidoRepository
+	caixa                 repository.CaixaEntradaEmail
+	repositoryUsuario     repository.UsuarioRepository
+	repositoryChamado     repository.BuscarChamado
+	transacao             repository.UnidadeDeTrabalho
+	usecaseChamado        usecase.ChamadoUsecase
+	usecaseAcompanhamento usecase.ArmazenarAcompanhamento
+	usecaseAnexo          usecase.EnviarAnexo
+	usecaseLog            usecase.LogUsecase
+	diretorio             usecase.AuthExternoUsecase // nil quando não há LDAP para identificar novos remetentes
+	categoriaID           string                     // categoria dos chamados abertos por e-mail
+	subcategoriaID        string                     // subcategoria dos chamados abertos por e-mail
+}
+
+// NewEntradaEmailUsecase cria uma nova instância de EntradaEmailUsecase.
+func NewEntradaEmailUsecase(
+	repository repository.EmailRecebidoRepository,
+	caixa repository.CaixaEntradaEmail,
+	repositoryUsuario repository.UsuarioRepository,
+	transacao repository.UnidadeDeTrabalho,
+	repositoryChamado repository.BuscarChamado,
+	usecaseChamado usecase.ChamadoUsecase,
+	usecaseAcompanhamento usecase.ArmazenarAcompanhamento,
+	usecaseAnexo usecase.EnviarAnexo,
+	usecaseLog usecase.LogUsecase,
+	diretorio usecase.AuthExternoUsecase,
+	categoriaID, subcategoriaID string,
+) *EntradaEmailUsecase {
+	return &EntradaEmailUsecase{
+		repository:            repository,
+		caixa:                 caixa,
+		repositoryUsuario:     repositoryUsuario,
+		repositoryChamado:     repositoryChamado,
+		transacao:             transacao,
+		usecaseChamado:        usecaseChamado,
+		usecaseAcompanhamento: usecaseAcompanhamento,
+		usecaseAnexo:          usecaseAnexo,
+		usecaseLog:            usecaseLog,
+		diretorio:             diretorio,
+		categoriaID:           categoriaID,
+		subcategoriaID:        subcategoriaID,
+	}
+}
+
+// ProcessarCaixaEntrada processa as mensagens pendentes da caixa de entrada. Mensagens que
+// nunca poderão ser processadas são rejeitadas; as que falharem por outros motivos continuam
+// pendentes para a próxima execução. Cada mensagem é processada em uma transação própria, de
+// modo que uma falha não deixa o chamado ou o acompanhamento incompleto.
+func (u *EntradaEmailUsecase) ProcessarCaixaEntrada(ctx context.Context) (processadas, rejeitadas int, err error) {
+	const metodo = "[usecase.ProcessarCaixaEntrada]: %w"
+
+	pendentes, err := u.caixa.ListarPendentes(ctx, limiteEmailsPorExecucao)
+	if err != nil {
+		return 0, 0, fmt.Errorf(metodo, err)
+	}
+
+	var falhas []error
+	for i := range pendentes {
+		if ctx.Err() != nil {
+			return processadas, rejeitadas, fmt.Errorf(metodo, ctx.Err())
+		}
+
+		email := &pendentes[i]
+		err := u.transacao.Executar(ctx, func(ctx context.Context) error {
+			_, err := u.ProcessarEmailRecebido(ctx, email)
+			return err
+		})
+
+		rejeitada := err != nil && erroPermanenteEmail(err)
+		if err != nil && !rejeitada {
+			falhas = append(falhas, err)
+			continue
+		}
+		if rejeitada {
+			log.Printf("[usecase.ProcessarCaixaEntrada] e-mail rejeitado: %s: %v", email.String(), err)
+		}
+
+		if err := u.caixa.Concluir(ctx, email.Chave, rejeitada); err != nil {
+			falhas = append(falhas, err)
+			continue
+		}
+		if rejeitada {
+			rejeitadas++
+		} else {
+			processadas++
+		}
+	}
+
+	if len(falhas) > 0 {
+		return processadas, rejeitadas, fmt.Errorf(metodo, errors.Join(falhas...))
+	}
+	return processadas, rejeitadas, nil
+}
+
+// ProcessarEmailRecebido abre um chamado em nome do remetente ou, quando o assunto referencia
+// um chamado existente, inclui a mensagem como acompanhamento. Os anexos aceitos pelas regras
+// de anexo são salvos; os demais são ignorados. Mensagens já processadas não são repetidas.
+func (u *EntradaEmailUsecase) ProcessarEmailRecebido(ctx context.Context, e *model.EmailRecebido) (*model.RegistroEmailRecebido, error) {
+	const metodo = "[usecase.ProcessarEmailRecebido]"
+
+	if e.MessageID == "" {
+		e.MessageID = identificadorConteudo(e)
+	}
+
+	registro, err := u.repository.BuscarPorMessageID(ctx, e.MessageID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	if registro != nil {
+		return registro, nil
+	}
+
+	if e.Automatico {
+		return nil, utils.NewAppError(metodo, utils.LevelInfo, e.String(), model.ErrEmailAutomatico)
+	}
+	if strings.TrimSpace(e.Corpo) == "" && len(e.Anexos) == 0 {
+		return nil, utils.NewAppError(metodo, utils.LevelInfo, e.String(), model.ErrEmailSemConteudo)
+	}
+
+	remetente, err := u.identificarRemetente(ctx, e)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	ctxRemetente := ContextoDoUsuario(ctx, remetente)
+
+	registro = &model.RegistroEmailRecebido{
+		MessageID:   e.MessageID,
+		RemetenteID: remetente.ID,
+		RecebidoEm:  e.RecebidoEm,
+	}
+
+	chamado, err := u.chamadoReferenciado(ctx, e)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if chamado != nil {
+		acompanhamento, err := u.responderChamado(ctxRemetente, chamado, remetente, e)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		registro.ChamadoID = chamado.ID
+		registro.AcompanhamentoID = &acompanhamento.ID
+	} else {
+		chamado, err = u.abrirChamado(ctxRemetente, e)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", metodo, err)
+		}
+		registro.ChamadoID = chamado.ID
+	}
+
+	u.salvarAnexos(ctxRemetente, registro, e)
+
+	registro.ProcessadoEm = time.Now()
+	if err := u.repository.Salvar(ctx, registro); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	return registro, nil
+}
+
+// Metodos auxiliares
+
+// identificarRemetente retorna o usuário com o e-mail do remetente. Remetentes ainda sem
+// cadastro são buscados no LDAP e cadastrados como usuários comuns.
+func (u *EntradaEmailUsecase) identificarRemetente(ctx context.Context, e *model.EmailRecebido) (*model.Usuario, error) {
+	const metodo = "[usecase.identificarRemetente]"
+
+	usuario, err := u.repositoryUsuario.BuscarPorEmail(ctx, e.RemetenteEmail)
+	if err == nil {
+		if !usuario.Status {
+			return nil, utils.NewAppError(metodo, utils.LevelInfo, e.RemetenteEmail, model.ErrRemetenteInativo)
+		}
+		return usuario, nil
+	}
+	if !errors.Is(err, infra.ErrUsuarioNaoEncontrado) {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if u.diretorio == nil {
+		return nil, utils.NewAppError(metodo, utils.LevelInfo, e.RemetenteEmail, model.ErrRemetenteDesconhecido)
+	}
+
+	nome, email, login, err := u.diretorio.PesquisarPorEmail(e.RemetenteEmail)
+	if err != nil || login == "" {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			fmt.Sprintf("%s não encontrado no LDAP: %v", e.RemetenteEmail, err),
+			model.ErrRemetenteDesconhecido,
+		)
+	}
+	if email == "" {
+		email = e.RemetenteEmail
+	}
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	usuario, err = model.NewUsuario(id, nome, login, email, model.PermUSR, true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+	if err := u.repositoryUsuario.Salvar(ctx, usuario); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	err = u.usecaseLog.CriarLog(
+		ContextoDoUsuario(ctx, usuario),
+		model.AcaoCriar,
+		entidadeUsuario,
+		fmt.Sprintf("Usuário cadastrado pelo LDAP ao enviar e-mail ao atendimento: %s", usuario.String()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	return usuario, nil
+}
+
+// chamadoReferenciado retorna o chamado referenciado no assunto, ou nil quando a mensagem
+// não referencia um chamado existente e deve abrir um novo.
+func (u *EntradaEmailUsecase) chamadoReferenciado(ctx context.Context, e *model.EmailRecebido) (*model.Chamado, error) {
+	id := e.ExtrairReferenciaChamado()
+	if id == "" {
+		return nil, nil
+	}
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, id)
+	if errors.Is(err, infra.ErrChamadoNaoEncontrado) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.chamadoReferenciado]: %w", err)
+	}
+	return chamado, nil
+}
+
+// abrirChamado abre um novo chamado em nome do remetente, na categoria configurada para
+// os chamados recebidos por e-mail. O contexto deve estar autenticado como o remetente, que
+// CriarChamado registra como criador.
+func (u *EntradaEmailUsecase) abrirChamado(ctx context.Context, e *model.EmailRecebido) (*model.Chamado, error) {
+	const metodo = "[usecase.abrirChamado]: %w"
+
+	descricao := e.Corpo
+	if strings.TrimSpace(descricao) == "" {
+		descricao = descricaoSomenteAnexos
+	}
+
+	chamado := &model.Chamado{
+		Titulo:         e.TituloChamado(),
+		Descricao:      descricao,
+		CategoriaID:    u.categoriaID,
+		SubcategoriaID: u.subcategoriaID,
+	}
+	if err := u.usecaseChamado.CriarChamado(ctx, chamado); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	err := u.usecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoCriar,
+		entidadeChamado,
+		chamado.ID,
+		fmt.Sprintf("Chamado criado por e-mail: ID(%s) | %s", chamado.ID, e.String()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	return chamado, nil
+}
+
+// responderChamado inclui a mensagem como acompanhamento público do chamado. Apenas o criador
+// e a equipe técnica respondem por e-mail; a resposta do criador a um chamado aguardando o
+// usuário devolve o chamado ao técnico.
+func (u *EntradaEmailUsecase) responderChamado(ctx context.Context, chamado *model.Chamado, remetente *model.Usuario, e *model.EmailRecebido) (*model.Acompanhamento, error) {
+	const metodo = "[usecase.responderChamado]"
+
+	if remetente.Permissao == model.PermUSR && chamado.CriadorID != remetente.ID {
+		return nil, utils.NewAppError(
+			metodo,
+			utils.LevelInfo,
+			fmt.Sprintf("%s não é o criador do chamado %s", remetente.Email, chamado.ID),
+			model.ErrRemetenteSemAcessoChamado,
+		)
+	}
+
+	conteudo := e.Corpo
+	if strings.TrimSpace(conteudo) == "" {
+		conteudo = fmt.Sprintf("%d anexo(s) enviado(s) por e-mail.", len(e.Anexos))
+	}
+
+	acompanhamento := &model.Acompanhamento{
+		ChamadoID:    chamado.ID,
+		UsuarioID:    remetente.ID,
+		Conteudo:     conteudo,
+		Remetente:    remetente.Permissao,
+		Visibilidade: model.VisibilidadePublico,
+	}
+	if err := u.usecaseAcompanhamento.CriarAcompanhamento(ctx, acompanhamento); err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	err := u.usecaseLog.CriarLogChamado(
+		ctx,
+		model.AcaoCriar,
+		entidadeAcompanhamento,
+		chamado.ID,
+		fmt.Sprintf("Acompanhamento criado por e-mail: ID(%s) | Chamado(%s) | %s", acompanhamento.ID, chamado.ID, e.String()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", metodo, err)
+	}
+
+	if chamado.Status == model.StatusAguardando && chamado.CriadorID == remetente.ID {
+		if err := u.usecaseChamado.AtualizarStatusChamado(ctx, chamado.ID, string(model.StatusAtribuido), nil); err != nil {
+			log.Printf("%s chamado %s não retornou ao técnico: %v", metodo, chamado.ID, err)
+		}
+	}
+
+	return acompanhamento, nil
+}
+
+// salvarAnexos salva os anexos da mensagem no chamado ou no acompanhamento criado. Anexos
+// recusados pelas regras de anexo são registrados no log e ignorados.
+func (u *EntradaEmailUsecase) salvarAnexos(ctx context.Context, registro *model.RegistroEmailRecebido, e *model.EmailRecebido) {
+	for _, anexo := range e.Anexos {
+		envio := &model.EnvioAnexo{
+			ChamadoID:        registro.ChamadoID,
+			AcompanhamentoID: registro.AcompanhamentoID,
+			NomeArquivo:      anexo.NomeArquivo,
+		}
+		if _, err := u.usecaseAnexo.EnviarAnexo(ctx, envio, bytes.NewReader(anexo.Conteudo)); err != nil {
+			log.Printf("[usecase.salvarAnexos] anexo %q do e-mail %s ignorado: %v", anexo.NomeArquivo, e.MessageID, err)
+		}
+	}
+}
+
+// identificadorConteudo identifica mensagens sem Message-ID pelo remetente, data e conteúdo.
+func identificadorConteudo(e *model.EmailRecebido) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s\n%s\n", e.RemetenteEmail, e.RecebidoEm.UTC().Format(time.RFC3339), e.Assunto, e.Corpo)
+	for _, anexo := range e.Anexos {
+		hash.Write(anexo.Conteudo)
+	}
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// erroPermanenteEmail indica se a falha impede o processamento da mensagem em novas tentativas.
+func erroPermanenteEmail(err error) bool {
+	for _, permanente := range errosPermanentesEmail {
+		if errors.Is(err, permanente) {
+			return true
+		}
+	}
+	return errors.As(err, &utils.ValidacaoErrors{})
+}
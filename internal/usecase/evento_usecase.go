@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// EventoUsecase representa a camada de caso de uso para a distribuição de eventos em tempo real.
+type EventoUsecase struct {
+	barramento            repository.BarramentoEventos
+	outbox                repository.OutboxRepository
+	repositoryMencao      repository.BuscarMencao
+	repositoryConcessao   repository.BuscarCategoriaPermissao
+	repositoryAtendimento repository.ListarAtendimento
+	repositoryObservador  repository.BuscarObservador
+}
+
+// NewEventoUsecase cria uma nova instância de EventoUsecase.
+func NewEventoUsecase(
+	barramento repository.BarramentoEventos,
+	outbox repository.OutboxRepository,
+	repositoryMencao repository.BuscarMencao,
+	repositoryConcessao repository.BuscarCategoriaPermissao,
+	repositoryAtendimento repository.ListarAtendimento,
+	repositoryObservador repository.BuscarObservador,
+) *EventoUsecase {
+	return &EventoUsecase{
+		barramento:            barramento,
+		outbox:                outbox,
+		repositoryMencao:      repositoryMencao,
+		repositoryConcessao:   repositoryConcessao,
+		repositoryAtendimento: repositoryAtendimento,
+		repositoryObservador:  repositoryObservador,
+	}
+}
+
+// PublicarEvento grava o evento na outbox, na mesma transação da alteração que o originou.
+// O despacho da outbox o distribui depois aos assinantes que podem vê-lo.
+func (u *EventoUsecase) PublicarEvento(ctx context.Context, e *model.Evento) error {
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf("[usecase.PublicarEvento]: %w", err)
+	}
+
+	if err := u.outbox.Salvar(ctx, model.NewMensagemOutboxEvento(id, e)); err != nil {
+		return fmt.Errorf("[usecase.PublicarEvento]: %w", err)
+	}
+	return nil
+}
+
+// AssinarEventos inscreve o usuário do contexto nos eventos dos chamados do seu escopo,
+// com as mesmas regras da listagem de chamados. Os eventos publicados após ultimoEventoID
+// ainda disponíveis no buffer são devolvidos como pendentes. Os chamados atribuídos ao
+// usuário, que ele passar a observar ou em que for mencionado durante a assinatura passam a
+// fazer parte do escopo.
+func (u *EventoUsecase) AssinarEventos(ctx context.Context, ultimoEventoID uint64) (*model.AssinaturaEventos, error) {
+	escopo, err := ExtrairEscopoDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.AssinarEventos]: %w", err)
+	}
+
+	escopoEventos, err := u.carregarEscopoEventos(ctx, escopo)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.AssinarEventos]: %w", err)
+	}
+
+	var mu sync.Mutex
+	filtro := func(e *model.Evento) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		escopoEventos.Registrar(e)
+		return escopoEventos.Inclui(e)
+	}
+	return u.barramento.Assinar(ultimoEventoID, filtro), nil
+}
+
+// carregarEscopoEventos carrega as permissões do usuário nas categorias e os chamados
+// atribuídos a ele, que observa ou em que foi mencionado, usados para filtrar os eventos da
+// assinatura.
+func (u *EventoUsecase) carregarEscopoEventos(ctx context.Context, escopo *model.EscopoChamados) (*model.EscopoEventos, error) {
+	if escopo.Irrestrito() {
+		return model.NewEscopoEventos(escopo, nil, nil), nil
+	}
+
+	concessoes, err := u.repositoryConcessao.ListarPorUsuario(ctx, escopo.UsuarioID)
+	if err != nil {
+		return nil, err
+	}
+
+	chamados, err := u.repositoryMencao.ListarChamadosMencionado(ctx, escopo.UsuarioID)
+	if err != nil {
+		return nil, err
+	}
+
+	observados, err := u.repositoryObservador.ListarChamadosObservados(ctx, escopo.UsuarioID)
+	if err != nil {
+		return nil, err
+	}
+	chamados = append(chamados, observados...)
+
+	if escopo.PorCategoria() {
+		atribuidos, err := u.repositoryAtendimento.ListarChamadosAtribuidos(ctx, escopo.UsuarioID)
+		if err != nil {
+			return nil, err
+		}
+		chamados = append(chamados, atribuidos...)
+	}
+
+	return model.NewEscopoEventos(escopo, concessoes, chamados), nil
+}
@@ -13,15 +13,18 @@ import (
 
 // LogUsecase representa a camada de caso de uso para operações relacionadas a logs.
 type LogUsecase struct {
-	repository repository.LogRepository
+	repository        repository.LogRepository
+	repositoryChamado repository.BuscarChamado
+	outbox            repository.OutboxRepository
 }
 
 // NewLogUsecase cria uma nova instância de LogUsecase.
-func NewLogUsecase(repository repository.LogRepository) *LogUsecase {
-	return &LogUsecase{repository: repository}
+func NewLogUsecase(repository repository.LogRepository, repositoryChamado repository.BuscarChamado, outbox repository.OutboxRepository) *LogUsecase {
+	return &LogUsecase{repository: repository, repositoryChamado: repositoryChamado, outbox: outbox}
 }
 
-// BuscarLogPorID busca um log pelo seu ID.
+// BuscarLogPorID busca um log pelo seu ID. Os logs de um chamado só são retornados a quem
+// pode ver o chamado.
 func (u *LogUsecase) BuscarLogPorID(ctx context.Context, id string) (*model.Log, error) {
 	if id == "" {
 		return nil, utils.NewAppError(
@@ -36,12 +39,63 @@ func (u *LogUsecase) BuscarLogPorID(ctx context.Context, id string) (*model.Log,
 	if err != nil {
 		return nil, fmt.Errorf("[usecase.BuscarLogPorID]: %w", err)
 	}
+
+	if log.ChamadoID != nil {
+		if err := verificarAcessoChamado(ctx, u.repositoryChamado, *log.ChamadoID); err != nil {
+			return nil, fmt.Errorf("[usecase.BuscarLogPorID]: %w", err)
+		}
+	}
 	return log, nil
 }
 
-// CriarLog cria um novo log.
+// CriarLog grava um novo log na outbox, na mesma transação da alteração registrada. O
+// despacho da outbox o inclui depois nos logs.
 func (u *LogUsecase) CriarLog(ctx context.Context, acao model.Acao, entidade, detalhes string) error {
-	const metodo = "[usecase.CriarLog]: %w"
+	if err := u.criarLog(ctx, acao, entidade, nil, detalhes); err != nil {
+		return fmt.Errorf("[usecase.CriarLog]: %w", err)
+	}
+	return nil
+}
+
+// CriarLogChamado grava um novo log referente ao chamado informado, visível apenas a quem
+// pode ver o chamado.
+func (u *LogUsecase) CriarLogChamado(ctx context.Context, acao model.Acao, entidade, chamadoID, detalhes string) error {
+	if err := u.criarLog(ctx, acao, entidade, &chamadoID, detalhes); err != nil {
+		return fmt.Errorf("[usecase.CriarLogChamado]: %w", err)
+	}
+	return nil
+}
+
+// ListarLogs lista logs com paginação e filtros opcionais. Os logs de chamados são
+// restritos aos chamados visíveis a quem consulta.
+func (u *LogUsecase) ListarLogs(ctx context.Context, filtro model.LogFiltro) ([]model.Log, int, model.LogFiltro, error) {
+	escopo, err := ExtrairEscopoDoContexto(ctx)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarLogs]: %w", err)
+	}
+	filtro.Escopo = escopo
+
+	if filtro.Pagina <= 0 {
+		filtro.Pagina = 1
+	}
+
+	if filtro.Limite <= 0 || filtro.Limite > 100 {
+		filtro.Limite = 10
+	}
+
+	logs, total, err := u.repository.Listar(ctx, filtro)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarLogs]: %w", err)
+	}
+
+	return logs, total, filtro, nil
+}
+
+// Metodos auxiliares
+
+// criarLog grava o log na outbox, referente ao chamado quando informado.
+func (u *LogUsecase) criarLog(ctx context.Context, acao model.Acao, entidade string, chamadoID *string, detalhes string) error {
+	const metodo = "[usecase.criarLog]: %w"
 
 	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
 	if err != nil {
@@ -63,34 +117,20 @@ func (u *LogUsecase) CriarLog(ctx context.Context, acao model.Acao, entidade, de
 	if err != nil {
 		return fmt.Errorf(metodo, err)
 	}
+	log.ChamadoID = chamadoID
 
-	err = u.repository.Salvar(ctx, log)
+	mensagemID, err := utils.NewUUIDv7String()
 	if err != nil {
 		return fmt.Errorf(metodo, err)
 	}
-	return nil
-}
-
-// ListarLogs lista logs com paginação e filtros opcionais.
-func (u *LogUsecase) ListarLogs(ctx context.Context, filtro model.LogFiltro) ([]model.Log, int, model.LogFiltro, error) {
-	if filtro.Pagina <= 0 {
-		filtro.Pagina = 1
-	}
 
-	if filtro.Limite <= 0 || filtro.Limite > 100 {
-		filtro.Limite = 10
-	}
-
-	logs, total, err := u.repository.Listar(ctx, filtro)
+	err = u.outbox.Salvar(ctx, model.NewMensagemOutboxLog(mensagemID, log))
 	if err != nil {
-		return nil, 0, filtro, fmt.Errorf("[usecase.ListarLogs]: %w", err)
+		return fmt.Errorf(metodo, err)
 	}
-
-	return logs, total, filtro, nil
+	return nil
 }
 
-// Metodos auxiliares
-
 // ExtrairUsuarioIDDoContexto extrai o ID do usuário do contexto.
 func ExtrairUsuarioIDDoContexto(ctx context.Context) (string, error) {
 	claims, ok := ctx.Value(middleware.ChaveUsuario).(*jwt.Claims)
@@ -104,3 +144,72 @@ func ExtrairUsuarioIDDoContexto(ctx context.Context) (string, error) {
 	}
 	return claims.ID, nil
 }
+
+// ExtrairPermissaoDoContexto extrai a permissão do usuário do contexto.
+func ExtrairPermissaoDoContexto(ctx context.Context) (model.Permissao, error) {
+	claims, ok := ctx.Value(middleware.ChaveUsuario).(*jwt.Claims)
+	if !ok || claims == nil {
+		return "", utils.NewAppError(
+			"[usecase.ExtrairPermissaoDoContexto]",
+			utils.LevelInfo,
+			"erro ao extrair permissão do usuário do contexto",
+			middleware.ErrUsuarioNaoAutenticado,
+		)
+	}
+	return model.Permissao(claims.Permissao), nil
+}
+
+// ExtrairPapelDoContexto extrai o papel do usuário do contexto. O papel vem vazio nos tokens
+// emitidos antes dos papéis.
+func ExtrairPapelDoContexto(ctx context.Context) (string, error) {
+	claims, ok := ctx.Value(middleware.ChaveUsuario).(*jwt.Claims)
+	if !ok || claims == nil {
+		return "", utils.NewAppError(
+			"[usecase.ExtrairPapelDoContexto]",
+			utils.LevelInfo,
+			"erro ao extrair papel do usuário do contexto",
+			middleware.ErrUsuarioNaoAutenticado,
+		)
+	}
+	return claims.Papel, nil
+}
+
+// ExtrairEscopoDoContexto retorna o escopo de chamados visíveis ao usuário do contexto.
+func ExtrairEscopoDoContexto(ctx context.Context) (*model.EscopoChamados, error) {
+	claims, ok := ctx.Value(middleware.ChaveUsuario).(*jwt.Claims)
+	if !ok || claims == nil {
+		return nil, utils.NewAppError(
+			"[usecase.ExtrairEscopoDoContexto]",
+			utils.LevelInfo,
+			"erro ao extrair escopo de chamados do contexto",
+			middleware.ErrUsuarioNaoAutenticado,
+		)
+	}
+	return model.NewEscopoChamados(claims.ID, model.Permissao(claims.Permissao)), nil
+}
+
+// ContextoDoSistema retorna um contexto autenticado como o usuário de sistema
+// informado, usado pelas rotinas automáticas para registrar suas ações.
+func ContextoDoSistema(ctx context.Context, usuarioID string) context.Context {
+	claims := &jwt.Claims{
+		ID:        usuarioID,
+		Login:     "sistema",
+		Nome:      "Sistema",
+		Permissao: string(model.PermADM),
+	}
+	return context.WithValue(ctx, middleware.ChaveUsuario, claims)
+}
+
+// ContextoDoUsuario retorna um contexto autenticado como o usuário informado, usado
+// quando a ação chega por outro canal que não a API, como o e-mail.
+func ContextoDoUsuario(ctx context.Context, usuario *model.Usuario) context.Context {
+	claims := &jwt.Claims{
+		ID:        usuario.ID,
+		Login:     usuario.Login,
+		Nome:      usuario.Nome,
+		Email:     usuario.Email,
+		Permissao: string(usuario.Permissao),
+		Papel:     usuario.PapelEfetivo(),
+	}
+	return context.WithValue(ctx, middleware.ChaveUsuario, claims)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+)
+
+// MatrizPrioridadeUsecase representa a camada de caso de uso da matriz de prioridade.
+type MatrizPrioridadeUsecase struct {
+	repository repository.MatrizPrioridadeRepository
+}
+
+// NewMatrizPrioridadeUsecase cria uma nova instância de MatrizPrioridadeUsecase.
+func NewMatrizPrioridadeUsecase(repository repository.MatrizPrioridadeRepository) *MatrizPrioridadeUsecase {
+	return &MatrizPrioridadeUsecase{repository: repository}
+}
+
+// BuscarMatrizPrioridade retorna a matriz de prioridade vigente.
+func (u *MatrizPrioridadeUsecase) BuscarMatrizPrioridade(ctx context.Context) (model.MatrizPrioridade, error) {
+	matriz, err := u.repository.Buscar(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarMatrizPrioridade]: %w", err)
+	}
+	return matriz, nil
+}
+
+// AtualizarMatrizPrioridade inclui ou atualiza combinações da matriz de prioridade.
+func (u *MatrizPrioridadeUsecase) AtualizarMatrizPrioridade(ctx context.Context, matriz model.MatrizPrioridade) error {
+	if err := model.ValidarMatrizPrioridade(matriz); err != nil {
+		return fmt.Errorf("[usecase.AtualizarMatrizPrioridade]: %w", err)
+	}
+
+	if err := u.repository.Salvar(ctx, matriz); err != nil {
+		return fmt.Errorf("[usecase.AtualizarMatrizPrioridade]: %w", err)
+	}
+	return nil
+}
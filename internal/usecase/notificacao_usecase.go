@@ -0,0 +1,399 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	infra "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// timeoutNotificacao limita a preparação das notificações de cada evento.
+const timeoutNotificacao = 30 * time.Second
+
+// PreferenciaNotificacaoUsecase representa a camada de caso de uso das preferências de notificação.
+type PreferenciaNotificacaoUsecase struct {
+	repository repository.PreferenciaNotificacaoRepository
+}
+
+// NewPreferenciaNotificacaoUsecase cria uma nova instância de PreferenciaNotificacaoUsecase.
+func NewPreferenciaNotificacaoUsecase(repository repository.PreferenciaNotificacaoRepository) *PreferenciaNotificacaoUsecase {
+	return &PreferenciaNotificacaoUsecase{repository: repository}
+}
+
+// BuscarPreferenciasNotificacao retorna as preferências do usuário autenticado para todos os
+// tipos de notificação; os tipos não configurados são enviados por padrão.
+func (u *PreferenciaNotificacaoUsecase) BuscarPreferenciasNotificacao(ctx context.Context) ([]model.PreferenciaNotificacao, error) {
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarPreferenciasNotificacao]: %w", err)
+	}
+
+	salvas, err := u.repository.ListarPreferencias(ctx, usuarioID)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarPreferenciasNotificacao]: %w", err)
+	}
+	return model.MesclarPreferenciasNotificacao(salvas), nil
+}
+
+// AtualizarPreferenciasNotificacao salva as preferências informadas do usuário autenticado,
+// mantendo as demais, e retorna as preferências resultantes.
+func (u *PreferenciaNotificacaoUsecase) AtualizarPreferenciasNotificacao(ctx context.Context, preferencias []model.PreferenciaNotificacao) ([]model.PreferenciaNotificacao, error) {
+	const metodo = "[usecase.AtualizarPreferenciasNotificacao]: %w"
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	for _, p := range preferencias {
+		if err := model.ValidarTipoNotificacao(p.Tipo); err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
+	}
+
+	if err := u.repository.SalvarPreferencias(ctx, usuarioID, preferencias); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	return u.BuscarPreferenciasNotificacao(ctx)
+}
+
+// CentralNotificacoesUsecase representa a camada de caso de uso da central de notificações.
+type CentralNotificacoesUsecase struct {
+	repository repository.NotificacaoUsuarioRepository
+}
+
+// NewCentralNotificacoesUsecase cria uma nova instância de CentralNotificacoesUsecase.
+func NewCentralNotificacoesUsecase(repository repository.NotificacaoUsuarioRepository) *CentralNotificacoesUsecase {
+	return &CentralNotificacoesUsecase{repository: repository}
+}
+
+// ListarNotificacoes retorna as notificações do usuário autenticado com paginação, das mais
+// recentes às mais antigas.
+func (u *CentralNotificacoesUsecase) ListarNotificacoes(ctx context.Context, filtro model.NotificacaoUsuarioFiltro) ([]model.NotificacaoUsuario, int, model.NotificacaoUsuarioFiltro, error) {
+	const metodo = "[usecase.ListarNotificacoes]: %w"
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf(metodo, err)
+	}
+	filtro.UsuarioID = usuarioID
+
+	if filtro.Pagina <= 0 {
+		filtro.Pagina = 1
+	}
+
+	if filtro.Limite <= 0 || filtro.Limite > 100 {
+		filtro.Limite = 10
+	}
+
+	notificacoes, total, err := u.repository.Listar(ctx, filtro)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf(metodo, err)
+	}
+
+	return notificacoes, total, filtro, nil
+}
+
+// ContarNotificacoesNaoLidas retorna a quantidade de notificações não lidas do usuário autenticado.
+func (u *CentralNotificacoesUsecase) ContarNotificacoesNaoLidas(ctx context.Context) (int, error) {
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[usecase.ContarNotificacoesNaoLidas]: %w", err)
+	}
+
+	total, err := u.repository.ContarNaoLidas(ctx, usuarioID)
+	if err != nil {
+		return 0, fmt.Errorf("[usecase.ContarNotificacoesNaoLidas]: %w", err)
+	}
+	return total, nil
+}
+
+// MarcarNotificacaoLida marca como lida uma notificação do usuário autenticado. As
+// notificações de outros usuários são tratadas como inexistentes.
+func (u *CentralNotificacoesUsecase) MarcarNotificacaoLida(ctx context.Context, id string) (*model.NotificacaoUsuario, error) {
+	const metodo = "[usecase.MarcarNotificacaoLida]: %w"
+
+	if id == "" {
+		return nil, utils.NewAppError(
+			"[usecase.MarcarNotificacaoLida]",
+			utils.LevelInfo,
+			"erro ao marcar notificação como lida",
+			model.ErrNotificacaoIDInvalido,
+		)
+	}
+
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	notificacao, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	if notificacao.UsuarioID != usuarioID {
+		return nil, utils.NewAppError(
+			"[usecase.MarcarNotificacaoLida]",
+			utils.LevelInfo,
+			"a notificação pertence a outro usuário",
+			infra.ErrNotificacaoNaoEncontrada,
+		)
+	}
+
+	if err := u.repository.MarcarLida(ctx, id); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	atualizada, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	return atualizada, nil
+}
+
+// MarcarTodasNotificacoesLidas marca como lidas todas as notificações do usuário
+// autenticado e retorna quantas foram marcadas.
+func (u *CentralNotificacoesUsecase) MarcarTodasNotificacoesLidas(ctx context.Context) (int, error) {
+	usuarioID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("[usecase.MarcarTodasNotificacoesLidas]: %w", err)
+	}
+
+	marcadas, err := u.repository.MarcarTodasLidas(ctx, usuarioID)
+	if err != nil {
+		return 0, fmt.Errorf("[usecase.MarcarTodasNotificacoesLidas]: %w", err)
+	}
+	return marcadas, nil
+}
+
+// ExpurgarNotificacoes exclui as notificações criadas há mais dias que a retenção, lidas ou
+// não, e retorna quantas foram excluídas.
+func (u *CentralNotificacoesUsecase) ExpurgarNotificacoes(ctx context.Context, diasRetencao int) (int, error) {
+	limite := time.Now().AddDate(0, 0, -diasRetencao)
+
+	removidas, err := u.repository.RemoverAnteriores(ctx, limite)
+	if err != nil {
+		return 0, fmt.Errorf("[usecase.ExpurgarNotificacoes]: %w", err)
+	}
+	return removidas, nil
+}
+
+// NotificacaoUsecase representa a camada de caso de uso que notifica os usuários a partir
+// dos eventos despachados da outbox, na central de notificações e por e-mail.
+type NotificacaoUsecase struct {
+	repositoryChamado     repository.BuscarChamado
+	repositoryUsuario     repository.BuscarUsuario
+	repositoryAtendimento repository.BuscarAtendimento
+	repositoryPreferencia repository.PreferenciaNotificacaoRepository
+	repositoryCentral     repository.NotificacaoUsuarioRepository
+	entrega               repository.EntregaNotificacao
+}
+
+// NewNotificacaoUsecase cria uma nova instância de NotificacaoUsecase. Com a entrega nil,
+// as notificações são incluídas apenas na central de notificações.
+func NewNotificacaoUsecase(
+	repositoryChamado repository.BuscarChamado,
+	repositoryUsuario repository.BuscarUsuario,
+	repositoryAtendimento repository.BuscarAtendimento,
+	repositoryPreferencia repository.PreferenciaNotificacaoRepository,
+	repositoryCentral repository.NotificacaoUsuarioRepository,
+	entrega repository.EntregaNotificacao,
+) *NotificacaoUsecase {
+	return &NotificacaoUsecase{
+		repositoryChamado:     repositoryChamado,
+		repositoryUsuario:     repositoryUsuario,
+		repositoryAtendimento: repositoryAtendimento,
+		repositoryPreferencia: repositoryPreferencia,
+		repositoryCentral:     repositoryCentral,
+		entrega:               entrega,
+	}
+}
+
+// ConsumirEvento inclui a notificação do evento na central do destinatário, na transação
+// do despacho da outbox, e a coloca na fila de e-mails, se o destinatário não a desabilitou.
+// Com a fila de e-mails cheia, o evento volta para a outbox; o e-mail só se repete se a
+// transação falhar depois de enfileirado.
+func (u *NotificacaoUsecase) ConsumirEvento(ctx context.Context, e *model.Evento) error {
+	const metodo = "[usecase.notificacao.ConsumirEvento]: %w"
+
+	if !eventoNotificavel(e) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeoutNotificacao)
+	defer cancel()
+
+	notificacao, err := u.montarNotificacao(ctx, e)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if notificacao == nil {
+		return nil
+	}
+
+	if err := u.incluirNaCentral(ctx, notificacao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if u.entrega == nil {
+		return nil
+	}
+
+	habilitado, err := u.repositoryPreferencia.EmailHabilitado(ctx, notificacao.Destinatario.ID, notificacao.Tipo)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if !habilitado {
+		return nil
+	}
+
+	if err := u.entrega.Entregar(notificacao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// Metodos auxiliares
+
+// eventoNotificavel indica se o evento pode gerar notificações. Notas internas não
+// são notificadas, pois o destinatário pode ser o usuário que abriu o chamado.
+func eventoNotificavel(e *model.Evento) bool {
+	switch e.Tipo {
+	case model.EventoChamadoCriado, model.EventoChamadoAtribuido, model.EventoSLAEmRisco:
+		return true
+	case model.EventoUsuarioMencionado:
+		// menções em notas internas só são registradas para a equipe técnica
+		return true
+	case model.EventoAcompanhamentoCriado:
+		return !e.Interno
+	case model.EventoStatusAlterado:
+		alteracao, ok := e.Dados.(model.AlteracaoStatusEvento)
+		return ok && alteracao.Status == model.StatusResolvido
+	default:
+		return false
+	}
+}
+
+// incluirNaCentral grava a notificação na central do destinatário, para os tipos exibidos na central.
+func (u *NotificacaoUsecase) incluirNaCentral(ctx context.Context, n *model.Notificacao) error {
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf("[usecase.incluirNaCentral]: %w", err)
+	}
+
+	notificacao := model.NewNotificacaoUsuario(id, n)
+	if notificacao == nil {
+		return nil
+	}
+
+	if err := u.repositoryCentral.Salvar(ctx, notificacao); err != nil {
+		return fmt.Errorf("[usecase.incluirNaCentral]: %w", err)
+	}
+	return nil
+}
+
+// montarNotificacao define o destinatário e os dados da notificação do evento. Retorna nil
+// quando não há a quem notificar, como em ações do próprio destinatário.
+func (u *NotificacaoUsecase) montarNotificacao(ctx context.Context, e *model.Evento) (*model.Notificacao, error) {
+	const metodo = "[usecase.montarNotificacao]: %w"
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, e.ChamadoID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	notificacao := &model.Notificacao{Chamado: chamado}
+	var destinatarioID, autorID string
+
+	switch e.Tipo {
+	case model.EventoChamadoCriado:
+		notificacao.Tipo = model.NotificacaoChamadoCriado
+		destinatarioID = chamado.CriadorID
+
+	case model.EventoChamadoAtribuido:
+		atendimento, ok := e.Dados.(*model.Atendimento)
+		if !ok {
+			return nil, nil
+		}
+		notificacao.Tipo = model.NotificacaoChamadoAtribuido
+		destinatarioID = atendimento.AtribuidoID
+		if atendimento.AtribuidoPorID != nil {
+			autorID = *atendimento.AtribuidoPorID
+		}
+
+	case model.EventoAcompanhamentoCriado:
+		acompanhamento, ok := e.Dados.(*model.Acompanhamento)
+		if !ok {
+			return nil, nil
+		}
+		notificacao.Tipo = model.NotificacaoNovoAcompanhamento
+		notificacao.Acompanhamento = acompanhamento
+		autorID = acompanhamento.UsuarioID
+
+		// mensagens do criador vão para o técnico responsável; as demais, para o criador
+		destinatarioID = chamado.CriadorID
+		if acompanhamento.UsuarioID == chamado.CriadorID {
+			destinatarioID, err = u.tecnicoResponsavel(ctx, chamado.ID)
+			if err != nil {
+				return nil, fmt.Errorf(metodo, err)
+			}
+		}
+
+	case model.EventoUsuarioMencionado:
+		mencao, ok := e.Dados.(model.MencaoEvento)
+		if !ok || mencao.Acompanhamento == nil {
+			return nil, nil
+		}
+		notificacao.Tipo = model.NotificacaoMencao
+		notificacao.Acompanhamento = mencao.Acompanhamento
+		destinatarioID = mencao.UsuarioID
+		autorID = mencao.Acompanhamento.UsuarioID
+
+	case model.EventoStatusAlterado:
+		notificacao.Tipo = model.NotificacaoChamadoResolvido
+		destinatarioID = chamado.CriadorID
+
+	case model.EventoSLAEmRisco:
+		risco, ok := e.Dados.(model.RiscoSLAEvento)
+		if !ok {
+			return nil, nil
+		}
+		notificacao.Tipo = model.NotificacaoSLAEmRisco
+		notificacao.Prazo = &risco.Prazo
+		destinatarioID, err = u.tecnicoResponsavel(ctx, chamado.ID)
+		if err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
+
+	default:
+		return nil, nil
+	}
+
+	if destinatarioID == "" || destinatarioID == autorID {
+		return nil, nil
+	}
+
+	notificacao.Destinatario, err = u.repositoryUsuario.BuscarPorID(ctx, destinatarioID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	return notificacao, nil
+}
+
+// tecnicoResponsavel retorna o técnico do atendimento vigente do chamado, ou vazio
+// quando o chamado está na fila.
+func (u *NotificacaoUsecase) tecnicoResponsavel(ctx context.Context, chamadoID string) (string, error) {
+	ativo, err := u.repositoryAtendimento.BuscarAtivo(ctx, chamadoID)
+	if err != nil {
+		return "", fmt.Errorf("[usecase.tecnicoResponsavel]: %w", err)
+	}
+	if ativo == nil {
+		return "", nil
+	}
+	return ativo.AtribuidoID, nil
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// ObservadorUsecase representa a camada de caso de uso dos observadores dos chamados.
+type ObservadorUsecase struct {
+	repository         repository.ObservadorRepository
+	repositoryChamado  repository.BuscarChamado
+	repositoryUsuario  repository.BuscarUsuario
+	usecaseAutorizacao usecase.AutorizarOperacao
+	usecaseEvento      usecase.PublicarEvento
+}
+
+// NewObservadorUsecase cria uma nova instância de ObservadorUsecase.
+func NewObservadorUsecase(
+	repository repository.ObservadorRepository,
+	repositoryChamado repository.BuscarChamado,
+	repositoryUsuario repository.BuscarUsuario,
+	usecaseAutorizacao usecase.AutorizarOperacao,
+	usecaseEvento usecase.PublicarEvento,
+) *ObservadorUsecase {
+	return &ObservadorUsecase{
+		repository:         repository,
+		repositoryChamado:  repositoryChamado,
+		repositoryUsuario:  repositoryUsuario,
+		usecaseAutorizacao: usecaseAutorizacao,
+		usecaseEvento:      usecaseEvento,
+	}
+}
+
+// AdicionarObservador inclui o usuário nos observadores do chamado visível ao usuário
+// autenticado. Sem usuário informado, o próprio usuário autenticado passa a observar o
+// chamado; incluir outro usuário exige a permissão de atualizar o chamado.
+func (u *ObservadorUsecase) AdicionarObservador(ctx context.Context, chamadoID, usuarioID string) (*model.Observador, error) {
+	const metodo = "[usecase.AdicionarObservador]: %w"
+
+	autenticadoID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	if usuarioID == "" {
+		usuarioID = autenticadoID
+	}
+
+	observador, err := model.NewObservador(chamadoID, usuarioID, autenticadoID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamadoID); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	if usuarioID != autenticadoID {
+		if err := u.autorizarGestao(ctx, chamado, autenticadoID); err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
+		if _, err := u.repositoryUsuario.BuscarPorID(ctx, usuarioID); err != nil {
+			return nil, fmt.Errorf(metodo, err)
+		}
+	}
+
+	if err := u.repository.Salvar(ctx, observador); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	if err := u.usecaseEvento.PublicarEvento(ctx, model.NewEvento(model.EventoObservadorAdicionado, chamado, false, observador)); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	return observador, nil
+}
+
+// RemoverObservador retira o usuário dos observadores do chamado. Sem usuário informado, o
+// próprio usuário autenticado deixa de observar o chamado; retirar outro usuário exige a
+// permissão de atualizar o chamado.
+func (u *ObservadorUsecase) RemoverObservador(ctx context.Context, chamadoID, usuarioID string) error {
+	const metodo = "[usecase.RemoverObservador]: %w"
+
+	if chamadoID == "" {
+		return utils.NewAppError(
+			"[usecase.RemoverObservador]",
+			utils.LevelInfo,
+			"o ID do chamado é obrigatório para remover o observador",
+			model.ErrChamadoIDInvalido,
+		)
+	}
+
+	autenticadoID, err := ExtrairUsuarioIDDoContexto(ctx)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if usuarioID == "" {
+		usuarioID = autenticadoID
+	}
+
+	// deixar de observar não depende de o chamado continuar visível ao usuário
+	if usuarioID != autenticadoID {
+		chamado, err := u.repositoryChamado.BuscarPorID(ctx, chamadoID)
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamadoID); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		if err := u.autorizarGestao(ctx, chamado, autenticadoID); err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+	}
+
+	if err := u.repository.Remover(ctx, chamadoID, usuarioID); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// ListarObservadores retorna os observadores do chamado, desde que seja visível ao usuário autenticado.
+func (u *ObservadorUsecase) ListarObservadores(ctx context.Context, chamadoID string) ([]model.Observador, error) {
+	if chamadoID == "" {
+		return nil, utils.NewAppError(
+			"[usecase.ListarObservadores]",
+			utils.LevelInfo,
+			"o ID do chamado é obrigatório para listar os observadores",
+			model.ErrChamadoIDInvalido,
+		)
+	}
+
+	if err := verificarAcessoChamado(ctx, u.repositoryChamado, chamadoID); err != nil {
+		return nil, fmt.Errorf("[usecase.ListarObservadores]: %w", err)
+	}
+
+	observadores, err := u.repository.ListarPorChamado(ctx, chamadoID)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.ListarObservadores]: %w", err)
+	}
+	return observadores, nil
+}
+
+// Metodos auxiliares
+
+// autorizarGestao garante que o usuário autenticado pode gerenciar os observadores de outros
+// usuários no chamado: o criador do chamado e quem pode atualizá-lo na categoria.
+func (u *ObservadorUsecase) autorizarGestao(ctx context.Context, chamado *model.Chamado, autenticadoID string) error {
+	permissao, err := u.usecaseAutorizacao.AutorizarOperacao(ctx, chamado.CategoriaID, model.OperacaoAtualizar)
+	if err != nil {
+		return fmt.Errorf("[usecase.autorizarGestao]: %w", err)
+	}
+
+	if !model.PermiteOperacaoNoChamado(permissao, model.OperacaoAtualizar, autenticadoID, chamado.CriadorID) {
+		return utils.NewAppError(
+			"[usecase.autorizarGestao]",
+			utils.LevelInfo,
+			fmt.Sprintf("os observadores do chamado %s são gerenciados pelo seu criador e pela equipe técnica", chamado.ID),
+			model.ErrOperacaoNaoPermitida,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+)
+
+const (
+	// limiteMensagensOutboxPorExecucao limita as mensagens publicadas a cada execução do despacho.
+	limiteMensagensOutboxPorExecucao = 100
+
+	// reservaMensagensOutbox é o tempo em que as mensagens reservadas ficam indisponíveis para
+	// as demais instâncias enquanto são publicadas.
+	reservaMensagensOutbox = time.Minute
+
+	// esperaInicialOutbox é a espera após a primeira falha na publicação de uma mensagem.
+	esperaInicialOutbox = 5 * time.Second
+
+	// esperaMaximaOutbox limita o intervalo entre as tentativas de publicação de uma mensagem.
+	esperaMaximaOutbox = time.Hour
+)
+
+// DespachoOutboxUsecase representa a camada de caso de uso que publica as mensagens gravadas
+// na outbox: os logs são incluídos na tabela de logs e os eventos de domínio são entregues aos
+// consumidores, que gravam as notificações e as entregas dos webhooks, e depois publicados no
+// barramento, de onde seguem para os eventos em tempo real.
+type DespachoOutboxUsecase struct {
+	outbox        repository.OutboxRepository
+	repositoryLog repository.ArmazenarLog
+	barramento    repository.BarramentoEventos
+	transacao     repository.UnidadeDeTrabalho
+	consumidores  []usecase.ConsumirEvento
+}
+
+// NewDespachoOutboxUsecase cria uma nova instância de DespachoOutboxUsecase. Os consumidores
+// recebem os eventos na ordem informada.
+func NewDespachoOutboxUsecase(
+	outbox repository.OutboxRepository,
+	repositoryLog repository.ArmazenarLog,
+	barramento repository.BarramentoEventos,
+	transacao repository.UnidadeDeTrabalho,
+	consumidores ...usecase.ConsumirEvento,
+) *DespachoOutboxUsecase {
+	return &DespachoOutboxUsecase{
+		outbox:        outbox,
+		repositoryLog: repositoryLog,
+		barramento:    barramento,
+		transacao:     transacao,
+		consumidores:  consumidores,
+	}
+}
+
+// Despachar publica as mensagens disponíveis da outbox, na ordem de gravação, e retorna
+// quantas foram publicadas e quantas falharam. O log e os registros gravados pelos
+// consumidores do evento são incluídos na mesma transação que remove a mensagem, e portanto
+// uma única vez; os eventos em tempo real são publicados após a confirmação, sem garantia de
+// entrega. As mensagens que falharam voltam a ficar disponíveis após uma espera que dobra a
+// cada tentativa.
+func (u *DespachoOutboxUsecase) Despachar(ctx context.Context) (int, int, error) {
+	const metodo = "[usecase.Despachar]: %w"
+
+	mensagens, err := u.outbox.ReservarPendentes(ctx, limiteMensagensOutboxPorExecucao, reservaMensagensOutbox)
+	if err != nil {
+		return 0, 0, fmt.Errorf(metodo, err)
+	}
+
+	var publicadas, falhas int
+	var erros []error
+
+	for i := range mensagens {
+		if ctx.Err() != nil {
+			break
+		}
+		mensagem := &mensagens[i]
+
+		if err := u.publicar(ctx, mensagem); err != nil {
+			falhas++
+			mensagem.RegistrarFalha(err.Error(), esperaAposFalhasOutbox(mensagem.Tentativas))
+			if err := u.outbox.RegistrarFalha(ctx, mensagem); err != nil {
+				erros = append(erros, err)
+			}
+			continue
+		}
+		publicadas++
+	}
+
+	if len(erros) > 0 {
+		return publicadas, falhas, fmt.Errorf(metodo, errors.Join(erros...))
+	}
+	return publicadas, falhas, nil
+}
+
+// Metodos auxiliares
+
+// publicar entrega a mensagem ao seu destino e a remove da outbox.
+func (u *DespachoOutboxUsecase) publicar(ctx context.Context, m *model.MensagemOutbox) error {
+	switch {
+	case m.Destino == model.OutboxLog && m.Log != nil:
+		return u.transacao.Executar(ctx, func(ctx context.Context) error {
+			if err := u.repositoryLog.Salvar(ctx, m.Log); err != nil {
+				return err
+			}
+			return u.outbox.Remover(ctx, m.ID)
+		})
+
+	case m.Destino == model.OutboxEvento && m.Evento != nil:
+		err := u.transacao.Executar(ctx, func(ctx context.Context) error {
+			for _, consumidor := range u.consumidores {
+				if err := consumidor.ConsumirEvento(ctx, m.Evento); err != nil {
+					return err
+				}
+			}
+			return u.outbox.Remover(ctx, m.ID)
+		})
+		if err != nil {
+			return err
+		}
+
+		u.barramento.Publicar(m.Evento)
+		return nil
+	}
+
+	// o conteúdo que não pôde ser lido permanece na outbox para análise
+	if m.UltimoErro != nil {
+		return errors.New(*m.UltimoErro)
+	}
+	return model.ValidarDestinoOutbox(m.Destino)
+}
+
+// esperaAposFalhasOutbox calcula a espera até a próxima tentativa, dobrando a espera inicial a
+// cada tentativa já realizada, até o limite de uma hora.
+func esperaAposFalhasOutbox(tentativas int) time.Duration {
+	espera := esperaInicialOutbox
+	for i := 0; i < tentativas && espera < esperaMaximaOutbox; i++ {
+		espera *= 2
+	}
+	return min(espera, esperaMaximaOutbox)
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+)
+
+// outboxFake guarda as mensagens pendentes, as removidas e as falhas registradas.
+type outboxFake struct {
+	repository.OutboxRepository
+	pendentes []model.MensagemOutbox
+	removidas []string
+	falhas    []model.MensagemOutbox
+}
+
+func (o *outboxFake) ReservarPendentes(context.Context, int, time.Duration) ([]model.MensagemOutbox, error) {
+	return o.pendentes, nil
+}
+
+func (o *outboxFake) Remover(_ context.Context, id string) error {
+	o.removidas = append(o.removidas, id)
+	return nil
+}
+
+func (o *outboxFake) RegistrarFalha(_ context.Context, m *model.MensagemOutbox) error {
+	o.falhas = append(o.falhas, *m)
+	return nil
+}
+
+// logsFake guarda os logs salvos.
+type logsFake struct {
+	logs []*model.Log
+}
+
+func (l *logsFake) Salvar(_ context.Context, log *model.Log) error {
+	l.logs = append(l.logs, log)
+	return nil
+}
+
+// barramentoFake guarda os eventos publicados.
+type barramentoFake struct {
+	repository.BarramentoEventos
+	publicados []*model.Evento
+}
+
+func (b *barramentoFake) Publicar(e *model.Evento) {
+	b.publicados = append(b.publicados, e)
+}
+
+// consumidorFake conta os eventos consumidos, recusando os do chamado informado.
+type consumidorFake struct {
+	recusar    string
+	consumidos int
+}
+
+func (c *consumidorFake) ConsumirEvento(_ context.Context, e *model.Evento) error {
+	if e.ChamadoID == c.recusar {
+		return errors.New("falha ao gravar a notificação")
+	}
+	c.consumidos++
+	return nil
+}
+
+func TestDespachoOutboxUsecaseDespachar(t *testing.T) {
+	outbox := &outboxFake{pendentes: []model.MensagemOutbox{
+		*model.NewMensagemOutboxLog("msg-1", &model.Log{}),
+		*model.NewMensagemOutboxEvento("msg-2", &model.Evento{Tipo: model.EventoStatusAlterado, ChamadoID: "ch-1"}),
+		*model.NewMensagemOutboxEvento("msg-3", &model.Evento{Tipo: model.EventoStatusAlterado, ChamadoID: "ch-2"}),
+		{ID: "msg-4", Destino: model.DestinoOutbox("FILA")},
+	}}
+	logs := &logsFake{}
+	barramento := &barramentoFake{}
+	consumidor := &consumidorFake{recusar: "ch-2"}
+	u := NewDespachoOutboxUsecase(outbox, logs, barramento, &unidadeTrabalhoFake{}, consumidor)
+
+	publicadas, falhas, err := u.Despachar(context.Background())
+	if err != nil {
+		t.Fatalf("Despachar = %v, esperado nil", err)
+	}
+	if publicadas != 2 || falhas != 2 {
+		t.Errorf("publicadas = %d e falhas = %d, esperado 2 e 2", publicadas, falhas)
+	}
+
+	if len(logs.logs) != 1 {
+		t.Errorf("logs salvos = %d, esperado 1", len(logs.logs))
+	}
+	if len(outbox.removidas) != 2 || outbox.removidas[0] != "msg-1" || outbox.removidas[1] != "msg-2" {
+		t.Errorf("removidas = %v, esperado [msg-1 msg-2]", outbox.removidas)
+	}
+
+	// o evento recusado por um consumidor não segue para o tempo real
+	if len(barramento.publicados) != 1 || barramento.publicados[0].ChamadoID != "ch-1" {
+		t.Errorf("eventos publicados = %v, esperado apenas o do ch-1", barramento.publicados)
+	}
+
+	if len(outbox.falhas) != 2 {
+		t.Fatalf("falhas registradas = %d, esperado 2", len(outbox.falhas))
+	}
+	for _, falha := range outbox.falhas {
+		if falha.Tentativas != 1 || falha.UltimoErro == nil || !falha.DisponivelEm.After(time.Now()) {
+			t.Errorf("falha %s = tentativas %d, erro %v, disponível em %s; esperado adiada com o erro",
+				falha.ID, falha.Tentativas, falha.UltimoErro, falha.DisponivelEm)
+		}
+	}
+}
+
+func TestEsperaAposFalhasOutbox(t *testing.T) {
+	casos := []struct {
+		tentativas int
+		esperado   time.Duration
+	}{
+		{0, esperaInicialOutbox},
+		{1, 2 * esperaInicialOutbox},
+		{3, 8 * esperaInicialOutbox},
+		{20, esperaMaximaOutbox},
+	}
+
+	for _, c := range casos {
+		if obtido := esperaAposFalhasOutbox(c.tentativas); obtido != c.esperado {
+			t.Errorf("esperaAposFalhasOutbox(%d) = %s, esperado %s", c.tentativas, obtido, c.esperado)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// validadeCachePermissoesPapel limita por quanto tempo as permissões de um papel ficam em
+// memória. As alterações feitas por esta instância valem de imediato; as feitas por outras
+// instâncias, após a validade.
+const validadeCachePermissoesPapel = time.Minute
+
+// permissoesPapelEmCache guarda as permissões de um papel lidas do repositório.
+type permissoesPapelEmCache struct {
+	permissoes map[model.PermissaoNomeada]struct{}
+	lidasEm    time.Time
+}
+
+// PapelUsecase representa a camada de caso de uso dos papéis e das permissões nomeadas,
+// consultada pelo middleware a cada requisição às rotas protegidas.
+type PapelUsecase struct {
+	repository       repository.PapelRepository
+	usecaseRevogacao usecase.RevogarTokens
+
+	mu    sync.RWMutex
+	cache map[string]permissoesPapelEmCache
+}
+
+// NewPapelUsecase cria uma nova instância de PapelUsecase.
+func NewPapelUsecase(repository repository.PapelRepository, usecaseRevogacao usecase.RevogarTokens) *PapelUsecase {
+	return &PapelUsecase{
+		repository:       repository,
+		usecaseRevogacao: usecaseRevogacao,
+		cache:            map[string]permissoesPapelEmCache{},
+	}
+}
+
+// BuscarPapelPorCodigo busca um papel pelo código, com as permissões concedidas.
+func (u *PapelUsecase) BuscarPapelPorCodigo(ctx context.Context, codigo string) (*model.Papel, error) {
+	if err := model.ValidarCodigoPapel(codigo); err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarPapelPorCodigo]: %w", err)
+	}
+
+	papel, err := u.repository.BuscarPorCodigo(ctx, codigo)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarPapelPorCodigo]: %w", err)
+	}
+	return papel, nil
+}
+
+// ListarPapeis lista todos os papéis, com as permissões concedidas.
+func (u *PapelUsecase) ListarPapeis(ctx context.Context) ([]model.Papel, error) {
+	papeis, err := u.repository.Listar(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.ListarPapeis]: %w", err)
+	}
+	return papeis, nil
+}
+
+// ListarPermissoesDisponiveis lista as permissões nomeadas que podem ser concedidas aos papéis.
+func (u *PapelUsecase) ListarPermissoesDisponiveis() []model.DefinicaoPermissao {
+	return model.PermissoesDisponiveis
+}
+
+// CriarPapel cria um novo papel com as permissões informadas.
+func (u *PapelUsecase) CriarPapel(ctx context.Context, papel *model.Papel) error {
+	const metodo = "[usecase.CriarPapel]: %w"
+
+	novo, err := model.NewPapel(
+		papel.Codigo,
+		papel.Nome,
+		papel.Descricao,
+		papel.PermissaoBase,
+		papel.Permissoes,
+	)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.Salvar(ctx, novo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	u.invalidarCache(novo.Codigo)
+	*papel = *novo
+	return nil
+}
+
+// AtualizarPapel atualiza o nome, a descrição e a permissão base de um papel. A permissão
+// base dos papéis do sistema não pode ser alterada.
+func (u *PapelUsecase) AtualizarPapel(ctx context.Context, codigo string, papel *model.Papel) error {
+	const metodo = "[usecase.AtualizarPapel]: %w"
+
+	atual, err := u.BuscarPapelPorCodigo(ctx, codigo)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if atual.Sistema && papel.PermissaoBase != atual.PermissaoBase {
+		return utils.NewAppError(
+			"[usecase.AtualizarPapel]",
+			utils.LevelInfo,
+			fmt.Sprintf("a permissão base do papel do sistema %s não pode ser alterada", codigo),
+			model.ErrPapelDoSistema,
+		)
+	}
+
+	papel.Codigo = atual.Codigo
+	papel.Sistema = atual.Sistema
+	papel.Permissoes = atual.Permissoes
+	if err := model.ValidarPapel(papel); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.Atualizar(ctx, codigo, papel); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	u.invalidarCache(codigo)
+	return nil
+}
+
+// AtualizarPermissoesPapel substitui as permissões concedidas a um papel.
+func (u *PapelUsecase) AtualizarPermissoesPapel(ctx context.Context, codigo string, permissoes []model.PermissaoNomeada) error {
+	const metodo = "[usecase.AtualizarPermissoesPapel]: %w"
+
+	if err := model.ValidarCodigoPapel(codigo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := model.ValidarPermissoesNomeadas(permissoes); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := model.ValidarPermissoesEssenciais(codigo, permissoes); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.AtualizarPermissoes(ctx, codigo, permissoes); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	u.invalidarCache(codigo)
+	return nil
+}
+
+// DeletarPapel remove um papel personalizado sem usuários. Os papéis do sistema não podem
+// ser removidos.
+func (u *PapelUsecase) DeletarPapel(ctx context.Context, codigo string) error {
+	const metodo = "[usecase.DeletarPapel]: %w"
+
+	if err := model.ValidarCodigoPapel(codigo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if model.PapelDoSistema(codigo) {
+		return utils.NewAppError(
+			"[usecase.DeletarPapel]",
+			utils.LevelInfo,
+			fmt.Sprintf("o papel do sistema %s não pode ser removido", codigo),
+			model.ErrPapelDoSistema,
+		)
+	}
+
+	if err := u.repository.Deletar(ctx, codigo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	u.invalidarCache(codigo)
+	return nil
+}
+
+// AtribuirPapelUsuario define o papel do usuário, cuja permissão passa a ser a permissão
+// base do papel. Os tokens do usuário são revogados e a alteração vale a partir do próximo
+// login.
+func (u *PapelUsecase) AtribuirPapelUsuario(ctx context.Context, usuarioID, codigo string) error {
+	const metodo = "[usecase.AtribuirPapelUsuario]: %w"
+
+	if usuarioID == "" {
+		return utils.NewAppError(
+			"[usecase.AtribuirPapelUsuario]",
+			utils.LevelInfo,
+			"erro ao atribuir papel ao usuário",
+			model.ErrIDInvalido,
+		)
+	}
+
+	papel, err := u.BuscarPapelPorCodigo(ctx, codigo)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.AtribuirAoUsuario(ctx, usuarioID, papel); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.usecaseRevogacao.RevogarTokensUsuario(ctx, usuarioID); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// PapelPossuiPermissao indica se o papel concede a permissão nomeada. Papéis inexistentes
+// não concedem permissões.
+func (u *PapelUsecase) PapelPossuiPermissao(ctx context.Context, codigo string, permissao model.PermissaoNomeada) (bool, error) {
+	u.mu.RLock()
+	emCache, ok := u.cache[codigo]
+	u.mu.RUnlock()
+
+	if !ok || time.Since(emCache.lidasEm) > validadeCachePermissoesPapel {
+		permissoes, err := u.repository.ListarPermissoes(ctx, codigo)
+		if err != nil {
+			return false, fmt.Errorf("[usecase.PapelPossuiPermissao]: %w", err)
+		}
+
+		emCache = permissoesPapelEmCache{
+			permissoes: make(map[model.PermissaoNomeada]struct{}, len(permissoes)),
+			lidasEm:    time.Now(),
+		}
+		for _, p := range permissoes {
+			emCache.permissoes[p] = struct{}{}
+		}
+
+		u.mu.Lock()
+		u.cache[codigo] = emCache
+		u.mu.Unlock()
+	}
+
+	_, possui := emCache.permissoes[permissao]
+	return possui, nil
+}
+
+// Metodos auxiliares
+
+// invalidarCache descarta as permissões do papel guardadas em memória.
+func (u *PapelUsecase) invalidarCache(codigo string) {
+	u.mu.Lock()
+	delete(u.cache, codigo)
+	u.mu.Unlock()
+}
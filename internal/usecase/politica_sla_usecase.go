@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// PoliticaSLAUsecase representa a camada de caso de uso para operações relacionadas a políticas de SLA.
+type PoliticaSLAUsecase struct {
+	repository repository.PoliticaSLARepository
+}
+
+// NewPoliticaSLAUsecase cria uma nova instância de PoliticaSLAUsecase.
+func NewPoliticaSLAUsecase(repository repository.PoliticaSLARepository) *PoliticaSLAUsecase {
+	return &PoliticaSLAUsecase{repository: repository}
+}
+
+// BuscarPoliticaSLAPorID busca uma política de SLA pelo seu ID.
+func (u *PoliticaSLAUsecase) BuscarPoliticaSLAPorID(ctx context.Context, id string) (*model.PoliticaSLA, error) {
+	if id == "" {
+		return nil, utils.NewAppError(
+			"[usecase.BuscarPoliticaSLAPorID]",
+			utils.LevelInfo,
+			"erro ao buscar política de SLA por id",
+			model.ErrPoliticaSLAIDInvalido,
+		)
+	}
+
+	politica, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarPoliticaSLAPorID]: %w", err)
+	}
+	return politica, nil
+}
+
+// CriarPoliticaSLA cria uma nova política de SLA.
+func (u *PoliticaSLAUsecase) CriarPoliticaSLA(ctx context.Context, politica *model.PoliticaSLA) error {
+	const metodo = "[usecase.CriarPoliticaSLA]: %w"
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	politica.ID = id
+
+	politica.Status = true
+
+	politica, err = model.NewPoliticaSLA(
+		politica.ID,
+		politica.Nome,
+		politica.CategoriaID,
+		politica.SubcategoriaID,
+		politica.PrimeiraRespostaMinutos,
+		politica.SolucaoMinutos,
+		politica.PausarAguardandoUsuario,
+		politica.Status,
+	)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.Salvar(ctx, politica); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// AtualizarPoliticaSLA atualiza as informações de uma política de SLA existente.
+func (u *PoliticaSLAUsecase) AtualizarPoliticaSLA(ctx context.Context, id string, politica *model.PoliticaSLA) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.AtualizarPoliticaSLA]",
+			utils.LevelInfo,
+			"erro ao atualizar política de SLA",
+			model.ErrPoliticaSLAIDInvalido,
+		)
+	}
+
+	if err := model.ValidarPoliticaSLA(politica); err != nil {
+		return fmt.Errorf("[usecase.AtualizarPoliticaSLA]: %w", err)
+	}
+
+	if err := u.repository.Atualizar(ctx, id, politica); err != nil {
+		return fmt.Errorf("[usecase.AtualizarPoliticaSLA]: %w", err)
+	}
+	return nil
+}
+
+// AtivarPoliticaSLA ativa uma política de SLA.
+func (u *PoliticaSLAUsecase) AtivarPoliticaSLA(ctx context.Context, id string) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.AtivarPoliticaSLA]",
+			utils.LevelInfo,
+			"erro ao ativar política de SLA",
+			model.ErrPoliticaSLAIDInvalido,
+		)
+	}
+
+	if err := u.repository.Ativar(ctx, id); err != nil {
+		return fmt.Errorf("[usecase.AtivarPoliticaSLA]: %w", err)
+	}
+	return nil
+}
+
+// DesativarPoliticaSLA desativa uma política de SLA.
+func (u *PoliticaSLAUsecase) DesativarPoliticaSLA(ctx context.Context, id string) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.DesativarPoliticaSLA]",
+			utils.LevelInfo,
+			"erro ao desativar política de SLA",
+			model.ErrPoliticaSLAIDInvalido,
+		)
+	}
+
+	if err := u.repository.Desativar(ctx, id); err != nil {
+		return fmt.Errorf("[usecase.DesativarPoliticaSLA]: %w", err)
+	}
+	return nil
+}
+
+// ListarPoliticasSLA lista políticas de SLA com paginação e filtros opcionais.
+func (u *PoliticaSLAUsecase) ListarPoliticasSLA(ctx context.Context, filtro model.PoliticaSLAFiltro) ([]model.PoliticaSLA, int, model.PoliticaSLAFiltro, error) {
+	if filtro.Pagina <= 0 {
+		filtro.Pagina = 1
+	}
+
+	if filtro.Limite <= 0 || filtro.Limite > 100 {
+		filtro.Limite = 10
+	}
+
+	politicas, total, err := u.repository.Listar(ctx, filtro)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarPoliticasSLA]: %w", err)
+	}
+
+	return politicas, total, filtro, nil
+}
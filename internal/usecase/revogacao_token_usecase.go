@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// validadeCacheRevogacoes limita por quanto tempo as revogações dos tokens de um usuário ficam
+// em memória. As revogações feitas por esta instância valem de imediato; as feitas por outras
+// instâncias, após a validade.
+const validadeCacheRevogacoes = time.Minute
+
+// revogacoesEmCache guarda as revogações dos tokens de um usuário lidas do repositório.
+type revogacoesEmCache struct {
+	revogacoes *model.RevogacoesUsuario
+	lidasEm    time.Time
+}
+
+// RevogacaoTokenUsecase representa a camada de caso de uso da revogação dos tokens, consultada
+// pelo middleware a cada requisição às rotas protegidas.
+type RevogacaoTokenUsecase struct {
+	repository       repository.TokenRevogadoRepository
+	repositorySessao repository.ArmazenarSessao
+	transacao        repository.UnidadeDeTrabalho // o cache só reflete revogações confirmadas
+
+	mu    sync.RWMutex
+	cache map[string]revogacoesEmCache
+}
+
+// NewRevogacaoTokenUsecase cria uma nova instância de RevogacaoTokenUsecase.
+func NewRevogacaoTokenUsecase(
+	repository repository.TokenRevogadoRepository,
+	repositorySessao repository.ArmazenarSessao,
+	transacao repository.UnidadeDeTrabalho,
+) *RevogacaoTokenUsecase {
+	return &RevogacaoTokenUsecase{
+		repository:       repository,
+		repositorySessao: repositorySessao,
+		transacao:        transacao,
+		cache:            map[string]revogacoesEmCache{},
+	}
+}
+
+// TokenRevogado indica se o token do usuário, identificado pela sessão, pelo jti e pelo
+// instante de emissão, foi revogado.
+func (u *RevogacaoTokenUsecase) TokenRevogado(ctx context.Context, usuarioID, sessaoID, jti string, emitidoEm *time.Time) (bool, error) {
+	u.mu.RLock()
+	emCache, ok := u.cache[usuarioID]
+	u.mu.RUnlock()
+
+	if !ok || time.Since(emCache.lidasEm) > validadeCacheRevogacoes {
+		revogacoes, err := u.repository.BuscarRevogacoesUsuario(ctx, usuarioID)
+		if err != nil {
+			return false, fmt.Errorf("[usecase.TokenRevogado]: %w", err)
+		}
+
+		emCache = revogacoesEmCache{revogacoes: revogacoes, lidasEm: time.Now()}
+
+		u.mu.Lock()
+		u.cache[usuarioID] = emCache
+		u.mu.Unlock()
+	}
+
+	return emCache.revogacoes.Revogado(sessaoID, jti, emitidoEm), nil
+}
+
+// RevogarToken revoga um único token até a expiração, no logout da sessão.
+func (u *RevogacaoTokenUsecase) RevogarToken(ctx context.Context, usuarioID, jti string, expiraEm time.Time) error {
+	const metodo = "[usecase.RevogarToken]: %w"
+
+	token, err := model.NewTokenRevogado(jti, usuarioID, expiraEm)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.Salvar(ctx, token); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	u.atualizarCache(ctx, usuarioID, func(r *model.RevogacoesUsuario) {
+		r.Tokens[jti] = struct{}{}
+	})
+	return nil
+}
+
+// RevogarSessao encerra a sessão do usuário; os tokens de acesso e de refresh emitidos para
+// a sessão deixam de valer.
+func (u *RevogacaoTokenUsecase) RevogarSessao(ctx context.Context, usuarioID, sessaoID string) error {
+	if sessaoID == "" {
+		return utils.NewAppError(
+			"[usecase.RevogarSessao]",
+			utils.LevelInfo,
+			"erro ao encerrar a sessão",
+			model.ErrSessaoIDInvalido,
+		)
+	}
+
+	if err := u.repositorySessao.Revogar(ctx, sessaoID, time.Now()); err != nil {
+		return fmt.Errorf("[usecase.RevogarSessao]: %w", err)
+	}
+
+	u.atualizarCache(ctx, usuarioID, func(r *model.RevogacoesUsuario) {
+		r.Sessoes[sessaoID] = struct{}{}
+	})
+	return nil
+}
+
+// RevogarTokensUsuario revoga todos os tokens emitidos até agora para o usuário e encerra as
+// suas sessões. Como o instante de emissão do JWT tem precisão de segundos, os tokens
+// emitidos no mesmo segundo da revogação também são recusados.
+func (u *RevogacaoTokenUsecase) RevogarTokensUsuario(ctx context.Context, usuarioID string) error {
+	if usuarioID == "" {
+		return utils.NewAppError(
+			"[usecase.RevogarTokensUsuario]",
+			utils.LevelInfo,
+			"erro ao revogar os tokens do usuário",
+			model.ErrIDInvalido,
+		)
+	}
+
+	revogadoEm := time.Now().Truncate(time.Second)
+	if err := u.repository.RevogarTodosDoUsuario(ctx, usuarioID, revogadoEm); err != nil {
+		return fmt.Errorf("[usecase.RevogarTokensUsuario]: %w", err)
+	}
+
+	if err := u.repositorySessao.RevogarTodasDoUsuario(ctx, usuarioID, revogadoEm); err != nil {
+		return fmt.Errorf("[usecase.RevogarTokensUsuario]: %w", err)
+	}
+
+	u.atualizarCache(ctx, usuarioID, func(r *model.RevogacoesUsuario) {
+		r.RevogadoEm = &revogadoEm
+	})
+	return nil
+}
+
+// ExpurgarTokensRevogados exclui as revogações dos tokens e as sessões já expirados, cujos
+// tokens a validação do JWT recusa por conta própria.
+func (u *RevogacaoTokenUsecase) ExpurgarTokensRevogados(ctx context.Context) (int, error) {
+	agora := time.Now()
+
+	tokens, err := u.repository.RemoverExpirados(ctx, agora)
+	if err != nil {
+		return 0, fmt.Errorf("[usecase.ExpurgarTokensRevogados]: %w", err)
+	}
+
+	sessoes, err := u.repositorySessao.RemoverExpiradas(ctx, agora)
+	if err != nil {
+		return tokens, fmt.Errorf("[usecase.ExpurgarTokensRevogados]: %w", err)
+	}
+	return tokens + sessoes, nil
+}
+
+// Metodos auxiliares
+
+// atualizarCache aplica a revogação às revogações do usuário guardadas em memória, para que
+// valha de imediato nesta instância. Dentro de uma transação, a revogação só é aplicada após
+// a confirmação, já que, desfeita, ela não existe no repositório. Sem revogações em memória,
+// a próxima verificação as lê do repositório.
+func (u *RevogacaoTokenUsecase) atualizarCache(ctx context.Context, usuarioID string, aplicar func(r *model.RevogacoesUsuario)) {
+	u.transacao.AposConfirmar(ctx, func() {
+		u.aplicarNoCache(usuarioID, aplicar)
+	})
+}
+
+// aplicarNoCache aplica a revogação a uma cópia das revogações do usuário em memória.
+func (u *RevogacaoTokenUsecase) aplicarNoCache(usuarioID string, aplicar func(r *model.RevogacoesUsuario)) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	emCache, ok := u.cache[usuarioID]
+	if !ok {
+		return
+	}
+
+	// Copia as revogações, já que as verificações em andamento podem estar lendo as atuais
+	revogacoes := &model.RevogacoesUsuario{
+		RevogadoEm: emCache.revogacoes.RevogadoEm,
+		Tokens:     make(map[string]struct{}, len(emCache.revogacoes.Tokens)+1),
+		Sessoes:    make(map[string]struct{}, len(emCache.revogacoes.Sessoes)+1),
+	}
+	for jti := range emCache.revogacoes.Tokens {
+		revogacoes.Tokens[jti] = struct{}{}
+	}
+	for sessaoID := range emCache.revogacoes.Sessoes {
+		revogacoes.Sessoes[sessaoID] = struct{}{}
+	}
+	aplicar(revogacoes)
+
+	u.cache[usuarioID] = revogacoesEmCache{revogacoes: revogacoes, lidasEm: emCache.lidasEm}
+}
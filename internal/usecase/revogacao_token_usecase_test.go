@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+)
+
+// tokenRevogadoRepositoryFake devolve revogações vazias e conta as leituras.
+type tokenRevogadoRepositoryFake struct {
+	repository.TokenRevogadoRepository
+	leituras int
+}
+
+func (r *tokenRevogadoRepositoryFake) BuscarRevogacoesUsuario(context.Context, string) (*model.RevogacoesUsuario, error) {
+	r.leituras++
+	return &model.RevogacoesUsuario{Tokens: map[string]struct{}{}, Sessoes: map[string]struct{}{}}, nil
+}
+
+func (r *tokenRevogadoRepositoryFake) Salvar(context.Context, *model.TokenRevogado) error {
+	return nil
+}
+
+func (r *tokenRevogadoRepositoryFake) RevogarTodosDoUsuario(context.Context, string, time.Time) error {
+	return nil
+}
+
+// sessoesRevogadasFake aceita o encerramento das sessões.
+type sessoesRevogadasFake struct {
+	repository.ArmazenarSessao
+}
+
+func (r *sessoesRevogadasFake) Revogar(context.Context, string, time.Time) error {
+	return nil
+}
+
+func (r *sessoesRevogadasFake) RevogarTodasDoUsuario(context.Context, string, time.Time) error {
+	return nil
+}
+
+// tokenRevogado consulta a revogação do token jti-1 da sessão ses-1 do usuário usr-1.
+func tokenRevogado(t *testing.T, u *RevogacaoTokenUsecase, emitidoEm time.Time) bool {
+	t.Helper()
+	revogado, err := u.TokenRevogado(context.Background(), "usr-1", "ses-1", "jti-1", &emitidoEm)
+	if err != nil {
+		t.Fatalf("TokenRevogado = %v, esperado nil", err)
+	}
+	return revogado
+}
+
+func TestRevogacaoTokenUsecaseCache(t *testing.T) {
+	ctx := context.Background()
+	emitidoEm := time.Now().Add(-time.Minute)
+	repo := &tokenRevogadoRepositoryFake{}
+	transacao := &unidadeTrabalhoFake{}
+	u := NewRevogacaoTokenUsecase(repo, &sessoesRevogadasFake{}, transacao)
+
+	// as revogações são lidas uma vez e guardadas em memória
+	if tokenRevogado(t, u, emitidoEm) || tokenRevogado(t, u, emitidoEm) {
+		t.Fatal("token revogado sem revogação, esperado válido")
+	}
+	if repo.leituras != 1 {
+		t.Errorf("leituras = %d, esperado 1", repo.leituras)
+	}
+
+	// a revogação desfeita não chega ao cache
+	falha := errors.New("falha após a revogação")
+	err := transacao.Executar(ctx, func(ctx context.Context) error {
+		if err := u.RevogarSessao(ctx, "usr-1", "ses-1"); err != nil {
+			return err
+		}
+		if tokenRevogado(t, u, emitidoEm) {
+			t.Error("token revogado antes da confirmação, esperado válido")
+		}
+		return falha
+	})
+	if !errors.Is(err, falha) {
+		t.Fatalf("Executar = %v, esperado %v", err, falha)
+	}
+	if tokenRevogado(t, u, emitidoEm) {
+		t.Error("token revogado por uma transação desfeita, esperado válido")
+	}
+
+	// a revogação confirmada vale de imediato, sem nova leitura
+	err = transacao.Executar(ctx, func(ctx context.Context) error {
+		return u.RevogarSessao(ctx, "usr-1", "ses-1")
+	})
+	if err != nil {
+		t.Fatalf("Executar = %v, esperado nil", err)
+	}
+	if !tokenRevogado(t, u, emitidoEm) {
+		t.Error("token válido após a revogação confirmada da sessão, esperado revogado")
+	}
+	if repo.leituras != 1 {
+		t.Errorf("leituras = %d, esperado 1", repo.leituras)
+	}
+}
+
+func TestRevogacaoTokenUsecaseRevogarTokensUsuario(t *testing.T) {
+	repo := &tokenRevogadoRepositoryFake{}
+	u := NewRevogacaoTokenUsecase(repo, &sessoesRevogadasFake{}, &unidadeTrabalhoFake{})
+
+	emitidoAntes := time.Now().Add(-time.Minute)
+	if tokenRevogado(t, u, emitidoAntes) {
+		t.Fatal("token revogado sem revogação, esperado válido")
+	}
+
+	// fora de uma transação, a revogação vale de imediato
+	if err := u.RevogarTokensUsuario(context.Background(), "usr-1"); err != nil {
+		t.Fatalf("RevogarTokensUsuario = %v, esperado nil", err)
+	}
+	if !tokenRevogado(t, u, emitidoAntes) {
+		t.Error("token emitido antes da revogação válido, esperado revogado")
+	}
+
+	// os tokens emitidos depois, como os do novo login, continuam valendo
+	if tokenRevogado(t, u, time.Now().Add(2*time.Second)) {
+		t.Error("token emitido após a revogação revogado, esperado válido")
+	}
+}
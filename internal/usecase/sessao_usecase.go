@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+	infra "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+// SessaoUsecase representa a camada de caso de uso das sessões dos usuários, abertas no
+// login e mantidas pela rotação dos tokens de refresh.
+type SessaoUsecase struct {
+	repository       repository.SessaoRepository
+	usecaseRevogacao usecase.RevogarTokens
+}
+
+// NewSessaoUsecase cria uma nova instância de SessaoUsecase.
+func NewSessaoUsecase(repository repository.SessaoRepository, usecaseRevogacao usecase.RevogarTokens) *SessaoUsecase {
+	return &SessaoUsecase{repository: repository, usecaseRevogacao: usecaseRevogacao}
+}
+
+// IniciarSessao registra a sessão aberta no login, com o token de refresh emitido.
+func (u *SessaoUsecase) IniciarSessao(ctx context.Context, sessaoID, usuarioID, refreshJTI string, origem model.OrigemSessao, expiraEm time.Time) error {
+	const metodo = "[usecase.IniciarSessao]: %w"
+
+	sessao, err := model.NewSessao(sessaoID, usuarioID, refreshJTI, origem, expiraEm)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.Salvar(ctx, sessao); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// RotacionarSessao substitui o token de refresh apresentado pelo novo token. Só o último
+// token emitido para a sessão é aceito: a reapresentação de um token já substituído indica
+// que ele foi copiado, e a sessão é encerrada, invalidando também o token legítimo.
+func (u *SessaoUsecase) RotacionarSessao(ctx context.Context, sessaoID, usuarioID, refreshJTI, novoRefreshJTI string, origem model.OrigemSessao, expiraEm time.Time) error {
+	const metodo = "[usecase.RotacionarSessao]: %w"
+
+	sessao, err := u.repository.BuscarPorID(ctx, sessaoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if sessao.UsuarioID != usuarioID {
+		return fmt.Errorf(metodo, model.ErrTokenOutroUsuario)
+	}
+
+	if sessao.RevogadaEm != nil {
+		return fmt.Errorf(metodo, model.ErrTokenRevogado)
+	}
+
+	if sessao.RefreshJTI == refreshJTI {
+		sessao.Rotacionar(novoRefreshJTI, origem, expiraEm)
+
+		// Falha quando outro refresh com o mesmo token foi aceito antes
+		rotacionada, err := u.repository.Rotacionar(ctx, sessao, refreshJTI)
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		if rotacionada {
+			return nil
+		}
+	}
+
+	log.Printf("[usecase.RotacionarSessao] reuso de token de refresh: sessão %s do usuário %s encerrada", sessaoID, usuarioID)
+
+	if err := u.usecaseRevogacao.RevogarSessao(ctx, usuarioID, sessaoID); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	return utils.NewAppError(
+		"[usecase.RotacionarSessao]",
+		utils.LevelWarning,
+		fmt.Sprintf("o token de refresh da sessão %s já foi utilizado", sessaoID),
+		model.ErrReusoRefreshToken,
+	)
+}
+
+// ListarSessoesUsuario retorna as sessões ativas do usuário, marcando a sessão atual.
+func (u *SessaoUsecase) ListarSessoesUsuario(ctx context.Context, usuarioID, sessaoAtual string) ([]model.Sessao, error) {
+	if usuarioID == "" {
+		return nil, utils.NewAppError(
+			"[usecase.ListarSessoesUsuario]",
+			utils.LevelInfo,
+			"erro ao listar as sessões do usuário",
+			model.ErrIDInvalido,
+		)
+	}
+
+	sessoes, err := u.repository.ListarAtivasPorUsuario(ctx, usuarioID)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.ListarSessoesUsuario]: %w", err)
+	}
+
+	for i := range sessoes {
+		sessoes[i].Atual = sessoes[i].ID == sessaoAtual
+	}
+	return sessoes, nil
+}
+
+// EncerrarSessaoPropria encerra uma sessão do próprio usuário. As sessões de outros usuários
+// são tratadas como não encontradas.
+func (u *SessaoUsecase) EncerrarSessaoPropria(ctx context.Context, usuarioID, sessaoID string) error {
+	const metodo = "[usecase.EncerrarSessaoPropria]: %w"
+
+	sessao, err := u.buscarSessao(ctx, sessaoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if sessao.UsuarioID != usuarioID {
+		return utils.NewAppError(
+			"[usecase.EncerrarSessaoPropria]",
+			utils.LevelInfo,
+			fmt.Sprintf("a sessão %s não pertence ao usuário", sessaoID),
+			infra.ErrSessaoNaoEncontrada,
+		)
+	}
+
+	if err := u.usecaseRevogacao.RevogarSessao(ctx, sessao.UsuarioID, sessao.ID); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// EncerrarSessao encerra a sessão de qualquer usuário.
+func (u *SessaoUsecase) EncerrarSessao(ctx context.Context, sessaoID string) error {
+	const metodo = "[usecase.EncerrarSessao]: %w"
+
+	sessao, err := u.buscarSessao(ctx, sessaoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.usecaseRevogacao.RevogarSessao(ctx, sessao.UsuarioID, sessao.ID); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// Metodos auxiliares
+
+// buscarSessao valida o ID e busca a sessão.
+func (u *SessaoUsecase) buscarSessao(ctx context.Context, sessaoID string) (*model.Sessao, error) {
+	if sessaoID == "" {
+		return nil, utils.NewAppError(
+			"[usecase.buscarSessao]",
+			utils.LevelInfo,
+			"erro ao buscar a sessão",
+			model.ErrSessaoIDInvalido,
+		)
+	}
+
+	sessao, err := u.repository.BuscarPorID(ctx, sessaoID)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.buscarSessao]: %w", err)
+	}
+	return sessao, nil
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
+)
+
+// sessaoRepositoryFake guarda as sessões em memória. A rotação é condicional ao token
+// vigente, como no repositório MySQL; antesDeRotacionar simula uma rotação concorrente.
+type sessaoRepositoryFake struct {
+	repository.SessaoRepository
+	sessoes           map[string]model.Sessao
+	antesDeRotacionar func()
+}
+
+func (r *sessaoRepositoryFake) BuscarPorID(_ context.Context, id string) (*model.Sessao, error) {
+	s, ok := r.sessoes[id]
+	if !ok {
+		return nil, errors.New("sessão não encontrada")
+	}
+	return &s, nil
+}
+
+func (r *sessaoRepositoryFake) Rotacionar(_ context.Context, s *model.Sessao, refreshJTIAnterior string) (bool, error) {
+	if r.antesDeRotacionar != nil {
+		r.antesDeRotacionar()
+	}
+	atual := r.sessoes[s.ID]
+	if atual.RefreshJTI != refreshJTIAnterior || atual.RevogadaEm != nil {
+		return false, nil
+	}
+	r.sessoes[s.ID] = *s
+	return true, nil
+}
+
+func (r *sessaoRepositoryFake) Revogar(_ context.Context, id string, revogadaEm time.Time) error {
+	s := r.sessoes[id]
+	s.RevogadaEm = &revogadaEm
+	r.sessoes[id] = s
+	return nil
+}
+
+// revogacaoSessaoFake encerra as sessões no repositório e registra as encerradas.
+type revogacaoSessaoFake struct {
+	usecase.RevogarTokens
+	repository *sessaoRepositoryFake
+	encerradas []string
+}
+
+func (r *revogacaoSessaoFake) RevogarSessao(ctx context.Context, _ string, sessaoID string) error {
+	r.encerradas = append(r.encerradas, sessaoID)
+	return r.repository.Revogar(ctx, sessaoID, time.Now())
+}
+
+// novaSessaoFake cria o repositório com a sessão ses-1 do usuário usr-1, no token jti-1.
+func novaSessaoFake() (*SessaoUsecase, *sessaoRepositoryFake, *revogacaoSessaoFake) {
+	repo := &sessaoRepositoryFake{sessoes: map[string]model.Sessao{
+		"ses-1": {ID: "ses-1", UsuarioID: "usr-1", RefreshJTI: "jti-1", ExpiraEm: time.Now().Add(time.Hour)},
+	}}
+	revogacao := &revogacaoSessaoFake{repository: repo}
+	return NewSessaoUsecase(repo, revogacao), repo, revogacao
+}
+
+func TestSessaoUsecaseRotacionarSessao(t *testing.T) {
+	ctx := context.Background()
+	expiraEm := time.Now().Add(2 * time.Hour)
+	u, repo, revogacao := novaSessaoFake()
+
+	// o token vigente é substituído pelo novo
+	if err := u.RotacionarSessao(ctx, "ses-1", "usr-1", "jti-1", "jti-2", model.OrigemSessao{}, expiraEm); err != nil {
+		t.Fatalf("RotacionarSessao(jti-1) = %v, esperado nil", err)
+	}
+	if s := repo.sessoes["ses-1"]; s.RefreshJTI != "jti-2" || !s.ExpiraEm.Equal(expiraEm) {
+		t.Errorf("sessão = %s até %s, esperado jti-2 até %s", s.RefreshJTI, s.ExpiraEm, expiraEm)
+	}
+
+	// o token substituído reapresentado encerra a sessão
+	err := u.RotacionarSessao(ctx, "ses-1", "usr-1", "jti-1", "jti-3", model.OrigemSessao{}, expiraEm)
+	if !errors.Is(err, model.ErrReusoRefreshToken) {
+		t.Fatalf("RotacionarSessao(jti-1 reapresentado) = %v, esperado %v", err, model.ErrReusoRefreshToken)
+	}
+	if len(revogacao.encerradas) != 1 || revogacao.encerradas[0] != "ses-1" {
+		t.Errorf("sessões encerradas = %v, esperado [ses-1]", revogacao.encerradas)
+	}
+
+	// o token legítimo também deixa de valer
+	err = u.RotacionarSessao(ctx, "ses-1", "usr-1", "jti-2", "jti-4", model.OrigemSessao{}, expiraEm)
+	if !errors.Is(err, model.ErrTokenRevogado) {
+		t.Errorf("RotacionarSessao(jti-2) = %v, esperado %v", err, model.ErrTokenRevogado)
+	}
+}
+
+func TestSessaoUsecaseRotacionarSessaoConcorrente(t *testing.T) {
+	u, repo, revogacao := novaSessaoFake()
+
+	// outro refresh com o mesmo token é aceito entre a leitura e a gravação
+	repo.antesDeRotacionar = func() {
+		s := repo.sessoes["ses-1"]
+		s.RefreshJTI = "jti-outro"
+		repo.sessoes["ses-1"] = s
+	}
+
+	err := u.RotacionarSessao(context.Background(), "ses-1", "usr-1", "jti-1", "jti-2", model.OrigemSessao{}, time.Now().Add(time.Hour))
+	if !errors.Is(err, model.ErrReusoRefreshToken) {
+		t.Fatalf("RotacionarSessao = %v, esperado %v", err, model.ErrReusoRefreshToken)
+	}
+	if len(revogacao.encerradas) != 1 {
+		t.Errorf("sessões encerradas = %v, esperado a sessão ses-1", revogacao.encerradas)
+	}
+}
+
+func TestSessaoUsecaseRotacionarSessaoOutroUsuario(t *testing.T) {
+	u, _, revogacao := novaSessaoFake()
+
+	err := u.RotacionarSessao(context.Background(), "ses-1", "usr-2", "jti-1", "jti-2", model.OrigemSessao{}, time.Now().Add(time.Hour))
+	if !errors.Is(err, model.ErrTokenOutroUsuario) {
+		t.Fatalf("RotacionarSessao = %v, esperado %v", err, model.ErrTokenOutroUsuario)
+	}
+	if len(revogacao.encerradas) != 0 {
+		t.Errorf("sessões encerradas = %v, esperado nenhuma", revogacao.encerradas)
+	}
+}
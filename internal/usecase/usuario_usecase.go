@@ -7,17 +7,19 @@ import (
 
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/usecase"
 	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
 )
 
 // UsuarioUsecase representa a camada de caso de uso para operações relacionadas a usuários.
 type UsuarioUsecase struct {
-	repository repository.UsuarioRepository
+	repository       repository.UsuarioRepository
+	usecaseRevogacao usecase.RevogarTokens
 }
 
 // NewUsuarioUsecase cria uma nova instância de UsuarioUsecase.
-func NewUsuarioUsecase(repository repository.UsuarioRepository) *UsuarioUsecase {
-	return &UsuarioUsecase{repository: repository}
+func NewUsuarioUsecase(repository repository.UsuarioRepository, usecaseRevogacao usecase.RevogarTokens) *UsuarioUsecase {
+	return &UsuarioUsecase{repository: repository, usecaseRevogacao: usecaseRevogacao}
 }
 
 // BuscarUsuarioPorID busca um usuário pelo seu ID.
@@ -107,7 +109,10 @@ func (u *UsuarioUsecase) AtualizarUltimoLoginUsuario(ctx context.Context, id str
 	return nil
 }
 
-// AtualizarUsuario atualiza as informações de um usuário.
+// AtualizarUsuario atualiza as informações cadastrais de um usuário e preenche usuario com os
+// dados atualizados. A permissão e o status são mantidos: eles só são alterados por
+// AtualizarPermissaoUsuario, DesativarUsuario e AtivarUsuario, que revogam os tokens emitidos
+// quando o acesso do usuário diminui.
 func (u *UsuarioUsecase) AtualizarUsuario(ctx context.Context, id string, usuario *model.Usuario) error {
 	if id == "" {
 		return utils.NewAppError(
@@ -118,17 +123,26 @@ func (u *UsuarioUsecase) AtualizarUsuario(ctx context.Context, id string, usuari
 		)
 	}
 
-	if err := model.ValidarUsuario(usuario); err != nil {
+	atual, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
 		return fmt.Errorf("[usecase.AtualizarUsuario]: %w", err)
 	}
+	atual.Nome = usuario.Nome
+	atual.Email = usuario.Email
+	atual.Avatar = usuario.Avatar
 
-	if err := u.repository.Atualizar(ctx, id, usuario); err != nil {
+	if err := model.ValidarUsuario(atual); err != nil {
 		return fmt.Errorf("[usecase.AtualizarUsuario]: %w", err)
 	}
+
+	if err := u.repository.Atualizar(ctx, id, atual); err != nil {
+		return fmt.Errorf("[usecase.AtualizarUsuario]: %w", err)
+	}
+	*usuario = *atual
 	return nil
 }
 
-// AtualizarPermissaoUsuario atualiza a permissão do usuário.
+// AtualizarPermissaoUsuario atualiza a permissão do usuário e revoga os tokens já emitidos.
 func (u *UsuarioUsecase) AtualizarPermissaoUsuario(ctx context.Context, id string, permissao string) error {
 	if id == "" {
 		return utils.NewAppError(
@@ -146,10 +160,15 @@ func (u *UsuarioUsecase) AtualizarPermissaoUsuario(ctx context.Context, id strin
 	if err := u.repository.AtualizarPermissao(ctx, id, permissao); err != nil {
 		return fmt.Errorf("[usecase.AtualizarPermissaoUsuario]: %w", err)
 	}
+
+	// Os tokens emitidos com a permissão anterior deixam de valer
+	if err := u.usecaseRevogacao.RevogarTokensUsuario(ctx, id); err != nil {
+		return fmt.Errorf("[usecase.AtualizarPermissaoUsuario]: %w", err)
+	}
 	return nil
 }
 
-// DesativarUsuario desativa um usuário.
+// DesativarUsuario desativa um usuário e revoga os tokens já emitidos.
 func (u *UsuarioUsecase) DesativarUsuario(ctx context.Context, id string) error {
 	if id == "" {
 		return utils.NewAppError(
@@ -163,6 +182,11 @@ func (u *UsuarioUsecase) DesativarUsuario(ctx context.Context, id string) error
 	if err := u.repository.Desativar(ctx, id); err != nil {
 		return fmt.Errorf("[usecase.DesativarUsuario]: %w", err)
 	}
+
+	// O usuário desativado perde as sessões abertas
+	if err := u.usecaseRevogacao.RevogarTokensUsuario(ctx, id); err != nil {
+		return fmt.Errorf("[usecase.DesativarUsuario]: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+)
+
+// usuarioRepositoryFake guarda os usuários em memória; os métodos não usados nos testes
+// ficam com a interface embutida.
+type usuarioRepositoryFake struct {
+	repository.UsuarioRepository
+	usuarios map[string]model.Usuario
+}
+
+func (r *usuarioRepositoryFake) BuscarPorID(_ context.Context, id string) (*model.Usuario, error) {
+	u, ok := r.usuarios[id]
+	if !ok {
+		return nil, errors.New("usuário não encontrado")
+	}
+	return &u, nil
+}
+
+func (r *usuarioRepositoryFake) Atualizar(_ context.Context, id string, u *model.Usuario) error {
+	r.usuarios[id] = *u
+	return nil
+}
+
+func TestUsuarioUsecaseAtualizarUsuarioMantemPermissaoEStatus(t *testing.T) {
+	papel := "SUPORTE"
+	repo := &usuarioRepositoryFake{usuarios: map[string]model.Usuario{
+		"usr-1": {ID: "usr-1", Nome: "Ana", Login: "ana", Email: "ana@exemplo.com", Permissao: model.PermUSR, Papel: &papel, Status: true},
+	}}
+	u := NewUsuarioUsecase(repo, nil)
+
+	// a permissão e o status enviados no payload não são aplicados pela atualização genérica
+	usuario := &model.Usuario{Nome: "Ana Souza", Email: "ana.souza@exemplo.com", Permissao: model.PermADM, Status: false}
+	if err := u.AtualizarUsuario(context.Background(), "usr-1", usuario); err != nil {
+		t.Fatalf("AtualizarUsuario = %v, esperado nil", err)
+	}
+
+	salvo := repo.usuarios["usr-1"]
+	if salvo.Permissao != model.PermUSR || salvo.Papel == nil || *salvo.Papel != papel {
+		t.Errorf("permissão salva = %s (papel %v), esperado %s (papel %s)", salvo.Permissao, salvo.Papel, model.PermUSR, papel)
+	}
+	if !salvo.Status {
+		t.Error("status salvo = false, esperado o usuário ativo")
+	}
+	if salvo.Nome != "Ana Souza" || salvo.Email != "ana.souza@exemplo.com" {
+		t.Errorf("dados salvos = %s <%s>, esperado os dados do payload", salvo.Nome, salvo.Email)
+	}
+	if usuario.Login != "ana" || usuario.Permissao != model.PermUSR {
+		t.Errorf("usuario devolvido = %s (%s), esperado os dados atualizados", usuario.Login, usuario.Permissao)
+	}
+}
@@ -0,0 +1,453 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	infra "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/utils"
+)
+
+const (
+	// timeoutEnfileiramentoWebhook limita a inclusão das entregas de cada evento na fila.
+	timeoutEnfileiramentoWebhook = 30 * time.Second
+
+	// limiteEntregasPorExecucao limita as entregas enviadas a cada execução do disparo.
+	limiteEntregasPorExecucao = 50
+
+	// esperaMaximaWebhook limita o intervalo entre as tentativas de uma entrega.
+	esperaMaximaWebhook = 24 * time.Hour
+
+	// tamanhoMaximoErroWebhook limita o erro guardado no registro da tentativa.
+	tamanhoMaximoErroWebhook = 1000
+
+	// bytesSegredoWebhook é o tamanho do segredo gerado na criação, antes da codificação hexadecimal.
+	bytesSegredoWebhook = 32
+)
+
+// WebhookUsecase representa a camada de caso de uso para operações relacionadas a webhooks.
+type WebhookUsecase struct {
+	repository        repository.WebhookRepository
+	repositoryEntrega repository.EntregaWebhookRepository
+}
+
+// NewWebhookUsecase cria uma nova instância de WebhookUsecase.
+func NewWebhookUsecase(repository repository.WebhookRepository, repositoryEntrega repository.EntregaWebhookRepository) *WebhookUsecase {
+	return &WebhookUsecase{repository: repository, repositoryEntrega: repositoryEntrega}
+}
+
+// BuscarWebhookPorID busca um webhook pelo seu ID, sem o segredo.
+func (u *WebhookUsecase) BuscarWebhookPorID(ctx context.Context, id string) (*model.Webhook, error) {
+	if id == "" {
+		return nil, utils.NewAppError(
+			"[usecase.BuscarWebhookPorID]",
+			utils.LevelInfo,
+			"erro ao buscar webhook por id",
+			model.ErrWebhookIDInvalido,
+		)
+	}
+
+	webhook, err := u.repository.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarWebhookPorID]: %w", err)
+	}
+	webhook.Segredo = ""
+	return webhook, nil
+}
+
+// CriarWebhook cria um novo webhook. Quando o segredo não é informado, um segredo aleatório
+// é gerado; ele é retornado apenas nesta operação.
+func (u *WebhookUsecase) CriarWebhook(ctx context.Context, webhook *model.Webhook) error {
+	const metodo = "[usecase.CriarWebhook]: %w"
+
+	id, err := utils.NewUUIDv7String()
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	webhook.ID = id
+
+	if webhook.Segredo == "" {
+		webhook.Segredo, err = utils.NewTokenAleatorio(bytesSegredoWebhook)
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+	}
+
+	webhook.Status = true
+
+	novo, err := model.NewWebhook(
+		webhook.ID,
+		webhook.URL,
+		webhook.Segredo,
+		webhook.TiposEvento,
+		webhook.Status,
+	)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	if err := u.repository.Salvar(ctx, novo); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	*webhook = *novo
+	return nil
+}
+
+// AtualizarWebhook atualiza a URL e os tipos de evento de um webhook existente. O segredo é
+// substituído apenas quando informado.
+func (u *WebhookUsecase) AtualizarWebhook(ctx context.Context, id string, webhook *model.Webhook) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.AtualizarWebhook]",
+			utils.LevelInfo,
+			"erro ao atualizar webhook",
+			model.ErrWebhookIDInvalido,
+		)
+	}
+
+	if err := model.ValidarWebhook(webhook); err != nil {
+		return fmt.Errorf("[usecase.AtualizarWebhook]: %w", err)
+	}
+
+	if err := u.repository.Atualizar(ctx, id, webhook); err != nil {
+		return fmt.Errorf("[usecase.AtualizarWebhook]: %w", err)
+	}
+	webhook.Segredo = ""
+	return nil
+}
+
+// AtivarWebhook ativa um webhook.
+func (u *WebhookUsecase) AtivarWebhook(ctx context.Context, id string) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.AtivarWebhook]",
+			utils.LevelInfo,
+			"erro ao ativar webhook",
+			model.ErrWebhookIDInvalido,
+		)
+	}
+
+	if err := u.repository.Ativar(ctx, id); err != nil {
+		return fmt.Errorf("[usecase.AtivarWebhook]: %w", err)
+	}
+	return nil
+}
+
+// DesativarWebhook desativa um webhook. As entregas pendentes são encerradas como falha no
+// próximo disparo e podem ser reenviadas após a reativação.
+func (u *WebhookUsecase) DesativarWebhook(ctx context.Context, id string) error {
+	if id == "" {
+		return utils.NewAppError(
+			"[usecase.DesativarWebhook]",
+			utils.LevelInfo,
+			"erro ao desativar webhook",
+			model.ErrWebhookIDInvalido,
+		)
+	}
+
+	if err := u.repository.Desativar(ctx, id); err != nil {
+		return fmt.Errorf("[usecase.DesativarWebhook]: %w", err)
+	}
+	return nil
+}
+
+// ListarWebhooks lista webhooks com paginação e filtros opcionais, sem os segredos.
+func (u *WebhookUsecase) ListarWebhooks(ctx context.Context, filtro model.WebhookFiltro) ([]model.Webhook, int, model.WebhookFiltro, error) {
+	if filtro.Pagina <= 0 {
+		filtro.Pagina = 1
+	}
+
+	if filtro.Limite <= 0 || filtro.Limite > 100 {
+		filtro.Limite = 10
+	}
+
+	if filtro.TipoEvento != nil {
+		if err := model.ValidarTipoEventoWebhook(*filtro.TipoEvento); err != nil {
+			return nil, 0, filtro, fmt.Errorf("[usecase.ListarWebhooks]: %w", err)
+		}
+	}
+
+	webhooks, total, err := u.repository.Listar(ctx, filtro)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf("[usecase.ListarWebhooks]: %w", err)
+	}
+
+	for i := range webhooks {
+		webhooks[i].Segredo = ""
+	}
+	return webhooks, total, filtro, nil
+}
+
+// BuscarEntregaWebhookPorID busca uma entrega pelo seu ID, com o payload e as tentativas realizadas.
+func (u *WebhookUsecase) BuscarEntregaWebhookPorID(ctx context.Context, id string) (*model.EntregaWebhook, error) {
+	if id == "" {
+		return nil, utils.NewAppError(
+			"[usecase.BuscarEntregaWebhookPorID]",
+			utils.LevelInfo,
+			"erro ao buscar entrega de webhook por id",
+			model.ErrEntregaWebhookIDInvalido,
+		)
+	}
+
+	entrega, err := u.repositoryEntrega.BuscarPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[usecase.BuscarEntregaWebhookPorID]: %w", err)
+	}
+	return entrega, nil
+}
+
+// ListarEntregasWebhook lista as entregas com paginação e filtros opcionais.
+func (u *WebhookUsecase) ListarEntregasWebhook(ctx context.Context, filtro model.EntregaWebhookFiltro) ([]model.EntregaWebhook, int, model.EntregaWebhookFiltro, error) {
+	const metodo = "[usecase.ListarEntregasWebhook]: %w"
+
+	if filtro.Pagina <= 0 {
+		filtro.Pagina = 1
+	}
+
+	if filtro.Limite <= 0 || filtro.Limite > 100 {
+		filtro.Limite = 10
+	}
+
+	if filtro.TipoEvento != nil {
+		if err := model.ValidarTipoEventoWebhook(*filtro.TipoEvento); err != nil {
+			return nil, 0, filtro, fmt.Errorf(metodo, err)
+		}
+	}
+
+	if filtro.Status != nil {
+		if err := model.ValidarStatusEntregaWebhook(*filtro.Status); err != nil {
+			return nil, 0, filtro, fmt.Errorf(metodo, err)
+		}
+	}
+
+	entregas, total, err := u.repositoryEntrega.Listar(ctx, filtro)
+	if err != nil {
+		return nil, 0, filtro, fmt.Errorf(metodo, err)
+	}
+
+	return entregas, total, filtro, nil
+}
+
+// ReenviarEntregaWebhook inclui na fila uma nova entrega com o conteúdo da entrega informada,
+// qualquer que seja a sua situação. O webhook precisa estar ativo.
+func (u *WebhookUsecase) ReenviarEntregaWebhook(ctx context.Context, id string) (*model.EntregaWebhook, error) {
+	const metodo = "[usecase.ReenviarEntregaWebhook]: %w"
+
+	original, err := u.BuscarEntregaWebhookPorID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	webhook, err := u.repository.BuscarPorID(ctx, original.WebhookID)
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	if !webhook.Status {
+		return nil, utils.NewAppError(
+			"[usecase.ReenviarEntregaWebhook]",
+			utils.LevelInfo,
+			"não é possível reenviar entregas de um webhook desativado",
+			model.ErrWebhookInativo,
+		)
+	}
+
+	novoID, err := utils.NewUUIDv7String()
+	if err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+
+	reenvio := model.NewReenvioEntregaWebhook(novoID, original)
+	if err := u.repositoryEntrega.Salvar(ctx, []model.EntregaWebhook{*reenvio}); err != nil {
+		return nil, fmt.Errorf(metodo, err)
+	}
+	return reenvio, nil
+}
+
+// DisparoWebhookUsecase representa a camada de caso de uso que inclui os eventos despachados
+// da outbox na fila de entregas e envia as entregas pendentes aos webhooks.
+type DisparoWebhookUsecase struct {
+	repositoryWebhook repository.BuscarWebhook
+	repositoryEntrega repository.EntregaWebhookRepository
+	repositoryChamado repository.BuscarChamado
+	envio             repository.EnvioWebhook
+	tentativasMaximas int
+	espera            time.Duration
+	timeoutEnvio      time.Duration
+}
+
+// NewDisparoWebhookUsecase cria uma nova instância de DisparoWebhookUsecase. A espera entre as
+// tentativas dobra a cada falha, até o limite de 24 horas.
+func NewDisparoWebhookUsecase(
+	repositoryWebhook repository.BuscarWebhook,
+	repositoryEntrega repository.EntregaWebhookRepository,
+	repositoryChamado repository.BuscarChamado,
+	envio repository.EnvioWebhook,
+	tentativasMaximas int,
+	espera time.Duration,
+	timeoutEnvio time.Duration,
+) *DisparoWebhookUsecase {
+	return &DisparoWebhookUsecase{
+		repositoryWebhook: repositoryWebhook,
+		repositoryEntrega: repositoryEntrega,
+		repositoryChamado: repositoryChamado,
+		envio:             envio,
+		tentativasMaximas: tentativasMaximas,
+		espera:            espera,
+		timeoutEnvio:      timeoutEnvio,
+	}
+}
+
+// ConsumirEvento inclui na fila uma entrega para cada webhook ativo que assina o evento,
+// na transação do despacho da outbox.
+func (u *DisparoWebhookUsecase) ConsumirEvento(ctx context.Context, e *model.Evento) error {
+	const metodo = "[usecase.webhook.ConsumirEvento]: %w"
+
+	if !model.EventoEnviadoPorWebhook(e) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeoutEnfileiramentoWebhook)
+	defer cancel()
+
+	webhooks, err := u.repositoryWebhook.ListarAtivosPorTipoEvento(ctx, e.Tipo)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	chamado, err := u.repositoryChamado.BuscarPorID(ctx, e.ChamadoID)
+	if err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+
+	entregas := make([]model.EntregaWebhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		id, err := utils.NewUUIDv7String()
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+
+		entrega, err := model.NewEntregaWebhook(id, w.ID, e, chamado)
+		if err != nil {
+			return fmt.Errorf(metodo, err)
+		}
+		entregas = append(entregas, *entrega)
+	}
+
+	if err := u.repositoryEntrega.Salvar(ctx, entregas); err != nil {
+		return fmt.Errorf(metodo, err)
+	}
+	return nil
+}
+
+// EntregarPendentes envia as entregas pendentes cuja próxima tentativa já venceu e retorna
+// quantas foram entregues e quantas falharam. As entregas de webhooks desativados ou
+// excluídos são encerradas como falha sem envio.
+func (u *DisparoWebhookUsecase) EntregarPendentes(ctx context.Context) (int, int, error) {
+	const metodo = "[usecase.EntregarPendentes]: %w"
+
+	reserva := time.Duration(limiteEntregasPorExecucao) * u.timeoutEnvio
+	entregas, err := u.repositoryEntrega.ReservarPendentes(ctx, limiteEntregasPorExecucao, reserva)
+	if err != nil {
+		return 0, 0, fmt.Errorf(metodo, err)
+	}
+
+	webhooks := map[string]*model.Webhook{}
+	var entregues, falhas int
+	var erros []error
+
+	for i := range entregas {
+		if ctx.Err() != nil {
+			break
+		}
+		entrega := &entregas[i]
+
+		webhook, ok := webhooks[entrega.WebhookID]
+		if !ok {
+			webhook, err = u.repositoryWebhook.BuscarPorID(ctx, entrega.WebhookID)
+			if err != nil && !errors.Is(err, infra.ErrWebhookNaoEncontrado) {
+				erros = append(erros, err)
+				continue
+			}
+			webhooks[entrega.WebhookID] = webhook
+		}
+
+		tentativa := u.enviar(ctx, webhook, entrega)
+		entrega.RegistrarTentativa(tentativa, u.tentativasMaximas, u.esperaAposFalhas(entrega.Tentativas))
+		if webhook == nil || !webhook.Status {
+			entrega.Status = model.EntregaWebhookFalhou
+			entrega.ProximaTentativaEm = nil
+		}
+
+		if err := u.repositoryEntrega.RegistrarTentativa(ctx, entrega, tentativa); err != nil {
+			erros = append(erros, err)
+			continue
+		}
+
+		switch entrega.Status {
+		case model.EntregaWebhookEntregue:
+			entregues++
+		case model.EntregaWebhookFalhou:
+			falhas++
+		}
+	}
+
+	if len(erros) > 0 {
+		return entregues, falhas, fmt.Errorf(metodo, errors.Join(erros...))
+	}
+	return entregues, falhas, nil
+}
+
+// Metodos auxiliares
+
+// enviar realiza uma tentativa de envio da entrega e retorna o seu resultado. Webhooks
+// desativados ou excluídos não recebem o envio.
+func (u *DisparoWebhookUsecase) enviar(ctx context.Context, webhook *model.Webhook, entrega *model.EntregaWebhook) *model.TentativaEntregaWebhook {
+	inicio := time.Now()
+	tentativa := &model.TentativaEntregaWebhook{RealizadaEm: inicio}
+
+	if webhook == nil || !webhook.Status {
+		erro := model.ErrWebhookInativo.Error()
+		tentativa.Erro = &erro
+		return tentativa
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.timeoutEnvio)
+	defer cancel()
+
+	status, err := u.envio.Enviar(ctx, webhook, entrega)
+	tentativa.DuracaoMs = time.Since(inicio).Milliseconds()
+	if status != 0 {
+		tentativa.StatusHTTP = &status
+	}
+	if err != nil {
+		erro := truncarErroWebhook(err.Error())
+		tentativa.Erro = &erro
+	}
+	return tentativa
+}
+
+// esperaAposFalhas calcula a espera até a próxima tentativa, dobrando a espera inicial a cada
+// tentativa já realizada, até o limite de 24 horas.
+func (u *DisparoWebhookUsecase) esperaAposFalhas(tentativas int) time.Duration {
+	espera := u.espera
+	for i := 0; i < tentativas && espera < esperaMaximaWebhook; i++ {
+		espera *= 2
+	}
+	return min(espera, esperaMaximaWebhook)
+}
+
+// truncarErroWebhook limita o tamanho do erro guardado no registro da tentativa.
+func truncarErroWebhook(erro string) string {
+	if utf8.RuneCountInString(erro) <= tamanhoMaximoErroWebhook {
+		return erro
+	}
+	return string([]rune(erro)[:tamanhoMaximoErroWebhook])
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/model"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/domain/repository"
+	infra "github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/repository"
+	"github.com/smdu-sp/gestor-de-chamados-backend-Go/internal/infra/webhook"
+)
+
+// webhooksFake busca os webhooks cadastrados pelo ID.
+type webhooksFake struct {
+	repository.BuscarWebhook
+	webhooks map[string]*model.Webhook
+}
+
+func (w *webhooksFake) BuscarPorID(_ context.Context, id string) (*model.Webhook, error) {
+	webhook, ok := w.webhooks[id]
+	if !ok {
+		return nil, infra.ErrWebhookNaoEncontrado
+	}
+	return webhook, nil
+}
+
+// entregasFake reserva as entregas pendentes e guarda as tentativas registradas.
+type entregasFake struct {
+	repository.EntregaWebhookRepository
+	pendentes  []model.EntregaWebhook
+	tentativas []model.TentativaEntregaWebhook
+}
+
+func (e *entregasFake) ReservarPendentes(context.Context, int, time.Duration) ([]model.EntregaWebhook, error) {
+	return e.pendentes, nil
+}
+
+func (e *entregasFake) RegistrarTentativa(_ context.Context, _ *model.EntregaWebhook, t *model.TentativaEntregaWebhook) error {
+	e.tentativas = append(e.tentativas, *t)
+	return nil
+}
+
+// receptorWebhook é o sistema de destino, que só aceita as entregas assinadas com o segredo
+// combinado e responde com o status configurado.
+type receptorWebhook struct {
+	segredo string
+	status  int
+
+	mu        sync.Mutex
+	recebidas []string
+}
+
+func (r *receptorWebhook) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	corpo, _ := io.ReadAll(req.Body)
+
+	mac := hmac.New(sha256.New, []byte(r.segredo))
+	mac.Write([]byte(req.Header.Get(webhook.CabecalhoTimestamp) + "."))
+	mac.Write(corpo)
+	esperada := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(req.Header.Get(webhook.CabecalhoAssinatura)), []byte(esperada)) {
+		http.Error(w, "assinatura inválida", http.StatusUnauthorized)
+		return
+	}
+
+	r.mu.Lock()
+	r.recebidas = append(r.recebidas, req.Header.Get(webhook.CabecalhoEntrega))
+	r.mu.Unlock()
+	w.WriteHeader(r.status)
+}
+
+func TestDisparoWebhookUsecaseEntregarPendentes(t *testing.T) {
+	const (
+		tentativasMaximas = 3
+		espera            = time.Minute
+	)
+
+	casos := []struct {
+		nome       string
+		segredo    string // segredo cadastrado no webhook
+		ativo      bool
+		status     int // resposta do receptor às entregas assinadas
+		tentativas int // tentativas já realizadas
+		esperado   model.StatusEntregaWebhook
+		statusHTTP int
+		proxima    time.Duration // espera até a próxima tentativa, se pendente
+		recebida   bool
+	}{
+		{"entrega assinada aceita", "segredo-combinado", true, http.StatusNoContent, 0, model.EntregaWebhookEntregue, http.StatusNoContent, 0, true},
+		{"assinatura com outro segredo é recusada", "outro-segredo", true, http.StatusOK, 0, model.EntregaWebhookPendente, http.StatusUnauthorized, espera, false},
+		{"falha do destino volta à fila", "segredo-combinado", true, http.StatusServiceUnavailable, 1, model.EntregaWebhookPendente, http.StatusServiceUnavailable, espera * 2, true},
+		{"falha na última tentativa encerra a entrega", "segredo-combinado", true, http.StatusInternalServerError, tentativasMaximas - 1, model.EntregaWebhookFalhou, http.StatusInternalServerError, 0, true},
+		{"webhook desativado não recebe o envio", "segredo-combinado", false, http.StatusOK, 0, model.EntregaWebhookFalhou, 0, 0, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			receptor := &receptorWebhook{segredo: "segredo-combinado", status: c.status}
+			servidor := httptest.NewServer(receptor)
+			defer servidor.Close()
+
+			webhooks := &webhooksFake{webhooks: map[string]*model.Webhook{
+				"wh-1": {ID: "wh-1", URL: servidor.URL, Segredo: c.segredo, Status: c.ativo},
+			}}
+			entregas := &entregasFake{pendentes: []model.EntregaWebhook{{
+				ID:         "ent-1",
+				WebhookID:  "wh-1",
+				TipoEvento: model.EventoStatusAlterado,
+				ChamadoID:  "ch-1",
+				Payload:    []byte(`{"id":"ent-1","evento":"chamado.status_alterado"}`),
+				Status:     model.EntregaWebhookPendente,
+				Tentativas: c.tentativas,
+			}}}
+			u := NewDisparoWebhookUsecase(webhooks, entregas, nil, webhook.NewEnviadorHTTP(time.Second), tentativasMaximas, espera, time.Second)
+
+			if _, _, err := u.EntregarPendentes(context.Background()); err != nil {
+				t.Fatalf("EntregarPendentes = %v, esperado nil", err)
+			}
+
+			entrega := entregas.pendentes[0]
+			if entrega.Status != c.esperado || entrega.Tentativas != c.tentativas+1 {
+				t.Errorf("entrega %s após %d tentativa(s), esperado %s após %d", entrega.Status, entrega.Tentativas, c.esperado, c.tentativas+1)
+			}
+			if len(entregas.tentativas) != 1 || entregas.tentativas[0].Numero != c.tentativas+1 {
+				t.Fatalf("tentativas registradas = %+v, esperado a tentativa %d", entregas.tentativas, c.tentativas+1)
+			}
+
+			tentativa := entregas.tentativas[0]
+			statusHTTP := 0
+			if tentativa.StatusHTTP != nil {
+				statusHTTP = *tentativa.StatusHTTP
+			}
+			if statusHTTP != c.statusHTTP || (tentativa.Erro == nil) != (c.esperado == model.EntregaWebhookEntregue) {
+				t.Errorf("tentativa com status %d e erro %v, esperado status %d", statusHTTP, tentativa.Erro, c.statusHTTP)
+			}
+
+			switch {
+			case c.proxima == 0 && entrega.ProximaTentativaEm != nil:
+				t.Errorf("próxima tentativa em %s, esperado nenhuma", entrega.ProximaTentativaEm)
+			case c.proxima != 0 && (entrega.ProximaTentativaEm == nil || !entrega.ProximaTentativaEm.Equal(tentativa.RealizadaEm.Add(c.proxima))):
+				t.Errorf("próxima tentativa em %v, esperado %s após a tentativa", entrega.ProximaTentativaEm, c.proxima)
+			}
+
+			if recebida := len(receptor.recebidas) == 1 && receptor.recebidas[0] == "ent-1"; recebida != c.recebida {
+				t.Errorf("entregas recebidas = %v, esperado recebida %t", receptor.recebidas, c.recebida)
+			}
+		})
+	}
+}
+
+func TestEsperaAposFalhasWebhook(t *testing.T) {
+	u := NewDisparoWebhookUsecase(nil, nil, nil, nil, 10, time.Minute, time.Second)
+
+	casos := []struct {
+		tentativas int
+		esperado   time.Duration
+	}{
+		{0, time.Minute},
+		{1, 2 * time.Minute},
+		{3, 8 * time.Minute},
+		{10, 1024 * time.Minute},
+		{11, esperaMaximaWebhook},
+		{1000, esperaMaximaWebhook},
+	}
+
+	for _, c := range casos {
+		if obtido := u.esperaAposFalhas(c.tentativas); obtido != c.esperado {
+			t.Errorf("esperaAposFalhas(%d) = %s, esperado %s", c.tentativas, obtido, c.esperado)
+		}
+	}
+}
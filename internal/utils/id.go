@@ -2,12 +2,16 @@ package utils
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
 )
 
-var ErrUUIDv7Generation = errors.New("falha ao gerar bytes aleatórios para UUID v7")
+var (
+	ErrUUIDv7Generation         = errors.New("falha ao gerar bytes aleatórios para UUID v7")
+	ErrTokenAleatorioGeneration = errors.New("falha ao gerar bytes aleatórios para o token")
+)
 
 // UUIDv7 representa um UUID v7 de 16 bytes
 type UUIDv7 [16]byte
@@ -63,3 +67,17 @@ func (u UUIDv7) String() string {
 		u[10:16],
 	)
 }
+
+// NewTokenAleatorio gera um valor aleatório de n bytes em hexadecimal, para segredos e tokens
+func NewTokenAleatorio(n int) (string, error) {
+	token := make([]byte, n)
+	if _, err := rand.Read(token); err != nil {
+		return "", NewAppError(
+			"[utils.NewTokenAleatorio]",
+			LevelError,
+			"erro ao gerar token aleatório",
+			fmt.Errorf(FmtErroWrap, ErrTokenAleatorioGeneration, err),
+		)
+	}
+	return hex.EncodeToString(token), nil
+}
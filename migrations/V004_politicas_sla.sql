@@ -0,0 +1,39 @@
+-- Políticas de SLA por categoria/subcategoria
+CREATE TABLE IF NOT EXISTS politicas_sla (
+  id                           CHAR(36)     NOT NULL PRIMARY KEY,
+  nome                         VARCHAR(255) NOT NULL,
+  categoria_id                 CHAR(36)     NOT NULL,
+  subcategoria_id              CHAR(36)     NULL, -- nulo indica política válida para toda a categoria
+  primeira_resposta_minutos    INT          NOT NULL,
+  solucao_minutos              INT          NOT NULL,
+  pausar_aguardando_usuario    BOOLEAN      NOT NULL DEFAULT TRUE,
+  status                       BOOLEAN      NOT NULL DEFAULT TRUE, -- ativo/inativo
+  criado_em                    DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  atualizado_em                DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (categoria_id) REFERENCES categorias(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (subcategoria_id) REFERENCES subcategorias(id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+  INDEX idx_politicas_sla_categoria_id (categoria_id),
+  INDEX idx_politicas_sla_subcategoria_id (subcategoria_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
+-- Prazos e indicadores de SLA nos chamados
+ALTER TABLE chamados
+  MODIFY COLUMN status ENUM('ABERTO','ATRIBUIDO','AGUARDANDO_USUARIO','RESOLVIDO','REJEITADO','FECHADO') NOT NULL DEFAULT 'ABERTO',
+  ADD COLUMN politica_sla_id               CHAR(36) NULL,
+  ADD COLUMN prazo_primeira_resposta       DATETIME NULL,
+  ADD COLUMN prazo_solucao                 DATETIME NULL,
+  ADD COLUMN primeira_resposta_em          DATETIME NULL,
+  ADD COLUMN sla_pausado_em                DATETIME NULL, -- preenchido enquanto o SLA está pausado aguardando o usuário
+  ADD COLUMN sla_primeira_resposta_violado BOOLEAN  NOT NULL DEFAULT FALSE,
+  ADD COLUMN sla_solucao_violado           BOOLEAN  NOT NULL DEFAULT FALSE,
+  ADD CONSTRAINT fk_chamados_politica_sla FOREIGN KEY (politica_sla_id) REFERENCES politicas_sla(id) ON DELETE SET NULL ON UPDATE CASCADE,
+  ADD INDEX idx_chamados_prazo_primeira_resposta (prazo_primeira_resposta),
+  ADD INDEX idx_chamados_prazo_solucao (prazo_solucao);
+
+
+-- Violações de SLA registradas nos logs
+ALTER TABLE logs
+  MODIFY COLUMN acao ENUM('CRIAR', 'ATUALIZAR', 'ATIVAR', 'DESATIVAR', 'ARQUIVAR', 'DESARQUIVAR', 'DELETAR', 'VIOLAR_SLA') NOT NULL;
@@ -0,0 +1,88 @@
+-- Calendários de expediente (o calendário sem categoria é o padrão)
+CREATE TABLE IF NOT EXISTS calendarios (
+  id            CHAR(36)     NOT NULL PRIMARY KEY,
+  nome          VARCHAR(255) NOT NULL,
+  categoria_id  CHAR(36)     NULL, -- nulo indica o calendário padrão
+  status        BOOLEAN      NOT NULL DEFAULT TRUE, -- ativo/inativo
+  criado_em     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  atualizado_em DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (categoria_id) REFERENCES categorias(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  INDEX idx_calendarios_categoria_id (categoria_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
+-- Horário de expediente por dia da semana (0 = domingo ... 6 = sábado)
+CREATE TABLE IF NOT EXISTS calendario_expedientes (
+  calendario_id CHAR(36) NOT NULL,
+  dia_semana    TINYINT  NOT NULL,
+  inicio        CHAR(5)  NOT NULL, -- HH:MM
+  fim           CHAR(5)  NOT NULL, -- HH:MM
+
+  PRIMARY KEY (calendario_id, dia_semana),
+  FOREIGN KEY (calendario_id) REFERENCES calendarios(id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
+-- Feriados do calendário
+CREATE TABLE IF NOT EXISTS calendario_feriados (
+  calendario_id CHAR(36)     NOT NULL,
+  data          DATE         NOT NULL,
+  descricao     VARCHAR(255) NOT NULL,
+
+  PRIMARY KEY (calendario_id, data),
+  FOREIGN KEY (calendario_id) REFERENCES calendarios(id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
+-- Controle do tempo em que o chamado permaneceu atribuído
+ALTER TABLE chamados
+  ADD COLUMN atribuido_em             DATETIME NULL, -- início do período atual no status ATRIBUIDO
+  ADD COLUMN tempo_atribuido_segundos BIGINT   NOT NULL DEFAULT 0; -- tempo útil acumulado em períodos anteriores
+
+UPDATE chamados SET atribuido_em = atualizado_em WHERE status = 'ATRIBUIDO';
+
+
+-- Calendário padrão: segunda a sexta, das 08:00 às 18:00
+INSERT IGNORE INTO calendarios (id, nome, categoria_id, status, criado_em, atualizado_em) VALUES
+('01998000-0002-7000-8000-000000000001', 'Expediente padrão', NULL, TRUE, NOW(), NOW());
+
+INSERT IGNORE INTO calendario_expedientes (calendario_id, dia_semana, inicio, fim) VALUES
+('01998000-0002-7000-8000-000000000001', 1, '08:00', '18:00'),
+('01998000-0002-7000-8000-000000000001', 2, '08:00', '18:00'),
+('01998000-0002-7000-8000-000000000001', 3, '08:00', '18:00'),
+('01998000-0002-7000-8000-000000000001', 4, '08:00', '18:00'),
+('01998000-0002-7000-8000-000000000001', 5, '08:00', '18:00');
+
+-- Feriados nacionais, estaduais e municipais de São Paulo
+INSERT IGNORE INTO calendario_feriados (calendario_id, data, descricao) VALUES
+('01998000-0002-7000-8000-000000000001', '2026-01-01', 'Confraternização Universal'),
+('01998000-0002-7000-8000-000000000001', '2026-01-25', 'Aniversário da Cidade de São Paulo'),
+('01998000-0002-7000-8000-000000000001', '2026-02-16', 'Carnaval'),
+('01998000-0002-7000-8000-000000000001', '2026-02-17', 'Carnaval'),
+('01998000-0002-7000-8000-000000000001', '2026-04-03', 'Paixão de Cristo'),
+('01998000-0002-7000-8000-000000000001', '2026-04-21', 'Tiradentes'),
+('01998000-0002-7000-8000-000000000001', '2026-05-01', 'Dia do Trabalho'),
+('01998000-0002-7000-8000-000000000001', '2026-06-04', 'Corpus Christi'),
+('01998000-0002-7000-8000-000000000001', '2026-07-09', 'Revolução Constitucionalista'),
+('01998000-0002-7000-8000-000000000001', '2026-09-07', 'Independência do Brasil'),
+('01998000-0002-7000-8000-000000000001', '2026-10-12', 'Nossa Senhora Aparecida'),
+('01998000-0002-7000-8000-000000000001', '2026-11-02', 'Finados'),
+('01998000-0002-7000-8000-000000000001', '2026-11-15', 'Proclamação da República'),
+('01998000-0002-7000-8000-000000000001', '2026-11-20', 'Dia Nacional de Zumbi e da Consciência Negra'),
+('01998000-0002-7000-8000-000000000001', '2026-12-25', 'Natal'),
+('01998000-0002-7000-8000-000000000001', '2027-01-01', 'Confraternização Universal'),
+('01998000-0002-7000-8000-000000000001', '2027-01-25', 'Aniversário da Cidade de São Paulo'),
+('01998000-0002-7000-8000-000000000001', '2027-02-08', 'Carnaval'),
+('01998000-0002-7000-8000-000000000001', '2027-02-09', 'Carnaval'),
+('01998000-0002-7000-8000-000000000001', '2027-03-26', 'Paixão de Cristo'),
+('01998000-0002-7000-8000-000000000001', '2027-04-21', 'Tiradentes'),
+('01998000-0002-7000-8000-000000000001', '2027-05-01', 'Dia do Trabalho'),
+('01998000-0002-7000-8000-000000000001', '2027-05-27', 'Corpus Christi'),
+('01998000-0002-7000-8000-000000000001', '2027-07-09', 'Revolução Constitucionalista'),
+('01998000-0002-7000-8000-000000000001', '2027-09-07', 'Independência do Brasil'),
+('01998000-0002-7000-8000-000000000001', '2027-10-12', 'Nossa Senhora Aparecida'),
+('01998000-0002-7000-8000-000000000001', '2027-11-02', 'Finados'),
+('01998000-0002-7000-8000-000000000001', '2027-11-15', 'Proclamação da República'),
+('01998000-0002-7000-8000-000000000001', '2027-11-20', 'Dia Nacional de Zumbi e da Consciência Negra'),
+('01998000-0002-7000-8000-000000000001', '2027-12-25', 'Natal');
@@ -0,0 +1,28 @@
+-- Matriz de impacto x urgência usada para derivar a prioridade dos chamados
+CREATE TABLE IF NOT EXISTS matriz_prioridades (
+  impacto       ENUM('BAIXO','MEDIO','ALTO')   NOT NULL,
+  urgencia      ENUM('BAIXA','MEDIA','ALTA')   NOT NULL,
+  prioridade    ENUM('P1','P2','P3','P4')      NOT NULL,
+  atualizado_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (impacto, urgencia)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT IGNORE INTO matriz_prioridades (impacto, urgencia, prioridade, atualizado_em) VALUES
+('ALTO',  'ALTA',  'P1', NOW()),
+('ALTO',  'MEDIA', 'P2', NOW()),
+('ALTO',  'BAIXA', 'P3', NOW()),
+('MEDIO', 'ALTA',  'P2', NOW()),
+('MEDIO', 'MEDIA', 'P3', NOW()),
+('MEDIO', 'BAIXA', 'P4', NOW()),
+('BAIXO', 'ALTA',  'P3', NOW()),
+('BAIXO', 'MEDIA', 'P4', NOW()),
+('BAIXO', 'BAIXA', 'P4', NOW());
+
+
+-- Impacto, urgência e prioridade dos chamados
+ALTER TABLE chamados
+  ADD COLUMN impacto    ENUM('BAIXO','MEDIO','ALTO') NOT NULL DEFAULT 'MEDIO',
+  ADD COLUMN urgencia   ENUM('BAIXA','MEDIA','ALTA') NOT NULL DEFAULT 'MEDIA',
+  ADD COLUMN prioridade ENUM('P1','P2','P3','P4')    NOT NULL DEFAULT 'P3',
+  ADD INDEX idx_chamados_prioridade (prioridade);
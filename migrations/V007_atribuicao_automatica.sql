@@ -0,0 +1,11 @@
+-- Estratégia de atribuição automática de técnicos por categoria
+ALTER TABLE categorias
+  ADD COLUMN estrategia_atribuicao ENUM('MANUAL','RODIZIO','MENOR_CARGA','PERMISSAO_CATEGORIA') NOT NULL DEFAULT 'MANUAL';
+
+
+-- Consultas de carga e rodízio dos técnicos
+ALTER TABLE categoria_permissoes
+  ADD INDEX idx_categoria_permissoes_usuario_id (usuario_id);
+
+ALTER TABLE chamados
+  ADD INDEX idx_chamados_categoria_id_status (categoria_id, status);
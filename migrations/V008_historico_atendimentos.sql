@@ -0,0 +1,21 @@
+-- Linha do tempo de atendimentos: cada troca de técnico finaliza o atendimento vigente
+ALTER TABLE atendimentos
+  ADD COLUMN atribuido_por      CHAR(36)     NULL, -- nulo na atribuição automática
+  ADD COLUMN motivo             VARCHAR(500) NULL,
+  ADD COLUMN finalizado_em      DATETIME     NULL, -- nulo enquanto o técnico estiver atribuído
+  ADD COLUMN finalizado_por     CHAR(36)     NULL,
+  ADD COLUMN motivo_finalizacao VARCHAR(500) NULL,
+  ADD CONSTRAINT fk_atendimentos_atribuido_por FOREIGN KEY (atribuido_por) REFERENCES usuarios(id) ON UPDATE CASCADE,
+  ADD CONSTRAINT fk_atendimentos_finalizado_por FOREIGN KEY (finalizado_por) REFERENCES usuarios(id) ON UPDATE CASCADE,
+  ADD INDEX idx_atendimentos_chamado_id_finalizado_em (chamado_id, finalizado_em);
+
+-- Atendimentos anteriores à linha do tempo: apenas o mais recente de cada chamado
+-- permanece vigente, e somente se o chamado ainda estiver em andamento
+UPDATE atendimentos a
+INNER JOIN chamados c ON c.id = a.chamado_id
+INNER JOIN (
+  SELECT chamado_id, MAX(criado_em) AS criado_em FROM atendimentos GROUP BY chamado_id
+) AS ultimo ON ultimo.chamado_id = a.chamado_id
+SET a.finalizado_em = a.atualizado_em
+WHERE c.status NOT IN ('ATRIBUIDO', 'AGUARDANDO_USUARIO')
+   OR a.criado_em < ultimo.criado_em;
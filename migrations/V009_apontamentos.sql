@@ -0,0 +1,20 @@
+-- Apontamentos de horas trabalhadas nos atendimentos
+CREATE TABLE IF NOT EXISTS apontamentos (
+  id             CHAR(36) NOT NULL PRIMARY KEY,
+  atendimento_id CHAR(36) NOT NULL,
+  usuario_id     CHAR(36) NOT NULL, -- técnico que realizou o trabalho
+  iniciado_em    DATETIME NOT NULL,
+  finalizado_em  DATETIME NULL, -- nulo enquanto o cronômetro estiver em andamento
+  minutos        INT      NOT NULL DEFAULT 0,
+  descricao      TEXT     NOT NULL,
+  faturavel      BOOLEAN  NOT NULL DEFAULT FALSE,
+  criado_em      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  atualizado_em  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (atendimento_id) REFERENCES atendimentos(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (usuario_id) REFERENCES usuarios(id) ON UPDATE CASCADE,
+
+  INDEX idx_apontamentos_atendimento_id (atendimento_id),
+  INDEX idx_apontamentos_usuario_id_finalizado_em (usuario_id, finalizado_em),
+  INDEX idx_apontamentos_iniciado_em (iniciado_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,20 @@
+-- Pesquisa de satisfação respondida pelo criador após a resolução do chamado
+CREATE TABLE IF NOT EXISTS avaliacoes (
+  id             CHAR(36)   NOT NULL PRIMARY KEY,
+  chamado_id     CHAR(36)   NOT NULL,
+  avaliador_id   CHAR(36)   NOT NULL,
+  tecnico_id     CHAR(36)   NULL, -- técnico do atendimento vigente na resolução
+  nota           TINYINT    NOT NULL,
+  comentario     TEXT       NULL,
+  solucao_aceita BOOLEAN    NOT NULL,
+  criado_em      DATETIME   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT chk_avaliacoes_nota CHECK (nota BETWEEN 1 AND 5),
+  FOREIGN KEY (chamado_id) REFERENCES chamados(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (avaliador_id) REFERENCES usuarios(id) ON UPDATE CASCADE,
+  FOREIGN KEY (tecnico_id) REFERENCES usuarios(id) ON UPDATE CASCADE,
+
+  INDEX idx_avaliacoes_chamado_id (chamado_id),
+  INDEX idx_avaliacoes_tecnico_id (tecnico_id),
+  INDEX idx_avaliacoes_criado_em (criado_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+-- Usuário de sistema que registra as ações das rotinas automáticas (fechamento e arquivamento de chamados).
+-- Fica inativo e sem permissão técnica para não entrar em login nem em atribuições.
+INSERT IGNORE INTO usuarios (id, nome, login, email, permissao, status, criado_em, atualizado_em) VALUES
+('01998000-00ff-7000-8000-000000000001', 'Sistema', 'sistema', 'sistema@gestor-de-chamados.local', 'USR', FALSE, NOW(), NOW());
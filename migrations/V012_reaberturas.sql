@@ -0,0 +1,5 @@
+-- Reabertura de chamados resolvidos ou fechados, contada como indicador de qualidade
+ALTER TABLE chamados
+  ADD COLUMN reaberturas INT      NOT NULL DEFAULT 0,
+  ADD COLUMN reaberto_em DATETIME NULL,
+  ADD INDEX idx_chamados_reaberturas (reaberturas);
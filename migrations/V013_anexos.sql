@@ -0,0 +1,21 @@
+-- Metadados dos arquivos anexados a chamados e acompanhamentos; o conteúdo fica no armazenamento de arquivos
+CREATE TABLE IF NOT EXISTS anexos (
+  id                CHAR(36)     NOT NULL PRIMARY KEY,
+  chamado_id        CHAR(36)     NOT NULL,
+  acompanhamento_id CHAR(36)     NULL, -- nulo quando anexado diretamente ao chamado
+  nome_arquivo      VARCHAR(255) NOT NULL,
+  tipo_mime         VARCHAR(100) NOT NULL,
+  tamanho           BIGINT       NOT NULL,
+  hash              CHAR(64)     NOT NULL, -- SHA-256 do conteúdo
+  chave             VARCHAR(255) NOT NULL, -- localização do conteúdo no armazenamento
+  enviado_por       CHAR(36)     NOT NULL,
+  criado_em         DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (chamado_id) REFERENCES chamados(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (acompanhamento_id) REFERENCES acompanhamentos(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (enviado_por) REFERENCES usuarios(id) ON UPDATE CASCADE,
+
+  UNIQUE KEY uq_anexos_chave (chave),
+  INDEX idx_anexos_chamado_id (chamado_id),
+  INDEX idx_anexos_acompanhamento_id (acompanhamento_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
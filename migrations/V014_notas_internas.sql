@@ -0,0 +1,5 @@
+-- Notas internas nos acompanhamentos, visíveis apenas à equipe técnica, e remetentes ADM e DEV
+ALTER TABLE acompanhamentos
+  MODIFY COLUMN remetente ENUM('ADM', 'TEC', 'USR', 'DEV') NOT NULL DEFAULT 'USR',
+  ADD COLUMN visibilidade ENUM('PUBLICO', 'INTERNO') NOT NULL DEFAULT 'PUBLICO' AFTER remetente,
+  ADD INDEX idx_acompanhamentos_chamado_visibilidade (chamado_id, visibilidade);
@@ -0,0 +1,19 @@
+-- Histórico de revisões e remoção lógica dos acompanhamentos
+ALTER TABLE acompanhamentos
+  ADD COLUMN editado_em DATETIME NULL AFTER atualizado_em,
+  ADD COLUMN removido_em DATETIME NULL AFTER editado_em,
+  ADD COLUMN removido_por CHAR(36) NULL AFTER removido_em,
+  ADD CONSTRAINT fk_acompanhamentos_removido_por FOREIGN KEY (removido_por) REFERENCES usuarios(id);
+
+CREATE TABLE IF NOT EXISTS acompanhamento_revisoes (
+  id CHAR(36) PRIMARY KEY,
+  acompanhamento_id CHAR(36) NOT NULL,
+  tipo ENUM('EDICAO', 'REMOCAO') NOT NULL,
+  conteudo_anterior TEXT NOT NULL,
+  visibilidade_anterior ENUM('PUBLICO', 'INTERNO') NOT NULL,
+  alterado_por CHAR(36) NOT NULL,
+  criado_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_acompanhamento_revisoes_acompanhamento (acompanhamento_id, criado_em),
+  CONSTRAINT fk_acompanhamento_revisoes_acompanhamento FOREIGN KEY (acompanhamento_id) REFERENCES acompanhamentos(id) ON DELETE CASCADE,
+  CONSTRAINT fk_acompanhamento_revisoes_usuario FOREIGN KEY (alterado_por) REFERENCES usuarios(id)
+);
@@ -0,0 +1,15 @@
+-- Aviso de prazo de solução perto de vencer, enviado uma única vez por prazo
+ALTER TABLE chamados
+  ADD COLUMN sla_aviso_em DATETIME NULL AFTER sla_solucao_violado;
+
+
+-- Preferências de notificação por e-mail; tipos sem registro são enviados por padrão
+CREATE TABLE IF NOT EXISTS preferencias_notificacao (
+  usuario_id    CHAR(36) NOT NULL,
+  tipo          ENUM('CHAMADO_CRIADO','CHAMADO_ATRIBUIDO','NOVO_ACOMPANHAMENTO','CHAMADO_RESOLVIDO','SLA_EM_RISCO') NOT NULL,
+  email         BOOLEAN  NOT NULL DEFAULT TRUE,
+  atualizado_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (usuario_id, tipo),
+  FOREIGN KEY (usuario_id) REFERENCES usuarios(id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
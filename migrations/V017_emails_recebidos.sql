@@ -0,0 +1,15 @@
+-- Mensagens recebidas por e-mail já processadas, para que cada mensagem gere um único chamado ou acompanhamento
+CREATE TABLE IF NOT EXISTS emails_recebidos (
+  message_id        VARCHAR(255) NOT NULL PRIMARY KEY, -- Message-ID da mensagem, sem os sinais < e >
+  chamado_id        CHAR(36)     NOT NULL,
+  acompanhamento_id CHAR(36)     NULL, -- nulo quando a mensagem abriu o chamado
+  remetente_id      CHAR(36)     NOT NULL,
+  recebido_em       DATETIME     NOT NULL,
+  processado_em     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (chamado_id) REFERENCES chamados(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (acompanhamento_id) REFERENCES acompanhamentos(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (remetente_id) REFERENCES usuarios(id) ON UPDATE CASCADE,
+
+  INDEX idx_emails_recebidos_chamado_id (chamado_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,61 @@
+-- Sistemas externos inscritos nos eventos dos chamados
+CREATE TABLE IF NOT EXISTS webhooks (
+  id            CHAR(36)      NOT NULL PRIMARY KEY,
+  url           VARCHAR(2048) NOT NULL,
+  segredo       VARCHAR(255)  NOT NULL, -- chave da assinatura HMAC-SHA256 das entregas
+  status        BOOLEAN       NOT NULL DEFAULT TRUE,
+  criado_em     DATETIME      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  atualizado_em DATETIME      NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Tipos de evento assinados por cada webhook
+CREATE TABLE IF NOT EXISTS webhook_eventos (
+  webhook_id CHAR(36) NOT NULL,
+  tipo       ENUM('CHAMADO_CRIADO','ACOMPANHAMENTO_CRIADO','STATUS_ALTERADO','CHAMADO_ATRIBUIDO','SLA_EM_RISCO','SLA_VIOLADO') NOT NULL,
+
+  PRIMARY KEY (webhook_id, tipo),
+  FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+  INDEX idx_webhook_eventos_tipo (tipo)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Fila persistente das entregas de eventos aos webhooks
+CREATE TABLE IF NOT EXISTS entregas_webhook (
+  id                   CHAR(36)     NOT NULL PRIMARY KEY,
+  webhook_id           CHAR(36)     NOT NULL,
+  reenvio_de_id        CHAR(36)     NULL, -- entrega original, nos reenvios manuais
+  tipo_evento          ENUM('CHAMADO_CRIADO','ACOMPANHAMENTO_CRIADO','STATUS_ALTERADO','CHAMADO_ATRIBUIDO','SLA_EM_RISCO','SLA_VIOLADO') NOT NULL,
+  chamado_id           CHAR(36)     NOT NULL,
+  payload              MEDIUMTEXT   NOT NULL,
+  status               ENUM('PENDENTE','ENTREGUE','FALHOU') NOT NULL DEFAULT 'PENDENTE',
+  tentativas           INT          NOT NULL DEFAULT 0,
+  proxima_tentativa_em DATETIME     NULL, -- nulo quando a entrega foi encerrada
+  ultimo_status_http   INT          NULL,
+  ultimo_erro          TEXT         NULL,
+  entregue_em          DATETIME     NULL,
+  criado_em            DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  atualizado_em        DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (reenvio_de_id) REFERENCES entregas_webhook(id) ON DELETE SET NULL ON UPDATE CASCADE,
+  FOREIGN KEY (chamado_id) REFERENCES chamados(id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+  INDEX idx_entregas_webhook_fila (status, proxima_tentativa_em),
+  INDEX idx_entregas_webhook_webhook_id (webhook_id, criado_em),
+  INDEX idx_entregas_webhook_chamado_id (chamado_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Registro de cada tentativa de envio das entregas
+CREATE TABLE IF NOT EXISTS tentativas_webhook (
+  id           BIGINT   NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  entrega_id   CHAR(36) NOT NULL,
+  numero       INT      NOT NULL,
+  status_http  INT      NULL, -- nulo quando não houve resposta
+  erro         TEXT     NULL,
+  duracao_ms   BIGINT   NOT NULL DEFAULT 0,
+  realizada_em DATETIME NOT NULL,
+
+  FOREIGN KEY (entrega_id) REFERENCES entregas_webhook(id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+  UNIQUE KEY uk_tentativas_webhook_entrega_numero (entrega_id, numero)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,13 @@
+-- Logs de auditoria e eventos de domínio gravados na transação da alteração que os originou,
+-- até que o despacho da outbox os publique
+CREATE TABLE IF NOT EXISTS outbox (
+  id            CHAR(36)              NOT NULL PRIMARY KEY,
+  destino       ENUM('LOG','EVENTO')  NOT NULL,
+  conteudo      MEDIUMTEXT            NOT NULL, -- log ou evento em JSON
+  tentativas    INT                   NOT NULL DEFAULT 0,
+  disponivel_em DATETIME              NOT NULL, -- próxima publicação, adiada durante a reserva e após as falhas
+  ultimo_erro   TEXT                  NULL,
+  criado_em     DATETIME              NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  INDEX idx_outbox_disponivel_em (disponivel_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
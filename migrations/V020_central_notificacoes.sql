@@ -0,0 +1,19 @@
+-- Central de notificações dos usuários, alimentada pelos eventos dos chamados
+CREATE TABLE IF NOT EXISTS notificacoes (
+  id                CHAR(36)     NOT NULL PRIMARY KEY,
+  usuario_id        CHAR(36)     NOT NULL,
+  tipo              ENUM('CHAMADO_ATRIBUIDO','NOVO_ACOMPANHAMENTO','SLA_EM_RISCO') NOT NULL,
+  chamado_id        CHAR(36)     NOT NULL,
+  acompanhamento_id CHAR(36)     NULL,
+  mensagem          VARCHAR(500) NOT NULL,
+  lida_em           DATETIME     NULL,
+  criado_em         DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (usuario_id) REFERENCES usuarios(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (chamado_id) REFERENCES chamados(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (acompanhamento_id) REFERENCES acompanhamentos(id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+  INDEX idx_notificacoes_usuario_lida (usuario_id, lida_em),
+  INDEX idx_notificacoes_usuario_criado_em (usuario_id, criado_em),
+  INDEX idx_notificacoes_criado_em (criado_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
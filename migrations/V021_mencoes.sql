@@ -0,0 +1,24 @@
+-- Usuários mencionados com @login nos acompanhamentos; a menção concede acesso de leitura ao chamado
+CREATE TABLE IF NOT EXISTS mencoes (
+  acompanhamento_id CHAR(36) NOT NULL,
+  usuario_id        CHAR(36) NOT NULL,
+  chamado_id        CHAR(36) NOT NULL,
+  criado_em         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (acompanhamento_id, usuario_id),
+
+  FOREIGN KEY (acompanhamento_id) REFERENCES acompanhamentos(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (usuario_id) REFERENCES usuarios(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (chamado_id) REFERENCES chamados(id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+  INDEX idx_mencoes_usuario_chamado (usuario_id, chamado_id),
+  INDEX idx_mencoes_chamado_usuario (chamado_id, usuario_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
+-- Notificação de menção nas preferências e na central de notificações
+ALTER TABLE preferencias_notificacao
+  MODIFY COLUMN tipo ENUM('CHAMADO_CRIADO','CHAMADO_ATRIBUIDO','NOVO_ACOMPANHAMENTO','CHAMADO_RESOLVIDO','SLA_EM_RISCO','MENCAO') NOT NULL;
+
+ALTER TABLE notificacoes
+  MODIFY COLUMN tipo ENUM('CHAMADO_ATRIBUIDO','NOVO_ACOMPANHAMENTO','SLA_EM_RISCO','MENCAO') NOT NULL;
@@ -0,0 +1,5 @@
+-- Chamado a que o registro de log se refere, usado para restringir os logs aos chamados
+-- visíveis ao usuário; os logs anteriores e os que não se referem a um chamado ficam sem chamado
+ALTER TABLE logs
+  ADD COLUMN chamado_id CHAR(36) NULL AFTER entidade,
+  ADD INDEX idx_logs_chamado_id (chamado_id);
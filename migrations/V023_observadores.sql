@@ -0,0 +1,16 @@
+-- Observadores dos chamados; observar concede acesso de leitura ao chamado e aos seus eventos
+CREATE TABLE IF NOT EXISTS observadores (
+  chamado_id        CHAR(36) NOT NULL,
+  usuario_id        CHAR(36) NOT NULL,
+  adicionado_por_id CHAR(36) NOT NULL, -- o próprio observador ou quem o incluiu no chamado
+  criado_em         DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (chamado_id, usuario_id),
+
+  FOREIGN KEY (chamado_id) REFERENCES chamados(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (usuario_id) REFERENCES usuarios(id) ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY (adicionado_por_id) REFERENCES usuarios(id) ON UPDATE CASCADE,
+
+  INDEX idx_observadores_usuario_chamado (usuario_id, chamado_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
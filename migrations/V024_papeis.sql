@@ -0,0 +1,213 @@
+-- Papéis que reúnem as permissões nomeadas exigidas pelas rotas. Os papéis do sistema
+-- correspondem às permissões ADM, TEC, USR e DEV; os personalizados herdam as regras de
+-- negócio da permissão base
+CREATE TABLE IF NOT EXISTS papeis (
+  codigo         VARCHAR(40)  NOT NULL PRIMARY KEY,
+  nome           VARCHAR(100) NOT NULL,
+  descricao      VARCHAR(255) NULL,
+  permissao_base ENUM('ADM','TEC','USR','DEV') NOT NULL,
+  sistema        BOOLEAN      NOT NULL DEFAULT FALSE, -- papéis do sistema não podem ser removidos
+  criado_em      DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  atualizado_em  DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Permissões nomeadas (recurso.acao) concedidas a cada papel
+CREATE TABLE IF NOT EXISTS papel_permissoes (
+  papel     VARCHAR(40) NOT NULL,
+  permissao VARCHAR(60) NOT NULL,
+
+  PRIMARY KEY (papel, permissao),
+  FOREIGN KEY (papel) REFERENCES papeis(codigo) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Papel personalizado do usuário; nulo para os papéis do sistema, que seguem a permissão
+ALTER TABLE usuarios
+  ADD COLUMN papel VARCHAR(40) NULL AFTER permissao,
+  ADD CONSTRAINT fk_usuarios_papel FOREIGN KEY (papel) REFERENCES papeis(codigo) ON UPDATE CASCADE;
+
+INSERT IGNORE INTO papeis (codigo, nome, descricao, permissao_base, sistema, criado_em, atualizado_em) VALUES
+('ADM', 'Administrador', 'Administra o sistema e os seus cadastros', 'ADM', TRUE, NOW(), NOW()),
+('TEC', 'Técnico', 'Atende os chamados', 'TEC', TRUE, NOW(), NOW()),
+('USR', 'Usuário', 'Abre e acompanha os próprios chamados', 'USR', TRUE, NOW(), NOW()),
+('DEV', 'Desenvolvedor', 'Atende os chamados e acompanha a operação do sistema', 'DEV', TRUE, NOW(), NOW());
+
+-- Mapeamento padrão, equivalente às permissões fixas das rotas antes dos papéis
+-- Administrador (ADM)
+INSERT IGNORE INTO papel_permissoes (papel, permissao) VALUES
+('ADM', 'usuario.criar'),
+('ADM', 'usuario.ler'),
+('ADM', 'usuario.editar'),
+('ADM', 'usuario.editar_permissao'),
+('ADM', 'usuario.ativar'),
+('ADM', 'chamado.criar'),
+('ADM', 'chamado.ler'),
+('ADM', 'chamado.observar'),
+('ADM', 'chamado.editar'),
+('ADM', 'chamado.alterar_status'),
+('ADM', 'chamado.alterar_prioridade'),
+('ADM', 'chamado.reabrir'),
+('ADM', 'chamado.ler_reaberturas'),
+('ADM', 'chamado.arquivar'),
+('ADM', 'categoria.criar'),
+('ADM', 'categoria.ler'),
+('ADM', 'categoria.editar'),
+('ADM', 'categoria.ativar'),
+('ADM', 'subcategoria.criar'),
+('ADM', 'subcategoria.ler'),
+('ADM', 'subcategoria.editar'),
+('ADM', 'subcategoria.ativar'),
+('ADM', 'log.ler'),
+('ADM', 'acompanhamento.criar'),
+('ADM', 'acompanhamento.ler'),
+('ADM', 'acompanhamento.editar'),
+('ADM', 'acompanhamento.deletar'),
+('ADM', 'acompanhamento.ler_revisoes'),
+('ADM', 'anexo.enviar'),
+('ADM', 'anexo.ler'),
+('ADM', 'anexo.deletar'),
+('ADM', 'evento.assinar'),
+('ADM', 'notificacao.ler'),
+('ADM', 'notificacao.editar'),
+('ADM', 'atendimento.criar'),
+('ADM', 'atendimento.ler'),
+('ADM', 'atendimento.transferir'),
+('ADM', 'apontamento.criar'),
+('ADM', 'apontamento.ler'),
+('ADM', 'apontamento.editar'),
+('ADM', 'apontamento.deletar'),
+('ADM', 'avaliacao.responder'),
+('ADM', 'avaliacao.ler'),
+('ADM', 'avaliacao.ler_csat'),
+('ADM', 'categoria_permissao.ler'),
+('ADM', 'categoria_permissao.editar'),
+('ADM', 'politica_sla.ler'),
+('ADM', 'politica_sla.editar'),
+('ADM', 'calendario.ler'),
+('ADM', 'calendario.editar'),
+('ADM', 'calendario.tempo_util'),
+('ADM', 'prioridade.ler'),
+('ADM', 'prioridade.editar'),
+('ADM', 'atribuicao.previa'),
+('ADM', 'autorizacao.ler'),
+('ADM', 'webhook.ler'),
+('ADM', 'webhook.editar'),
+('ADM', 'papel.ler'),
+('ADM', 'papel.editar');
+
+-- Técnico (TEC)
+INSERT IGNORE INTO papel_permissoes (papel, permissao) VALUES
+('TEC', 'chamado.criar'),
+('TEC', 'chamado.ler'),
+('TEC', 'chamado.observar'),
+('TEC', 'chamado.editar'),
+('TEC', 'chamado.alterar_status'),
+('TEC', 'chamado.alterar_prioridade'),
+('TEC', 'chamado.reabrir'),
+('TEC', 'chamado.ler_reaberturas'),
+('TEC', 'chamado.arquivar'),
+('TEC', 'categoria.ler'),
+('TEC', 'subcategoria.ler'),
+('TEC', 'log.ler'),
+('TEC', 'acompanhamento.criar'),
+('TEC', 'acompanhamento.ler'),
+('TEC', 'acompanhamento.editar'),
+('TEC', 'acompanhamento.deletar'),
+('TEC', 'anexo.enviar'),
+('TEC', 'anexo.ler'),
+('TEC', 'anexo.deletar'),
+('TEC', 'evento.assinar'),
+('TEC', 'notificacao.ler'),
+('TEC', 'notificacao.editar'),
+('TEC', 'atendimento.criar'),
+('TEC', 'atendimento.ler'),
+('TEC', 'atendimento.transferir'),
+('TEC', 'apontamento.criar'),
+('TEC', 'apontamento.ler'),
+('TEC', 'apontamento.editar'),
+('TEC', 'apontamento.deletar'),
+('TEC', 'avaliacao.responder'),
+('TEC', 'avaliacao.ler'),
+('TEC', 'avaliacao.ler_csat'),
+('TEC', 'categoria_permissao.ler'),
+('TEC', 'politica_sla.ler'),
+('TEC', 'calendario.ler'),
+('TEC', 'calendario.tempo_util'),
+('TEC', 'prioridade.ler'),
+('TEC', 'autorizacao.ler');
+
+-- Usuário (USR)
+-- As permissões nomeadas só liberam a rota; a operação sobre o chamado ainda é autorizada pela
+-- permissão efetiva do usuário na categoria. Por isso o USR recebe chamado.alterar_prioridade,
+-- chamado.arquivar e atendimento.transferir: sem permissão concedida na categoria, a operação
+-- é recusada, e o usuário com permissão TEC concedida em uma categoria precisa alcançar a rota
+-- para operar os chamados dela.
+INSERT IGNORE INTO papel_permissoes (papel, permissao) VALUES
+('USR', 'chamado.criar'),
+('USR', 'chamado.ler'),
+('USR', 'chamado.observar'),
+('USR', 'chamado.editar'),
+('USR', 'chamado.alterar_status'),
+('USR', 'chamado.alterar_prioridade'),
+('USR', 'chamado.reabrir'),
+('USR', 'chamado.arquivar'),
+('USR', 'categoria.ler'),
+('USR', 'subcategoria.ler'),
+('USR', 'acompanhamento.criar'),
+('USR', 'acompanhamento.ler'),
+('USR', 'acompanhamento.editar'),
+('USR', 'acompanhamento.deletar'),
+('USR', 'anexo.enviar'),
+('USR', 'anexo.ler'),
+('USR', 'anexo.deletar'),
+('USR', 'evento.assinar'),
+('USR', 'notificacao.ler'),
+('USR', 'notificacao.editar'),
+('USR', 'atendimento.criar'),
+('USR', 'atendimento.ler'),
+('USR', 'atendimento.transferir'),
+('USR', 'avaliacao.responder'),
+('USR', 'avaliacao.ler'),
+('USR', 'categoria_permissao.ler'),
+('USR', 'calendario.tempo_util'),
+('USR', 'prioridade.ler'),
+('USR', 'autorizacao.ler');
+
+-- Desenvolvedor (DEV)
+INSERT IGNORE INTO papel_permissoes (papel, permissao) VALUES
+('DEV', 'chamado.criar'),
+('DEV', 'chamado.ler'),
+('DEV', 'chamado.observar'),
+('DEV', 'chamado.editar'),
+('DEV', 'chamado.alterar_status'),
+('DEV', 'chamado.alterar_prioridade'),
+('DEV', 'chamado.reabrir'),
+('DEV', 'chamado.ler_reaberturas'),
+('DEV', 'chamado.arquivar'),
+('DEV', 'categoria.ler'),
+('DEV', 'subcategoria.ler'),
+('DEV', 'acompanhamento.criar'),
+('DEV', 'acompanhamento.ler'),
+('DEV', 'acompanhamento.editar'),
+('DEV', 'acompanhamento.deletar'),
+('DEV', 'anexo.enviar'),
+('DEV', 'anexo.ler'),
+('DEV', 'anexo.deletar'),
+('DEV', 'evento.assinar'),
+('DEV', 'notificacao.ler'),
+('DEV', 'notificacao.editar'),
+('DEV', 'atendimento.criar'),
+('DEV', 'atendimento.ler'),
+('DEV', 'atendimento.transferir'),
+('DEV', 'apontamento.criar'),
+('DEV', 'apontamento.ler'),
+('DEV', 'apontamento.editar'),
+('DEV', 'apontamento.deletar'),
+('DEV', 'avaliacao.responder'),
+('DEV', 'avaliacao.ler'),
+('DEV', 'avaliacao.ler_csat'),
+('DEV', 'categoria_permissao.ler'),
+('DEV', 'politica_sla.ler'),
+('DEV', 'calendario.ler'),
+('DEV', 'calendario.tempo_util'),
+('DEV', 'prioridade.ler'),
+('DEV', 'autorizacao.ler');
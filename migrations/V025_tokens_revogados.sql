@@ -0,0 +1,22 @@
+-- Tokens de acesso e de refresh revogados no logout, até a expiração
+CREATE TABLE IF NOT EXISTS tokens_revogados (
+  jti         CHAR(36) NOT NULL PRIMARY KEY,
+  usuario_id  CHAR(36) NOT NULL,
+  expira_em   DATETIME NOT NULL,
+  revogado_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (usuario_id) REFERENCES usuarios(id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+  INDEX idx_tokens_revogados_usuario_expira_em (usuario_id, expira_em),
+  INDEX idx_tokens_revogados_expira_em (expira_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
+-- Revogação de todos os tokens do usuário emitidos até o instante registrado: logout de todas
+-- as sessões, desativação do usuário e alteração da permissão
+CREATE TABLE IF NOT EXISTS revogacoes_usuarios (
+  usuario_id  CHAR(36) NOT NULL PRIMARY KEY,
+  revogado_em DATETIME NOT NULL,
+
+  FOREIGN KEY (usuario_id) REFERENCES usuarios(id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
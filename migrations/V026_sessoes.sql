@@ -0,0 +1,28 @@
+-- Sessões abertas no login e mantidas pelos tokens de refresh. Cada refresh substitui o token
+-- de refresh vigente; a reapresentação de um token substituído encerra a sessão
+CREATE TABLE IF NOT EXISTS sessoes (
+  id            CHAR(36)     NOT NULL PRIMARY KEY,
+  usuario_id    CHAR(36)     NOT NULL,
+  dispositivo   VARCHAR(255) NULL, -- user agent do cliente
+  ip            VARCHAR(45)  NULL,
+  refresh_jti   CHAR(36)     NOT NULL, -- jti do único token de refresh válido da sessão
+  criado_em     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  ultimo_uso_em DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expira_em     DATETIME     NOT NULL,
+  revogada_em   DATETIME     NULL,
+
+  FOREIGN KEY (usuario_id) REFERENCES usuarios(id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+  INDEX idx_sessoes_usuario_expira_em (usuario_id, expira_em),
+  INDEX idx_sessoes_expira_em (expira_em)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
+-- Consulta e encerramento das próprias sessões, para todos os papéis do sistema, e das
+-- sessões dos demais usuários, para o ADM
+INSERT IGNORE INTO papel_permissoes (papel, permissao) VALUES
+('ADM', 'sessao.propria'),
+('ADM', 'sessao.gerenciar'),
+('TEC', 'sessao.propria'),
+('USR', 'sessao.propria'),
+('DEV', 'sessao.propria');
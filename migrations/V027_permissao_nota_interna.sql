@@ -0,0 +1,6 @@
+-- Permissão nomeada que concede as notas internas aos papéis. Nos papéis personalizados, as
+-- operações sobre os chamados passam a seguir as permissões nomeadas do papel
+INSERT IGNORE INTO papel_permissoes (papel, permissao) VALUES
+('ADM', 'acompanhamento.nota_interna'),
+('TEC', 'acompanhamento.nota_interna'),
+('DEV', 'acompanhamento.nota_interna');